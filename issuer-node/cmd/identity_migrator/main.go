@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/identityarchive"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	// passphraseEnv is the environment variable with the passphrase of the archive.
+	// It is not a flag to keep it out of the shell history.
+	passphraseEnv = "ISSUER_IDENTITY_ARCHIVE_PASSPHRASE"
+
+	permFile os.FileMode = 0o600
+)
+
+var (
+	fOperation = flag.String("operation", "export", "operation to perform: export or import")
+	fDID       = flag.String("did", "", "did of the identity to export")
+	fFile      = flag.String("file", "identity.archive", "archive file to write on export or to read on import")
+)
+
+// This is a tool to move an identity, with its states, merkle trees, credentials and keys,
+// from one issuer node deployment to another.
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Error(ctx, "cannot load config", "err", err)
+		return
+	}
+	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
+
+	passphrase := os.Getenv(passphraseEnv)
+	if passphrase == "" {
+		log.Error(ctx, "the archive passphrase is required", "env", passphraseEnv)
+		return
+	}
+
+	storage, err := db.NewStorage(cfg.Database.URL)
+	if err != nil {
+		log.Error(ctx, "cannot connect to database", "err", err)
+		return
+	}
+	defer func(storage *db.Storage) {
+		if err := storage.Close(); err != nil {
+			log.Error(ctx, "error closing database connection", "err", err)
+		}
+	}(storage)
+
+	vaultCfg := providers.Config{
+		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
+		Pass:                cfg.KeyStore.VaultUserPassAuthPassword,
+		Address:             cfg.KeyStore.Address,
+		Token:               cfg.KeyStore.Token,
+		TLSEnabled:          cfg.KeyStore.TLSEnabled,
+		CertPath:            cfg.KeyStore.CertPath,
+	}
+	keyStore, err := config.KeyStoreConfig(ctx, cfg, vaultCfg)
+	if err != nil {
+		log.Error(ctx, "cannot initialize key store", "err", err)
+		return
+	}
+
+	switch *fOperation {
+	case "export":
+		did, err := w3c.ParseDID(*fDID)
+		if err != nil {
+			log.Error(ctx, "a valid did is required to export an identity", "err", err, "did", *fDID)
+			return
+		}
+		archive, err := identityarchive.Export(ctx, storage.Pgx, keyStore, *did)
+		if err != nil {
+			log.Error(ctx, "cannot export identity", "err", err, "did", did)
+			return
+		}
+		content, err := identityarchive.Encrypt(archive, passphrase)
+		if err != nil {
+			log.Error(ctx, "cannot encrypt archive", "err", err)
+			return
+		}
+		if err := os.WriteFile(*fFile, content, permFile); err != nil {
+			log.Error(ctx, "cannot write archive", "err", err, "file", *fFile)
+			return
+		}
+		log.Info(ctx, "identity exported", "did", did, "file", *fFile)
+	case "import":
+		content, err := os.ReadFile(*fFile)
+		if err != nil {
+			log.Error(ctx, "cannot read archive", "err", err, "file", *fFile)
+			return
+		}
+		archive, err := identityarchive.Decrypt(content, passphrase)
+		if err != nil {
+			log.Error(ctx, "cannot decrypt archive", "err", err, "file", *fFile)
+			return
+		}
+		mtService := services.NewIdentityMerkleTrees(repositories.NewIdentityMerkleTreeRepository())
+		if err := identityarchive.Import(ctx, storage, keyStore, mtService, archive); err != nil {
+			log.Error(ctx, "cannot import identity", "err", err, "did", archive.DID)
+			return
+		}
+		log.Info(ctx, "identity imported", "did", archive.DID, "file", *fFile)
+	default:
+		log.Error(ctx, "unknown operation", "operation", *fOperation)
+	}
+}
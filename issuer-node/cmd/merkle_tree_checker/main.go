@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	exitError     = 1
+	exitUnhealthy = 2
+)
+
+var (
+	fDID    = flag.String("did", "", "did of the identity to check")
+	fRepair = flag.Bool("repair", false, "regenerate the stale merkle tree proofs of the credentials included in the last confirmed state")
+)
+
+// This is a tool to rebuild the merkle trees of an identity from its credentials and revocations,
+// and report the roots that diverge from the stored trees, the stored states and the state published on chain.
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Error(ctx, "cannot load config", "err", err)
+		return exitError
+	}
+	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stderr)
+
+	did, err := w3c.ParseDID(*fDID)
+	if err != nil {
+		log.Error(ctx, "a valid did is required", "err", err, "did", *fDID)
+		return exitError
+	}
+
+	storage, err := db.NewStorage(cfg.Database.URL)
+	if err != nil {
+		log.Error(ctx, "cannot connect to database", "err", err)
+		return exitError
+	}
+	defer func(storage *db.Storage) {
+		if err := storage.Close(); err != nil {
+			log.Error(ctx, "error closing database connection", "err", err)
+		}
+	}(storage)
+
+	vaultCfg := providers.Config{
+		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
+		Pass:                cfg.KeyStore.VaultUserPassAuthPassword,
+		Address:             cfg.KeyStore.Address,
+		Token:               cfg.KeyStore.Token,
+		TLSEnabled:          cfg.KeyStore.TLSEnabled,
+		CertPath:            cfg.KeyStore.CertPath,
+	}
+	keyStore, err := config.KeyStoreConfig(ctx, cfg, vaultCfg)
+	if err != nil {
+		log.Error(ctx, "cannot initialize key store", "err", err)
+		return exitError
+	}
+
+	reader, err := network.GetReaderFromConfig(cfg, ctx)
+	if err != nil {
+		log.Error(ctx, "cannot read network resolver file", "err", err)
+		return exitError
+	}
+	networkResolver, err := network.NewResolver(ctx, *cfg, keyStore, reader)
+	if err != nil {
+		log.Error(ctx, "failed initialize network resolver", "err", err)
+		return exitError
+	}
+
+	mtService := services.NewIdentityMerkleTrees(repositories.NewIdentityMerkleTreeRepository())
+	integrityService := services.NewMerkleTreeIntegrity(storage, mtService, repositories.NewIdentityState(), repositories.NewClaim(), repositories.NewRevocation(), *networkResolver)
+
+	report, err := integrityService.Check(ctx, *did, *fRepair)
+	if err != nil {
+		log.Error(ctx, "cannot check the merkle trees", "err", err, "did", did)
+		return exitError
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Error(ctx, "cannot write the report", "err", err)
+		return exitError
+	}
+	if len(report.Divergences) > 0 || len(report.RepairedProofs) < len(report.StaleProofs) {
+		return exitUnhealthy
+	}
+	return 0
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/eth"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
@@ -122,7 +123,7 @@ func main() {
 	)
 
 	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, services.NewStatusList(storage, repositories.NewStatusList(*storage), keyStore, revocationStatusResolver), mediaTypeManager, cfg.UniversalLinks, nil)
 
 	circuitsLoaderService := circuitLoaders.NewCircuits(cfg.Circuit.Path)
 	proofService := initProofService(circuitsLoaderService)
@@ -132,12 +133,21 @@ func main() {
 		log.Error(ctx, "error creating transaction service", "err", err)
 		panic("error creating transaction service")
 	}
-	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath)
+	accountService := services.NewAccountService(*networkResolver)
+	nonceAllocator := services.NewNonceAllocator(storage, repositories.NewPublishingNonce())
+	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPaths(), eth.AccountSelection(cfg.PublishingAccountSelection), accountService, nonceAllocator)
 	if err != nil {
 		log.Error(ctx, "error creating publish gateway", "err", err)
 		panic("error creating publish gateway")
 	}
 	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, networkResolver, ps)
+	publishPolicyService := services.NewPublishPolicy(repositories.NewPublishPolicy(*storage), identityRepo, publisher, storage)
+	stateBridgeGateway, err := gateways.NewStateBridgeEthGateway(*networkResolver, cfg.PublishingKeyPaths(), eth.AccountSelection(cfg.PublishingAccountSelection), nonceAllocator)
+	if err != nil {
+		log.Error(ctx, "error creating state bridge gateway", "err", err)
+		panic("error creating state bridge gateway")
+	}
+	stateReplicationService := services.NewStateReplication(repositories.NewStateReplication(), identityStateRepo, stateBridgeGateway, *networkResolver, storage)
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
@@ -155,6 +165,38 @@ func main() {
 		}
 	}(ctx)
 
+	go func(ctx context.Context) {
+		ticker := time.NewTicker(cfg.OnChainPublishStateFrequency)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := publishPolicyService.PublishDue(ctx); err != nil {
+					log.Error(ctx, "publishing pending states", "err", err)
+				}
+			case <-ctx.Done():
+				log.Info(ctx, "finishing publish states job")
+				return
+			}
+		}
+	}(ctx)
+
+	go func(ctx context.Context) {
+		ticker := time.NewTicker(cfg.OnChainCheckStatusFrequency)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := stateReplicationService.Replicate(ctx); err != nil {
+					log.Error(ctx, "replicating states", "err", err)
+				}
+			case <-ctx.Done():
+				log.Info(ctx, "finishing replicate states job")
+				return
+			}
+		}
+	}(ctx)
+
 	go func() {
 		http.Handle("/status", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			_, err := w.Write([]byte("OK"))
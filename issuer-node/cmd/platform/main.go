@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
@@ -21,14 +22,18 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/buildinfo"
 	"github.com/polygonid/sh-id-platform/internal/cache"
 	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/eth"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/health"
+	httpPkg "github.com/polygonid/sh-id-platform/internal/http"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/oidc"
 	"github.com/polygonid/sh-id-platform/internal/packagemanager"
 	"github.com/polygonid/sh-id-platform/internal/payments"
 	"github.com/polygonid/sh-id-platform/internal/providers"
@@ -41,6 +46,9 @@ import (
 
 var build = buildinfo.Revision()
 
+// idempotencyKeysCleanerFrequency is how often the expired idempotency keys are deleted
+const idempotencyKeysCleanerFrequency = time.Hour
+
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -115,6 +123,7 @@ func main() {
 	sessionRepository := repositories.NewSessionCached(cachex)
 	keyRepository := repositories.NewKey(*storage)
 	paymentsRepo := repositories.NewPayment(*storage)
+	bulkIssuanceRepository := repositories.NewBulkIssuance(*storage)
 
 	// services initialization
 	mtService := services.NewIdentityMerkleTrees(mtRepository)
@@ -126,6 +135,7 @@ func main() {
 			iden3commProtocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
 			iden3commProtocol.RevocationStatusRequestMessageType: {"*"},
 			iden3commProtocol.DiscoverFeatureQueriesMessageType:  {"*"},
+			iden3commProtocol.CredentialRefreshMessageType:       {string(packers.MediaTypeZKPMessage)},
 		},
 		*cfg.MediaTypeManager.Enabled,
 	)
@@ -156,7 +166,9 @@ func main() {
 
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
 	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionsRepository, storage, verifier, sessionRepository, ps, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	statusListRepository := repositories.NewStatusList(*storage)
+	statusListService := services.NewStatusList(storage, statusListRepository, keyStore, revocationStatusResolver)
+	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, ps, cfg.IPFS.GatewayURL, revocationStatusResolver, statusListService, mediaTypeManager, cfg.UniversalLinks, services.NewEncryptionKeyResolver(connectionsRepository, storage, universalDIDResolverHandler))
 	proofService := services.NewProver(circuitsLoaderService)
 	displayMethodService := services.NewDisplayMethod(repositories.NewDisplayMethod(*storage))
 	schemaService := services.NewSchema(schemaRepository, schemaLoader, displayMethodService)
@@ -167,6 +179,30 @@ func main() {
 		return
 	}
 	keyService := services.NewKey(keyStore, claimsService, keyRepository)
+	credentialExportService := services.NewCredentialExport(keyService, keyStore)
+	credentialTemplateService := services.NewCredentialTemplate(repositories.NewCredentialTemplate(*storage), schemaService, displayMethodService)
+	notificationService := services.NewNotification(gateways.NewPushNotificationClient(httpPkg.DefaultHTTPClientWithRetry), connectionsService, claimsService)
+	credentialExpirationService := services.NewCredentialExpiration(repositories.NewCredentialExpirationPolicy(*storage), schemaService, claimsRepository, claimsService, notificationService, storage)
+	var refreshDataSource ports.RefreshDataSource
+	if cfg.RefreshService.DataSourceURL != "" {
+		refreshDataSource = gateways.NewHTTPRefreshDataSource(httpPkg.DefaultHTTPClientWithRetry, cfg.RefreshService.DataSourceURL)
+	}
+	refreshService := services.NewRefresh(claimsService, refreshDataSource, mediaTypeManager)
+	verificationService := services.NewVerification(repositories.NewVerification(*storage), verifier, qrService, cfg.UniversalLinks)
+	idempotencyService := services.NewIdempotency(repositories.NewIdempotencyKey(*storage), cfg.IdempotencyKeys.TTL)
+	apiKeyService := services.NewAPIKey(storage, repositories.NewAPIKey(*storage), identityRepository)
+	didDocumentService := services.NewDIDDocument(storage, identityRepository, claimsRepository, keyStore, cfg.ServerUrl, cfg.DIDDocument)
+	credentialVerificationService := services.NewCredentialVerification(identityStateRepository, claimsService, statusListRepository, didDocumentService, storage, schemaLoader)
+	merkleTreeIntegrityService := services.NewMerkleTreeIntegrity(storage, mtService, identityStateRepository, claimsRepository, revocationRepository, *networkResolver)
+	var tokenAuthenticator *oidc.Authenticator
+	if cfg.OIDC.Enabled() {
+		tokenAuthenticator, err = oidc.NewAuthenticator(ctx, cfg.OIDC)
+		if err != nil {
+			log.Error(ctx, "error creating oidc authenticator", "err", err)
+			return
+		}
+	}
+	bulkIssuanceService := services.NewBulkIssuance(storage, bulkIssuanceRepository, schemaService, claimsService, claimsRepository, schemaLoader, ps, cfg.BulkIssuance.BatchSize)
 	transactionService, err := gateways.NewTransaction(*networkResolver)
 	discoveryService := services.NewDiscovery(mediaTypeManager, packageManager, mediaTypeManager.GetSupportedProtocolMessages())
 	if err != nil {
@@ -175,13 +211,22 @@ func main() {
 	}
 	accountService := services.NewAccountService(*networkResolver)
 
-	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath)
+	nonceAllocator := services.NewNonceAllocator(storage, repositories.NewPublishingNonce())
+	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPaths(), eth.AccountSelection(cfg.PublishingAccountSelection), accountService, nonceAllocator)
 	if err != nil {
 		log.Error(ctx, "error creating publish gateway", "err", err)
 		return
 	}
 
 	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, networkResolver, ps)
+	publishPolicyService := services.NewPublishPolicy(repositories.NewPublishPolicy(*storage), identityRepository, publisher, storage)
+	stateBridgeGateway, err := gateways.NewStateBridgeEthGateway(*networkResolver, cfg.PublishingKeyPaths(), eth.AccountSelection(cfg.PublishingAccountSelection), nonceAllocator)
+	if err != nil {
+		log.Error(ctx, "error creating state bridge gateway", "err", err)
+		return
+	}
+	stateReplicationService := services.NewStateReplication(repositories.NewStateReplication(), identityStateRepository, stateBridgeGateway, *networkResolver, storage)
+	keyRotationService := services.NewKeyRotation(storage, repositories.NewKeyRotation(*storage), keyService, identityService, claimsService, claimsRepository, publisher)
 
 	serverHealth := health.New(health.Monitors{
 		"postgres": storage.Ping,
@@ -191,12 +236,21 @@ func main() {
 	})
 	serverHealth.Run(ctx, health.DefaultPingPeriod)
 
+	go runBulkIssuanceWorker(ctx, bulkIssuanceService, cfg.BulkIssuance.WorkerFrequency)
+	go runIdempotencyKeysCleaner(ctx, idempotencyService, idempotencyKeysCleanerFrequency)
+	if cfg.CredentialExpiration.SweeperFrequency > 0 {
+		go runCredentialExpirationSweeper(ctx, credentialExpirationService, cfg.CredentialExpiration.SweeperFrequency)
+	}
+	if cfg.KeyRotation.WorkerFrequency > 0 {
+		go runKeyRotationWorker(ctx, keyRotationService, cfg.KeyRotation.WorkerFrequency)
+	}
+
 	mux := chi.NewRouter()
 
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:8088", "http://localhost:3000", "http://localhost:3001", "https://bradly-subfoliar-beefily.ngrok-free.dev", "localhost", "127.0.0.1", "*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "Idempotency-Key", "X-API-Key"},
 		AllowCredentials: true,
 	})
 
@@ -210,8 +264,8 @@ func main() {
 
 	api.HandlerWithOptions(
 		api.NewStrictHandlerWithOptions(
-			api.NewServer(cfg, identityService, accountService, connectionsService, claimsService, qrService, publisher, packageManager, *networkResolver, serverHealth, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService),
-			middlewares(ctx, cfg.HTTPBasicAuth),
+			api.NewServer(cfg, identityService, accountService, connectionsService, claimsService, qrService, publisher, packageManager, *networkResolver, serverHealth, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService, bulkIssuanceService, statusListService, credentialExportService, credentialTemplateService, credentialExpirationService, refreshService, credentialVerificationService, verificationService, keyRotationService, apiKeyService, didDocumentService, merkleTreeIntegrityService, publishPolicyService, stateReplicationService),
+			middlewares(ctx, cfg.HTTPBasicAuth, idempotencyService, apiKeyService, tokenAuthenticator),
 			api.StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
@@ -240,9 +294,78 @@ func main() {
 	log.Info(ctx, "Shutting down")
 }
 
-func middlewares(ctx context.Context, auth config.HTTPBasicAuth) []api.StrictMiddlewareFunc {
+// runBulkIssuanceWorker issues the credentials of the pending bulk issuance jobs periodically until the context is done
+func runBulkIssuanceWorker(ctx context.Context, bulkIssuanceService ports.BulkIssuanceService, frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := bulkIssuanceService.ProcessPendingJobs(ctx); err != nil {
+				log.Error(ctx, "processing bulk issuance jobs", "err", err)
+			}
+		case <-ctx.Done():
+			log.Info(ctx, "finishing bulk issuance worker")
+			return
+		}
+	}
+}
+
+// runCredentialExpirationSweeper applies the credential expiration policies periodically until the context is done
+func runCredentialExpirationSweeper(ctx context.Context, credentialExpirationService ports.CredentialExpirationService, frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := credentialExpirationService.Sweep(ctx); err != nil {
+				log.Error(ctx, "sweeping expiring credentials", "err", err)
+			}
+		case <-ctx.Done():
+			log.Info(ctx, "finishing credential expiration sweeper")
+			return
+		}
+	}
+}
+
+// runKeyRotationWorker resumes the unfinished key rotations periodically until the context is done
+func runKeyRotationWorker(ctx context.Context, keyRotationService ports.KeyRotationService, frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := keyRotationService.ProcessPending(ctx); err != nil {
+				log.Error(ctx, "processing key rotations", "err", err)
+			}
+		case <-ctx.Done():
+			log.Info(ctx, "finishing key rotation worker")
+			return
+		}
+	}
+}
+
+// runIdempotencyKeysCleaner deletes the expired idempotency keys periodically until the context is done
+func runIdempotencyKeysCleaner(ctx context.Context, idempotencyService ports.IdempotencyService, frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := idempotencyService.DeleteExpired(ctx); err != nil {
+				log.Error(ctx, "deleting expired idempotency keys", "err", err)
+			}
+		case <-ctx.Done():
+			log.Info(ctx, "finishing idempotency keys cleaner")
+			return
+		}
+	}
+}
+
+func middlewares(ctx context.Context, auth config.HTTPBasicAuth, idempotencyService ports.IdempotencyService, apiKeyService ports.APIKeyService, tokenAuthenticator *oidc.Authenticator) []api.StrictMiddlewareFunc {
 	return []api.StrictMiddlewareFunc{
 		api.LogMiddleware(ctx),
-		api.BasicAuthMiddleware(ctx, auth.User, auth.Password),
+		api.IdempotencyMiddleware(idempotencyService),
+		api.AuthMiddleware(ctx, auth.User, auth.Password, apiKeyService, tokenAuthenticator),
 	}
 }
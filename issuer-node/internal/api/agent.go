@@ -45,6 +45,13 @@ func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentRe
 			return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
 		}
 
+	case protocol.CredentialRefreshMessageType:
+		response, err = s.refreshService.Agent(ctx, req, mediatype)
+		if err != nil {
+			log.Error(ctx, "agent error", "err", err)
+			return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+
 	default:
 		log.Error(ctx, "agent error", "err", "type is not supported", basicMessage.Type)
 	}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	domain "github.com/polygonid/sh-id-platform/internal/core/domain"
 	payments "github.com/polygonid/sh-id-platform/internal/payments"
 	timeapi "github.com/polygonid/sh-id-platform/internal/timeapi"
 )
@@ -26,6 +28,26 @@ const (
 	BasicAuthScopes = "basicAuth.Scopes"
 )
 
+// Defines values for BulkIssuanceJobFormat.
+const (
+	BulkIssuanceJobFormatCsv    BulkIssuanceJobFormat = "csv"
+	BulkIssuanceJobFormatNdjson BulkIssuanceJobFormat = "ndjson"
+)
+
+// Defines values for BulkIssuanceJobStatus.
+const (
+	BulkIssuanceJobStatusCompleted  BulkIssuanceJobStatus = "completed"
+	BulkIssuanceJobStatusPending    BulkIssuanceJobStatus = "pending"
+	BulkIssuanceJobStatusProcessing BulkIssuanceJobStatus = "processing"
+)
+
+// Defines values for BulkIssuanceJobRowStatus.
+const (
+	BulkIssuanceJobRowStatusFailed  BulkIssuanceJobRowStatus = "failed"
+	BulkIssuanceJobRowStatusIssued  BulkIssuanceJobRowStatus = "issued"
+	BulkIssuanceJobRowStatusPending BulkIssuanceJobRowStatus = "pending"
+)
+
 // Defines values for CreateAuthCredentialRequestCredentialStatusType.
 const (
 	CreateAuthCredentialRequestCredentialStatusTypeIden3OnchainSparseMerkleTreeProof2023 CreateAuthCredentialRequestCredentialStatusType = "Iden3OnchainSparseMerkleTreeProof2023"
@@ -33,8 +55,36 @@ const (
 	CreateAuthCredentialRequestCredentialStatusTypeIden3commRevocationStatusV10          CreateAuthCredentialRequestCredentialStatusType = "Iden3commRevocationStatusV1.0"
 )
 
+// Defines values for CreateBulkIssuanceJobRequestCredentialStatusType.
+const (
+	CreateBulkIssuanceJobRequestCredentialStatusTypeIden3OnchainSparseMerkleTreeProof2023 CreateBulkIssuanceJobRequestCredentialStatusType = "Iden3OnchainSparseMerkleTreeProof2023"
+	CreateBulkIssuanceJobRequestCredentialStatusTypeIden3ReverseSparseMerkleTreeProof     CreateBulkIssuanceJobRequestCredentialStatusType = "Iden3ReverseSparseMerkleTreeProof"
+	CreateBulkIssuanceJobRequestCredentialStatusTypeIden3commRevocationStatusV10          CreateBulkIssuanceJobRequestCredentialStatusType = "Iden3commRevocationStatusV1.0"
+)
+
+// Defines values for CreateBulkIssuanceJobRequestFormat.
+const (
+	CreateBulkIssuanceJobRequestFormatCsv    CreateBulkIssuanceJobRequestFormat = "csv"
+	CreateBulkIssuanceJobRequestFormatNdjson CreateBulkIssuanceJobRequestFormat = "ndjson"
+)
+
+// Defines values for CreateBulkIssuanceJobRequestProofs.
+const (
+	CreateBulkIssuanceJobRequestProofsBJJSignature2021           CreateBulkIssuanceJobRequestProofs = "BJJSignature2021"
+	CreateBulkIssuanceJobRequestProofsIden3SparseMerkleTreeProof CreateBulkIssuanceJobRequestProofs = "Iden3SparseMerkleTreeProof"
+)
+
+// Defines values for CreateCredentialFromTemplateRequestCredentialStatusType.
+const (
+	CreateCredentialFromTemplateRequestCredentialStatusTypeBitstringStatusListEntry              CreateCredentialFromTemplateRequestCredentialStatusType = "BitstringStatusListEntry"
+	CreateCredentialFromTemplateRequestCredentialStatusTypeIden3OnchainSparseMerkleTreeProof2023 CreateCredentialFromTemplateRequestCredentialStatusType = "Iden3OnchainSparseMerkleTreeProof2023"
+	CreateCredentialFromTemplateRequestCredentialStatusTypeIden3ReverseSparseMerkleTreeProof     CreateCredentialFromTemplateRequestCredentialStatusType = "Iden3ReverseSparseMerkleTreeProof"
+	CreateCredentialFromTemplateRequestCredentialStatusTypeIden3commRevocationStatusV10          CreateCredentialFromTemplateRequestCredentialStatusType = "Iden3commRevocationStatusV1.0"
+)
+
 // Defines values for CreateCredentialRequestCredentialStatusType.
 const (
+	CreateCredentialRequestCredentialStatusTypeBitstringStatusListEntry              CreateCredentialRequestCredentialStatusType = "BitstringStatusListEntry"
 	CreateCredentialRequestCredentialStatusTypeIden3OnchainSparseMerkleTreeProof2023 CreateCredentialRequestCredentialStatusType = "Iden3OnchainSparseMerkleTreeProof2023"
 	CreateCredentialRequestCredentialStatusTypeIden3ReverseSparseMerkleTreeProof     CreateCredentialRequestCredentialStatusType = "Iden3ReverseSparseMerkleTreeProof"
 	CreateCredentialRequestCredentialStatusTypeIden3commRevocationStatusV10          CreateCredentialRequestCredentialStatusType = "Iden3commRevocationStatusV1.0"
@@ -42,8 +92,8 @@ const (
 
 // Defines values for CreateCredentialRequestProofs.
 const (
-	BJJSignature2021           CreateCredentialRequestProofs = "BJJSignature2021"
-	Iden3SparseMerkleTreeProof CreateCredentialRequestProofs = "Iden3SparseMerkleTreeProof"
+	CreateCredentialRequestProofsBJJSignature2021           CreateCredentialRequestProofs = "BJJSignature2021"
+	CreateCredentialRequestProofsIden3SparseMerkleTreeProof CreateCredentialRequestProofs = "Iden3SparseMerkleTreeProof"
 )
 
 // Defines values for CreateIdentityRequestCredentialStatusType.
@@ -55,8 +105,9 @@ const (
 
 // Defines values for CreateIdentityRequestDidMetadataType.
 const (
-	BJJ CreateIdentityRequestDidMetadataType = "BJJ"
-	ETH CreateIdentityRequestDidMetadataType = "ETH"
+	BJJ        CreateIdentityRequestDidMetadataType = "BJJ"
+	ETH        CreateIdentityRequestDidMetadataType = "ETH"
+	Ed25519Key CreateIdentityRequestDidMetadataType = "Ed25519"
 )
 
 // Defines values for CreateIdentityResponseCredentialStatusType.
@@ -82,6 +133,35 @@ const (
 	CreatePaymentRequestResponseStatusSuccess     CreatePaymentRequestResponseStatus = "success"
 )
 
+// Defines values for CredentialEncryptionPolicy.
+const (
+	None      CredentialEncryptionPolicy = "none"
+	Preferred CredentialEncryptionPolicy = "preferred"
+	Required  CredentialEncryptionPolicy = "required"
+)
+
+// Defines values for CredentialJWTExportFormat.
+const (
+	CredentialJWTExportFormatJwtVc   CredentialJWTExportFormat = "jwt-vc"
+	CredentialJWTExportFormatSdJwtVc CredentialJWTExportFormat = "sd-jwt-vc"
+)
+
+// Defines values for CredentialVerificationCheckName.
+const (
+	BJJSignatureProof2021      CredentialVerificationCheckName = "BJJSignatureProof2021"
+	CredentialStatus           CredentialVerificationCheckName = "credentialStatus"
+	Expiration                 CredentialVerificationCheckName = "expiration"
+	Iden3SparseMerkleTreeProof CredentialVerificationCheckName = "Iden3SparseMerkleTreeProof"
+	JsonWebSignature2020       CredentialVerificationCheckName = "JsonWebSignature2020"
+)
+
+// Defines values for CredentialVerificationCheckStatus.
+const (
+	CredentialVerificationCheckStatusFailed  CredentialVerificationCheckStatus = "failed"
+	CredentialVerificationCheckStatusPassed  CredentialVerificationCheckStatus = "passed"
+	CredentialVerificationCheckStatusSkipped CredentialVerificationCheckStatus = "skipped"
+)
+
 // Defines values for DisplayMethodType.
 const (
 	Iden3BasicDisplayMethodV1 DisplayMethodType = "Iden3BasicDisplayMethodV1"
@@ -108,6 +188,18 @@ const (
 	KeyKeyTypeSecp256k1  KeyKeyType = "secp256k1"
 )
 
+// Defines values for KeyRotationStep.
+const (
+	Completed            KeyRotationStep = "completed"
+	CreateAuthCredential KeyRotationStep = "create_auth_credential"
+	CreateKey            KeyRotationStep = "create_key"
+	PublishState         KeyRotationStep = "publish_state"
+	RepublishState       KeyRotationStep = "republish_state"
+	ResignCredentials    KeyRotationStep = "resign_credentials"
+	RevokeAuthCredential KeyRotationStep = "revoke_auth_credential"
+	WaitConfirmation     KeyRotationStep = "wait_confirmation"
+)
+
 // Defines values for LinkStatus.
 const (
 	LinkStatusActive   LinkStatus = "active"
@@ -115,6 +207,21 @@ const (
 	LinkStatusInactive LinkStatus = "inactive"
 )
 
+// Defines values for MerkleTreeDivergenceSource.
+const (
+	MerkleTreeDivergenceSourceDatabase MerkleTreeDivergenceSource = "database"
+	MerkleTreeDivergenceSourceOnchain  MerkleTreeDivergenceSource = "onchain"
+	MerkleTreeDivergenceSourceState    MerkleTreeDivergenceSource = "state"
+)
+
+// Defines values for MerkleTreeDivergenceTree.
+const (
+	MerkleTreeDivergenceTreeClaims      MerkleTreeDivergenceTree = "claims"
+	MerkleTreeDivergenceTreeRevocations MerkleTreeDivergenceTree = "revocations"
+	MerkleTreeDivergenceTreeRoots       MerkleTreeDivergenceTree = "roots"
+	MerkleTreeDivergenceTreeState       MerkleTreeDivergenceTree = "state"
+)
+
 // Defines values for PaymentStatusStatus.
 const (
 	PaymentStatusStatusCanceled PaymentStatusStatus = "canceled"
@@ -128,12 +235,41 @@ const (
 	Iden3RefreshService2023 RefreshServiceType = "Iden3RefreshService2023"
 )
 
+// Defines values for StaleMTPProofReason.
+const (
+	StaleMTPProofReasonInvalid          StaleMTPProofReason = "invalid"
+	StaleMTPProofReasonMissing          StaleMTPProofReason = "missing"
+	StaleMTPProofReasonUnconfirmedState StaleMTPProofReason = "unconfirmedState"
+)
+
+// Defines values for StateChangeType.
+const (
+	StateChangeTypeCredential StateChangeType = "credential"
+	StateChangeTypeRevocation StateChangeType = "revocation"
+)
+
+// Defines values for StateReplicationStatus.
+const (
+	StateReplicationStatusConfirmed  StateReplicationStatus = "confirmed"
+	StateReplicationStatusFailed     StateReplicationStatus = "failed"
+	StateReplicationStatusPending    StateReplicationStatus = "pending"
+	StateReplicationStatusSuperseded StateReplicationStatus = "superseded"
+	StateReplicationStatusTransacted StateReplicationStatus = "transacted"
+)
+
 // Defines values for StateTransactionStatus.
 const (
-	Created   StateTransactionStatus = "created"
-	Failed    StateTransactionStatus = "failed"
-	Pending   StateTransactionStatus = "pending"
-	Published StateTransactionStatus = "published"
+	StateTransactionStatusCreated   StateTransactionStatus = "created"
+	StateTransactionStatusFailed    StateTransactionStatus = "failed"
+	StateTransactionStatusPending   StateTransactionStatus = "pending"
+	StateTransactionStatusPublished StateTransactionStatus = "published"
+)
+
+// Defines values for VerificationSessionStatus.
+const (
+	VerificationSessionStatusFailed   VerificationSessionStatus = "failed"
+	VerificationSessionStatusPending  VerificationSessionStatus = "pending"
+	VerificationSessionStatusVerified VerificationSessionStatus = "verified"
 )
 
 // Defines values for GetConnectionsParamsSort.
@@ -146,9 +282,10 @@ const (
 
 // Defines values for GetCredentialsParamsStatus.
 const (
-	GetCredentialsParamsStatusAll     GetCredentialsParamsStatus = "all"
-	GetCredentialsParamsStatusExpired GetCredentialsParamsStatus = "expired"
-	GetCredentialsParamsStatusRevoked GetCredentialsParamsStatus = "revoked"
+	GetCredentialsParamsStatusAll       GetCredentialsParamsStatus = "all"
+	GetCredentialsParamsStatusExpired   GetCredentialsParamsStatus = "expired"
+	GetCredentialsParamsStatusRevoked   GetCredentialsParamsStatus = "revoked"
+	GetCredentialsParamsStatusSuspended GetCredentialsParamsStatus = "suspended"
 )
 
 // Defines values for GetCredentialsParamsSort.
@@ -163,6 +300,13 @@ const (
 	GetCredentialsParamsSortSchemaType      GetCredentialsParamsSort = "schemaType"
 )
 
+// Defines values for GetBulkIssuanceJobRowsParamsStatus.
+const (
+	GetBulkIssuanceJobRowsParamsStatusFailed  GetBulkIssuanceJobRowsParamsStatus = "failed"
+	GetBulkIssuanceJobRowsParamsStatusIssued  GetBulkIssuanceJobRowsParamsStatus = "issued"
+	GetBulkIssuanceJobRowsParamsStatusPending GetBulkIssuanceJobRowsParamsStatus = "pending"
+)
+
 // Defines values for GetLinksParamsStatus.
 const (
 	GetLinksParamsStatusActive   GetLinksParamsStatus = "active"
@@ -171,6 +315,13 @@ const (
 	GetLinksParamsStatusInactive GetLinksParamsStatus = "inactive"
 )
 
+// Defines values for GetCredentialParamsFormat.
+const (
+	GetCredentialParamsFormatIden3   GetCredentialParamsFormat = "iden3"
+	GetCredentialParamsFormatJwtVc   GetCredentialParamsFormat = "jwt-vc"
+	GetCredentialParamsFormatSdJwtVc GetCredentialParamsFormat = "sd-jwt-vc"
+)
+
 // Defines values for GetCredentialOfferParamsType.
 const (
 	GetCredentialOfferParamsTypeDeepLink      GetCredentialOfferParamsType = "deepLink"
@@ -215,6 +366,21 @@ const (
 	AuthenticationParamsTypeRaw  AuthenticationParamsType = "raw"
 )
 
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt   TimeUTC            `json:"createdAt"`
+	Id          uuid.UUID          `json:"id"`
+	Identities  []string           `json:"identities"`
+	LastUsedAt  *TimeUTC           `json:"lastUsedAt"`
+	Name        string             `json:"name"`
+	Permissions []APIKeyPermission `json:"permissions"`
+}
+
+// APIKeyPermission One of identities:read, identities:write, connections:read, connections:write, credentials:read,
+// credentials:write, schemas:read, schemas:write, links:read, links:write, verifications:read,
+// verifications:write, keys:admin, payments:read, payments:write
+type APIKeyPermission = domain.APIKeyPermission
+
 // AgentResponse defines model for AgentResponse.
 type AgentResponse = BasicMessage
 
@@ -244,12 +410,65 @@ type BasicMessage struct {
 	Type string      `json:"type"`
 }
 
+// BulkIssuanceJob defines model for BulkIssuanceJob.
+type BulkIssuanceJob struct {
+	CreatedAt   TimeUTC               `json:"createdAt"`
+	FailedRows  int                   `json:"failedRows"`
+	Format      BulkIssuanceJobFormat `json:"format"`
+	Id          uuid.UUID             `json:"id"`
+	IssuedRows  int                   `json:"issuedRows"`
+	PendingRows int                   `json:"pendingRows"`
+	SchemaID    uuid.UUID             `json:"schemaID"`
+	Status      BulkIssuanceJobStatus `json:"status"`
+	TotalRows   int                   `json:"totalRows"`
+	UpdatedAt   TimeUTC               `json:"updatedAt"`
+}
+
+// BulkIssuanceJobFormat defines model for BulkIssuanceJob.Format.
+type BulkIssuanceJobFormat string
+
+// BulkIssuanceJobStatus defines model for BulkIssuanceJob.Status.
+type BulkIssuanceJobStatus string
+
+// BulkIssuanceJobRow defines model for BulkIssuanceJobRow.
+type BulkIssuanceJobRow struct {
+	CredentialID      *uuid.UUID               `json:"credentialID,omitempty"`
+	CredentialSubject CredentialSubject        `json:"credentialSubject"`
+	Error             *string                  `json:"error,omitempty"`
+	RowNumber         int                      `json:"rowNumber"`
+	Status            BulkIssuanceJobRowStatus `json:"status"`
+}
+
+// BulkIssuanceJobRowStatus defines model for BulkIssuanceJobRow.Status.
+type BulkIssuanceJobRowStatus string
+
+// BulkIssuanceJobRowsPaginated defines model for BulkIssuanceJobRowsPaginated.
+type BulkIssuanceJobRowsPaginated struct {
+	Items []BulkIssuanceJobRow `json:"items"`
+	Meta  PaginatedMetadata    `json:"meta"`
+}
+
 // ConnectionsPaginated defines model for ConnectionsPaginated.
 type ConnectionsPaginated struct {
 	Items GetConnectionsResponse `json:"items"`
 	Meta  PaginatedMetadata      `json:"meta"`
 }
 
+// CreateAPIKeyRequest defines model for CreateAPIKeyRequest.
+type CreateAPIKeyRequest struct {
+	Identities  []string           `json:"identities"`
+	Name        string             `json:"name"`
+	Permissions []APIKeyPermission `json:"permissions"`
+}
+
+// CreateAPIKeyResponse defines model for CreateAPIKeyResponse.
+type CreateAPIKeyResponse struct {
+	ApiKey APIKey `json:"apiKey"`
+
+	// Key The key to send in the X-API-Key header. It is only returned once.
+	Key string `json:"key"`
+}
+
 // CreateAuthCredentialRequest defines model for CreateAuthCredentialRequest.
 type CreateAuthCredentialRequest struct {
 	CredentialStatusType CreateAuthCredentialRequestCredentialStatusType `json:"credentialStatusType,omitempty"`
@@ -262,6 +481,34 @@ type CreateAuthCredentialRequest struct {
 // CreateAuthCredentialRequestCredentialStatusType defines model for CreateAuthCredentialRequest.CredentialStatusType.
 type CreateAuthCredentialRequestCredentialStatusType string
 
+// CreateBulkIssuanceJobRequest defines model for CreateBulkIssuanceJobRequest.
+type CreateBulkIssuanceJobRequest struct {
+	CredentialStatusType *CreateBulkIssuanceJobRequestCredentialStatusType `json:"credentialStatusType,omitempty"`
+	Expiration           *int64                                            `json:"expiration,omitempty"`
+
+	// File CSV or NDJSON file with one credential subject per row
+	File openapi_types.File `json:"file"`
+
+	// Format File format. If omitted, it is inferred from the file name. Default is csv.
+	Format   *CreateBulkIssuanceJobRequestFormat   `json:"format,omitempty"`
+	Proofs   *[]CreateBulkIssuanceJobRequestProofs `json:"proofs,omitempty"`
+	SchemaID *uuid.UUID                            `json:"schemaID,omitempty"`
+
+	// TemplateID Credential template to issue the credentials from. schemaID is not required when it is set.
+	// The default attributes of the template are added to the rows that do not set them and its expiration,
+	// proofs, display method and refresh service are used unless expiration or proofs are given.
+	TemplateID *uuid.UUID `json:"templateID,omitempty"`
+}
+
+// CreateBulkIssuanceJobRequestCredentialStatusType defines model for CreateBulkIssuanceJobRequest.CredentialStatusType.
+type CreateBulkIssuanceJobRequestCredentialStatusType string
+
+// CreateBulkIssuanceJobRequestFormat File format. If omitted, it is inferred from the file name. Default is csv.
+type CreateBulkIssuanceJobRequestFormat string
+
+// CreateBulkIssuanceJobRequestProofs defines model for CreateBulkIssuanceJobRequest.Proofs.
+type CreateBulkIssuanceJobRequestProofs string
+
 // CreateConnectionRequest defines model for CreateConnectionRequest.
 type CreateConnectionRequest struct {
 	IssuerDoc map[string]interface{} `json:"issuerDoc"`
@@ -269,22 +516,42 @@ type CreateConnectionRequest struct {
 	UserDoc   map[string]interface{} `json:"userDoc"`
 }
 
+// CreateCredentialFromTemplateRequest defines model for CreateCredentialFromTemplateRequest.
+type CreateCredentialFromTemplateRequest struct {
+	ClaimID              *uuid.UUID                                               `json:"claimID,omitempty"`
+	CredentialStatusType *CreateCredentialFromTemplateRequestCredentialStatusType `json:"credentialStatusType,omitempty"`
+	CredentialSubject    map[string]interface{}                                   `json:"credentialSubject"`
+	RevNonce             *uint64                                                  `json:"revNonce,omitempty"`
+}
+
+// CreateCredentialFromTemplateRequestCredentialStatusType defines model for CreateCredentialFromTemplateRequest.CredentialStatusType.
+type CreateCredentialFromTemplateRequestCredentialStatusType string
+
 // CreateCredentialRequest defines model for CreateCredentialRequest.
 type CreateCredentialRequest struct {
-	ClaimID               *uuid.UUID                                   `json:"claimID"`
-	CredentialSchema      string                                       `json:"credentialSchema"`
-	CredentialStatusType  *CreateCredentialRequestCredentialStatusType `json:"credentialStatusType,omitempty"`
-	CredentialSubject     map[string]interface{}                       `json:"credentialSubject"`
-	DisplayMethod         *DisplayMethod                               `json:"displayMethod,omitempty"`
-	EncryptionKey         *map[string]interface{}                      `json:"encryptionKey,omitempty"`
-	Expiration            *int64                                       `json:"expiration,omitempty"`
-	MerklizedRootPosition *string                                      `json:"merklizedRootPosition,omitempty"`
-	Proofs                *[]CreateCredentialRequestProofs             `json:"proofs,omitempty"`
-	RefreshService        *RefreshService                              `json:"refreshService,omitempty"`
-	RevNonce              *uint64                                      `json:"revNonce,omitempty"`
-	SubjectPosition       *string                                      `json:"subjectPosition,omitempty"`
-	Type                  string                                       `json:"type"`
-	Version               *uint32                                      `json:"version,omitempty"`
+	ClaimID              *uuid.UUID                                   `json:"claimID"`
+	CredentialSchema     string                                       `json:"credentialSchema"`
+	CredentialStatusType *CreateCredentialRequestCredentialStatusType `json:"credentialStatusType,omitempty"`
+	CredentialSubject    map[string]interface{}                       `json:"credentialSubject"`
+	DisplayMethod        *DisplayMethod                               `json:"displayMethod,omitempty"`
+	EncryptionKey        *map[string]interface{}                      `json:"encryptionKey,omitempty"`
+
+	// EncryptionPolicy Encryption of the credentials with a key agreement key of the DID document of the holder.
+	// none: credentials are only encrypted when the request includes an encryption key.
+	// preferred: credentials are encrypted when the holder has a usable key and issued in plain text otherwise.
+	// required: credentials are not issued when the holder has no usable key.
+	EncryptionPolicy *CredentialEncryptionPolicy `json:"encryptionPolicy,omitempty"`
+	Expiration       *int64                      `json:"expiration,omitempty"`
+
+	// JwtExport Exports the credential as a JWT-VC or an SD-JWT VC signed with an ETH or Ed25519 key of the identity
+	JwtExport             *CredentialJWTExport             `json:"jwtExport,omitempty"`
+	MerklizedRootPosition *string                          `json:"merklizedRootPosition,omitempty"`
+	Proofs                *[]CreateCredentialRequestProofs `json:"proofs,omitempty"`
+	RefreshService        *RefreshService                  `json:"refreshService,omitempty"`
+	RevNonce              *uint64                          `json:"revNonce,omitempty"`
+	SubjectPosition       *string                          `json:"subjectPosition,omitempty"`
+	Type                  string                           `json:"type"`
+	Version               *uint32                          `json:"version,omitempty"`
 }
 
 // CreateCredentialRequestCredentialStatusType defines model for CreateCredentialRequest.CredentialStatusType.
@@ -296,6 +563,9 @@ type CreateCredentialRequestProofs string
 // CreateCredentialResponse defines model for CreateCredentialResponse.
 type CreateCredentialResponse struct {
 	Id string `json:"id"`
+
+	// Jwt The credential exported as requested in jwtExport
+	Jwt *string `json:"jwt,omitempty"`
 }
 
 // CreateDisplayMethodRequest defines model for CreateDisplayMethodRequest.
@@ -311,10 +581,14 @@ type CreateDisplayMethodRequest struct {
 type CreateIdentityRequest struct {
 	CredentialStatusType *CreateIdentityRequestCredentialStatusType `json:"credentialStatusType,omitempty"`
 	DidMetadata          struct {
-		Blockchain string                               `json:"blockchain"`
-		Method     string                               `json:"method"`
-		Network    string                               `json:"network"`
-		Type       CreateIdentityRequestDidMetadataType `json:"type"`
+		Blockchain string `json:"blockchain"`
+
+		// Method DID method of the identity. With the web method a did:web identity is created under the host of the
+		// server url. It is backed by an ETH or Ed25519 key, its blockchain and network are ignored and its
+		// credentials are signed with a JsonWebSignature2020 proof and revoked with a BitstringStatusListEntry.
+		Method  string                               `json:"method"`
+		Network string                               `json:"network"`
+		Type    CreateIdentityRequestDidMetadataType `json:"type"`
 	} `json:"didMetadata"`
 	DisplayName *string `json:"displayName"`
 }
@@ -354,17 +628,41 @@ type CreateKeyResponse struct {
 	Id string `json:"id"`
 }
 
+// CreateKeyRotationRequest defines model for CreateKeyRotationRequest.
+type CreateKeyRotationRequest struct {
+	// AuthCredentialID Auth credential to replace. If omitted, the current auth credential of the identity is replaced
+	AuthCredentialID *uuid.UUID `json:"authCredentialID,omitempty"`
+
+	// KeyName Name of the new BJJ key
+	KeyName string `json:"keyName"`
+
+	// ResignCredentials Sign again the credentials signed with the replaced auth credential
+	ResignCredentials *bool `json:"resignCredentials,omitempty"`
+}
+
+// CreateLinkFromTemplateRequest defines model for CreateLinkFromTemplateRequest.
+type CreateLinkFromTemplateRequest struct {
+	CredentialSubject *map[string]interface{} `json:"credentialSubject,omitempty"`
+	Expiration        *time.Time              `json:"expiration,omitempty"`
+	LimitedClaims     *int                    `json:"limitedClaims,omitempty"`
+}
+
 // CreateLinkRequest defines model for CreateLinkRequest.
 type CreateLinkRequest struct {
-	CredentialExpiration *time.Time        `json:"credentialExpiration,omitempty"`
-	CredentialSubject    CredentialSubject `json:"credentialSubject"`
-	DisplayMethod        *DisplayMethod    `json:"displayMethod,omitempty"`
-	Expiration           *time.Time        `json:"expiration,omitempty"`
-	LimitedClaims        *int              `json:"limitedClaims"`
-	MtProof              bool              `json:"mtProof"`
-	RefreshService       *RefreshService   `json:"refreshService,omitempty"`
-	SchemaID             uuid.UUID         `json:"schemaID"`
-	SignatureProof       bool              `json:"signatureProof"`
+	// CredentialEncryption Encryption of the credentials with a key agreement key of the DID document of the holder.
+	// none: credentials are only encrypted when the request includes an encryption key.
+	// preferred: credentials are encrypted when the holder has a usable key and issued in plain text otherwise.
+	// required: credentials are not issued when the holder has no usable key.
+	CredentialEncryption *CredentialEncryptionPolicy `json:"credentialEncryption,omitempty"`
+	CredentialExpiration *time.Time                  `json:"credentialExpiration,omitempty"`
+	CredentialSubject    CredentialSubject           `json:"credentialSubject"`
+	DisplayMethod        *DisplayMethod              `json:"displayMethod,omitempty"`
+	Expiration           *time.Time                  `json:"expiration,omitempty"`
+	LimitedClaims        *int                        `json:"limitedClaims"`
+	MtProof              bool                        `json:"mtProof"`
+	RefreshService       *RefreshService             `json:"refreshService,omitempty"`
+	SchemaID             uuid.UUID                   `json:"schemaID"`
+	SignatureProof       bool                        `json:"signatureProof"`
 }
 
 // CreatePaymentRequest defines model for CreatePaymentRequest.
@@ -392,16 +690,71 @@ type CreatePaymentRequestResponse struct {
 // CreatePaymentRequestResponseStatus defines model for CreatePaymentRequestResponse.Status.
 type CreatePaymentRequestResponseStatus string
 
+// CreateVerificationSessionResponse defines model for CreateVerificationSessionResponse.
+type CreateVerificationSessionResponse struct {
+	DeepLink string `json:"deepLink"`
+
+	// Message Authorization request to be shown as a raw QR code
+	Message       string    `json:"message"`
+	SessionID     uuid.UUID `json:"sessionID"`
+	UniversalLink string    `json:"universalLink"`
+}
+
 // Credential defines model for Credential.
 type Credential struct {
-	EncryptedVC *EncryptedVC              `json:"encryptedVC,omitempty"`
-	Id          string                    `json:"id"`
-	ProofTypes  []string                  `json:"proofTypes"`
-	Revoked     bool                      `json:"revoked"`
-	SchemaHash  string                    `json:"schemaHash"`
-	Vc          *verifiable.W3CCredential `json:"vc,omitempty"`
+	EncryptedVC *EncryptedVC `json:"encryptedVC,omitempty"`
+	Id          string       `json:"id"`
+
+	// Jwt The credential exported as a JWT-VC or SD-JWT VC when requested
+	Jwt        *string                   `json:"jwt,omitempty"`
+	ProofTypes []string                  `json:"proofTypes"`
+	Revoked    bool                      `json:"revoked"`
+	SchemaHash string                    `json:"schemaHash"`
+	Suspended  bool                      `json:"suspended"`
+	Vc         *verifiable.W3CCredential `json:"vc,omitempty"`
+}
+
+// CredentialEncryptionPolicy Encryption of the credentials with a key agreement key of the DID document of the holder.
+// none: credentials are only encrypted when the request includes an encryption key.
+// preferred: credentials are encrypted when the holder has a usable key and issued in plain text otherwise.
+// required: credentials are not issued when the holder has no usable key.
+type CredentialEncryptionPolicy string
+
+// CredentialExpirationPolicy defines model for CredentialExpirationPolicy.
+type CredentialExpirationPolicy struct {
+	AutoRevoke       bool       `json:"autoRevoke"`
+	CreatedAt        TimeUTC    `json:"createdAt"`
+	Id               uuid.UUID  `json:"id"`
+	NotifyDaysBefore *int       `json:"notifyDaysBefore,omitempty"`
+	SchemaID         *uuid.UUID `json:"schemaID,omitempty"`
+	UpdatedAt        TimeUTC    `json:"updatedAt"`
+}
+
+// CredentialExpirationPolicyRequest defines model for CredentialExpirationPolicyRequest.
+type CredentialExpirationPolicyRequest struct {
+	// AutoRevoke Revoke the credentials once they expire
+	AutoRevoke bool `json:"autoRevoke"`
+
+	// NotifyDaysBefore Days before the expiration when the holder gets a renewal notice. Empty to not send notices.
+	NotifyDaysBefore *int `json:"notifyDaysBefore,omitempty"`
+
+	// SchemaID Schema of the credentials the policy applies to. Empty for the policy of the whole identity.
+	SchemaID *uuid.UUID `json:"schemaID,omitempty"`
+}
+
+// CredentialJWTExport Exports the credential as a JWT-VC or an SD-JWT VC signed with an ETH or Ed25519 key of the identity
+type CredentialJWTExport struct {
+	// DisclosableFields Top level credentialSubject attributes that can be selectively disclosed in the SD-JWT VC. All by default.
+	DisclosableFields *[]string                 `json:"disclosableFields,omitempty"`
+	Format            CredentialJWTExportFormat `json:"format"`
+
+	// KeyID Base64 id of the key used to sign the JWT. If not provided an Ed25519 key is preferred.
+	KeyID *string `json:"keyID,omitempty"`
 }
 
+// CredentialJWTExportFormat defines model for CredentialJWTExport.Format.
+type CredentialJWTExportFormat string
+
 // CredentialLinkQrCodeResponse defines model for CredentialLinkQrCodeResponse.
 type CredentialLinkQrCodeResponse struct {
 	DeepLink      string            `json:"deepLink"`
@@ -420,12 +773,82 @@ type CredentialOfferResponse struct {
 // CredentialSubject defines model for CredentialSubject.
 type CredentialSubject = map[string]interface{}
 
+// CredentialTemplate defines model for CredentialTemplate.
+type CredentialTemplate struct {
+	CreatedAt            TimeUTC                `json:"createdAt"`
+	CredentialExpiration *string                `json:"credentialExpiration,omitempty"`
+	CredentialSubject    map[string]interface{} `json:"credentialSubject"`
+	DisplayMethodID      *uuid.UUID             `json:"displayMethodID,omitempty"`
+	Id                   uuid.UUID              `json:"id"`
+	MtProof              bool                   `json:"mtProof"`
+	Name                 string                 `json:"name"`
+	RefreshService       *RefreshService        `json:"refreshService,omitempty"`
+	SchemaID             uuid.UUID              `json:"schemaID"`
+	SignatureProof       bool                   `json:"signatureProof"`
+	UpdatedAt            TimeUTC                `json:"updatedAt"`
+}
+
+// CredentialTemplateRequest defines model for CredentialTemplateRequest.
+type CredentialTemplateRequest struct {
+	// CredentialExpiration Expiration relative to the issuance time in hours (h), days (d), weeks (w) or years (y), up to 100 years.
+	CredentialExpiration *string `json:"credentialExpiration,omitempty"`
+
+	// CredentialSubject Default attributes of the credentials
+	CredentialSubject *map[string]interface{} `json:"credentialSubject,omitempty"`
+	DisplayMethodID   *uuid.UUID              `json:"displayMethodID,omitempty"`
+	MtProof           bool                    `json:"mtProof"`
+	Name              string                  `json:"name"`
+	RefreshService    *RefreshService         `json:"refreshService,omitempty"`
+	SchemaID          uuid.UUID               `json:"schemaID"`
+	SignatureProof    bool                    `json:"signatureProof"`
+}
+
+// CredentialTemplatesPaginated defines model for CredentialTemplatesPaginated.
+type CredentialTemplatesPaginated struct {
+	Items []CredentialTemplate `json:"items"`
+	Meta  PaginatedMetadata    `json:"meta"`
+}
+
+// CredentialVerificationCheck defines model for CredentialVerificationCheck.
+type CredentialVerificationCheck struct {
+	Message *string                           `json:"message,omitempty"`
+	Name    CredentialVerificationCheckName   `json:"name"`
+	Status  CredentialVerificationCheckStatus `json:"status"`
+}
+
+// CredentialVerificationCheckName defines model for CredentialVerificationCheck.Name.
+type CredentialVerificationCheckName string
+
+// CredentialVerificationCheckStatus defines model for CredentialVerificationCheck.Status.
+type CredentialVerificationCheckStatus string
+
+// CredentialVerificationReport defines model for CredentialVerificationReport.
+type CredentialVerificationReport struct {
+	Checks []CredentialVerificationCheck `json:"checks"`
+
+	// Valid True when the credential has at least one valid proof and none of the checks failed
+	Valid bool `json:"valid"`
+}
+
 // CredentialsPaginated defines model for CredentialsPaginated.
 type CredentialsPaginated struct {
 	Items []Credential      `json:"items"`
 	Meta  PaginatedMetadata `json:"meta"`
 }
 
+// DIDDocument defines model for DIDDocument.
+type DIDDocument = domain.DIDDocument
+
+// DIDResolutionResult defines model for DIDResolutionResult.
+type DIDResolutionResult struct {
+	Context               string                 `json:"@context"`
+	DidDocument           DIDDocument            `json:"didDocument"`
+	DidDocumentMetadata   map[string]interface{} `json:"didDocumentMetadata"`
+	DidResolutionMetadata struct {
+		ContentType string `json:"contentType"`
+	} `json:"didResolutionMetadata"`
+}
+
 // DisplayMethod defines model for DisplayMethod.
 type DisplayMethod struct {
 	Id   string            `json:"id"`
@@ -541,12 +964,17 @@ type IdentityState struct {
 
 // ImportSchemaRequest defines model for ImportSchemaRequest.
 type ImportSchemaRequest struct {
-	Description     *string    `json:"description,omitempty"`
-	DisplayMethodID *uuid.UUID `json:"displayMethodID"`
-	SchemaType      string     `json:"schemaType"`
-	Title           *string    `json:"title,omitempty"`
-	Url             string     `json:"url"`
-	Version         string     `json:"version"`
+	// CredentialEncryption Encryption of the credentials with a key agreement key of the DID document of the holder.
+	// none: credentials are only encrypted when the request includes an encryption key.
+	// preferred: credentials are encrypted when the holder has a usable key and issued in plain text otherwise.
+	// required: credentials are not issued when the holder has no usable key.
+	CredentialEncryption *CredentialEncryptionPolicy `json:"credentialEncryption,omitempty"`
+	Description          *string                     `json:"description,omitempty"`
+	DisplayMethodID      *uuid.UUID                  `json:"displayMethodID"`
+	SchemaType           string                      `json:"schemaType"`
+	Title                *string                     `json:"title,omitempty"`
+	Url                  string                      `json:"url"`
+	Version              string                      `json:"version"`
 }
 
 // IssuerDescription defines model for IssuerDescription.
@@ -568,6 +996,35 @@ type Key struct {
 // KeyKeyType defines model for Key.KeyType.
 type KeyKeyType string
 
+// KeyRotation defines model for KeyRotation.
+type KeyRotation struct {
+	CreatedAt TimeUTC `json:"createdAt"`
+
+	// Error Error of the last run of the step, it is retried when the rotation is resumed
+	Error               *string    `json:"error,omitempty"`
+	Id                  uuid.UUID  `json:"id"`
+	KeyName             string     `json:"keyName"`
+	NewAuthCredentialID *uuid.UUID `json:"newAuthCredentialID,omitempty"`
+
+	// NewKeyID base64 encoded keyID of the new key
+	NewKeyID            *string   `json:"newKeyID,omitempty"`
+	OldAuthCredentialID uuid.UUID `json:"oldAuthCredentialID"`
+
+	// PublishedState State that includes the new auth credential
+	PublishedState    *string `json:"publishedState,omitempty"`
+	ResignCredentials bool    `json:"resignCredentials"`
+
+	// ResignedCredentials Number of credentials signed again with the new key
+	ResignedCredentials int `json:"resignedCredentials"`
+
+	// Step Next step of the rotation
+	Step      KeyRotationStep `json:"step"`
+	UpdatedAt TimeUTC         `json:"updatedAt"`
+}
+
+// KeyRotationStep Next step of the rotation
+type KeyRotationStep string
+
 // KeysPaginated defines model for KeysPaginated.
 type KeysPaginated struct {
 	Items []Key             `json:"items"`
@@ -576,23 +1033,29 @@ type KeysPaginated struct {
 
 // Link defines model for Link.
 type Link struct {
-	Active               bool              `json:"active"`
-	CreatedAt            TimeUTC           `json:"createdAt"`
-	CredentialExpiration *TimeUTC          `json:"credentialExpiration"`
-	CredentialSubject    CredentialSubject `json:"credentialSubject"`
-	DeepLink             string            `json:"deepLink"`
-	DisplayMethod        *DisplayMethod    `json:"displayMethod,omitempty"`
-	Expiration           *TimeUTC          `json:"expiration"`
-	Id                   uuid.UUID         `json:"id"`
-	IssuedClaims         int               `json:"issuedClaims"`
-	MaxIssuance          *int              `json:"maxIssuance"`
-	ProofTypes           []string          `json:"proofTypes"`
-	RefreshService       *RefreshService   `json:"refreshService,omitempty"`
-	SchemaHash           string            `json:"schemaHash"`
-	SchemaType           string            `json:"schemaType"`
-	SchemaUrl            string            `json:"schemaUrl"`
-	Status               LinkStatus        `json:"status"`
-	UniversalLink        string            `json:"universalLink"`
+	Active    bool    `json:"active"`
+	CreatedAt TimeUTC `json:"createdAt"`
+
+	// CredentialEncryption Encryption of the credentials with a key agreement key of the DID document of the holder.
+	// none: credentials are only encrypted when the request includes an encryption key.
+	// preferred: credentials are encrypted when the holder has a usable key and issued in plain text otherwise.
+	// required: credentials are not issued when the holder has no usable key.
+	CredentialEncryption CredentialEncryptionPolicy `json:"credentialEncryption"`
+	CredentialExpiration *TimeUTC                   `json:"credentialExpiration"`
+	CredentialSubject    CredentialSubject          `json:"credentialSubject"`
+	DeepLink             string                     `json:"deepLink"`
+	DisplayMethod        *DisplayMethod             `json:"displayMethod,omitempty"`
+	Expiration           *TimeUTC                   `json:"expiration"`
+	Id                   uuid.UUID                  `json:"id"`
+	IssuedClaims         int                        `json:"issuedClaims"`
+	MaxIssuance          *int                       `json:"maxIssuance"`
+	ProofTypes           []string                   `json:"proofTypes"`
+	RefreshService       *RefreshService            `json:"refreshService,omitempty"`
+	SchemaHash           string                     `json:"schemaHash"`
+	SchemaType           string                     `json:"schemaType"`
+	SchemaUrl            string                     `json:"schemaUrl"`
+	Status               LinkStatus                 `json:"status"`
+	UniversalLink        string                     `json:"universalLink"`
 }
 
 // LinkStatus defines model for Link.Status.
@@ -607,6 +1070,51 @@ type LinkSimple struct {
 	SchemaUrl  string    `json:"schemaUrl"`
 }
 
+// MerkleTreeDivergence defines model for MerkleTreeDivergence.
+type MerkleTreeDivergence struct {
+	// Actual The root recomputed from the credentials and revocations, or the last confirmed state for on-chain divergences
+	Actual string `json:"actual"`
+
+	// Expected The root in the source
+	Expected string `json:"expected"`
+
+	// Source Where the diverging root is stored
+	Source MerkleTreeDivergenceSource `json:"source"`
+
+	// State The state whose root diverges
+	State *string                  `json:"state,omitempty"`
+	Tree  MerkleTreeDivergenceTree `json:"tree"`
+}
+
+// MerkleTreeDivergenceSource Where the diverging root is stored
+type MerkleTreeDivergenceSource string
+
+// MerkleTreeDivergenceTree defines model for MerkleTreeDivergence.Tree.
+type MerkleTreeDivergenceTree string
+
+// MerkleTreeIntegrityReport defines model for MerkleTreeIntegrityReport.
+type MerkleTreeIntegrityReport struct {
+	CheckedAt      TimeUTC                `json:"checkedAt"`
+	ConfirmedState *string                `json:"confirmedState,omitempty"`
+	Divergences    []MerkleTreeDivergence `json:"divergences"`
+
+	// Healthy True if no divergences nor stale proofs were found
+	Healthy     bool    `json:"healthy"`
+	Identifier  string  `json:"identifier"`
+	LatestState *string `json:"latestState,omitempty"`
+
+	// OnChainError The error getting the state from the state contract, if any
+	OnChainError *string `json:"onChainError,omitempty"`
+	OnChainState *string `json:"onChainState,omitempty"`
+
+	// RecomputedState The latest state recomputed from the credentials and revocations
+	RecomputedState string `json:"recomputedState"`
+
+	// RepairedProofs Credentials whose merkle tree proof was regenerated
+	RepairedProofs []uuid.UUID     `json:"repairedProofs"`
+	StaleProofs    []StaleMTPProof `json:"staleProofs"`
+}
+
 // NetworkData defines model for NetworkData.
 type NetworkData struct {
 	CredentialStatus []string `json:"credentialStatus"`
@@ -699,6 +1207,39 @@ type PublishIdentityStateResponse struct {
 	TxID               *string `json:"txID,omitempty"`
 }
 
+// PublishPolicy defines model for PublishPolicy.
+type PublishPolicy struct {
+	CreatedAt           TimeUTC `json:"createdAt"`
+	MaxDelayMinutes     *int    `json:"maxDelayMinutes,omitempty"`
+	MinIntervalMinutes  *int    `json:"minIntervalMinutes,omitempty"`
+	MinPendingChanges   int     `json:"minPendingChanges"`
+	PublishOnRevocation bool    `json:"publishOnRevocation"`
+	UpdatedAt           TimeUTC `json:"updatedAt"`
+	WindowEnd           *string `json:"windowEnd,omitempty"`
+	WindowStart         *string `json:"windowStart,omitempty"`
+}
+
+// PublishPolicyRequest defines model for PublishPolicyRequest.
+type PublishPolicyRequest struct {
+	// MaxDelayMinutes Time after which the pending changes are published even if there are fewer than minPendingChanges
+	MaxDelayMinutes *int `json:"maxDelayMinutes,omitempty"`
+
+	// MinIntervalMinutes Minimum time between two publications
+	MinIntervalMinutes *int `json:"minIntervalMinutes,omitempty"`
+
+	// MinPendingChanges Number of pending credentials and revocations that triggers the publication
+	MinPendingChanges int `json:"minPendingChanges"`
+
+	// PublishOnRevocation Publish the pending revocations on the next run, regardless of the other settings
+	PublishOnRevocation *bool `json:"publishOnRevocation,omitempty"`
+
+	// WindowEnd End of the daily publish window, HH:MM in UTC
+	WindowEnd *string `json:"windowEnd,omitempty"`
+
+	// WindowStart Start of the daily publish window, HH:MM in UTC
+	WindowStart *string `json:"windowStart,omitempty"`
+}
+
 // RefreshService defines model for RefreshService.
 type RefreshService struct {
 	Id   string             `json:"id"`
@@ -708,6 +1249,19 @@ type RefreshService struct {
 // RefreshServiceType defines model for RefreshService.Type.
 type RefreshServiceType string
 
+// ReissueCredentialRequest defines model for ReissueCredentialRequest.
+type ReissueCredentialRequest struct {
+	CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	Expiration        *int64                 `json:"expiration,omitempty"`
+}
+
+// ReissueCredentialResponse defines model for ReissueCredentialResponse.
+type ReissueCredentialResponse struct {
+	Id         string `json:"id"`
+	PreviousID string `json:"previousID"`
+	Version    uint32 `json:"version"`
+}
+
 // RevocationStatusResponse defines model for RevocationStatusResponse.
 type RevocationStatusResponse struct {
 	Issuer struct {
@@ -724,6 +1278,9 @@ type RevocationStatusResponse struct {
 		} `json:"node_aux,omitempty"`
 		Siblings *[]string `json:"siblings"`
 	} `json:"mtp"`
+
+	// Suspended The credentials that use this nonce are suspended. Suspension is not part of the revocation proof.
+	Suspended *bool `json:"suspended,omitempty"`
 }
 
 // RevokeClaimResponse defines model for RevokeClaimResponse.
@@ -733,31 +1290,114 @@ type RevokeClaimResponse struct {
 
 // Schema defines model for Schema.
 type Schema struct {
-	BigInt          string     `json:"bigInt"`
-	ContextURL      string     `json:"contextURL"`
-	CreatedAt       TimeUTC    `json:"createdAt"`
-	Description     *string    `json:"description"`
-	DisplayMethodID *uuid.UUID `json:"displayMethodID"`
-	Hash            string     `json:"hash"`
-	Id              string     `json:"id"`
-	Title           *string    `json:"title"`
-	Type            string     `json:"type"`
-	Url             string     `json:"url"`
-	Version         string     `json:"version"`
+	BigInt     string  `json:"bigInt"`
+	ContextURL string  `json:"contextURL"`
+	CreatedAt  TimeUTC `json:"createdAt"`
+
+	// CredentialEncryption Encryption of the credentials with a key agreement key of the DID document of the holder.
+	// none: credentials are only encrypted when the request includes an encryption key.
+	// preferred: credentials are encrypted when the holder has a usable key and issued in plain text otherwise.
+	// required: credentials are not issued when the holder has no usable key.
+	CredentialEncryption CredentialEncryptionPolicy `json:"credentialEncryption"`
+	Description          *string                    `json:"description"`
+	DisplayMethodID      *uuid.UUID                 `json:"displayMethodID"`
+	Hash                 string                     `json:"hash"`
+	Id                   string                     `json:"id"`
+	Title                *string                    `json:"title"`
+	Type                 string                     `json:"type"`
+	Url                  string                     `json:"url"`
+	Version              string                     `json:"version"`
+}
+
+// StaleMTPProof defines model for StaleMTPProof.
+type StaleMTPProof struct {
+	CredentialID uuid.UUID           `json:"credentialID"`
+	Reason       StaleMTPProofReason `json:"reason"`
+
+	// State The state the credential was added in
+	State *string `json:"state,omitempty"`
+}
+
+// StaleMTPProofReason defines model for StaleMTPProof.Reason.
+type StaleMTPProofReason string
+
+// StateChange defines model for StateChange.
+type StateChange struct {
+	CredentialID    *uuid.UUID      `json:"credentialID,omitempty"`
+	RevocationNonce *uint64         `json:"revocationNonce,omitempty"`
+	State           string          `json:"state"`
+	Type            StateChangeType `json:"type"`
+}
+
+// StateChangeType defines model for StateChange.Type.
+type StateChangeType string
+
+// StateChangesPaginated defines model for StateChangesPaginated.
+type StateChangesPaginated struct {
+	Items []StateChange     `json:"items"`
+	Meta  PaginatedMetadata `json:"meta"`
+}
+
+// StateDetailsResponse defines model for StateDetailsResponse.
+type StateDetailsResponse struct {
+	Changes            StateChangesPaginated `json:"changes"`
+	ClaimsTreeRoot     *string               `json:"claimsTreeRoot,omitempty"`
+	PreviousState      *string               `json:"previousState,omitempty"`
+	RevocationTreeRoot *string               `json:"revocationTreeRoot,omitempty"`
+	RootOfRoots        *string               `json:"rootOfRoots,omitempty"`
+	State              StateTransaction      `json:"state"`
+}
+
+// StateDiffResponse defines model for StateDiffResponse.
+type StateDiffResponse struct {
+	Changes []StateChange `json:"changes"`
+
+	// Credentials Credentials added to the claims tree in the diff
+	Credentials []uuid.UUID `json:"credentials"`
+	From        string      `json:"from"`
+
+	// RevocationNonces Revocation nonces added to the revocation tree in the diff
+	RevocationNonces []uint64          `json:"revocationNonces"`
+	States           StateTransactions `json:"states"`
+	To               string            `json:"to"`
+}
+
+// StateReplication defines model for StateReplication.
+type StateReplication struct {
+	BlockNumber    *int `json:"blockNumber,omitempty"`
+	BlockTimestamp *int `json:"blockTimestamp,omitempty"`
+
+	// Chain Resolver prefix of the chain the state is replicated to.
+	Chain string `json:"chain"`
+
+	// FailureReason Revert reason of the bridge contract, when it rejected the state.
+	FailureReason *string                `json:"failureReason,omitempty"`
+	State         string                 `json:"state"`
+	Status        StateReplicationStatus `json:"status"`
+	TxID          *string                `json:"txID,omitempty"`
 }
 
+// StateReplicationStatus defines model for StateReplication.Status.
+type StateReplicationStatus string
+
 // StateStatusResponse defines model for StateStatusResponse.
 type StateStatusResponse struct {
-	PendingActions bool `json:"pendingActions"`
+	PendingActions bool               `json:"pendingActions"`
+	Replications   []StateReplication `json:"replications"`
 }
 
 // StateTransaction defines model for StateTransaction.
 type StateTransaction struct {
-	Id          int64                  `json:"id"`
-	PublishDate TimeUTC                `json:"publishDate"`
-	State       string                 `json:"state"`
-	Status      StateTransactionStatus `json:"status"`
-	TxID        string                 `json:"txID"`
+	// FailureReason Decoded revert reason of the state transition, when it failed on chain or its simulation reverted.
+	FailureReason *string `json:"failureReason,omitempty"`
+	Id            int64   `json:"id"`
+	PublishDate   TimeUTC `json:"publishDate"`
+
+	// ReplacementTxIDs Hashes of the transactions sent with higher fees to replace a stuck state transition, in the order they were sent. Once one of them is mined, txID is the hash of the mined one.
+	ReplacementTxIDs *[]string              `json:"replacementTxIDs,omitempty"`
+	State            string                 `json:"state"`
+	Status           StateTransactionStatus `json:"status"`
+	TxID             string                 `json:"txID"`
 }
 
 // StateTransactionStatus defines model for StateTransaction.Status.
@@ -772,6 +1412,16 @@ type StateTransactionsPaginated struct {
 	Meta  PaginatedMetadata `json:"meta"`
 }
 
+// StateTransitionSimulation defines model for StateTransitionSimulation.
+type StateTransitionSimulation struct {
+	// RevertReason Decoded revert reason of the state transition, when it would fail.
+	RevertReason *string `json:"revertReason,omitempty"`
+	State        string  `json:"state"`
+
+	// Success Whether the state transition would succeed if it was published.
+	Success bool `json:"success"`
+}
+
 // SupportedNetworks defines model for SupportedNetworks.
 type SupportedNetworks struct {
 	Blockchain string        `json:"blockchain"`
@@ -789,6 +1439,13 @@ type UUIDResponse struct {
 // UUIDString defines model for UUIDString.
 type UUIDString = string
 
+// UpdateAPIKeyRequest defines model for UpdateAPIKeyRequest.
+type UpdateAPIKeyRequest struct {
+	Identities  *[]string           `json:"identities,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+	Permissions *[]APIKeyPermission `json:"permissions,omitempty"`
+}
+
 // UpdatePaymentOptionRequest defines model for UpdatePaymentOptionRequest.
 type UpdatePaymentOptionRequest struct {
 	Description    *string              `json:"description,omitempty"`
@@ -796,9 +1453,51 @@ type UpdatePaymentOptionRequest struct {
 	PaymentOptions *PaymentOptionConfig `json:"paymentOptions,omitempty"`
 }
 
+// VerificationQuery defines model for VerificationQuery.
+type VerificationQuery struct {
+	CreatedAt TimeUTC                     `json:"createdAt"`
+	Id        uuid.UUID                   `json:"id"`
+	Name      string                      `json:"name"`
+	Reason    string                      `json:"reason"`
+	Scope     []ZeroKnowledgeProofRequest `json:"scope"`
+}
+
+// VerificationQueryRequest defines model for VerificationQueryRequest.
+type VerificationQueryRequest struct {
+	Name   string                      `json:"name"`
+	Reason *string                     `json:"reason,omitempty"`
+	Scope  []ZeroKnowledgeProofRequest `json:"scope"`
+}
+
+// VerificationSession defines model for VerificationSession.
+type VerificationSession struct {
+	CreatedAt TimeUTC   `json:"createdAt"`
+	Error     *string   `json:"error,omitempty"`
+	Id        uuid.UUID `json:"id"`
+
+	// Response Proofs of the holder for each request of the scope
+	Response            *[]ZeroKnowledgeProofResponse `json:"response,omitempty"`
+	Status              VerificationSessionStatus     `json:"status"`
+	UpdatedAt           TimeUTC                       `json:"updatedAt"`
+	UserDID             *string                       `json:"userDID,omitempty"`
+	VerificationQueryID uuid.UUID                     `json:"verificationQueryID"`
+}
+
+// VerificationSessionStatus defines model for VerificationSession.Status.
+type VerificationSessionStatus string
+
+// ZeroKnowledgeProofRequest defines model for ZeroKnowledgeProofRequest.
+type ZeroKnowledgeProofRequest = protocol.ZeroKnowledgeProofRequest
+
+// ZeroKnowledgeProofResponse defines model for ZeroKnowledgeProofResponse.
+type ZeroKnowledgeProofResponse = protocol.ZeroKnowledgeProofResponse
+
 // Id defines model for id.
 type Id = uuid.UUID
 
+// IdempotencyKey defines model for idempotencyKey.
+type IdempotencyKey = string
+
 // LinkID defines model for linkID.
 type LinkID = uuid.UUID
 
@@ -866,6 +1565,16 @@ type AuthCallbackParams struct {
 	SessionID SessionID `form:"sessionID" json:"sessionID"`
 }
 
+// VerifyCredentialJSONBody defines parameters for VerifyCredential.
+type VerifyCredentialJSONBody = verifiable.W3CCredential
+
+// CreateIdentityParams defines parameters for CreateIdentity.
+type CreateIdentityParams struct {
+	// IdempotencyKey Unique key to retry the request safely. The first response is stored and replayed for repeated requests
+	// with the same key and body. A request with the same key and a different body is rejected with a 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // UpdateIdentityJSONBody defines parameters for UpdateIdentity.
 type UpdateIdentityJSONBody struct {
 	DisplayName string `json:"displayName"`
@@ -899,6 +1608,28 @@ type DeleteConnectionParams struct {
 	DeleteCredentials *bool `form:"deleteCredentials,omitempty" json:"deleteCredentials,omitempty"`
 }
 
+// GetCredentialTemplatesParams defines parameters for GetCredentialTemplates.
+type GetCredentialTemplatesParams struct {
+	Page *uint `form:"page,omitempty" json:"page,omitempty"`
+
+	// MaxResults Number of items to fetch on each page. Default is 50.
+	MaxResults *uint `form:"max_results,omitempty" json:"max_results,omitempty"`
+}
+
+// CreateCredentialFromTemplateParams defines parameters for CreateCredentialFromTemplate.
+type CreateCredentialFromTemplateParams struct {
+	// IdempotencyKey Unique key to retry the request safely. The first response is stored and replayed for repeated requests
+	// with the same key and body. A request with the same key and a different body is rejected with a 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateLinkFromTemplateParams defines parameters for CreateLinkFromTemplate.
+type CreateLinkFromTemplateParams struct {
+	// IdempotencyKey Unique key to retry the request safely. The first response is stored and replayed for repeated requests
+	// with the same key and body. A request with the same key and a different body is rejected with a 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetCredentialsParams defines parameters for GetCredentials.
 type GetCredentialsParams struct {
 	// Page Page to fetch. First is one. If omitted, all results will be returned.
@@ -915,6 +1646,7 @@ type GetCredentialsParams struct {
 	//   * `all` - All Credentials. (default value)
 	//   * `revoked` - Only revoked credentials
 	//   * `expired` - Only expired credentials
+	//   * `suspended` - Only suspended credentials
 	Status *GetCredentialsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
 	// Query Query string to do full text search
@@ -931,6 +1663,26 @@ type GetCredentialsParamsStatus string
 // GetCredentialsParamsSort defines parameters for GetCredentials.
 type GetCredentialsParamsSort string
 
+// CreateCredentialParams defines parameters for CreateCredential.
+type CreateCredentialParams struct {
+	// IdempotencyKey Unique key to retry the request safely. The first response is stored and replayed for repeated requests
+	// with the same key and body. A request with the same key and a different body is rejected with a 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetBulkIssuanceJobRowsParams defines parameters for GetBulkIssuanceJobRows.
+type GetBulkIssuanceJobRowsParams struct {
+	// Status Filter rows by status
+	Status *GetBulkIssuanceJobRowsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Page   *uint                               `form:"page,omitempty" json:"page,omitempty"`
+
+	// MaxResults Number of items to fetch on each page. Minimum is 10. Default is 50. No maximum by the moment.
+	MaxResults *uint `form:"max_results,omitempty" json:"max_results,omitempty"`
+}
+
+// GetBulkIssuanceJobRowsParamsStatus defines parameters for GetBulkIssuanceJobRows.
+type GetBulkIssuanceJobRowsParamsStatus string
+
 // GetLinksParams defines parameters for GetLinks.
 type GetLinksParams struct {
 	// Query Query string to do full text search in schema types and attributes.
@@ -947,6 +1699,13 @@ type GetLinksParams struct {
 // GetLinksParamsStatus defines parameters for GetLinks.
 type GetLinksParamsStatus string
 
+// CreateLinkParams defines parameters for CreateLink.
+type CreateLinkParams struct {
+	// IdempotencyKey Unique key to retry the request safely. The first response is stored and replayed for repeated requests
+	// with the same key and body. A request with the same key and a different body is rejected with a 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // CreateLinkQrCodeCallbackTextBody defines parameters for CreateLinkQrCodeCallback.
 type CreateLinkQrCodeCallbackTextBody = string
 
@@ -961,6 +1720,24 @@ type ActivateLinkJSONBody struct {
 	Active bool `json:"active"`
 }
 
+// GetCredentialParams defines parameters for GetCredential.
+type GetCredentialParams struct {
+	// Format Format:
+	//   * `iden3` - (default value) Only the iden3 JSON-LD credential.
+	//   * `jwt-vc` - Also export the credential as a JWT-VC.
+	//   * `sd-jwt-vc` - Also export the credential as an SD-JWT VC.
+	Format *GetCredentialParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// KeyID Base64 id of the ETH or Ed25519 key used to sign the JWT. If not provided an Ed25519 key is preferred.
+	KeyID *string `form:"keyID,omitempty" json:"keyID,omitempty"`
+
+	// DisclosableFields Top level credentialSubject attributes that can be selectively disclosed in the SD-JWT VC. All by default.
+	DisclosableFields *[]string `form:"disclosableFields,omitempty" json:"disclosableFields,omitempty"`
+}
+
+// GetCredentialParamsFormat defines parameters for GetCredential.
+type GetCredentialParamsFormat string
+
 // GetCredentialOfferParams defines parameters for GetCredentialOffer.
 type GetCredentialOfferParams struct {
 	// Type Type:
@@ -1007,11 +1784,24 @@ type GetKeysParams struct {
 // GetKeysParamsType defines parameters for GetKeys.
 type GetKeysParamsType string
 
+// CreateKeyParams defines parameters for CreateKey.
+type CreateKeyParams struct {
+	// IdempotencyKey Unique key to retry the request safely. The first response is stored and replayed for repeated requests
+	// with the same key and body. A request with the same key and a different body is rejected with a 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // UpdateKeyJSONBody defines parameters for UpdateKey.
 type UpdateKeyJSONBody struct {
 	Name string `json:"name"`
 }
 
+// CheckMerkleTreesIntegrityParams defines parameters for CheckMerkleTreesIntegrity.
+type CheckMerkleTreesIntegrityParams struct {
+	// Repair Regenerate the stale merkle tree proofs
+	Repair *bool `form:"repair,omitempty" json:"repair,omitempty"`
+}
+
 // GetPaymentRequestsParams defines parameters for GetPaymentRequests.
 type GetPaymentRequestsParams struct {
 	// UserDID Filter by user DID
@@ -1024,6 +1814,13 @@ type GetPaymentRequestsParams struct {
 	Nonce *string `form:"nonce,omitempty" json:"nonce,omitempty"`
 }
 
+// CreatePaymentRequestParams defines parameters for CreatePaymentRequest.
+type CreatePaymentRequestParams struct {
+	// IdempotencyKey Unique key to retry the request safely. The first response is stored and replayed for repeated requests
+	// with the same key and body. A request with the same key and a different body is rejected with a 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetSchemasParams defines parameters for GetSchemas.
 type GetSchemasParams struct {
 	// Query Query string to do full text search in schema types and attributes.
@@ -1032,7 +1829,21 @@ type GetSchemasParams struct {
 
 // UpdateSchemaJSONBody defines parameters for UpdateSchema.
 type UpdateSchemaJSONBody struct {
-	DisplayMethodID *uuid.UUID `json:"displayMethodID"`
+	// CredentialEncryption Encryption of the credentials with a key agreement key of the DID document of the holder.
+	// none: credentials are only encrypted when the request includes an encryption key.
+	// preferred: credentials are encrypted when the holder has a usable key and issued in plain text otherwise.
+	// required: credentials are not issued when the holder has no usable key.
+	CredentialEncryption *CredentialEncryptionPolicy `json:"credentialEncryption,omitempty"`
+	DisplayMethodID      *uuid.UUID                  `json:"displayMethodID"`
+}
+
+// GetStateDiffParams defines parameters for GetStateDiff.
+type GetStateDiffParams struct {
+	// From Identity state hash to start from, not included in the diff
+	From string `form:"from" json:"from"`
+
+	// To Identity state hash to end at, included in the diff
+	To string `form:"to" json:"to"`
 }
 
 // GetStateTransactionsParams defines parameters for GetStateTransactions.
@@ -1053,12 +1864,30 @@ type GetStateTransactionsParamsFilter string
 // GetStateTransactionsParamsSort defines parameters for GetStateTransactions.
 type GetStateTransactionsParamsSort string
 
+// GetStateDetailsParams defines parameters for GetStateDetails.
+type GetStateDetailsParams struct {
+	// Page Page to fetch. First is 1. If not provided, default is 1.
+	Page *uint `form:"page,omitempty" json:"page,omitempty"`
+
+	// MaxResults Number of items to fetch on each page. Default is 50.
+	MaxResults *uint `form:"max_results,omitempty" json:"max_results,omitempty"`
+}
+
 // GetQrFromStoreParams defines parameters for GetQrFromStore.
 type GetQrFromStoreParams struct {
 	Id     *uuid.UUID `form:"id,omitempty" json:"id,omitempty"`
 	Issuer *string    `form:"issuer,omitempty" json:"issuer,omitempty"`
 }
 
+// VerificationCallbackTextBody defines parameters for VerificationCallback.
+type VerificationCallbackTextBody = string
+
+// VerificationCallbackParams defines parameters for VerificationCallback.
+type VerificationCallbackParams struct {
+	// SessionID Session ID e.g: 89d298fa-15a6-4a1d-ab13-d1069467eedd
+	SessionID SessionID `form:"sessionID" json:"sessionID"`
+}
+
 // AuthenticationParams defines parameters for Authentication.
 type AuthenticationParams struct {
 	// Type Type:
@@ -1076,9 +1905,18 @@ type AgentV1TextRequestBody = AgentV1TextBody
 // AgentTextRequestBody defines body for Agent for text/plain ContentType.
 type AgentTextRequestBody = AgentTextBody
 
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = CreateAPIKeyRequest
+
+// UpdateAPIKeyJSONRequestBody defines body for UpdateAPIKey for application/json ContentType.
+type UpdateAPIKeyJSONRequestBody = UpdateAPIKeyRequest
+
 // AuthCallbackTextRequestBody defines body for AuthCallback for text/plain ContentType.
 type AuthCallbackTextRequestBody = AuthCallbackTextBody
 
+// VerifyCredentialJSONRequestBody defines body for VerifyCredential for application/json ContentType.
+type VerifyCredentialJSONRequestBody = VerifyCredentialJSONBody
+
 // CreateIdentityJSONRequestBody defines body for CreateIdentity for application/json ContentType.
 type CreateIdentityJSONRequestBody = CreateIdentityRequest
 
@@ -1091,9 +1929,30 @@ type CreateConnectionJSONRequestBody = CreateConnectionRequest
 // CreateAuthCredentialJSONRequestBody defines body for CreateAuthCredential for application/json ContentType.
 type CreateAuthCredentialJSONRequestBody = CreateAuthCredentialRequest
 
+// CreateCredentialExpirationPolicyJSONRequestBody defines body for CreateCredentialExpirationPolicy for application/json ContentType.
+type CreateCredentialExpirationPolicyJSONRequestBody = CredentialExpirationPolicyRequest
+
+// UpdateCredentialExpirationPolicyJSONRequestBody defines body for UpdateCredentialExpirationPolicy for application/json ContentType.
+type UpdateCredentialExpirationPolicyJSONRequestBody = CredentialExpirationPolicyRequest
+
+// CreateCredentialTemplateJSONRequestBody defines body for CreateCredentialTemplate for application/json ContentType.
+type CreateCredentialTemplateJSONRequestBody = CredentialTemplateRequest
+
+// UpdateCredentialTemplateJSONRequestBody defines body for UpdateCredentialTemplate for application/json ContentType.
+type UpdateCredentialTemplateJSONRequestBody = CredentialTemplateRequest
+
+// CreateCredentialFromTemplateJSONRequestBody defines body for CreateCredentialFromTemplate for application/json ContentType.
+type CreateCredentialFromTemplateJSONRequestBody = CreateCredentialFromTemplateRequest
+
+// CreateLinkFromTemplateJSONRequestBody defines body for CreateLinkFromTemplate for application/json ContentType.
+type CreateLinkFromTemplateJSONRequestBody = CreateLinkFromTemplateRequest
+
 // CreateCredentialJSONRequestBody defines body for CreateCredential for application/json ContentType.
 type CreateCredentialJSONRequestBody = CreateCredentialRequest
 
+// CreateBulkIssuanceJobMultipartRequestBody defines body for CreateBulkIssuanceJob for multipart/form-data ContentType.
+type CreateBulkIssuanceJobMultipartRequestBody = CreateBulkIssuanceJobRequest
+
 // CreateLinkJSONRequestBody defines body for CreateLink for application/json ContentType.
 type CreateLinkJSONRequestBody = CreateLinkRequest
 
@@ -1103,12 +1962,18 @@ type CreateLinkQrCodeCallbackTextRequestBody = CreateLinkQrCodeCallbackTextBody
 // ActivateLinkJSONRequestBody defines body for ActivateLink for application/json ContentType.
 type ActivateLinkJSONRequestBody ActivateLinkJSONBody
 
+// ReissueCredentialJSONRequestBody defines body for ReissueCredential for application/json ContentType.
+type ReissueCredentialJSONRequestBody = ReissueCredentialRequest
+
 // CreateDisplayMethodJSONRequestBody defines body for CreateDisplayMethod for application/json ContentType.
 type CreateDisplayMethodJSONRequestBody = CreateDisplayMethodRequest
 
 // UpdateDisplayMethodJSONRequestBody defines body for UpdateDisplayMethod for application/json ContentType.
 type UpdateDisplayMethodJSONRequestBody UpdateDisplayMethodJSONBody
 
+// CreateKeyRotationJSONRequestBody defines body for CreateKeyRotation for application/json ContentType.
+type CreateKeyRotationJSONRequestBody = CreateKeyRotationRequest
+
 // CreateKeyJSONRequestBody defines body for CreateKey for application/json ContentType.
 type CreateKeyJSONRequestBody = CreateKeyRequest
 
@@ -1133,8 +1998,23 @@ type ImportSchemaJSONRequestBody = ImportSchemaRequest
 // UpdateSchemaJSONRequestBody defines body for UpdateSchema for application/json ContentType.
 type UpdateSchemaJSONRequestBody UpdateSchemaJSONBody
 
+// UpdatePublishPolicyJSONRequestBody defines body for UpdatePublishPolicy for application/json ContentType.
+type UpdatePublishPolicyJSONRequestBody = PublishPolicyRequest
+
+// CreateVerificationQueryJSONRequestBody defines body for CreateVerificationQuery for application/json ContentType.
+type CreateVerificationQueryJSONRequestBody = VerificationQueryRequest
+
+// VerificationCallbackTextRequestBody defines body for VerificationCallback for text/plain ContentType.
+type VerificationCallbackTextRequestBody = VerificationCallbackTextBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Resolve DID
+	// (GET /1.0/identifiers/{identifier})
+	ResolveDID(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get did:web DID Document
+	// (GET /issuers/{id}/did.json)
+	GetWebDIDDocument(w http.ResponseWriter, r *http.Request, id string)
 	// Healthcheck
 	// (GET /status)
 	Health(w http.ResponseWriter, r *http.Request)
@@ -1147,18 +2027,36 @@ type ServerInterface interface {
 	// Agent
 	// (POST /v2/agent)
 	Agent(w http.ResponseWriter, r *http.Request)
+	// Get API Keys
+	// (GET /v2/api-keys)
+	GetAPIKeys(w http.ResponseWriter, r *http.Request)
+	// Create API Key
+	// (POST /v2/api-keys)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	// Delete API Key
+	// (DELETE /v2/api-keys/{id})
+	DeleteAPIKey(w http.ResponseWriter, r *http.Request, id Id)
+	// Get API Key
+	// (GET /v2/api-keys/{id})
+	GetAPIKey(w http.ResponseWriter, r *http.Request, id Id)
+	// Update API Key
+	// (PATCH /v2/api-keys/{id})
+	UpdateAPIKey(w http.ResponseWriter, r *http.Request, id Id)
 	// Authentication Callback
 	// (POST /v2/authentication/callback)
 	AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams)
 	// Get Authentication Connection
 	// (GET /v2/authentication/sessions/{id})
 	GetAuthenticationConnection(w http.ResponseWriter, r *http.Request, id Id)
+	// Verify Credential
+	// (POST /v2/credentials/verify)
+	VerifyCredential(w http.ResponseWriter, r *http.Request)
 	// Get Identities
 	// (GET /v2/identities)
 	GetIdentities(w http.ResponseWriter, r *http.Request)
 	// Create Identity
 	// (POST /v2/identities)
-	CreateIdentity(w http.ResponseWriter, r *http.Request)
+	CreateIdentity(w http.ResponseWriter, r *http.Request, params CreateIdentityParams)
 	// Get Identity Detail
 	// (GET /v2/identities/{identifier})
 	GetIdentityDetails(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
@@ -1186,18 +2084,60 @@ type ServerInterface interface {
 	// Create Auth Credential
 	// (POST /v2/identities/{identifier}/create-auth-credential)
 	CreateAuthCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2)
+	// Get Credential Expiration Policies
+	// (GET /v2/identities/{identifier}/credential-expiration-policies)
+	GetCredentialExpirationPolicies(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Create Credential Expiration Policy
+	// (POST /v2/identities/{identifier}/credential-expiration-policies)
+	CreateCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Delete Credential Expiration Policy
+	// (DELETE /v2/identities/{identifier}/credential-expiration-policies/{id})
+	DeleteCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Update Credential Expiration Policy
+	// (PUT /v2/identities/{identifier}/credential-expiration-policies/{id})
+	UpdateCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Credential Templates
+	// (GET /v2/identities/{identifier}/credential-templates)
+	GetCredentialTemplates(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetCredentialTemplatesParams)
+	// Create Credential Template
+	// (POST /v2/identities/{identifier}/credential-templates)
+	CreateCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Delete Credential Template
+	// (DELETE /v2/identities/{identifier}/credential-templates/{id})
+	DeleteCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Credential Template
+	// (GET /v2/identities/{identifier}/credential-templates/{id})
+	GetCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Update Credential Template
+	// (PUT /v2/identities/{identifier}/credential-templates/{id})
+	UpdateCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Create Credential From Template
+	// (POST /v2/identities/{identifier}/credential-templates/{id}/credentials)
+	CreateCredentialFromTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params CreateCredentialFromTemplateParams)
+	// Create Link From Template
+	// (POST /v2/identities/{identifier}/credential-templates/{id}/links)
+	CreateLinkFromTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params CreateLinkFromTemplateParams)
 	// Get Credentials
 	// (GET /v2/identities/{identifier}/credentials)
 	GetCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetCredentialsParams)
 	// Create Credential
 	// (POST /v2/identities/{identifier}/credentials)
-	CreateCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	CreateCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateCredentialParams)
+	// Create Bulk Issuance Job
+	// (POST /v2/identities/{identifier}/credentials/bulk)
+	CreateBulkIssuanceJob(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Bulk Issuance Job
+	// (GET /v2/identities/{identifier}/credentials/bulk/{id})
+	GetBulkIssuanceJob(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Bulk Issuance Job Rows
+	// (GET /v2/identities/{identifier}/credentials/bulk/{id}/rows)
+	GetBulkIssuanceJobRows(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params GetBulkIssuanceJobRowsParams)
 	// Get Links
 	// (GET /v2/identities/{identifier}/credentials/links)
 	GetLinks(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetLinksParams)
 	// Create Link
 	// (POST /v2/identities/{identifier}/credentials/links)
-	CreateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	CreateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateLinkParams)
 	// Create Link QR Code Callback
 	// (POST /v2/identities/{identifier}/credentials/links/callback)
 	CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateLinkQrCodeCallbackParams)
@@ -1213,21 +2153,33 @@ type ServerInterface interface {
 	// Create a credential offer for a link
 	// (POST /v2/identities/{identifier}/credentials/links/{id}/offer)
 	CreateLinkOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Resume Credential
+	// (POST /v2/identities/{identifier}/credentials/resume/{nonce})
+	ResumeCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce)
 	// Get Revocation Status
 	// (GET /v2/identities/{identifier}/credentials/revocation/status/{nonce})
 	GetRevocationStatusV2(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce)
 	// Revoke Credential
 	// (POST /v2/identities/{identifier}/credentials/revoke/{nonce})
 	RevokeCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce)
+	// Suspend Credential
+	// (POST /v2/identities/{identifier}/credentials/suspend/{nonce})
+	SuspendCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce)
 	// Delete Credential
 	// (DELETE /v2/identities/{identifier}/credentials/{id})
 	DeleteCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
 	// Get Credential
 	// (GET /v2/identities/{identifier}/credentials/{id})
-	GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
+	GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialParams)
+	// Reissue Credential
+	// (PATCH /v2/identities/{identifier}/credentials/{id})
+	ReissueCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
 	// Get Credentials Offer
 	// (GET /v2/identities/{identifier}/credentials/{id}/offer)
 	GetCredentialOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialOfferParams)
+	// Get DID Document
+	// (GET /v2/identities/{identifier}/did.json)
+	GetDIDDocument(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
 	// Get All Display Methods
 	// (GET /v2/identities/{identifier}/display-method)
 	GetAllDisplayMethods(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAllDisplayMethodsParams)
@@ -1243,12 +2195,27 @@ type ServerInterface interface {
 	// Update Display Method
 	// (PATCH /v2/identities/{identifier}/display-method/{id})
 	UpdateDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Key Rotations
+	// (GET /v2/identities/{identifier}/key-rotations)
+	GetKeyRotations(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2)
+	// Rotate Auth Key
+	// (POST /v2/identities/{identifier}/key-rotations)
+	CreateKeyRotation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2)
+	// Get Key Rotation
+	// (GET /v2/identities/{identifier}/key-rotations/{id})
+	GetKeyRotation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id Id)
+	// Get Credentials To Re-sign
+	// (GET /v2/identities/{identifier}/key-rotations/{id}/credentials)
+	GetKeyRotationCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id Id)
+	// Resume Key Rotation
+	// (POST /v2/identities/{identifier}/key-rotations/{id}/resume)
+	ResumeKeyRotation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id Id)
 	// Get Keys
 	// (GET /v2/identities/{identifier}/keys)
 	GetKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params GetKeysParams)
 	// Create a Key
 	// (POST /v2/identities/{identifier}/keys)
-	CreateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2)
+	CreateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params CreateKeyParams)
 	// Delete Key
 	// (DELETE /v2/identities/{identifier}/keys/{id})
 	DeleteKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID)
@@ -1258,12 +2225,15 @@ type ServerInterface interface {
 	// Update a Key
 	// (PATCH /v2/identities/{identifier}/keys/{id})
 	UpdateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID)
+	// Check Merkle Trees Integrity
+	// (POST /v2/identities/{identifier}/merkle-trees/integrity)
+	CheckMerkleTreesIntegrity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CheckMerkleTreesIntegrityParams)
 	// Get Payment Requests
 	// (GET /v2/identities/{identifier}/payment-request)
 	GetPaymentRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetPaymentRequestsParams)
 	// Create Payment Request
 	// (POST /v2/identities/{identifier}/payment-request)
-	CreatePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	CreatePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreatePaymentRequestParams)
 	// Delete Payment Request
 	// (DELETE /v2/identities/{identifier}/payment-request/{id})
 	DeletePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
@@ -1300,9 +2270,24 @@ type ServerInterface interface {
 	// Update Schema
 	// (PATCH /v2/identities/{identifier}/schemas/{id})
 	UpdateSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Identity State Diff
+	// (GET /v2/identities/{identifier}/state/diff)
+	GetStateDiff(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetStateDiffParams)
+	// Dry Run Publish Identity State
+	// (POST /v2/identities/{identifier}/state/dry-run)
+	DryRunPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
 	// Publish Identity State
 	// (POST /v2/identities/{identifier}/state/publish)
 	PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Delete Publish Policy
+	// (DELETE /v2/identities/{identifier}/state/publish-policy)
+	DeletePublishPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Publish Policy
+	// (GET /v2/identities/{identifier}/state/publish-policy)
+	GetPublishPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Set Publish Policy
+	// (PUT /v2/identities/{identifier}/state/publish-policy)
+	UpdatePublishPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
 	// Retry Publish Identity State
 	// (POST /v2/identities/{identifier}/state/retry)
 	RetryPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
@@ -1312,6 +2297,30 @@ type ServerInterface interface {
 	// Get Identity State Transactions
 	// (GET /v2/identities/{identifier}/state/transactions)
 	GetStateTransactions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetStateTransactionsParams)
+	// Get Identity State Details
+	// (GET /v2/identities/{identifier}/states/{state})
+	GetStateDetails(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, state string, params GetStateDetailsParams)
+	// Get Status List Credential
+	// (GET /v2/identities/{identifier}/status-lists/{id})
+	GetStatusListCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Verification Queries
+	// (GET /v2/identities/{identifier}/verification-queries)
+	GetVerificationQueries(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Create Verification Query
+	// (POST /v2/identities/{identifier}/verification-queries)
+	CreateVerificationQuery(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Delete Verification Query
+	// (DELETE /v2/identities/{identifier}/verification-queries/{id})
+	DeleteVerificationQuery(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Verification Query
+	// (GET /v2/identities/{identifier}/verification-queries/{id})
+	GetVerificationQuery(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Create Verification Session
+	// (POST /v2/identities/{identifier}/verification-queries/{id}/sessions)
+	CreateVerificationSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Verification Session
+	// (GET /v2/identities/{identifier}/verification-sessions/{id})
+	GetVerificationSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
 	// Payments Configuration
 	// (GET /v2/payment/settings)
 	GetPaymentSettings(w http.ResponseWriter, r *http.Request)
@@ -1321,6 +2330,9 @@ type ServerInterface interface {
 	// Get Supported Networks
 	// (GET /v2/supported-networks)
 	GetSupportedNetworks(w http.ResponseWriter, r *http.Request)
+	// Verification Callback
+	// (POST /v2/verification/callback)
+	VerificationCallback(w http.ResponseWriter, r *http.Request, params VerificationCallbackParams)
 	// Get Authentication Message
 	// (POST /v2/{identifier}/authentication)
 	Authentication(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params AuthenticationParams)
@@ -1330,8 +2342,20 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
-// Healthcheck
-// (GET /status)
+// Resolve DID
+// (GET /1.0/identifiers/{identifier})
+func (_ Unimplemented) ResolveDID(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get did:web DID Document
+// (GET /issuers/{id}/did.json)
+func (_ Unimplemented) GetWebDIDDocument(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Healthcheck
+// (GET /status)
 func (_ Unimplemented) Health(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
@@ -1354,6 +2378,36 @@ func (_ Unimplemented) Agent(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get API Keys
+// (GET /v2/api-keys)
+func (_ Unimplemented) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create API Key
+// (POST /v2/api-keys)
+func (_ Unimplemented) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete API Key
+// (DELETE /v2/api-keys/{id})
+func (_ Unimplemented) DeleteAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get API Key
+// (GET /v2/api-keys/{id})
+func (_ Unimplemented) GetAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update API Key
+// (PATCH /v2/api-keys/{id})
+func (_ Unimplemented) UpdateAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Authentication Callback
 // (POST /v2/authentication/callback)
 func (_ Unimplemented) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
@@ -1366,6 +2420,12 @@ func (_ Unimplemented) GetAuthenticationConnection(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Verify Credential
+// (POST /v2/credentials/verify)
+func (_ Unimplemented) VerifyCredential(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Identities
 // (GET /v2/identities)
 func (_ Unimplemented) GetIdentities(w http.ResponseWriter, r *http.Request) {
@@ -1374,7 +2434,7 @@ func (_ Unimplemented) GetIdentities(w http.ResponseWriter, r *http.Request) {
 
 // Create Identity
 // (POST /v2/identities)
-func (_ Unimplemented) CreateIdentity(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateIdentity(w http.ResponseWriter, r *http.Request, params CreateIdentityParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1432,6 +2492,72 @@ func (_ Unimplemented) CreateAuthCredential(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Credential Expiration Policies
+// (GET /v2/identities/{identifier}/credential-expiration-policies)
+func (_ Unimplemented) GetCredentialExpirationPolicies(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Credential Expiration Policy
+// (POST /v2/identities/{identifier}/credential-expiration-policies)
+func (_ Unimplemented) CreateCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete Credential Expiration Policy
+// (DELETE /v2/identities/{identifier}/credential-expiration-policies/{id})
+func (_ Unimplemented) DeleteCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Credential Expiration Policy
+// (PUT /v2/identities/{identifier}/credential-expiration-policies/{id})
+func (_ Unimplemented) UpdateCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Credential Templates
+// (GET /v2/identities/{identifier}/credential-templates)
+func (_ Unimplemented) GetCredentialTemplates(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetCredentialTemplatesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Credential Template
+// (POST /v2/identities/{identifier}/credential-templates)
+func (_ Unimplemented) CreateCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete Credential Template
+// (DELETE /v2/identities/{identifier}/credential-templates/{id})
+func (_ Unimplemented) DeleteCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Credential Template
+// (GET /v2/identities/{identifier}/credential-templates/{id})
+func (_ Unimplemented) GetCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Credential Template
+// (PUT /v2/identities/{identifier}/credential-templates/{id})
+func (_ Unimplemented) UpdateCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Credential From Template
+// (POST /v2/identities/{identifier}/credential-templates/{id}/credentials)
+func (_ Unimplemented) CreateCredentialFromTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params CreateCredentialFromTemplateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Link From Template
+// (POST /v2/identities/{identifier}/credential-templates/{id}/links)
+func (_ Unimplemented) CreateLinkFromTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params CreateLinkFromTemplateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Credentials
 // (GET /v2/identities/{identifier}/credentials)
 func (_ Unimplemented) GetCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetCredentialsParams) {
@@ -1440,7 +2566,25 @@ func (_ Unimplemented) GetCredentials(w http.ResponseWriter, r *http.Request, id
 
 // Create Credential
 // (POST /v2/identities/{identifier}/credentials)
-func (_ Unimplemented) CreateCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+func (_ Unimplemented) CreateCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateCredentialParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Bulk Issuance Job
+// (POST /v2/identities/{identifier}/credentials/bulk)
+func (_ Unimplemented) CreateBulkIssuanceJob(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Bulk Issuance Job
+// (GET /v2/identities/{identifier}/credentials/bulk/{id})
+func (_ Unimplemented) GetBulkIssuanceJob(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Bulk Issuance Job Rows
+// (GET /v2/identities/{identifier}/credentials/bulk/{id}/rows)
+func (_ Unimplemented) GetBulkIssuanceJobRows(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params GetBulkIssuanceJobRowsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1452,7 +2596,7 @@ func (_ Unimplemented) GetLinks(w http.ResponseWriter, r *http.Request, identifi
 
 // Create Link
 // (POST /v2/identities/{identifier}/credentials/links)
-func (_ Unimplemented) CreateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+func (_ Unimplemented) CreateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateLinkParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1486,6 +2630,12 @@ func (_ Unimplemented) CreateLinkOffer(w http.ResponseWriter, r *http.Request, i
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Resume Credential
+// (POST /v2/identities/{identifier}/credentials/resume/{nonce})
+func (_ Unimplemented) ResumeCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Revocation Status
 // (GET /v2/identities/{identifier}/credentials/revocation/status/{nonce})
 func (_ Unimplemented) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
@@ -1498,6 +2648,12 @@ func (_ Unimplemented) RevokeCredential(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Suspend Credential
+// (POST /v2/identities/{identifier}/credentials/suspend/{nonce})
+func (_ Unimplemented) SuspendCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete Credential
 // (DELETE /v2/identities/{identifier}/credentials/{id})
 func (_ Unimplemented) DeleteCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
@@ -1506,7 +2662,13 @@ func (_ Unimplemented) DeleteCredential(w http.ResponseWriter, r *http.Request,
 
 // Get Credential
 // (GET /v2/identities/{identifier}/credentials/{id})
-func (_ Unimplemented) GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+func (_ Unimplemented) GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reissue Credential
+// (PATCH /v2/identities/{identifier}/credentials/{id})
+func (_ Unimplemented) ReissueCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1516,6 +2678,12 @@ func (_ Unimplemented) GetCredentialOffer(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get DID Document
+// (GET /v2/identities/{identifier}/did.json)
+func (_ Unimplemented) GetDIDDocument(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get All Display Methods
 // (GET /v2/identities/{identifier}/display-method)
 func (_ Unimplemented) GetAllDisplayMethods(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAllDisplayMethodsParams) {
@@ -1546,6 +2714,36 @@ func (_ Unimplemented) UpdateDisplayMethod(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Key Rotations
+// (GET /v2/identities/{identifier}/key-rotations)
+func (_ Unimplemented) GetKeyRotations(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rotate Auth Key
+// (POST /v2/identities/{identifier}/key-rotations)
+func (_ Unimplemented) CreateKeyRotation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Key Rotation
+// (GET /v2/identities/{identifier}/key-rotations/{id})
+func (_ Unimplemented) GetKeyRotation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Credentials To Re-sign
+// (GET /v2/identities/{identifier}/key-rotations/{id}/credentials)
+func (_ Unimplemented) GetKeyRotationCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resume Key Rotation
+// (POST /v2/identities/{identifier}/key-rotations/{id}/resume)
+func (_ Unimplemented) ResumeKeyRotation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Keys
 // (GET /v2/identities/{identifier}/keys)
 func (_ Unimplemented) GetKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params GetKeysParams) {
@@ -1554,7 +2752,7 @@ func (_ Unimplemented) GetKeys(w http.ResponseWriter, r *http.Request, identifie
 
 // Create a Key
 // (POST /v2/identities/{identifier}/keys)
-func (_ Unimplemented) CreateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+func (_ Unimplemented) CreateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params CreateKeyParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1576,6 +2774,12 @@ func (_ Unimplemented) UpdateKey(w http.ResponseWriter, r *http.Request, identif
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Check Merkle Trees Integrity
+// (POST /v2/identities/{identifier}/merkle-trees/integrity)
+func (_ Unimplemented) CheckMerkleTreesIntegrity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CheckMerkleTreesIntegrityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Payment Requests
 // (GET /v2/identities/{identifier}/payment-request)
 func (_ Unimplemented) GetPaymentRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetPaymentRequestsParams) {
@@ -1584,7 +2788,7 @@ func (_ Unimplemented) GetPaymentRequests(w http.ResponseWriter, r *http.Request
 
 // Create Payment Request
 // (POST /v2/identities/{identifier}/payment-request)
-func (_ Unimplemented) CreatePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+func (_ Unimplemented) CreatePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreatePaymentRequestParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1660,12 +2864,42 @@ func (_ Unimplemented) UpdateSchema(w http.ResponseWriter, r *http.Request, iden
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Identity State Diff
+// (GET /v2/identities/{identifier}/state/diff)
+func (_ Unimplemented) GetStateDiff(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetStateDiffParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dry Run Publish Identity State
+// (POST /v2/identities/{identifier}/state/dry-run)
+func (_ Unimplemented) DryRunPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Publish Identity State
 // (POST /v2/identities/{identifier}/state/publish)
 func (_ Unimplemented) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete Publish Policy
+// (DELETE /v2/identities/{identifier}/state/publish-policy)
+func (_ Unimplemented) DeletePublishPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Publish Policy
+// (GET /v2/identities/{identifier}/state/publish-policy)
+func (_ Unimplemented) GetPublishPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set Publish Policy
+// (PUT /v2/identities/{identifier}/state/publish-policy)
+func (_ Unimplemented) UpdatePublishPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Retry Publish Identity State
 // (POST /v2/identities/{identifier}/state/retry)
 func (_ Unimplemented) RetryPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
@@ -1684,6 +2918,54 @@ func (_ Unimplemented) GetStateTransactions(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Identity State Details
+// (GET /v2/identities/{identifier}/states/{state})
+func (_ Unimplemented) GetStateDetails(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, state string, params GetStateDetailsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Status List Credential
+// (GET /v2/identities/{identifier}/status-lists/{id})
+func (_ Unimplemented) GetStatusListCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Verification Queries
+// (GET /v2/identities/{identifier}/verification-queries)
+func (_ Unimplemented) GetVerificationQueries(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Verification Query
+// (POST /v2/identities/{identifier}/verification-queries)
+func (_ Unimplemented) CreateVerificationQuery(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete Verification Query
+// (DELETE /v2/identities/{identifier}/verification-queries/{id})
+func (_ Unimplemented) DeleteVerificationQuery(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Verification Query
+// (GET /v2/identities/{identifier}/verification-queries/{id})
+func (_ Unimplemented) GetVerificationQuery(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Verification Session
+// (POST /v2/identities/{identifier}/verification-queries/{id}/sessions)
+func (_ Unimplemented) CreateVerificationSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Verification Session
+// (GET /v2/identities/{identifier}/verification-sessions/{id})
+func (_ Unimplemented) GetVerificationSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Payments Configuration
 // (GET /v2/payment/settings)
 func (_ Unimplemented) GetPaymentSettings(w http.ResponseWriter, r *http.Request) {
@@ -1702,6 +2984,12 @@ func (_ Unimplemented) GetSupportedNetworks(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Verification Callback
+// (POST /v2/verification/callback)
+func (_ Unimplemented) VerificationCallback(w http.ResponseWriter, r *http.Request, params VerificationCallbackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Authentication Message
 // (POST /v2/{identifier}/authentication)
 func (_ Unimplemented) Authentication(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params AuthenticationParams) {
@@ -1717,6 +3005,56 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ResolveDID operation middleware
+func (siw *ServerInterfaceWrapper) ResolveDID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResolveDID(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebDIDDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetWebDIDDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebDIDDocument(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // Health operation middleware
 func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
 
@@ -1793,31 +3131,37 @@ func (siw *ServerInterfaceWrapper) Agent(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r)
 }
 
-// AuthCallback operation middleware
-func (siw *ServerInterfaceWrapper) AuthCallback(w http.ResponseWriter, r *http.Request) {
+// GetAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AuthCallbackParams
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// ------------- Required query parameter "sessionID" -------------
+	r = r.WithContext(ctx)
 
-	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIKeys(w, r)
+	}))
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthCallback(w, r, params)
+		siw.Handler.CreateAPIKey(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1827,8 +3171,8 @@ func (siw *ServerInterfaceWrapper) AuthCallback(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetAuthenticationConnection operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request) {
+// DeleteAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1848,7 +3192,7 @@ func (siw *ServerInterfaceWrapper) GetAuthenticationConnection(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAuthenticationConnection(w, r, id)
+		siw.Handler.DeleteAPIKey(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1858,29 +3202,20 @@ func (siw *ServerInterfaceWrapper) GetAuthenticationConnection(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// GetIdentities operation middleware
-func (siw *ServerInterfaceWrapper) GetIdentities(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// GetAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIKey(w http.ResponseWriter, r *http.Request) {
 
-	r = r.WithContext(ctx)
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetIdentities(w, r)
-	}))
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// CreateIdentity operation middleware
-func (siw *ServerInterfaceWrapper) CreateIdentity(w http.ResponseWriter, r *http.Request) {
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
@@ -1888,7 +3223,7 @@ func (siw *ServerInterfaceWrapper) CreateIdentity(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateIdentity(w, r)
+		siw.Handler.GetAPIKey(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1898,17 +3233,17 @@ func (siw *ServerInterfaceWrapper) CreateIdentity(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetIdentityDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetIdentityDetails(w http.ResponseWriter, r *http.Request) {
+// UpdateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAPIKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -1919,7 +3254,7 @@ func (siw *ServerInterfaceWrapper) GetIdentityDetails(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetIdentityDetails(w, r, identifier)
+		siw.Handler.UpdateAPIKey(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1929,8 +3264,184 @@ func (siw *ServerInterfaceWrapper) GetIdentityDetails(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateIdentity operation middleware
-func (siw *ServerInterfaceWrapper) UpdateIdentity(w http.ResponseWriter, r *http.Request) {
+// AuthCallback operation middleware
+func (siw *ServerInterfaceWrapper) AuthCallback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuthCallbackParams
+
+	// ------------- Required query parameter "sessionID" -------------
+
+	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthCallback(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthenticationConnection operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthenticationConnection(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyCredential operation middleware
+func (siw *ServerInterfaceWrapper) VerifyCredential(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyCredential(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIdentities operation middleware
+func (siw *ServerInterfaceWrapper) GetIdentities(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIdentities(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateIdentity operation middleware
+func (siw *ServerInterfaceWrapper) CreateIdentity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateIdentityParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateIdentity(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIdentityDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetIdentityDetails(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIdentityDetails(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateIdentity operation middleware
+func (siw *ServerInterfaceWrapper) UpdateIdentity(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2275,8 +3786,8 @@ func (siw *ServerInterfaceWrapper) CreateAuthCredential(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetCredentials operation middleware
-func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http.Request) {
+// GetCredentialExpirationPolicies operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialExpirationPolicies(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2295,75 +3806,79 @@ func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCredentialsParams
-
-	// ------------- Optional query parameter "page" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCredentialExpirationPolicies(w, r, identifier)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "credentialSubject" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "credentialSubject", r.URL.Query(), &params.CredentialSubject)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "credentialSubject", Err: err})
-		return
-	}
+// CreateCredentialExpirationPolicy operation middleware
+func (siw *ServerInterfaceWrapper) CreateCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "schemaType" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "schemaType", r.URL.Query(), &params.SchemaType)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaType", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "schemaUrl" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "schemaUrl", r.URL.Query(), &params.SchemaUrl)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaUrl", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// ------------- Optional query parameter "status" -------------
+	r = r.WithContext(ctx)
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCredentialExpirationPolicy(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "query" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
-		return
-	}
+// DeleteCredentialExpirationPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "max_results" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredentials(w, r, identifier, params)
+		siw.Handler.DeleteCredentialExpirationPolicy(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2373,8 +3888,8 @@ func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCredential operation middleware
-func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *http.Request) {
+// UpdateCredentialExpirationPolicy operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2387,6 +3902,15 @@ func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
@@ -2394,7 +3918,7 @@ func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCredential(w, r, identifier)
+		siw.Handler.UpdateCredentialExpirationPolicy(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2404,8 +3928,8 @@ func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
+// GetCredentialTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialTemplates(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2425,26 +3949,26 @@ func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Reque
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLinksParams
+	var params GetCredentialTemplatesParams
 
-	// ------------- Optional query parameter "query" -------------
+	// ------------- Optional query parameter "page" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Optional query parameter "max_results" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinks(w, r, identifier, params)
+		siw.Handler.GetCredentialTemplates(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2454,8 +3978,8 @@ func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// CreateLink operation middleware
-func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Request) {
+// CreateCredentialTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateCredentialTemplate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2475,7 +3999,7 @@ func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLink(w, r, identifier)
+		siw.Handler.CreateCredentialTemplate(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2485,8 +4009,8 @@ func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateLinkQrCodeCallback operation middleware
-func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request) {
+// DeleteCredentialTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCredentialTemplate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2499,26 +4023,23 @@ func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWrite
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateLinkQrCodeCallbackParams
-
-	// ------------- Required query parameter "linkID" -------------
-
-	if paramValue := r.URL.Query().Get("linkID"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "linkID"})
-		return
-	}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, true, "linkID", r.URL.Query(), &params.LinkID)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLinkQrCodeCallback(w, r, identifier, params)
+		siw.Handler.DeleteCredentialTemplate(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2528,8 +4049,8 @@ func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteLink operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Request) {
+// GetCredentialTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialTemplate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2558,7 +4079,7 @@ func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLink(w, r, identifier, id)
+		siw.Handler.GetCredentialTemplate(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2568,8 +4089,8 @@ func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetLink operation middleware
-func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Request) {
+// UpdateCredentialTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCredentialTemplate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2598,7 +4119,7 @@ func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Reques
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLink(w, r, identifier, id)
+		siw.Handler.UpdateCredentialTemplate(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2608,8 +4129,8 @@ func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// ActivateLink operation middleware
-func (siw *ServerInterfaceWrapper) ActivateLink(w http.ResponseWriter, r *http.Request) {
+// CreateCredentialFromTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateCredentialFromTemplate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2637,8 +4158,32 @@ func (siw *ServerInterfaceWrapper) ActivateLink(w http.ResponseWriter, r *http.R
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateCredentialFromTemplateParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ActivateLink(w, r, identifier, id)
+		siw.Handler.CreateCredentialFromTemplate(w, r, identifier, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2648,8 +4193,8 @@ func (siw *ServerInterfaceWrapper) ActivateLink(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateLinkOffer operation middleware
-func (siw *ServerInterfaceWrapper) CreateLinkOffer(w http.ResponseWriter, r *http.Request) {
+// CreateLinkFromTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkFromTemplate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2671,8 +4216,38 @@ func (siw *ServerInterfaceWrapper) CreateLinkOffer(w http.ResponseWriter, r *htt
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLinkFromTemplateParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLinkOffer(w, r, identifier, id)
+		siw.Handler.CreateLinkFromTemplate(w, r, identifier, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2682,8 +4257,8 @@ func (siw *ServerInterfaceWrapper) CreateLinkOffer(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetRevocationStatusV2 operation middleware
-func (siw *ServerInterfaceWrapper) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request) {
+// GetCredentials operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2696,17 +4271,81 @@ func (siw *ServerInterfaceWrapper) GetRevocationStatusV2(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "nonce" -------------
-	var nonce PathNonce
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "credentialSubject" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "credentialSubject", r.URL.Query(), &params.CredentialSubject)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "credentialSubject", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "schemaType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schemaType", r.URL.Query(), &params.SchemaType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "schemaUrl" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schemaUrl", r.URL.Query(), &params.SchemaUrl)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaUrl", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRevocationStatusV2(w, r, identifier, nonce)
+		siw.Handler.GetCredentials(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2716,8 +4355,8 @@ func (siw *ServerInterfaceWrapper) GetRevocationStatusV2(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// RevokeCredential operation middleware
-func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *http.Request) {
+// CreateCredential operation middleware
+func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2730,23 +4369,38 @@ func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "nonce" -------------
-	var nonce PathNonce
-
-	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateCredentialParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RevokeCredential(w, r, identifier, nonce)
+		siw.Handler.CreateCredential(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2756,8 +4410,8 @@ func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCredential operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *http.Request) {
+// CreateBulkIssuanceJob operation middleware
+func (siw *ServerInterfaceWrapper) CreateBulkIssuanceJob(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2770,15 +4424,6 @@ func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id PathClaim
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
@@ -2786,7 +4431,7 @@ func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCredential(w, r, identifier, id)
+		siw.Handler.CreateBulkIssuanceJob(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2796,8 +4441,8 @@ func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetCredential operation middleware
-func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.Request) {
+// GetBulkIssuanceJob operation middleware
+func (siw *ServerInterfaceWrapper) GetBulkIssuanceJob(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2811,7 +4456,7 @@ func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.
 	}
 
 	// ------------- Path parameter "id" -------------
-	var id PathClaim
+	var id Id
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2826,7 +4471,7 @@ func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredential(w, r, identifier, id)
+		siw.Handler.GetBulkIssuanceJob(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2836,8 +4481,8 @@ func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetCredentialOffer operation middleware
-func (siw *ServerInterfaceWrapper) GetCredentialOffer(w http.ResponseWriter, r *http.Request) {
+// GetBulkIssuanceJobRows operation middleware
+func (siw *ServerInterfaceWrapper) GetBulkIssuanceJobRows(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2851,7 +4496,7 @@ func (siw *ServerInterfaceWrapper) GetCredentialOffer(w http.ResponseWriter, r *
 	}
 
 	// ------------- Path parameter "id" -------------
-	var id PathClaim
+	var id Id
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2866,18 +4511,34 @@ func (siw *ServerInterfaceWrapper) GetCredentialOffer(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCredentialOfferParams
+	var params GetBulkIssuanceJobRowsParams
 
-	// ------------- Optional query parameter "type" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredentialOffer(w, r, identifier, id, params)
+		siw.Handler.GetBulkIssuanceJobRows(w, r, identifier, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2887,8 +4548,8 @@ func (siw *ServerInterfaceWrapper) GetCredentialOffer(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllDisplayMethods operation middleware
-func (siw *ServerInterfaceWrapper) GetAllDisplayMethods(w http.ResponseWriter, r *http.Request) {
+// GetLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2908,34 +4569,26 @@ func (siw *ServerInterfaceWrapper) GetAllDisplayMethods(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllDisplayMethodsParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+	var params GetLinksParams
 
-	// ------------- Optional query parameter "max_results" -------------
+	// ------------- Optional query parameter "query" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllDisplayMethods(w, r, identifier, params)
+		siw.Handler.GetLinks(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2945,8 +4598,8 @@ func (siw *ServerInterfaceWrapper) GetAllDisplayMethods(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// CreateDisplayMethod operation middleware
-func (siw *ServerInterfaceWrapper) CreateDisplayMethod(w http.ResponseWriter, r *http.Request) {
+// CreateLink operation middleware
+func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2965,8 +4618,32 @@ func (siw *ServerInterfaceWrapper) CreateDisplayMethod(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLinkParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDisplayMethod(w, r, identifier)
+		siw.Handler.CreateLink(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2976,8 +4653,51 @@ func (siw *ServerInterfaceWrapper) CreateDisplayMethod(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteDisplayMethod operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDisplayMethod(w http.ResponseWriter, r *http.Request) {
+// CreateLinkQrCodeCallback operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLinkQrCodeCallbackParams
+
+	// ------------- Required query parameter "linkID" -------------
+
+	if paramValue := r.URL.Query().Get("linkID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "linkID"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "linkID", r.URL.Query(), &params.LinkID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLinkQrCodeCallback(w, r, identifier, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteLink operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3006,7 +4726,7 @@ func (siw *ServerInterfaceWrapper) DeleteDisplayMethod(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteDisplayMethod(w, r, identifier, id)
+		siw.Handler.DeleteLink(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3016,8 +4736,8 @@ func (siw *ServerInterfaceWrapper) DeleteDisplayMethod(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetDisplayMethod operation middleware
-func (siw *ServerInterfaceWrapper) GetDisplayMethod(w http.ResponseWriter, r *http.Request) {
+// GetLink operation middleware
+func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3046,7 +4766,7 @@ func (siw *ServerInterfaceWrapper) GetDisplayMethod(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDisplayMethod(w, r, identifier, id)
+		siw.Handler.GetLink(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3056,8 +4776,8 @@ func (siw *ServerInterfaceWrapper) GetDisplayMethod(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateDisplayMethod operation middleware
-func (siw *ServerInterfaceWrapper) UpdateDisplayMethod(w http.ResponseWriter, r *http.Request) {
+// ActivateLink operation middleware
+func (siw *ServerInterfaceWrapper) ActivateLink(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3086,7 +4806,7 @@ func (siw *ServerInterfaceWrapper) UpdateDisplayMethod(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateDisplayMethod(w, r, identifier, id)
+		siw.Handler.ActivateLink(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3096,13 +4816,13 @@ func (siw *ServerInterfaceWrapper) UpdateDisplayMethod(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetKeys operation middleware
-func (siw *ServerInterfaceWrapper) GetKeys(w http.ResponseWriter, r *http.Request) {
+// CreateLinkOffer operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkOffer(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier2
+	var identifier PathIdentifier
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3110,41 +4830,57 @@ func (siw *ServerInterfaceWrapper) GetKeys(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLinkOffer(w, r, identifier, id)
+	}))
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetKeysParams
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// ------------- Optional query parameter "max_results" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
-		return
-	}
+// ResumeCredential operation middleware
+func (siw *ServerInterfaceWrapper) ResumeCredential(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "page" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "type" -------------
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKeys(w, r, identifier, params)
+		siw.Handler.ResumeCredential(w, r, identifier, nonce)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3154,13 +4890,13 @@ func (siw *ServerInterfaceWrapper) GetKeys(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// CreateKey operation middleware
-func (siw *ServerInterfaceWrapper) CreateKey(w http.ResponseWriter, r *http.Request) {
+// GetRevocationStatusV2 operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier2
+	var identifier PathIdentifier
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3168,14 +4904,17 @@ func (siw *ServerInterfaceWrapper) CreateKey(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
 
-	r = r.WithContext(ctx)
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateKey(w, r, identifier)
+		siw.Handler.GetRevocationStatusV2(w, r, identifier, nonce)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3185,13 +4924,13 @@ func (siw *ServerInterfaceWrapper) CreateKey(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteKey operation middleware
-func (siw *ServerInterfaceWrapper) DeleteKey(w http.ResponseWriter, r *http.Request) {
+// RevokeCredential operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier2
+	var identifier PathIdentifier
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3199,12 +4938,12 @@ func (siw *ServerInterfaceWrapper) DeleteKey(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id PathKeyID
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
 		return
 	}
 
@@ -3215,7 +4954,7 @@ func (siw *ServerInterfaceWrapper) DeleteKey(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteKey(w, r, identifier, id)
+		siw.Handler.RevokeCredential(w, r, identifier, nonce)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3225,13 +4964,13 @@ func (siw *ServerInterfaceWrapper) DeleteKey(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetKey operation middleware
-func (siw *ServerInterfaceWrapper) GetKey(w http.ResponseWriter, r *http.Request) {
+// SuspendCredential operation middleware
+func (siw *ServerInterfaceWrapper) SuspendCredential(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier2
+	var identifier PathIdentifier
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3239,12 +4978,12 @@ func (siw *ServerInterfaceWrapper) GetKey(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id PathKeyID
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
 		return
 	}
 
@@ -3255,7 +4994,7 @@ func (siw *ServerInterfaceWrapper) GetKey(w http.ResponseWriter, r *http.Request
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetKey(w, r, identifier, id)
+		siw.Handler.SuspendCredential(w, r, identifier, nonce)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3265,13 +5004,13 @@ func (siw *ServerInterfaceWrapper) GetKey(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateKey operation middleware
-func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Request) {
+// DeleteCredential operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier2
+	var identifier PathIdentifier
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3280,7 +5019,7 @@ func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Requ
 	}
 
 	// ------------- Path parameter "id" -------------
-	var id PathKeyID
+	var id PathClaim
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3295,7 +5034,7 @@ func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateKey(w, r, identifier, id)
+		siw.Handler.DeleteCredential(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3305,8 +5044,8 @@ func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetPaymentRequests operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentRequests(w http.ResponseWriter, r *http.Request) {
+// GetCredential operation middleware
+func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3319,6 +5058,15 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequests(w http.ResponseWriter, r *
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id PathClaim
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
@@ -3326,34 +5074,34 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequests(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPaymentRequestsParams
+	var params GetCredentialParams
 
-	// ------------- Optional query parameter "userDID" -------------
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "userDID", r.URL.Query(), &params.UserDID)
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userDID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "schemaID" -------------
+	// ------------- Optional query parameter "keyID" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "schemaID", r.URL.Query(), &params.SchemaID)
+	err = runtime.BindQueryParameter("form", true, false, "keyID", r.URL.Query(), &params.KeyID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "nonce" -------------
+	// ------------- Optional query parameter "disclosableFields" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "nonce", r.URL.Query(), &params.Nonce)
+	err = runtime.BindQueryParameter("form", false, false, "disclosableFields", r.URL.Query(), &params.DisclosableFields)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "disclosableFields", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentRequests(w, r, identifier, params)
+		siw.Handler.GetCredential(w, r, identifier, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3363,8 +5111,8 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequests(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// CreatePaymentRequest operation middleware
-func (siw *ServerInterfaceWrapper) CreatePaymentRequest(w http.ResponseWriter, r *http.Request) {
+// ReissueCredential operation middleware
+func (siw *ServerInterfaceWrapper) ReissueCredential(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3377,6 +5125,15 @@ func (siw *ServerInterfaceWrapper) CreatePaymentRequest(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id PathClaim
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
@@ -3384,7 +5141,7 @@ func (siw *ServerInterfaceWrapper) CreatePaymentRequest(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePaymentRequest(w, r, identifier)
+		siw.Handler.ReissueCredential(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3394,8 +5151,8 @@ func (siw *ServerInterfaceWrapper) CreatePaymentRequest(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeletePaymentRequest operation middleware
-func (siw *ServerInterfaceWrapper) DeletePaymentRequest(w http.ResponseWriter, r *http.Request) {
+// GetCredentialOffer operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialOffer(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3409,7 +5166,7 @@ func (siw *ServerInterfaceWrapper) DeletePaymentRequest(w http.ResponseWriter, r
 	}
 
 	// ------------- Path parameter "id" -------------
-	var id Id
+	var id PathClaim
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3423,8 +5180,19 @@ func (siw *ServerInterfaceWrapper) DeletePaymentRequest(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialOfferParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePaymentRequest(w, r, identifier, id)
+		siw.Handler.GetCredentialOffer(w, r, identifier, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3434,8 +5202,8 @@ func (siw *ServerInterfaceWrapper) DeletePaymentRequest(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetPaymentRequest operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentRequest(w http.ResponseWriter, r *http.Request) {
+// GetDIDDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetDIDDocument(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3448,23 +5216,8 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequest(w http.ResponseWriter, r *h
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentRequest(w, r, identifier, id)
+		siw.Handler.GetDIDDocument(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3474,8 +5227,8 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequest(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetPaymentOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentOptions(w http.ResponseWriter, r *http.Request) {
+// GetAllDisplayMethods operation middleware
+func (siw *ServerInterfaceWrapper) GetAllDisplayMethods(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3494,8 +5247,35 @@ func (siw *ServerInterfaceWrapper) GetPaymentOptions(w http.ResponseWriter, r *h
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllDisplayMethodsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentOptions(w, r, identifier)
+		siw.Handler.GetAllDisplayMethods(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3505,8 +5285,8 @@ func (siw *ServerInterfaceWrapper) GetPaymentOptions(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// CreatePaymentOption operation middleware
-func (siw *ServerInterfaceWrapper) CreatePaymentOption(w http.ResponseWriter, r *http.Request) {
+// CreateDisplayMethod operation middleware
+func (siw *ServerInterfaceWrapper) CreateDisplayMethod(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3526,7 +5306,7 @@ func (siw *ServerInterfaceWrapper) CreatePaymentOption(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePaymentOption(w, r, identifier)
+		siw.Handler.CreateDisplayMethod(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3536,8 +5316,8 @@ func (siw *ServerInterfaceWrapper) CreatePaymentOption(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeletePaymentOption operation middleware
-func (siw *ServerInterfaceWrapper) DeletePaymentOption(w http.ResponseWriter, r *http.Request) {
+// DeleteDisplayMethod operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDisplayMethod(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3566,7 +5346,7 @@ func (siw *ServerInterfaceWrapper) DeletePaymentOption(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePaymentOption(w, r, identifier, id)
+		siw.Handler.DeleteDisplayMethod(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3576,8 +5356,8 @@ func (siw *ServerInterfaceWrapper) DeletePaymentOption(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetPaymentOption operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentOption(w http.ResponseWriter, r *http.Request) {
+// GetDisplayMethod operation middleware
+func (siw *ServerInterfaceWrapper) GetDisplayMethod(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3606,7 +5386,7 @@ func (siw *ServerInterfaceWrapper) GetPaymentOption(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentOption(w, r, identifier, id)
+		siw.Handler.GetDisplayMethod(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3616,8 +5396,8 @@ func (siw *ServerInterfaceWrapper) GetPaymentOption(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// UpdatePaymentOption operation middleware
-func (siw *ServerInterfaceWrapper) UpdatePaymentOption(w http.ResponseWriter, r *http.Request) {
+// UpdateDisplayMethod operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDisplayMethod(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3646,7 +5426,7 @@ func (siw *ServerInterfaceWrapper) UpdatePaymentOption(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdatePaymentOption(w, r, identifier, id)
+		siw.Handler.UpdateDisplayMethod(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3656,13 +5436,13 @@ func (siw *ServerInterfaceWrapper) UpdatePaymentOption(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// VerifyPayment operation middleware
-func (siw *ServerInterfaceWrapper) VerifyPayment(w http.ResponseWriter, r *http.Request) {
+// GetKeyRotations operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyRotations(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier string
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3670,15 +5450,6 @@ func (siw *ServerInterfaceWrapper) VerifyPayment(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Path parameter "nonce" -------------
-	var nonce string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
@@ -3686,7 +5457,7 @@ func (siw *ServerInterfaceWrapper) VerifyPayment(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.VerifyPayment(w, r, identifier, nonce)
+		siw.Handler.GetKeyRotations(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3696,13 +5467,13 @@ func (siw *ServerInterfaceWrapper) VerifyPayment(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetSchemas operation middleware
-func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Request) {
+// CreateKeyRotation operation middleware
+func (siw *ServerInterfaceWrapper) CreateKeyRotation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3716,19 +5487,8 @@ func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Req
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSchemasParams
-
-	// ------------- Optional query parameter "query" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchemas(w, r, identifier, params)
+		siw.Handler.CreateKeyRotation(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3738,13 +5498,13 @@ func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ImportSchema operation middleware
-func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.Request) {
+// GetKeyRotation operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyRotation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3752,6 +5512,15 @@ func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
@@ -3759,7 +5528,7 @@ func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ImportSchema(w, r, identifier)
+		siw.Handler.GetKeyRotation(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3769,13 +5538,13 @@ func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetSchema operation middleware
-func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Request) {
+// GetKeyRotationCredentials operation middleware
+func (siw *ServerInterfaceWrapper) GetKeyRotationCredentials(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3799,7 +5568,7 @@ func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchema(w, r, identifier, id)
+		siw.Handler.GetKeyRotationCredentials(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3809,13 +5578,13 @@ func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateSchema operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.Request) {
+// ResumeKeyRotation operation middleware
+func (siw *ServerInterfaceWrapper) ResumeKeyRotation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3839,7 +5608,7 @@ func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSchema(w, r, identifier, id)
+		siw.Handler.ResumeKeyRotation(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3849,13 +5618,13 @@ func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// PublishIdentityState operation middleware
-func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r *http.Request) {
+// GetKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetKeys(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3869,8 +5638,35 @@ func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetKeysParams
+
+	// ------------- Optional query parameter "max_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PublishIdentityState(w, r, identifier)
+		siw.Handler.GetKeys(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3880,13 +5676,13 @@ func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// RetryPublishState operation middleware
-func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+// CreateKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3900,8 +5696,32 @@ func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *h
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateKeyParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RetryPublishState(w, r, identifier)
+		siw.Handler.CreateKey(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3911,13 +5731,13 @@ func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetStateStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http.Request) {
+// DeleteKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3925,6 +5745,15 @@ func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id PathKeyID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
@@ -3932,7 +5761,7 @@ func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStateStatus(w, r, identifier)
+		siw.Handler.DeleteKey(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3942,13 +5771,13 @@ func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetStateTransactions operation middleware
-func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+// GetKey operation middleware
+func (siw *ServerInterfaceWrapper) GetKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3956,61 +5785,55 @@ func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id PathKeyID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetStateTransactionsParams
-
-	// ------------- Optional query parameter "filter" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetKey(w, r, identifier, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+// UpdateKey operation middleware
+func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "max_results" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier2
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	// ------------- Path parameter "id" -------------
+	var id PathKeyID
 
-	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStateTransactions(w, r, identifier, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// GetPaymentSettings operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentSettings(w http.ResponseWriter, r *http.Request) {
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
@@ -4018,7 +5841,7 @@ func (siw *ServerInterfaceWrapper) GetPaymentSettings(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentSettings(w, r)
+		siw.Handler.UpdateKey(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4028,32 +5851,39 @@ func (siw *ServerInterfaceWrapper) GetPaymentSettings(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetQrFromStore operation middleware
-func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http.Request) {
+// CheckMerkleTreesIntegrity operation middleware
+func (siw *ServerInterfaceWrapper) CheckMerkleTreesIntegrity(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetQrFromStoreParams
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-	// ------------- Optional query parameter "id" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "issuer" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "issuer", r.URL.Query(), &params.Issuer)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CheckMerkleTreesIntegrityParams
+
+	// ------------- Optional query parameter "repair" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repair", r.URL.Query(), &params.Repair)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "issuer", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repair", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetQrFromStore(w, r, params)
+		siw.Handler.CheckMerkleTreesIntegrity(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4063,8 +5893,19 @@ func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetSupportedNetworks operation middleware
-func (siw *ServerInterfaceWrapper) GetSupportedNetworks(w http.ResponseWriter, r *http.Request) {
+// GetPaymentRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -4072,8 +5913,35 @@ func (siw *ServerInterfaceWrapper) GetSupportedNetworks(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPaymentRequestsParams
+
+	// ------------- Optional query parameter "userDID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userDID", r.URL.Query(), &params.UserDID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userDID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "schemaID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schemaID", r.URL.Query(), &params.SchemaID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nonce" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nonce", r.URL.Query(), &params.Nonce)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSupportedNetworks(w, r)
+		siw.Handler.GetPaymentRequests(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4083,8 +5951,8 @@ func (siw *ServerInterfaceWrapper) GetSupportedNetworks(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// Authentication operation middleware
-func (siw *ServerInterfaceWrapper) Authentication(w http.ResponseWriter, r *http.Request) {
+// CreatePaymentRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePaymentRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4097,19 +5965,38 @@ func (siw *ServerInterfaceWrapper) Authentication(w http.ResponseWriter, r *http
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AuthenticationParams
+	var params CreatePaymentRequestParams
 
-	// ------------- Optional query parameter "type" -------------
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Authentication(w, r, identifier, params)
+		siw.Handler.CreatePaymentRequest(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4119,3257 +6006,8465 @@ func (siw *ServerInterfaceWrapper) Authentication(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// DeletePaymentRequest operation middleware
+func (siw *ServerInterfaceWrapper) DeletePaymentRequest(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	ctx := r.Context()
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePaymentRequest(w, r, identifier, id)
+	}))
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// GetPaymentRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentRequest(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	ctx := r.Context()
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	r = r.WithContext(ctx)
 
-	if r == nil {
-		r = chi.NewRouter()
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPaymentRequest(w, r, identifier, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPaymentOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentOptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPaymentOptions(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/status", wrapper.Health)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/agent", wrapper.AgentV1)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/{identifier}/claims/revocation/status/{nonce}", wrapper.GetRevocationStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/agent", wrapper.Agent)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/authentication/callback", wrapper.AuthCallback)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/authentication/sessions/{id}", wrapper.GetAuthenticationConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities", wrapper.GetIdentities)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities", wrapper.CreateIdentity)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}", wrapper.GetIdentityDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}", wrapper.UpdateIdentity)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections", wrapper.GetConnections)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/connections", wrapper.CreateConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/connections/{id}", wrapper.DeleteConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections/{id}", wrapper.GetConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/credentials", wrapper.DeleteConnectionCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/credentials/revoke", wrapper.RevokeConnectionCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/create-auth-credential", wrapper.CreateAuthCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials", wrapper.GetCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials", wrapper.CreateCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/links", wrapper.GetLinks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links", wrapper.CreateLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links/callback", wrapper.CreateLinkQrCodeCallback)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.DeleteLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.GetLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.ActivateLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}/offer", wrapper.CreateLinkOffer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/revocation/status/{nonce}", wrapper.GetRevocationStatusV2)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/revoke/{nonce}", wrapper.RevokeCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}", wrapper.DeleteCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}", wrapper.GetCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}/offer", wrapper.GetCredentialOffer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/display-method", wrapper.GetAllDisplayMethods)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/display-method", wrapper.CreateDisplayMethod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.DeleteDisplayMethod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.GetDisplayMethod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.UpdateDisplayMethod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/keys", wrapper.GetKeys)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/keys", wrapper.CreateKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.DeleteKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.GetKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.UpdateKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment-request", wrapper.GetPaymentRequests)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment-request", wrapper.CreatePaymentRequest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/payment-request/{id}", wrapper.DeletePaymentRequest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment-request/{id}", wrapper.GetPaymentRequest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment/options", wrapper.GetPaymentOptions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment/options", wrapper.CreatePaymentOption)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.DeletePaymentOption)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.GetPaymentOption)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.UpdatePaymentOption)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment/verify/{nonce}", wrapper.VerifyPayment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/schemas", wrapper.GetSchemas)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/schemas", wrapper.ImportSchema)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/schemas/{id}", wrapper.GetSchema)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/schemas/{id}", wrapper.UpdateSchema)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/state/publish", wrapper.PublishIdentityState)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/state/retry", wrapper.RetryPublishState)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/status", wrapper.GetStateStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/transactions", wrapper.GetStateTransactions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/payment/settings", wrapper.GetPaymentSettings)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/qr-store", wrapper.GetQrFromStore)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/supported-networks", wrapper.GetSupportedNetworks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/{identifier}/authentication", wrapper.Authentication)
-	})
-
-	return r
+	handler.ServeHTTP(w, r)
 }
 
-type N400JSONResponse GenericErrorMessage
+// CreatePaymentOption operation middleware
+func (siw *ServerInterfaceWrapper) CreatePaymentOption(w http.ResponseWriter, r *http.Request) {
 
-type N401JSONResponse GenericErrorMessage
+	var err error
 
-type N403JSONResponse GenericErrorMessage
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type N404JSONResponse GenericErrorMessage
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type N409JSONResponse GenericErrorMessage
+	ctx := r.Context()
 
-type N410JSONResponse GenericErrorMessage
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type N422JSONResponse GenericErrorMessage
+	r = r.WithContext(ctx)
 
-type N500JSONResponse GenericErrorMessage
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePaymentOption(w, r, identifier)
+	}))
 
-type N500CreateIdentityJSONResponse struct {
-	Code      *int    `json:"code,omitempty"`
-	Error     *string `json:"error,omitempty"`
-	RequestID *string `json:"requestID,omitempty"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type HealthRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
-}
+// DeletePaymentOption operation middleware
+func (siw *ServerInterfaceWrapper) DeletePaymentOption(w http.ResponseWriter, r *http.Request) {
 
-type Health200JSONResponse Health
+	var err error
 
-func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type Health500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type AgentV1RequestObject struct {
-	Body *AgentV1TextRequestBody
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type AgentV1ResponseObject interface {
-	VisitAgentV1Response(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type AgentV1200JSONResponse AgentResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePaymentOption(w, r, identifier, id)
+	}))
 
-func (response AgentV1200JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type AgentV1400JSONResponse struct{ N400JSONResponse }
+// GetPaymentOption operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentOption(w http.ResponseWriter, r *http.Request) {
 
-func (response AgentV1400JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type AgentV1500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response AgentV1500JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetRevocationStatusRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Nonce      PathNonce      `json:"nonce"`
-}
+	ctx := r.Context()
 
-type GetRevocationStatusResponseObject interface {
-	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type GetRevocationStatus200JSONResponse RevocationStatusResponse
+	r = r.WithContext(ctx)
 
-func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPaymentOption(w, r, identifier, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
+// UpdatePaymentOption operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePaymentOption(w http.ResponseWriter, r *http.Request) {
 
-func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type AgentRequestObject struct {
-	Body *AgentTextRequestBody
-}
+	ctx := r.Context()
 
-type AgentResponseObject interface {
-	VisitAgentResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type Agent200JSONResponse AgentResponse
+	r = r.WithContext(ctx)
 
-func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePaymentOption(w, r, identifier, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type Agent400JSONResponse struct{ N400JSONResponse }
+	handler.ServeHTTP(w, r)
+}
 
-func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// VerifyPayment operation middleware
+func (siw *ServerInterfaceWrapper) VerifyPayment(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type Agent500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "identifier" -------------
+	var identifier string
 
-func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "nonce" -------------
+	var nonce string
 
-type AuthCallbackRequestObject struct {
-	Params AuthCallbackParams
-	Body   *AuthCallbackTextRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
 
-type AuthCallbackResponseObject interface {
-	VisitAuthCallbackResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type AuthCallback200Response struct {
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-func (response AuthCallback200Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type AuthCallback400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyPayment(w, r, identifier, nonce)
+	}))
 
-func (response AuthCallback400JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type AuthCallback500JSONResponse struct{ N500JSONResponse }
-
-func (response AuthCallback500JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetSchemas operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetAuthenticationConnectionRequestObject struct {
-	Id Id `json:"id"`
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetAuthenticationConnectionResponseObject interface {
-	VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type GetAuthenticationConnection200JSONResponse GetAuthenticationConnectionResponse
+	ctx := r.Context()
 
-func (response GetAuthenticationConnection200JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetAuthenticationConnection400JSONResponse struct{ N400JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSchemasParams
 
-func (response GetAuthenticationConnection400JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "query" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
 
-type GetAuthenticationConnection401JSONResponse struct{ N401JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemas(w, r, identifier, params)
+	}))
 
-func (response GetAuthenticationConnection401JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetAuthenticationConnection404JSONResponse struct{ N404JSONResponse }
+// ImportSchema operation middleware
+func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.Request) {
 
-func (response GetAuthenticationConnection404JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetAuthenticationConnection500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response GetAuthenticationConnection500JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetIdentitiesRequestObject struct {
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type GetIdentitiesResponseObject interface {
-	VisitGetIdentitiesResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetIdentities200JSONResponse []GetIdentitiesResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportSchema(w, r, identifier)
+	}))
 
-func (response GetIdentities200JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetIdentities401JSONResponse struct{ N401JSONResponse }
+// GetSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Request) {
 
-func (response GetIdentities401JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetIdentities500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response GetIdentities500JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateIdentityRequestObject struct {
-	Body *CreateIdentityJSONRequestBody
-}
+	ctx := r.Context()
 
-type CreateIdentityResponseObject interface {
-	VisitCreateIdentityResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type CreateIdentity201JSONResponse CreateIdentityResponse
+	r = r.WithContext(ctx)
 
-func (response CreateIdentity201JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchema(w, r, identifier, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateIdentity400JSONResponse struct{ N400JSONResponse }
+// UpdateSchema operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateIdentity400JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type CreateIdentity401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response CreateIdentity401JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateIdentity403JSONResponse struct{ N403JSONResponse }
+	ctx := r.Context()
 
-func (response CreateIdentity403JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateIdentity409JSONResponse struct{ N409JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchema(w, r, identifier, id)
+	}))
 
-func (response CreateIdentity409JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateIdentity500JSONResponse struct{ N500JSONResponse }
+// GetStateDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetStateDiff(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateIdentity500JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetIdentityDetailsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type GetIdentityDetailsResponseObject interface {
-	VisitGetIdentityDetailsResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetIdentityDetails200JSONResponse GetIdentityDetailsResponse
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-func (response GetIdentityDetails200JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStateDiffParams
 
-type GetIdentityDetails400JSONResponse struct{ N400JSONResponse }
+	// ------------- Required query parameter "from" -------------
 
-func (response GetIdentityDetails400JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
 
-type GetIdentityDetails401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-func (response GetIdentityDetails401JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Required query parameter "to" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
 
-type GetIdentityDetails500JSONResponse struct{ N500JSONResponse }
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
 
-func (response GetIdentityDetails500JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateDiff(w, r, identifier, params)
+	}))
 
-type UpdateIdentityRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *UpdateIdentityJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateIdentityResponseObject interface {
-	VisitUpdateIdentityResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateIdentity200JSONResponse GenericMessage
+// DryRunPublishState operation middleware
+func (siw *ServerInterfaceWrapper) DryRunPublishState(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateIdentity200JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type UpdateIdentity400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response UpdateIdentity400JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type UpdateIdentity401JSONResponse struct{ N401JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response UpdateIdentity401JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DryRunPublishState(w, r, identifier)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateIdentity403JSONResponse struct{ N403JSONResponse }
+// PublishIdentityState operation middleware
+func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateIdentity403JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type UpdateIdentity500JSONResponse struct{ N500CreateIdentityJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response UpdateIdentity500JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetConnectionsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetConnectionsParams
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type GetConnectionsResponseObject interface {
-	VisitGetConnectionsResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetConnections200JSONResponse ConnectionsPaginated
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishIdentityState(w, r, identifier)
+	}))
 
-func (response GetConnections200JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetConnections400JSONResponse struct{ N400JSONResponse }
-
-func (response GetConnections400JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DeletePublishPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeletePublishPolicy(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetConnections500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-func (response GetConnections500JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CreateConnectionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreateConnectionJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type CreateConnectionResponseObject interface {
-	VisitCreateConnectionResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type CreateConnection201JSONResponse GenericMessage
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePublishPolicy(w, r, identifier)
+	}))
 
-func (response CreateConnection201JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateConnection400JSONResponse struct{ N400JSONResponse }
-
-func (response CreateConnection400JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetPublishPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetPublishPolicy(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CreateConnection500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-func (response CreateConnection500JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type DeleteConnectionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-	Params     DeleteConnectionParams
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type DeleteConnectionResponseObject interface {
-	VisitDeleteConnectionResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type DeleteConnection200JSONResponse GenericMessage
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPublishPolicy(w, r, identifier)
+	}))
 
-func (response DeleteConnection200JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteConnection400JSONResponse struct{ N400JSONResponse }
+// UpdatePublishPolicy operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePublishPolicy(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteConnection400JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type DeleteConnection500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type GetConnectionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+	r = r.WithContext(ctx)
 
-type GetConnectionResponseObject interface {
-	VisitGetConnectionResponse(w http.ResponseWriter) error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePublishPolicy(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetConnection200JSONResponse GetConnectionResponse
+// RetryPublishState operation middleware
+func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
 
-func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetConnection400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response GetConnection400JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type GetConnection500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryPublishState(w, r, identifier)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteConnectionCredentialsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteConnectionCredentialsResponseObject interface {
-	VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error
-}
+// GetStateStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http.Request) {
 
-type DeleteConnectionCredentials200JSONResponse GenericMessage
+	var err error
 
-func (response DeleteConnectionCredentials200JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type DeleteConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+	ctx := r.Context()
 
-func (response DeleteConnectionCredentials400JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type DeleteConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateStatus(w, r, identifier)
+	}))
 
-func (response DeleteConnectionCredentials500JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type RevokeConnectionCredentialsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+// GetStateTransactions operation middleware
+func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
 
-type RevokeConnectionCredentialsResponseObject interface {
-	VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type RevokeConnectionCredentials202JSONResponse GenericMessage
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-func (response RevokeConnectionCredentials202JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type RevokeConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-func (response RevokeConnectionCredentials400JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStateTransactionsParams
 
-type RevokeConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+	// ------------- Optional query parameter "filter" -------------
 
-func (response RevokeConnectionCredentials500JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "page" -------------
 
-type CreateAuthCredentialRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Body       *CreateAuthCredentialJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type CreateAuthCredentialResponseObject interface {
-	VisitCreateAuthCredentialResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "max_results" -------------
 
-type CreateAuthCredential201JSONResponse struct {
-	// Id The ID of the created Auth Credential
-	Id uuid.UUID `json:"id"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
 
-func (response CreateAuthCredential201JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional query parameter "sort" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-type CreateAuthCredential400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateTransactions(w, r, identifier, params)
+	}))
 
-func (response CreateAuthCredential400JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateAuthCredential500JSONResponse struct{ N500JSONResponse }
+// GetStateDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetStateDetails(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateAuthCredential500JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetCredentialsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetCredentialsParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type GetCredentialsResponseObject interface {
-	VisitGetCredentialsResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "state" -------------
+	var state string
 
-type GetCredentials200JSONResponse CredentialsPaginated
+	err = runtime.BindStyledParameterWithOptions("simple", "state", chi.URLParam(r, "state"), &state, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
 
-func (response GetCredentials200JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type GetCredentials400JSONResponse struct{ N400JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response GetCredentials400JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStateDetailsParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "page" -------------
 
-type GetCredentials404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-func (response GetCredentials404JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "max_results" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
 
-type GetCredentials500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateDetails(w, r, identifier, state, params)
+	}))
 
-func (response GetCredentials500JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCredentialRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreateCredentialJSONRequestBody
-}
+// GetStatusListCredential operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusListCredential(w http.ResponseWriter, r *http.Request) {
 
-type CreateCredentialResponseObject interface {
-	VisitCreateCredentialResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type CreateCredential201JSONResponse CreateCredentialResponse
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-func (response CreateCredential201JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type CreateCredential400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response CreateCredential400JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatusListCredential(w, r, identifier, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCredential401JSONResponse struct{ N401JSONResponse }
+// GetVerificationQueries operation middleware
+func (siw *ServerInterfaceWrapper) GetVerificationQueries(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateCredential401JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type CreateCredential422JSONResponse struct{ N422JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response CreateCredential422JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type CreateCredential500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response CreateCredential500JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVerificationQueries(w, r, identifier)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetLinksRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetLinksParams
+	handler.ServeHTTP(w, r)
 }
 
-type GetLinksResponseObject interface {
-	VisitGetLinksResponse(w http.ResponseWriter) error
-}
+// CreateVerificationQuery operation middleware
+func (siw *ServerInterfaceWrapper) CreateVerificationQuery(w http.ResponseWriter, r *http.Request) {
 
-type GetLinks200JSONResponse []Link
+	var err error
 
-func (response GetLinks200JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type GetLinks400JSONResponse struct{ N400JSONResponse }
+	ctx := r.Context()
 
-func (response GetLinks400JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetLinks404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVerificationQuery(w, r, identifier)
+	}))
 
-func (response GetLinks404JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetLinks500JSONResponse struct{ N500JSONResponse }
+// DeleteVerificationQuery operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVerificationQuery(w http.ResponseWriter, r *http.Request) {
 
-func (response GetLinks500JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type CreateLinkRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreateLinkJSONRequestBody
-}
-
-type CreateLinkResponseObject interface {
-	VisitCreateLinkResponse(w http.ResponseWriter) error
-}
-
-type CreateLink201JSONResponse UUIDResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response CreateLink201JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateLink400JSONResponse struct{ N400JSONResponse }
+	ctx := r.Context()
 
-func (response CreateLink400JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateLink500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVerificationQuery(w, r, identifier, id)
+	}))
 
-func (response CreateLink500JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateLinkQrCodeCallbackRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     CreateLinkQrCodeCallbackParams
-	Body       *CreateLinkQrCodeCallbackTextRequestBody
-}
+// GetVerificationQuery operation middleware
+func (siw *ServerInterfaceWrapper) GetVerificationQuery(w http.ResponseWriter, r *http.Request) {
 
-type CreateLinkQrCodeCallbackResponseObject interface {
-	VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type CreateLinkQrCodeCallback200JSONResponse Offer
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-func (response CreateLinkQrCodeCallback200JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type CreateLinkQrCodeCallback400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response CreateLinkQrCodeCallback400JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type CreateLinkQrCodeCallback500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response CreateLinkQrCodeCallback500JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVerificationQuery(w, r, identifier, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteLinkRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteLinkResponseObject interface {
-	VisitDeleteLinkResponse(w http.ResponseWriter) error
-}
+// CreateVerificationSession operation middleware
+func (siw *ServerInterfaceWrapper) CreateVerificationSession(w http.ResponseWriter, r *http.Request) {
 
-type DeleteLink200JSONResponse GenericMessage
+	var err error
 
-func (response DeleteLink200JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type DeleteLink400JSONResponse struct{ N400JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (response DeleteLink400JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type DeleteLink500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-func (response DeleteLink500JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVerificationSession(w, r, identifier, id)
+	}))
 
-type GetLinkRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetLinkResponseObject interface {
-	VisitGetLinkResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetLink200JSONResponse Link
-
-func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetVerificationSession operation middleware
+func (siw *ServerInterfaceWrapper) GetVerificationSession(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetLink400JSONResponse struct{ N400JSONResponse }
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-func (response GetLink400JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetLink404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetLink404JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type GetLink500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response GetLink500JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVerificationSession(w, r, identifier, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ActivateLinkRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-	Body       *ActivateLinkJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type ActivateLinkResponseObject interface {
-	VisitActivateLinkResponse(w http.ResponseWriter) error
-}
+// GetPaymentSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentSettings(w http.ResponseWriter, r *http.Request) {
 
-type ActivateLink200JSONResponse GenericMessage
+	ctx := r.Context()
 
-func (response ActivateLink200JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type ActivateLink400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPaymentSettings(w, r)
+	}))
 
-func (response ActivateLink400JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ActivateLink500JSONResponse struct{ N500JSONResponse }
+// GetQrFromStore operation middleware
+func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http.Request) {
 
-func (response ActivateLink500JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQrFromStoreParams
 
-type CreateLinkOfferRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+	// ------------- Optional query parameter "id" -------------
 
-type CreateLinkOfferResponseObject interface {
-	VisitCreateLinkOfferResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateLinkOffer200JSONResponse CredentialLinkQrCodeResponse
+	// ------------- Optional query parameter "issuer" -------------
 
-func (response CreateLinkOffer200JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "issuer", r.URL.Query(), &params.Issuer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "issuer", Err: err})
+		return
+	}
 
-type CreateLinkOffer400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQrFromStore(w, r, params)
+	}))
 
-func (response CreateLinkOffer400JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateLinkOffer404JSONResponse struct{ N404JSONResponse }
-
-func (response CreateLinkOffer404JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetSupportedNetworks operation middleware
+func (siw *ServerInterfaceWrapper) GetSupportedNetworks(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CreateLinkOffer500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-func (response CreateLinkOffer500JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupportedNetworks(w, r)
+	}))
 
-type GetRevocationStatusV2RequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Nonce      PathNonce      `json:"nonce"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetRevocationStatusV2ResponseObject interface {
-	VisitGetRevocationStatusV2Response(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetRevocationStatusV2200JSONResponse RevocationStatusResponse
+// VerificationCallback operation middleware
+func (siw *ServerInterfaceWrapper) VerificationCallback(w http.ResponseWriter, r *http.Request) {
 
-func (response GetRevocationStatusV2200JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VerificationCallbackParams
 
-type GetRevocationStatusV2400JSONResponse struct{ N400JSONResponse }
+	// ------------- Required query parameter "sessionID" -------------
 
-func (response GetRevocationStatusV2400JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	if paramValue := r.URL.Query().Get("sessionID"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sessionID"})
+		return
+	}
 
-type GetRevocationStatusV2500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, true, "sessionID", r.URL.Query(), &params.SessionID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		return
+	}
 
-func (response GetRevocationStatusV2500JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerificationCallback(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type RevokeCredentialRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Nonce      PathNonce      `json:"nonce"`
+	handler.ServeHTTP(w, r)
 }
 
-type RevokeCredentialResponseObject interface {
-	VisitRevokeCredentialResponse(w http.ResponseWriter) error
-}
+// Authentication operation middleware
+func (siw *ServerInterfaceWrapper) Authentication(w http.ResponseWriter, r *http.Request) {
 
-type RevokeCredential202JSONResponse RevokeClaimResponse
+	var err error
 
-func (response RevokeCredential202JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type RevokeCredential400JSONResponse struct{ N400JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuthenticationParams
 
-func (response RevokeCredential400JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "type" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
 
-type RevokeCredential401JSONResponse struct{ N401JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Authentication(w, r, identifier, params)
+	}))
 
-func (response RevokeCredential401JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type RevokeCredential404JSONResponse struct{ N404JSONResponse }
-
-func (response RevokeCredential404JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type RevokeCredential500JSONResponse struct{ N500JSONResponse }
-
-func (response RevokeCredential500JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type DeleteCredentialRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type DeleteCredentialResponseObject interface {
-	VisitDeleteCredentialResponse(w http.ResponseWriter) error
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type DeleteCredential200JSONResponse GenericMessage
-
-func (response DeleteCredential200JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-type DeleteCredential400JSONResponse struct{ N400JSONResponse }
+type RequiredParamError struct {
+	ParamName string
+}
 
-func (response DeleteCredential400JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
 
-	return json.NewEncoder(w).Encode(response)
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type DeleteCredential401JSONResponse struct{ N401JSONResponse }
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
 
-func (response DeleteCredential401JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
 
-	return json.NewEncoder(w).Encode(response)
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
 }
 
-type DeleteCredential500JSONResponse struct{ N500JSONResponse }
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
 
-func (response DeleteCredential500JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
 
-	return json.NewEncoder(w).Encode(response)
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
 }
 
-type GetCredentialRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
-type GetCredentialResponseObject interface {
-	VisitGetCredentialResponse(w http.ResponseWriter) error
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
 }
 
-type GetCredential200JSONResponse Credential
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-func (response GetCredential200JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
 
-	return json.NewEncoder(w).Encode(response)
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
 }
 
-type GetCredential400JSONResponse struct{ N400JSONResponse }
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
 
-func (response GetCredential400JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/1.0/identifiers/{identifier}", wrapper.ResolveDID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/issuers/{id}/did.json", wrapper.GetWebDIDDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status", wrapper.Health)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/agent", wrapper.AgentV1)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/claims/revocation/status/{nonce}", wrapper.GetRevocationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/agent", wrapper.Agent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/api-keys", wrapper.GetAPIKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/api-keys", wrapper.CreateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/api-keys/{id}", wrapper.DeleteAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/api-keys/{id}", wrapper.GetAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/api-keys/{id}", wrapper.UpdateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/authentication/callback", wrapper.AuthCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/authentication/sessions/{id}", wrapper.GetAuthenticationConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/credentials/verify", wrapper.VerifyCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities", wrapper.GetIdentities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities", wrapper.CreateIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}", wrapper.GetIdentityDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}", wrapper.UpdateIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections", wrapper.GetConnections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/connections", wrapper.CreateConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/connections/{id}", wrapper.DeleteConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections/{id}", wrapper.GetConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/credentials", wrapper.DeleteConnectionCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/credentials/revoke", wrapper.RevokeConnectionCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/create-auth-credential", wrapper.CreateAuthCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credential-expiration-policies", wrapper.GetCredentialExpirationPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credential-expiration-policies", wrapper.CreateCredentialExpirationPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credential-expiration-policies/{id}", wrapper.DeleteCredentialExpirationPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/identities/{identifier}/credential-expiration-policies/{id}", wrapper.UpdateCredentialExpirationPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credential-templates", wrapper.GetCredentialTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credential-templates", wrapper.CreateCredentialTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credential-templates/{id}", wrapper.DeleteCredentialTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credential-templates/{id}", wrapper.GetCredentialTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/identities/{identifier}/credential-templates/{id}", wrapper.UpdateCredentialTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credential-templates/{id}/credentials", wrapper.CreateCredentialFromTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credential-templates/{id}/links", wrapper.CreateLinkFromTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials", wrapper.GetCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials", wrapper.CreateCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/bulk", wrapper.CreateBulkIssuanceJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/bulk/{id}", wrapper.GetBulkIssuanceJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/bulk/{id}/rows", wrapper.GetBulkIssuanceJobRows)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/links", wrapper.GetLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links", wrapper.CreateLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links/callback", wrapper.CreateLinkQrCodeCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.DeleteLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.GetLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.ActivateLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}/offer", wrapper.CreateLinkOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/resume/{nonce}", wrapper.ResumeCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/revocation/status/{nonce}", wrapper.GetRevocationStatusV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/revoke/{nonce}", wrapper.RevokeCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/suspend/{nonce}", wrapper.SuspendCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}", wrapper.DeleteCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}", wrapper.GetCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}", wrapper.ReissueCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}/offer", wrapper.GetCredentialOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/did.json", wrapper.GetDIDDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/display-method", wrapper.GetAllDisplayMethods)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/display-method", wrapper.CreateDisplayMethod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.DeleteDisplayMethod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.GetDisplayMethod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.UpdateDisplayMethod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/key-rotations", wrapper.GetKeyRotations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/key-rotations", wrapper.CreateKeyRotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/key-rotations/{id}", wrapper.GetKeyRotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/key-rotations/{id}/credentials", wrapper.GetKeyRotationCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/key-rotations/{id}/resume", wrapper.ResumeKeyRotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/keys", wrapper.GetKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/keys", wrapper.CreateKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.DeleteKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.GetKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.UpdateKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/merkle-trees/integrity", wrapper.CheckMerkleTreesIntegrity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment-request", wrapper.GetPaymentRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment-request", wrapper.CreatePaymentRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/payment-request/{id}", wrapper.DeletePaymentRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment-request/{id}", wrapper.GetPaymentRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment/options", wrapper.GetPaymentOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment/options", wrapper.CreatePaymentOption)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.DeletePaymentOption)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.GetPaymentOption)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.UpdatePaymentOption)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment/verify/{nonce}", wrapper.VerifyPayment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/schemas", wrapper.GetSchemas)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/schemas", wrapper.ImportSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/schemas/{id}", wrapper.GetSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/schemas/{id}", wrapper.UpdateSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/diff", wrapper.GetStateDiff)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/state/dry-run", wrapper.DryRunPublishState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/state/publish", wrapper.PublishIdentityState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/state/publish-policy", wrapper.DeletePublishPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/publish-policy", wrapper.GetPublishPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/identities/{identifier}/state/publish-policy", wrapper.UpdatePublishPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/state/retry", wrapper.RetryPublishState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/status", wrapper.GetStateStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/transactions", wrapper.GetStateTransactions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/states/{state}", wrapper.GetStateDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/status-lists/{id}", wrapper.GetStatusListCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/verification-queries", wrapper.GetVerificationQueries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/verification-queries", wrapper.CreateVerificationQuery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/verification-queries/{id}", wrapper.DeleteVerificationQuery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/verification-queries/{id}", wrapper.GetVerificationQuery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/verification-queries/{id}/sessions", wrapper.CreateVerificationSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/verification-sessions/{id}", wrapper.GetVerificationSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/payment/settings", wrapper.GetPaymentSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/qr-store", wrapper.GetQrFromStore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/supported-networks", wrapper.GetSupportedNetworks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/verification/callback", wrapper.VerificationCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/{identifier}/authentication", wrapper.Authentication)
+	})
+
+	return r
+}
+
+type N400JSONResponse GenericErrorMessage
+
+type N401JSONResponse GenericErrorMessage
+
+type N403JSONResponse GenericErrorMessage
+
+type N404JSONResponse GenericErrorMessage
+
+type N409JSONResponse GenericErrorMessage
+
+type N410JSONResponse GenericErrorMessage
+
+type N422JSONResponse GenericErrorMessage
+
+type N500JSONResponse GenericErrorMessage
+
+type N500CreateIdentityJSONResponse struct {
+	Code      *int    `json:"code,omitempty"`
+	Error     *string `json:"error,omitempty"`
+	RequestID *string `json:"requestID,omitempty"`
+}
+
+type ResolveDIDRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type ResolveDIDResponseObject interface {
+	VisitResolveDIDResponse(w http.ResponseWriter) error
+}
+
+type ResolveDID200JSONResponse DIDResolutionResult
+
+func (response ResolveDID200JSONResponse) VisitResolveDIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveDID400JSONResponse struct{ N400JSONResponse }
+
+func (response ResolveDID400JSONResponse) VisitResolveDIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveDID404JSONResponse struct{ N404JSONResponse }
+
+func (response ResolveDID404JSONResponse) VisitResolveDIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveDID500JSONResponse struct{ N500JSONResponse }
+
+func (response ResolveDID500JSONResponse) VisitResolveDIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebDIDDocumentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetWebDIDDocumentResponseObject interface {
+	VisitGetWebDIDDocumentResponse(w http.ResponseWriter) error
+}
+
+type GetWebDIDDocument200JSONResponse DIDDocument
+
+func (response GetWebDIDDocument200JSONResponse) VisitGetWebDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebDIDDocument404JSONResponse struct{ N404JSONResponse }
+
+func (response GetWebDIDDocument404JSONResponse) VisitGetWebDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebDIDDocument500JSONResponse struct{ N500JSONResponse }
+
+func (response GetWebDIDDocument500JSONResponse) VisitGetWebDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
+}
+
+type Health200JSONResponse Health
+
+func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Health500JSONResponse struct{ N500JSONResponse }
+
+func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentV1RequestObject struct {
+	Body *AgentV1TextRequestBody
+}
+
+type AgentV1ResponseObject interface {
+	VisitAgentV1Response(w http.ResponseWriter) error
+}
+
+type AgentV1200JSONResponse AgentResponse
+
+func (response AgentV1200JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentV1400JSONResponse struct{ N400JSONResponse }
+
+func (response AgentV1400JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentV1500JSONResponse struct{ N500JSONResponse }
+
+func (response AgentV1500JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type GetRevocationStatusResponseObject interface {
+	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationStatus200JSONResponse RevocationStatusResponse
+
+func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentRequestObject struct {
+	Body *AgentTextRequestBody
+}
+
+type AgentResponseObject interface {
+	VisitAgentResponse(w http.ResponseWriter) error
+}
+
+type Agent200JSONResponse AgentResponse
+
+func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent400JSONResponse struct{ N400JSONResponse }
+
+func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent500JSONResponse struct{ N500JSONResponse }
+
+func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeysRequestObject struct {
+}
+
+type GetAPIKeysResponseObject interface {
+	VisitGetAPIKeysResponse(w http.ResponseWriter) error
+}
+
+type GetAPIKeys200JSONResponse []APIKey
+
+func (response GetAPIKeys200JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeys401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAPIKeys401JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeys403JSONResponse struct{ N403JSONResponse }
+
+func (response GetAPIKeys403JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeys500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAPIKeys500JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKeyRequestObject struct {
+	Body *CreateAPIKeyJSONRequestBody
+}
+
+type CreateAPIKeyResponseObject interface {
+	VisitCreateAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateAPIKey201JSONResponse CreateAPIKeyResponse
+
+func (response CreateAPIKey201JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateAPIKey400JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateAPIKey401JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateAPIKey403JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateAPIKey500JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIKeyRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type DeleteAPIKeyResponseObject interface {
+	VisitDeleteAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPIKey200JSONResponse GenericMessage
+
+func (response DeleteAPIKey200JSONResponse) VisitDeleteAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteAPIKey401JSONResponse) VisitDeleteAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIKey403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteAPIKey403JSONResponse) VisitDeleteAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIKey404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteAPIKey404JSONResponse) VisitDeleteAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteAPIKey500JSONResponse) VisitDeleteAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeyRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetAPIKeyResponseObject interface {
+	VisitGetAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type GetAPIKey200JSONResponse APIKey
+
+func (response GetAPIKey200JSONResponse) VisitGetAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAPIKey401JSONResponse) VisitGetAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKey403JSONResponse struct{ N403JSONResponse }
+
+func (response GetAPIKey403JSONResponse) VisitGetAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKey404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAPIKey404JSONResponse) VisitGetAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAPIKey500JSONResponse) VisitGetAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAPIKeyRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateAPIKeyJSONRequestBody
+}
+
+type UpdateAPIKeyResponseObject interface {
+	VisitUpdateAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type UpdateAPIKey200JSONResponse APIKey
+
+func (response UpdateAPIKey200JSONResponse) VisitUpdateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAPIKey400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateAPIKey400JSONResponse) VisitUpdateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateAPIKey401JSONResponse) VisitUpdateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAPIKey403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateAPIKey403JSONResponse) VisitUpdateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAPIKey404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateAPIKey404JSONResponse) VisitUpdateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateAPIKey500JSONResponse) VisitUpdateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallbackRequestObject struct {
+	Params AuthCallbackParams
+	Body   *AuthCallbackTextRequestBody
+}
+
+type AuthCallbackResponseObject interface {
+	VisitAuthCallbackResponse(w http.ResponseWriter) error
+}
+
+type AuthCallback200Response struct {
+}
+
+func (response AuthCallback200Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AuthCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response AuthCallback400JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response AuthCallback500JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnectionRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetAuthenticationConnectionResponseObject interface {
+	VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error
+}
+
+type GetAuthenticationConnection200JSONResponse GetAuthenticationConnectionResponse
+
+func (response GetAuthenticationConnection200JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAuthenticationConnection400JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAuthenticationConnection401JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAuthenticationConnection404JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAuthenticationConnection500JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyCredentialRequestObject struct {
+	Body *VerifyCredentialJSONRequestBody
+}
+
+type VerifyCredentialResponseObject interface {
+	VisitVerifyCredentialResponse(w http.ResponseWriter) error
+}
+
+type VerifyCredential200JSONResponse CredentialVerificationReport
+
+func (response VerifyCredential200JSONResponse) VisitVerifyCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response VerifyCredential400JSONResponse) VisitVerifyCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response VerifyCredential500JSONResponse) VisitVerifyCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentitiesRequestObject struct {
+}
+
+type GetIdentitiesResponseObject interface {
+	VisitGetIdentitiesResponse(w http.ResponseWriter) error
+}
+
+type GetIdentities200JSONResponse []GetIdentitiesResponse
+
+func (response GetIdentities200JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentities401JSONResponse struct{ N401JSONResponse }
+
+func (response GetIdentities401JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentities500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIdentities500JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentityRequestObject struct {
+	Params CreateIdentityParams
+	Body   *CreateIdentityJSONRequestBody
+}
+
+type CreateIdentityResponseObject interface {
+	VisitCreateIdentityResponse(w http.ResponseWriter) error
+}
+
+type CreateIdentity201JSONResponse CreateIdentityResponse
+
+func (response CreateIdentity201JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateIdentity400JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateIdentity401JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateIdentity403JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateIdentity409JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateIdentity500JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityDetailsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetIdentityDetailsResponseObject interface {
+	VisitGetIdentityDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetIdentityDetails200JSONResponse GetIdentityDetailsResponse
+
+func (response GetIdentityDetails200JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityDetails400JSONResponse struct{ N400JSONResponse }
+
+func (response GetIdentityDetails400JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityDetails401JSONResponse struct{ N401JSONResponse }
+
+func (response GetIdentityDetails401JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityDetails500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIdentityDetails500JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentityRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *UpdateIdentityJSONRequestBody
+}
+
+type UpdateIdentityResponseObject interface {
+	VisitUpdateIdentityResponse(w http.ResponseWriter) error
+}
+
+type UpdateIdentity200JSONResponse GenericMessage
+
+func (response UpdateIdentity200JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentity400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateIdentity400JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentity401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateIdentity401JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentity403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateIdentity403JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentity500JSONResponse struct{ N500CreateIdentityJSONResponse }
+
+func (response UpdateIdentity500JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetConnectionsParams
+}
+
+type GetConnectionsResponseObject interface {
+	VisitGetConnectionsResponse(w http.ResponseWriter) error
+}
+
+type GetConnections200JSONResponse ConnectionsPaginated
+
+func (response GetConnections200JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnections400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnections400JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnections500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnections500JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConnectionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateConnectionJSONRequestBody
+}
+
+type CreateConnectionResponseObject interface {
+	VisitCreateConnectionResponse(w http.ResponseWriter) error
+}
+
+type CreateConnection201JSONResponse GenericMessage
+
+func (response CreateConnection201JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateConnection400JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateConnection500JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Params     DeleteConnectionParams
+}
+
+type DeleteConnectionResponseObject interface {
+	VisitDeleteConnectionResponse(w http.ResponseWriter) error
+}
+
+type DeleteConnection200JSONResponse GenericMessage
+
+func (response DeleteConnection200JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteConnection400JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetConnectionResponseObject interface {
+	VisitGetConnectionResponse(w http.ResponseWriter) error
+}
+
+type GetConnection200JSONResponse GetConnectionResponse
+
+func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnection400JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentialsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeleteConnectionCredentialsResponseObject interface {
+	VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error
+}
+
+type DeleteConnectionCredentials200JSONResponse GenericMessage
+
+func (response DeleteConnectionCredentials200JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteConnectionCredentials400JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteConnectionCredentials500JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentialsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type RevokeConnectionCredentialsResponseObject interface {
+	VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error
+}
+
+type RevokeConnectionCredentials202JSONResponse GenericMessage
+
+func (response RevokeConnectionCredentials202JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeConnectionCredentials400JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeConnectionCredentials500JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAuthCredentialRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Body       *CreateAuthCredentialJSONRequestBody
+}
+
+type CreateAuthCredentialResponseObject interface {
+	VisitCreateAuthCredentialResponse(w http.ResponseWriter) error
+}
+
+type CreateAuthCredential201JSONResponse struct {
+	// Id The ID of the created Auth Credential
+	Id uuid.UUID `json:"id"`
+}
+
+func (response CreateAuthCredential201JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAuthCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateAuthCredential400JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAuthCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateAuthCredential500JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialExpirationPoliciesRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetCredentialExpirationPoliciesResponseObject interface {
+	VisitGetCredentialExpirationPoliciesResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialExpirationPolicies200JSONResponse []CredentialExpirationPolicy
+
+func (response GetCredentialExpirationPolicies200JSONResponse) VisitGetCredentialExpirationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialExpirationPolicies400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialExpirationPolicies400JSONResponse) VisitGetCredentialExpirationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialExpirationPolicies401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialExpirationPolicies401JSONResponse) VisitGetCredentialExpirationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialExpirationPolicies500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialExpirationPolicies500JSONResponse) VisitGetCredentialExpirationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialExpirationPolicyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateCredentialExpirationPolicyJSONRequestBody
+}
+
+type CreateCredentialExpirationPolicyResponseObject interface {
+	VisitCreateCredentialExpirationPolicyResponse(w http.ResponseWriter) error
+}
+
+type CreateCredentialExpirationPolicy201JSONResponse CredentialExpirationPolicy
+
+func (response CreateCredentialExpirationPolicy201JSONResponse) VisitCreateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialExpirationPolicy400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCredentialExpirationPolicy400JSONResponse) VisitCreateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialExpirationPolicy401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCredentialExpirationPolicy401JSONResponse) VisitCreateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialExpirationPolicy404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateCredentialExpirationPolicy404JSONResponse) VisitCreateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialExpirationPolicy500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredentialExpirationPolicy500JSONResponse) VisitCreateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialExpirationPolicyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeleteCredentialExpirationPolicyResponseObject interface {
+	VisitDeleteCredentialExpirationPolicyResponse(w http.ResponseWriter) error
+}
+
+type DeleteCredentialExpirationPolicy200JSONResponse GenericMessage
+
+func (response DeleteCredentialExpirationPolicy200JSONResponse) VisitDeleteCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialExpirationPolicy400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteCredentialExpirationPolicy400JSONResponse) VisitDeleteCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialExpirationPolicy401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteCredentialExpirationPolicy401JSONResponse) VisitDeleteCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialExpirationPolicy404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteCredentialExpirationPolicy404JSONResponse) VisitDeleteCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialExpirationPolicy500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCredentialExpirationPolicy500JSONResponse) VisitDeleteCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialExpirationPolicyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *UpdateCredentialExpirationPolicyJSONRequestBody
+}
+
+type UpdateCredentialExpirationPolicyResponseObject interface {
+	VisitUpdateCredentialExpirationPolicyResponse(w http.ResponseWriter) error
+}
+
+type UpdateCredentialExpirationPolicy200JSONResponse CredentialExpirationPolicy
+
+func (response UpdateCredentialExpirationPolicy200JSONResponse) VisitUpdateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialExpirationPolicy400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateCredentialExpirationPolicy400JSONResponse) VisitUpdateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialExpirationPolicy401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateCredentialExpirationPolicy401JSONResponse) VisitUpdateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialExpirationPolicy404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateCredentialExpirationPolicy404JSONResponse) VisitUpdateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialExpirationPolicy500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateCredentialExpirationPolicy500JSONResponse) VisitUpdateCredentialExpirationPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplatesRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetCredentialTemplatesParams
+}
+
+type GetCredentialTemplatesResponseObject interface {
+	VisitGetCredentialTemplatesResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialTemplates200JSONResponse CredentialTemplatesPaginated
+
+func (response GetCredentialTemplates200JSONResponse) VisitGetCredentialTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplates400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialTemplates400JSONResponse) VisitGetCredentialTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplates401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialTemplates401JSONResponse) VisitGetCredentialTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplates500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialTemplates500JSONResponse) VisitGetCredentialTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateCredentialTemplateJSONRequestBody
+}
+
+type CreateCredentialTemplateResponseObject interface {
+	VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateCredentialTemplate201JSONResponse CredentialTemplate
+
+func (response CreateCredentialTemplate201JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCredentialTemplate400JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCredentialTemplate401JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateCredentialTemplate404JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredentialTemplate500JSONResponse) VisitCreateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialTemplateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeleteCredentialTemplateResponseObject interface {
+	VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteCredentialTemplate200JSONResponse GenericMessage
+
+func (response DeleteCredentialTemplate200JSONResponse) VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteCredentialTemplate400JSONResponse) VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteCredentialTemplate401JSONResponse) VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteCredentialTemplate404JSONResponse) VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCredentialTemplate500JSONResponse) VisitDeleteCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetCredentialTemplateResponseObject interface {
+	VisitGetCredentialTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialTemplate200JSONResponse CredentialTemplate
+
+func (response GetCredentialTemplate200JSONResponse) VisitGetCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialTemplate400JSONResponse) VisitGetCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredentialTemplate401JSONResponse) VisitGetCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialTemplate404JSONResponse) VisitGetCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialTemplate500JSONResponse) VisitGetCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialTemplateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *UpdateCredentialTemplateJSONRequestBody
+}
+
+type UpdateCredentialTemplateResponseObject interface {
+	VisitUpdateCredentialTemplateResponse(w http.ResponseWriter) error
+}
+
+type UpdateCredentialTemplate200JSONResponse CredentialTemplate
+
+func (response UpdateCredentialTemplate200JSONResponse) VisitUpdateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateCredentialTemplate400JSONResponse) VisitUpdateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateCredentialTemplate401JSONResponse) VisitUpdateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateCredentialTemplate404JSONResponse) VisitUpdateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCredentialTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateCredentialTemplate500JSONResponse) VisitUpdateCredentialTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Params     CreateCredentialFromTemplateParams
+	Body       *CreateCredentialFromTemplateJSONRequestBody
+}
+
+type CreateCredentialFromTemplateResponseObject interface {
+	VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateCredentialFromTemplate201JSONResponse CreateCredentialResponse
+
+func (response CreateCredentialFromTemplate201JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCredentialFromTemplate400JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCredentialFromTemplate401JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateCredentialFromTemplate404JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateCredentialFromTemplate409JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate422JSONResponse struct{ N422JSONResponse }
+
+func (response CreateCredentialFromTemplate422JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialFromTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredentialFromTemplate500JSONResponse) VisitCreateCredentialFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkFromTemplateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Params     CreateLinkFromTemplateParams
+	Body       *CreateLinkFromTemplateJSONRequestBody
+}
+
+type CreateLinkFromTemplateResponseObject interface {
+	VisitCreateLinkFromTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkFromTemplate201JSONResponse UUIDResponse
+
+func (response CreateLinkFromTemplate201JSONResponse) VisitCreateLinkFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkFromTemplate400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkFromTemplate400JSONResponse) VisitCreateLinkFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkFromTemplate401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateLinkFromTemplate401JSONResponse) VisitCreateLinkFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkFromTemplate404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateLinkFromTemplate404JSONResponse) VisitCreateLinkFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkFromTemplate409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateLinkFromTemplate409JSONResponse) VisitCreateLinkFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkFromTemplate500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkFromTemplate500JSONResponse) VisitCreateLinkFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetCredentialsParams
+}
+
+type GetCredentialsResponseObject interface {
+	VisitGetCredentialsResponse(w http.ResponseWriter) error
+}
+
+type GetCredentials200JSONResponse CredentialsPaginated
+
+func (response GetCredentials200JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentials400JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentials404JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentials500JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     CreateCredentialParams
+	Body       *CreateCredentialJSONRequestBody
+}
+
+type CreateCredentialResponseObject interface {
+	VisitCreateCredentialResponse(w http.ResponseWriter) error
+}
+
+type CreateCredential201JSONResponse CreateCredentialResponse
+
+func (response CreateCredential201JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCredential400JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCredential401JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateCredential409JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential422JSONResponse struct{ N422JSONResponse }
+
+func (response CreateCredential422JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredential500JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBulkIssuanceJobRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *multipart.Reader
+}
+
+type CreateBulkIssuanceJobResponseObject interface {
+	VisitCreateBulkIssuanceJobResponse(w http.ResponseWriter) error
+}
+
+type CreateBulkIssuanceJob202JSONResponse BulkIssuanceJob
+
+func (response CreateBulkIssuanceJob202JSONResponse) VisitCreateBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBulkIssuanceJob400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateBulkIssuanceJob400JSONResponse) VisitCreateBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBulkIssuanceJob401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateBulkIssuanceJob401JSONResponse) VisitCreateBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBulkIssuanceJob404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateBulkIssuanceJob404JSONResponse) VisitCreateBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBulkIssuanceJob500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateBulkIssuanceJob500JSONResponse) VisitCreateBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJobRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetBulkIssuanceJobResponseObject interface {
+	VisitGetBulkIssuanceJobResponse(w http.ResponseWriter) error
+}
+
+type GetBulkIssuanceJob200JSONResponse BulkIssuanceJob
+
+func (response GetBulkIssuanceJob200JSONResponse) VisitGetBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJob400JSONResponse struct{ N400JSONResponse }
+
+func (response GetBulkIssuanceJob400JSONResponse) VisitGetBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJob401JSONResponse struct{ N401JSONResponse }
+
+func (response GetBulkIssuanceJob401JSONResponse) VisitGetBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJob404JSONResponse struct{ N404JSONResponse }
+
+func (response GetBulkIssuanceJob404JSONResponse) VisitGetBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJob500JSONResponse struct{ N500JSONResponse }
+
+func (response GetBulkIssuanceJob500JSONResponse) VisitGetBulkIssuanceJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJobRowsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Params     GetBulkIssuanceJobRowsParams
+}
+
+type GetBulkIssuanceJobRowsResponseObject interface {
+	VisitGetBulkIssuanceJobRowsResponse(w http.ResponseWriter) error
+}
+
+type GetBulkIssuanceJobRows200JSONResponse BulkIssuanceJobRowsPaginated
+
+func (response GetBulkIssuanceJobRows200JSONResponse) VisitGetBulkIssuanceJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJobRows400JSONResponse struct{ N400JSONResponse }
+
+func (response GetBulkIssuanceJobRows400JSONResponse) VisitGetBulkIssuanceJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJobRows401JSONResponse struct{ N401JSONResponse }
+
+func (response GetBulkIssuanceJobRows401JSONResponse) VisitGetBulkIssuanceJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJobRows404JSONResponse struct{ N404JSONResponse }
+
+func (response GetBulkIssuanceJobRows404JSONResponse) VisitGetBulkIssuanceJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkIssuanceJobRows500JSONResponse struct{ N500JSONResponse }
+
+func (response GetBulkIssuanceJobRows500JSONResponse) VisitGetBulkIssuanceJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinksRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetLinksParams
+}
+
+type GetLinksResponseObject interface {
+	VisitGetLinksResponse(w http.ResponseWriter) error
+}
+
+type GetLinks200JSONResponse []Link
+
+func (response GetLinks200JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks400JSONResponse struct{ N400JSONResponse }
+
+func (response GetLinks400JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLinks404JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinks500JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     CreateLinkParams
+	Body       *CreateLinkJSONRequestBody
+}
+
+type CreateLinkResponseObject interface {
+	VisitCreateLinkResponse(w http.ResponseWriter) error
+}
+
+type CreateLink201JSONResponse UUIDResponse
+
+func (response CreateLink201JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLink400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLink400JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLink409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateLink409JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLink500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLink500JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallbackRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     CreateLinkQrCodeCallbackParams
+	Body       *CreateLinkQrCodeCallbackTextRequestBody
+}
+
+type CreateLinkQrCodeCallbackResponseObject interface {
+	VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkQrCodeCallback200JSONResponse Offer
+
+func (response CreateLinkQrCodeCallback200JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkQrCodeCallback400JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkQrCodeCallback500JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLinkRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeleteLinkResponseObject interface {
+	VisitDeleteLinkResponse(w http.ResponseWriter) error
+}
+
+type DeleteLink200JSONResponse GenericMessage
+
+func (response DeleteLink200JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLink400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteLink400JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLink500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteLink500JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetLinkResponseObject interface {
+	VisitGetLinkResponse(w http.ResponseWriter) error
+}
+
+type GetLink200JSONResponse Link
+
+func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLink400JSONResponse struct{ N400JSONResponse }
+
+func (response GetLink400JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLink404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLink404JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLink500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLink500JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateLinkRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *ActivateLinkJSONRequestBody
+}
+
+type ActivateLinkResponseObject interface {
+	VisitActivateLinkResponse(w http.ResponseWriter) error
+}
+
+type ActivateLink200JSONResponse GenericMessage
+
+func (response ActivateLink200JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateLink400JSONResponse struct{ N400JSONResponse }
+
+func (response ActivateLink400JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateLink500JSONResponse struct{ N500JSONResponse }
+
+func (response ActivateLink500JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOfferRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type CreateLinkOfferResponseObject interface {
+	VisitCreateLinkOfferResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkOffer200JSONResponse CredentialLinkQrCodeResponse
+
+func (response CreateLinkOffer200JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkOffer400JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateLinkOffer404JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkOffer500JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type ResumeCredentialResponseObject interface {
+	VisitResumeCredentialResponse(w http.ResponseWriter) error
+}
+
+type ResumeCredential200JSONResponse GenericMessage
+
+func (response ResumeCredential200JSONResponse) VisitResumeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response ResumeCredential400JSONResponse) VisitResumeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response ResumeCredential401JSONResponse) VisitResumeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response ResumeCredential404JSONResponse) VisitResumeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response ResumeCredential500JSONResponse) VisitResumeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusV2RequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type GetRevocationStatusV2ResponseObject interface {
+	VisitGetRevocationStatusV2Response(w http.ResponseWriter) error
+}
+
+type GetRevocationStatusV2200JSONResponse RevocationStatusResponse
+
+func (response GetRevocationStatusV2200JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusV2400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationStatusV2400JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusV2500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationStatusV2500JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type RevokeCredentialResponseObject interface {
+	VisitRevokeCredentialResponse(w http.ResponseWriter) error
+}
+
+type RevokeCredential202JSONResponse RevokeClaimResponse
+
+func (response RevokeCredential202JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeCredential400JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeCredential401JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeCredential404JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeCredential500JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type SuspendCredentialResponseObject interface {
+	VisitSuspendCredentialResponse(w http.ResponseWriter) error
+}
+
+type SuspendCredential200JSONResponse GenericMessage
+
+func (response SuspendCredential200JSONResponse) VisitSuspendCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response SuspendCredential400JSONResponse) VisitSuspendCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response SuspendCredential401JSONResponse) VisitSuspendCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response SuspendCredential404JSONResponse) VisitSuspendCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuspendCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response SuspendCredential500JSONResponse) VisitSuspendCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+}
+
+type DeleteCredentialResponseObject interface {
+	VisitDeleteCredentialResponse(w http.ResponseWriter) error
+}
+
+type DeleteCredential200JSONResponse GenericMessage
+
+func (response DeleteCredential200JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteCredential400JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteCredential401JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCredential500JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+	Params     GetCredentialParams
+}
+
+type GetCredentialResponseObject interface {
+	VisitGetCredentialResponse(w http.ResponseWriter) error
+}
+
+type GetCredential200JSONResponse Credential
+
+func (response GetCredential200JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredential400JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredential401JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredential404JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredential500JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReissueCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+	Body       *ReissueCredentialJSONRequestBody
+}
+
+type ReissueCredentialResponseObject interface {
+	VisitReissueCredentialResponse(w http.ResponseWriter) error
+}
+
+type ReissueCredential201JSONResponse ReissueCredentialResponse
+
+func (response ReissueCredential201JSONResponse) VisitReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReissueCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response ReissueCredential400JSONResponse) VisitReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReissueCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response ReissueCredential401JSONResponse) VisitReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReissueCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response ReissueCredential404JSONResponse) VisitReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReissueCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response ReissueCredential500JSONResponse) VisitReissueCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOfferRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+	Params     GetCredentialOfferParams
+}
+
+type GetCredentialOfferResponseObject interface {
+	VisitGetCredentialOfferResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialOffer200JSONResponse CredentialOfferResponse
+
+func (response GetCredentialOffer200JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialOffer400JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialOffer404JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOffer409JSONResponse struct{ N409JSONResponse }
+
+func (response GetCredentialOffer409JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialOffer500JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDIDDocumentRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetDIDDocumentResponseObject interface {
+	VisitGetDIDDocumentResponse(w http.ResponseWriter) error
+}
+
+type GetDIDDocument200JSONResponse DIDDocument
+
+func (response GetDIDDocument200JSONResponse) VisitGetDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDIDDocument400JSONResponse struct{ N400JSONResponse }
+
+func (response GetDIDDocument400JSONResponse) VisitGetDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDIDDocument404JSONResponse struct{ N404JSONResponse }
+
+func (response GetDIDDocument404JSONResponse) VisitGetDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDIDDocument500JSONResponse struct{ N500JSONResponse }
+
+func (response GetDIDDocument500JSONResponse) VisitGetDIDDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllDisplayMethodsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetAllDisplayMethodsParams
+}
+
+type GetAllDisplayMethodsResponseObject interface {
+	VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error
+}
+
+type GetAllDisplayMethods200JSONResponse DisplayMethodPaginated
+
+func (response GetAllDisplayMethods200JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllDisplayMethods400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAllDisplayMethods400JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllDisplayMethods404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAllDisplayMethods404JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllDisplayMethods500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAllDisplayMethods500JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDisplayMethodRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateDisplayMethodJSONRequestBody
+}
+
+type CreateDisplayMethodResponseObject interface {
+	VisitCreateDisplayMethodResponse(w http.ResponseWriter) error
+}
+
+type CreateDisplayMethod201JSONResponse UUIDResponse
+
+func (response CreateDisplayMethod201JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDisplayMethod400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateDisplayMethod400JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDisplayMethod500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateDisplayMethod500JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDisplayMethodRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeleteDisplayMethodResponseObject interface {
+	VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error
+}
+
+type DeleteDisplayMethod200JSONResponse GenericMessage
+
+func (response DeleteDisplayMethod200JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDisplayMethod400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteDisplayMethod400JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDisplayMethod404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteDisplayMethod404JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDisplayMethod500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteDisplayMethod500JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDisplayMethodRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetDisplayMethodResponseObject interface {
+	VisitGetDisplayMethodResponse(w http.ResponseWriter) error
+}
+
+type GetDisplayMethod200JSONResponse DisplayMethodEntity
+
+func (response GetDisplayMethod200JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDisplayMethod400JSONResponse struct{ N400JSONResponse }
+
+func (response GetDisplayMethod400JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDisplayMethod404JSONResponse struct{ N404JSONResponse }
+
+func (response GetDisplayMethod404JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDisplayMethod500JSONResponse struct{ N500JSONResponse }
+
+func (response GetDisplayMethod500JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDisplayMethodRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *UpdateDisplayMethodJSONRequestBody
+}
+
+type UpdateDisplayMethodResponseObject interface {
+	VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error
+}
+
+type UpdateDisplayMethod200JSONResponse GenericMessage
+
+func (response UpdateDisplayMethod200JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDisplayMethod400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateDisplayMethod400JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDisplayMethod404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateDisplayMethod404JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDisplayMethod500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateDisplayMethod500JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotationsRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+}
+
+type GetKeyRotationsResponseObject interface {
+	VisitGetKeyRotationsResponse(w http.ResponseWriter) error
+}
+
+type GetKeyRotations200JSONResponse []KeyRotation
+
+func (response GetKeyRotations200JSONResponse) VisitGetKeyRotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotations400JSONResponse struct{ N400JSONResponse }
+
+func (response GetKeyRotations400JSONResponse) VisitGetKeyRotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotations401JSONResponse struct{ N401JSONResponse }
+
+func (response GetKeyRotations401JSONResponse) VisitGetKeyRotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotations500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKeyRotations500JSONResponse) VisitGetKeyRotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKeyRotationRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Body       *CreateKeyRotationJSONRequestBody
+}
+
+type CreateKeyRotationResponseObject interface {
+	VisitCreateKeyRotationResponse(w http.ResponseWriter) error
+}
+
+type CreateKeyRotation201JSONResponse KeyRotation
+
+func (response CreateKeyRotation201JSONResponse) VisitCreateKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKeyRotation400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateKeyRotation400JSONResponse) VisitCreateKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKeyRotation401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateKeyRotation401JSONResponse) VisitCreateKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKeyRotation409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateKeyRotation409JSONResponse) VisitCreateKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKeyRotation500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateKeyRotation500JSONResponse) VisitCreateKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotationRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Id         Id              `json:"id"`
+}
+
+type GetKeyRotationResponseObject interface {
+	VisitGetKeyRotationResponse(w http.ResponseWriter) error
+}
+
+type GetKeyRotation200JSONResponse KeyRotation
+
+func (response GetKeyRotation200JSONResponse) VisitGetKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotation400JSONResponse struct{ N400JSONResponse }
+
+func (response GetKeyRotation400JSONResponse) VisitGetKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotation401JSONResponse struct{ N401JSONResponse }
+
+func (response GetKeyRotation401JSONResponse) VisitGetKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotation404JSONResponse struct{ N404JSONResponse }
+
+func (response GetKeyRotation404JSONResponse) VisitGetKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotation500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKeyRotation500JSONResponse) VisitGetKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotationCredentialsRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Id         Id              `json:"id"`
+}
+
+type GetKeyRotationCredentialsResponseObject interface {
+	VisitGetKeyRotationCredentialsResponse(w http.ResponseWriter) error
+}
+
+type GetKeyRotationCredentials200JSONResponse []Credential
+
+func (response GetKeyRotationCredentials200JSONResponse) VisitGetKeyRotationCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotationCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response GetKeyRotationCredentials400JSONResponse) VisitGetKeyRotationCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotationCredentials401JSONResponse struct{ N401JSONResponse }
+
+func (response GetKeyRotationCredentials401JSONResponse) VisitGetKeyRotationCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotationCredentials404JSONResponse struct{ N404JSONResponse }
+
+func (response GetKeyRotationCredentials404JSONResponse) VisitGetKeyRotationCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRotationCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKeyRotationCredentials500JSONResponse) VisitGetKeyRotationCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyRotationRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Id         Id              `json:"id"`
+}
+
+type ResumeKeyRotationResponseObject interface {
+	VisitResumeKeyRotationResponse(w http.ResponseWriter) error
+}
+
+type ResumeKeyRotation200JSONResponse KeyRotation
+
+func (response ResumeKeyRotation200JSONResponse) VisitResumeKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyRotation400JSONResponse struct{ N400JSONResponse }
+
+func (response ResumeKeyRotation400JSONResponse) VisitResumeKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyRotation401JSONResponse struct{ N401JSONResponse }
+
+func (response ResumeKeyRotation401JSONResponse) VisitResumeKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyRotation404JSONResponse struct{ N404JSONResponse }
+
+func (response ResumeKeyRotation404JSONResponse) VisitResumeKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyRotation409JSONResponse struct{ N409JSONResponse }
+
+func (response ResumeKeyRotation409JSONResponse) VisitResumeKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeKeyRotation500JSONResponse struct{ N500JSONResponse }
+
+func (response ResumeKeyRotation500JSONResponse) VisitResumeKeyRotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeysRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Params     GetKeysParams
+}
+
+type GetKeysResponseObject interface {
+	VisitGetKeysResponse(w http.ResponseWriter) error
+}
+
+type GetKeys200JSONResponse KeysPaginated
+
+func (response GetKeys200JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeys400JSONResponse struct{ N400JSONResponse }
+
+func (response GetKeys400JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeys404JSONResponse struct{ N404JSONResponse }
+
+func (response GetKeys404JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeys500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKeys500JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKeyRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Params     CreateKeyParams
+	Body       *CreateKeyJSONRequestBody
+}
+
+type CreateKeyResponseObject interface {
+	VisitCreateKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateKey201JSONResponse CreateKeyResponse
+
+func (response CreateKey201JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKey400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateKey400JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKey401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateKey401JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKey409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateKey409JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKey500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateKey500JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKeyRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Id         PathKeyID       `json:"id"`
+}
+
+type DeleteKeyResponseObject interface {
+	VisitDeleteKeyResponse(w http.ResponseWriter) error
+}
+
+type DeleteKey200JSONResponse GenericMessage
+
+func (response DeleteKey200JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKey400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteKey400JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKey401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteKey401JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKey404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteKey404JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKey500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteKey500JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Id         PathKeyID       `json:"id"`
+}
+
+type GetKeyResponseObject interface {
+	VisitGetKeyResponse(w http.ResponseWriter) error
+}
+
+type GetKey200JSONResponse Key
+
+func (response GetKey200JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKey400JSONResponse struct{ N400JSONResponse }
+
+func (response GetKey400JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKey401JSONResponse struct{ N401JSONResponse }
+
+func (response GetKey401JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKey404JSONResponse struct{ N404JSONResponse }
+
+func (response GetKey404JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKey500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKey500JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKeyRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Id         PathKeyID       `json:"id"`
+	Body       *UpdateKeyJSONRequestBody
+}
+
+type UpdateKeyResponseObject interface {
+	VisitUpdateKeyResponse(w http.ResponseWriter) error
+}
+
+type UpdateKey200JSONResponse GenericMessage
+
+func (response UpdateKey200JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKey400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateKey400JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKey401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateKey401JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKey404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateKey404JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKey500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateKey500JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckMerkleTreesIntegrityRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     CheckMerkleTreesIntegrityParams
+}
+
+type CheckMerkleTreesIntegrityResponseObject interface {
+	VisitCheckMerkleTreesIntegrityResponse(w http.ResponseWriter) error
+}
+
+type CheckMerkleTreesIntegrity200JSONResponse MerkleTreeIntegrityReport
+
+func (response CheckMerkleTreesIntegrity200JSONResponse) VisitCheckMerkleTreesIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckMerkleTreesIntegrity400JSONResponse struct{ N400JSONResponse }
+
+func (response CheckMerkleTreesIntegrity400JSONResponse) VisitCheckMerkleTreesIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckMerkleTreesIntegrity404JSONResponse struct{ N404JSONResponse }
+
+func (response CheckMerkleTreesIntegrity404JSONResponse) VisitCheckMerkleTreesIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckMerkleTreesIntegrity409JSONResponse struct{ N409JSONResponse }
+
+func (response CheckMerkleTreesIntegrity409JSONResponse) VisitCheckMerkleTreesIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckMerkleTreesIntegrity500JSONResponse struct{ N500JSONResponse }
+
+func (response CheckMerkleTreesIntegrity500JSONResponse) VisitCheckMerkleTreesIntegrityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequestsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetPaymentRequestsParams
+}
+
+type GetPaymentRequestsResponseObject interface {
+	VisitGetPaymentRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetPaymentRequests200JSONResponse GetPaymentRequestsResponse
+
+func (response GetPaymentRequests200JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequests400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPaymentRequests400JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequests401JSONResponse struct{ N401JSONResponse }
+
+func (response GetPaymentRequests401JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequests500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPaymentRequests500JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentRequestRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     CreatePaymentRequestParams
+	Body       *CreatePaymentRequestJSONRequestBody
+}
+
+type CreatePaymentRequestResponseObject interface {
+	VisitCreatePaymentRequestResponse(w http.ResponseWriter) error
+}
+
+type CreatePaymentRequest201JSONResponse CreatePaymentRequestResponse
+
+func (response CreatePaymentRequest201JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentRequest400JSONResponse struct{ N400JSONResponse }
+
+func (response CreatePaymentRequest400JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentRequest401JSONResponse struct{ N401JSONResponse }
+
+func (response CreatePaymentRequest401JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentRequest409JSONResponse struct{ N409JSONResponse }
+
+func (response CreatePaymentRequest409JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response CreatePaymentRequest500JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentRequestRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeletePaymentRequestResponseObject interface {
+	VisitDeletePaymentRequestResponse(w http.ResponseWriter) error
+}
+
+type DeletePaymentRequest200JSONResponse GenericMessage
+
+func (response DeletePaymentRequest200JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentRequest400JSONResponse struct{ N400JSONResponse }
+
+func (response DeletePaymentRequest400JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response DeletePaymentRequest500JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequestRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetPaymentRequestResponseObject interface {
+	VisitGetPaymentRequestResponse(w http.ResponseWriter) error
+}
+
+type GetPaymentRequest200JSONResponse CreatePaymentRequestResponse
+
+func (response GetPaymentRequest200JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequest400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPaymentRequest400JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequest404JSONResponse struct{ N404JSONResponse }
+
+func (response GetPaymentRequest404JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPaymentRequest500JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptionsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetPaymentOptionsResponseObject interface {
+	VisitGetPaymentOptionsResponse(w http.ResponseWriter) error
+}
+
+type GetPaymentOptions200JSONResponse PaymentOptionsPaginated
+
+func (response GetPaymentOptions200JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptions400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPaymentOptions400JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptions401JSONResponse struct{ N401JSONResponse }
+
+func (response GetPaymentOptions401JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPaymentOptions500JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOptionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreatePaymentOptionJSONRequestBody
+}
+
+type CreatePaymentOptionResponseObject interface {
+	VisitCreatePaymentOptionResponse(w http.ResponseWriter) error
+}
+
+type CreatePaymentOption201JSONResponse UUIDResponse
+
+func (response CreatePaymentOption201JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOption400JSONResponse struct{ N400JSONResponse }
+
+func (response CreatePaymentOption400JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOption401JSONResponse struct{ N401JSONResponse }
+
+func (response CreatePaymentOption401JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOption409JSONResponse struct{ N409JSONResponse }
+
+func (response CreatePaymentOption409JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOption500JSONResponse struct{ N500JSONResponse }
+
+func (response CreatePaymentOption500JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentOptionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeletePaymentOptionResponseObject interface {
+	VisitDeletePaymentOptionResponse(w http.ResponseWriter) error
+}
+
+type DeletePaymentOption200JSONResponse GenericMessage
+
+func (response DeletePaymentOption200JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentOption400JSONResponse struct{ N400JSONResponse }
+
+func (response DeletePaymentOption400JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentOption500JSONResponse struct{ N500JSONResponse }
+
+func (response DeletePaymentOption500JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetPaymentOptionResponseObject interface {
+	VisitGetPaymentOptionResponse(w http.ResponseWriter) error
+}
+
+type GetPaymentOption200JSONResponse PaymentOption
+
+func (response GetPaymentOption200JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOption400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPaymentOption400JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOption404JSONResponse struct{ N404JSONResponse }
+
+func (response GetPaymentOption404JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOption500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPaymentOption500JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePaymentOptionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *UpdatePaymentOptionJSONRequestBody
+}
+
+type UpdatePaymentOptionResponseObject interface {
+	VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error
+}
+
+type UpdatePaymentOption200JSONResponse GenericMessage
+
+func (response UpdatePaymentOption200JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePaymentOption400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdatePaymentOption400JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePaymentOption404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdatePaymentOption404JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePaymentOption500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdatePaymentOption500JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPaymentRequestObject struct {
+	Identifier string `json:"identifier"`
+	Nonce      string `json:"nonce"`
+	Body       *VerifyPaymentJSONRequestBody
+}
+
+type VerifyPaymentResponseObject interface {
+	VisitVerifyPaymentResponse(w http.ResponseWriter) error
+}
+
+type VerifyPayment200JSONResponse PaymentStatus
+
+func (response VerifyPayment200JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPayment400JSONResponse struct{ N400JSONResponse }
+
+func (response VerifyPayment400JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPayment401JSONResponse struct{ N401JSONResponse }
+
+func (response VerifyPayment401JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPayment500JSONResponse struct{ N500JSONResponse }
+
+func (response VerifyPayment500JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemasRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetSchemasParams
+}
+
+type GetSchemasResponseObject interface {
+	VisitGetSchemasResponse(w http.ResponseWriter) error
+}
+
+type GetSchemas200JSONResponse []Schema
+
+func (response GetSchemas200JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemas400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSchemas400JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemas500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchemas500JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchemaRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *ImportSchemaJSONRequestBody
+}
+
+type ImportSchemaResponseObject interface {
+	VisitImportSchemaResponse(w http.ResponseWriter) error
+}
+
+type ImportSchema201JSONResponse UUIDResponse
+
+func (response ImportSchema201JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response ImportSchema400JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response ImportSchema500JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetSchemaResponseObject interface {
+	VisitGetSchemaResponse(w http.ResponseWriter) error
+}
+
+type GetSchema200JSONResponse Schema
+
+func (response GetSchema200JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSchema400JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchema404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSchema404JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchema500JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchemaRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *UpdateSchemaJSONRequestBody
+}
+
+type UpdateSchemaResponseObject interface {
+	VisitUpdateSchemaResponse(w http.ResponseWriter) error
+}
+
+type UpdateSchema200JSONResponse GenericMessage
+
+func (response UpdateSchema200JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchema400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSchema400JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredential401JSONResponse struct{ N401JSONResponse }
+type UpdateSchema404JSONResponse struct{ N404JSONResponse }
 
-func (response GetCredential401JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+func (response UpdateSchema404JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSchema500JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateDiffRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetStateDiffParams
+}
+
+type GetStateDiffResponseObject interface {
+	VisitGetStateDiffResponse(w http.ResponseWriter) error
+}
+
+type GetStateDiff200JSONResponse StateDiffResponse
+
+func (response GetStateDiff200JSONResponse) VisitGetStateDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateDiff400JSONResponse struct{ N400JSONResponse }
+
+func (response GetStateDiff400JSONResponse) VisitGetStateDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateDiff404JSONResponse struct{ N404JSONResponse }
+
+func (response GetStateDiff404JSONResponse) VisitGetStateDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStateDiff500JSONResponse struct{ N500JSONResponse }
+
+func (response GetStateDiff500JSONResponse) VisitGetStateDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DryRunPublishStateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type DryRunPublishStateResponseObject interface {
+	VisitDryRunPublishStateResponse(w http.ResponseWriter) error
+}
+
+type DryRunPublishState200JSONResponse StateTransitionSimulation
+
+func (response DryRunPublishState200JSONResponse) VisitDryRunPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DryRunPublishState400JSONResponse struct{ N400JSONResponse }
+
+func (response DryRunPublishState400JSONResponse) VisitDryRunPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DryRunPublishState404JSONResponse struct{ N404JSONResponse }
+
+func (response DryRunPublishState404JSONResponse) VisitDryRunPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DryRunPublishState500JSONResponse struct{ N500JSONResponse }
+
+func (response DryRunPublishState500JSONResponse) VisitDryRunPublishStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityStateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type PublishIdentityStateResponseObject interface {
+	VisitPublishIdentityStateResponse(w http.ResponseWriter) error
+}
+
+type PublishIdentityState200JSONResponse GenericMessage
+
+func (response PublishIdentityState200JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityState202JSONResponse PublishIdentityStateResponse
+
+func (response PublishIdentityState202JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityState400JSONResponse struct{ N400JSONResponse }
+
+func (response PublishIdentityState400JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityState401JSONResponse struct{ N401JSONResponse }
+
+func (response PublishIdentityState401JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredential404JSONResponse struct{ N404JSONResponse }
+type PublishIdentityState500JSONResponse struct{ N500JSONResponse }
 
-func (response GetCredential404JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+func (response PublishIdentityState500JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePublishPolicyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type DeletePublishPolicyResponseObject interface {
+	VisitDeletePublishPolicyResponse(w http.ResponseWriter) error
+}
+
+type DeletePublishPolicy200JSONResponse GenericMessage
+
+func (response DeletePublishPolicy200JSONResponse) VisitDeletePublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePublishPolicy400JSONResponse struct{ N400JSONResponse }
+
+func (response DeletePublishPolicy400JSONResponse) VisitDeletePublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePublishPolicy401JSONResponse struct{ N401JSONResponse }
+
+func (response DeletePublishPolicy401JSONResponse) VisitDeletePublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePublishPolicy404JSONResponse struct{ N404JSONResponse }
+
+func (response DeletePublishPolicy404JSONResponse) VisitDeletePublishPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredential500JSONResponse struct{ N500JSONResponse }
+type DeletePublishPolicy500JSONResponse struct{ N500JSONResponse }
 
-func (response GetCredential500JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+func (response DeletePublishPolicy500JSONResponse) VisitDeletePublishPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialOfferRequestObject struct {
+type GetPublishPolicyRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
-	Params     GetCredentialOfferParams
 }
 
-type GetCredentialOfferResponseObject interface {
-	VisitGetCredentialOfferResponse(w http.ResponseWriter) error
+type GetPublishPolicyResponseObject interface {
+	VisitGetPublishPolicyResponse(w http.ResponseWriter) error
 }
 
-type GetCredentialOffer200JSONResponse CredentialOfferResponse
+type GetPublishPolicy200JSONResponse PublishPolicy
 
-func (response GetCredentialOffer200JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+func (response GetPublishPolicy200JSONResponse) VisitGetPublishPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialOffer400JSONResponse struct{ N400JSONResponse }
+type GetPublishPolicy400JSONResponse struct{ N400JSONResponse }
 
-func (response GetCredentialOffer400JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+func (response GetPublishPolicy400JSONResponse) VisitGetPublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublishPolicy401JSONResponse struct{ N401JSONResponse }
+
+func (response GetPublishPolicy401JSONResponse) VisitGetPublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublishPolicy404JSONResponse struct{ N404JSONResponse }
+
+func (response GetPublishPolicy404JSONResponse) VisitGetPublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublishPolicy500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPublishPolicy500JSONResponse) VisitGetPublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePublishPolicyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *UpdatePublishPolicyJSONRequestBody
+}
+
+type UpdatePublishPolicyResponseObject interface {
+	VisitUpdatePublishPolicyResponse(w http.ResponseWriter) error
+}
+
+type UpdatePublishPolicy200JSONResponse PublishPolicy
+
+func (response UpdatePublishPolicy200JSONResponse) VisitUpdatePublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePublishPolicy400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdatePublishPolicy400JSONResponse) VisitUpdatePublishPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialOffer404JSONResponse struct{ N404JSONResponse }
+type UpdatePublishPolicy401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdatePublishPolicy401JSONResponse) VisitUpdatePublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePublishPolicy404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdatePublishPolicy404JSONResponse) VisitUpdatePublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePublishPolicy500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdatePublishPolicy500JSONResponse) VisitUpdatePublishPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPublishStateRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type RetryPublishStateResponseObject interface {
+	VisitRetryPublishStateResponse(w http.ResponseWriter) error
+}
+
+type RetryPublishState202JSONResponse PublishIdentityStateResponse
 
-func (response GetCredentialOffer404JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+func (response RetryPublishState202JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(202)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialOffer409JSONResponse struct{ N409JSONResponse }
+type RetryPublishState400JSONResponse struct{ N400JSONResponse }
 
-func (response GetCredentialOffer409JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+func (response RetryPublishState400JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialOffer500JSONResponse struct{ N500JSONResponse }
+type RetryPublishState500JSONResponse struct{ N500JSONResponse }
 
-func (response GetCredentialOffer500JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+func (response RetryPublishState500JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllDisplayMethodsRequestObject struct {
+type GetStateStatusRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Params     GetAllDisplayMethodsParams
 }
 
-type GetAllDisplayMethodsResponseObject interface {
-	VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error
+type GetStateStatusResponseObject interface {
+	VisitGetStateStatusResponse(w http.ResponseWriter) error
 }
 
-type GetAllDisplayMethods200JSONResponse DisplayMethodPaginated
+type GetStateStatus200JSONResponse StateStatusResponse
 
-func (response GetAllDisplayMethods200JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+func (response GetStateStatus200JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllDisplayMethods400JSONResponse struct{ N400JSONResponse }
+type GetStateStatus400JSONResponse struct{ N400JSONResponse }
 
-func (response GetAllDisplayMethods400JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+func (response GetStateStatus400JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllDisplayMethods404JSONResponse struct{ N404JSONResponse }
-
-func (response GetAllDisplayMethods404JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetAllDisplayMethods500JSONResponse struct{ N500JSONResponse }
+type GetStateStatus500JSONResponse struct{ N500JSONResponse }
 
-func (response GetAllDisplayMethods500JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+func (response GetStateStatus500JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDisplayMethodRequestObject struct {
+type GetStateTransactionsRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreateDisplayMethodJSONRequestBody
+	Params     GetStateTransactionsParams
 }
 
-type CreateDisplayMethodResponseObject interface {
-	VisitCreateDisplayMethodResponse(w http.ResponseWriter) error
+type GetStateTransactionsResponseObject interface {
+	VisitGetStateTransactionsResponse(w http.ResponseWriter) error
 }
 
-type CreateDisplayMethod201JSONResponse UUIDResponse
+type GetStateTransactions200JSONResponse StateTransactionsPaginated
 
-func (response CreateDisplayMethod201JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStateTransactions200JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDisplayMethod400JSONResponse struct{ N400JSONResponse }
+type GetStateTransactions400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateDisplayMethod400JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStateTransactions400JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDisplayMethod500JSONResponse struct{ N500JSONResponse }
+type GetStateTransactions500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateDisplayMethod500JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStateTransactions500JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDisplayMethodRequestObject struct {
+type GetStateDetailsRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+	State      string         `json:"state"`
+	Params     GetStateDetailsParams
 }
 
-type DeleteDisplayMethodResponseObject interface {
-	VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error
+type GetStateDetailsResponseObject interface {
+	VisitGetStateDetailsResponse(w http.ResponseWriter) error
 }
 
-type DeleteDisplayMethod200JSONResponse GenericMessage
+type GetStateDetails200JSONResponse StateDetailsResponse
 
-func (response DeleteDisplayMethod200JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStateDetails200JSONResponse) VisitGetStateDetailsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDisplayMethod400JSONResponse struct{ N400JSONResponse }
+type GetStateDetails400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteDisplayMethod400JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStateDetails400JSONResponse) VisitGetStateDetailsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDisplayMethod404JSONResponse struct{ N404JSONResponse }
+type GetStateDetails404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteDisplayMethod404JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStateDetails404JSONResponse) VisitGetStateDetailsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDisplayMethod500JSONResponse struct{ N500JSONResponse }
+type GetStateDetails500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteDisplayMethod500JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStateDetails500JSONResponse) VisitGetStateDetailsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDisplayMethodRequestObject struct {
+type GetStatusListCredentialRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
 	Id         Id             `json:"id"`
 }
 
-type GetDisplayMethodResponseObject interface {
-	VisitGetDisplayMethodResponse(w http.ResponseWriter) error
+type GetStatusListCredentialResponseObject interface {
+	VisitGetStatusListCredentialResponse(w http.ResponseWriter) error
 }
 
-type GetDisplayMethod200JSONResponse DisplayMethodEntity
+type GetStatusListCredential200ApplicationvcJwtResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response GetDisplayMethod200JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetStatusListCredential200ApplicationvcJwtResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vc+jwt")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type GetDisplayMethod400JSONResponse struct{ N400JSONResponse }
+type GetStatusListCredential400JSONResponse struct{ N400JSONResponse }
 
-func (response GetDisplayMethod400JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStatusListCredential400JSONResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDisplayMethod404JSONResponse struct{ N404JSONResponse }
+type GetStatusListCredential404JSONResponse struct{ N404JSONResponse }
 
-func (response GetDisplayMethod404JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStatusListCredential404JSONResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDisplayMethod500JSONResponse struct{ N500JSONResponse }
+type GetStatusListCredential500JSONResponse struct{ N500JSONResponse }
 
-func (response GetDisplayMethod500JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetStatusListCredential500JSONResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateDisplayMethodRequestObject struct {
+type GetVerificationQueriesRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-	Body       *UpdateDisplayMethodJSONRequestBody
 }
 
-type UpdateDisplayMethodResponseObject interface {
-	VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error
+type GetVerificationQueriesResponseObject interface {
+	VisitGetVerificationQueriesResponse(w http.ResponseWriter) error
 }
 
-type UpdateDisplayMethod200JSONResponse GenericMessage
+type GetVerificationQueries200JSONResponse []VerificationQuery
 
-func (response UpdateDisplayMethod200JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetVerificationQueries200JSONResponse) VisitGetVerificationQueriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateDisplayMethod400JSONResponse struct{ N400JSONResponse }
+type GetVerificationQueries400JSONResponse struct{ N400JSONResponse }
 
-func (response UpdateDisplayMethod400JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetVerificationQueries400JSONResponse) VisitGetVerificationQueriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateDisplayMethod404JSONResponse struct{ N404JSONResponse }
+type GetVerificationQueries401JSONResponse struct{ N401JSONResponse }
 
-func (response UpdateDisplayMethod404JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetVerificationQueries401JSONResponse) VisitGetVerificationQueriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateDisplayMethod500JSONResponse struct{ N500JSONResponse }
+type GetVerificationQueries500JSONResponse struct{ N500JSONResponse }
 
-func (response UpdateDisplayMethod500JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetVerificationQueries500JSONResponse) VisitGetVerificationQueriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeysRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Params     GetKeysParams
+type CreateVerificationQueryRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateVerificationQueryJSONRequestBody
 }
 
-type GetKeysResponseObject interface {
-	VisitGetKeysResponse(w http.ResponseWriter) error
+type CreateVerificationQueryResponseObject interface {
+	VisitCreateVerificationQueryResponse(w http.ResponseWriter) error
 }
 
-type GetKeys200JSONResponse KeysPaginated
+type CreateVerificationQuery201JSONResponse VerificationQuery
 
-func (response GetKeys200JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response CreateVerificationQuery201JSONResponse) VisitCreateVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeys400JSONResponse struct{ N400JSONResponse }
+type CreateVerificationQuery400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKeys400JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response CreateVerificationQuery400JSONResponse) VisitCreateVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeys404JSONResponse struct{ N404JSONResponse }
+type CreateVerificationQuery401JSONResponse struct{ N401JSONResponse }
 
-func (response GetKeys404JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response CreateVerificationQuery401JSONResponse) VisitCreateVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeys500JSONResponse struct{ N500JSONResponse }
+type CreateVerificationQuery500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKeys500JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response CreateVerificationQuery500JSONResponse) VisitCreateVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateKeyRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Body       *CreateKeyJSONRequestBody
+type DeleteVerificationQueryRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
 }
 
-type CreateKeyResponseObject interface {
-	VisitCreateKeyResponse(w http.ResponseWriter) error
+type DeleteVerificationQueryResponseObject interface {
+	VisitDeleteVerificationQueryResponse(w http.ResponseWriter) error
 }
 
-type CreateKey201JSONResponse CreateKeyResponse
+type DeleteVerificationQuery200JSONResponse GenericMessage
 
-func (response CreateKey201JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+func (response DeleteVerificationQuery200JSONResponse) VisitDeleteVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateKey400JSONResponse struct{ N400JSONResponse }
+type DeleteVerificationQuery400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateKey400JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+func (response DeleteVerificationQuery400JSONResponse) VisitDeleteVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateKey401JSONResponse struct{ N401JSONResponse }
+type DeleteVerificationQuery401JSONResponse struct{ N401JSONResponse }
 
-func (response CreateKey401JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+func (response DeleteVerificationQuery401JSONResponse) VisitDeleteVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateKey500JSONResponse struct{ N500JSONResponse }
+type DeleteVerificationQuery404JSONResponse struct{ N404JSONResponse }
 
-func (response CreateKey500JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+func (response DeleteVerificationQuery404JSONResponse) VisitDeleteVerificationQueryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVerificationQuery500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteVerificationQuery500JSONResponse) VisitDeleteVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeyRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Id         PathKeyID       `json:"id"`
+type GetVerificationQueryRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
 }
 
-type DeleteKeyResponseObject interface {
-	VisitDeleteKeyResponse(w http.ResponseWriter) error
+type GetVerificationQueryResponseObject interface {
+	VisitGetVerificationQueryResponse(w http.ResponseWriter) error
 }
 
-type DeleteKey200JSONResponse GenericMessage
+type GetVerificationQuery200JSONResponse VerificationQuery
 
-func (response DeleteKey200JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationQuery200JSONResponse) VisitGetVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKey400JSONResponse struct{ N400JSONResponse }
+type GetVerificationQuery400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteKey400JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationQuery400JSONResponse) VisitGetVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKey401JSONResponse struct{ N401JSONResponse }
+type GetVerificationQuery401JSONResponse struct{ N401JSONResponse }
 
-func (response DeleteKey401JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationQuery401JSONResponse) VisitGetVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKey404JSONResponse struct{ N404JSONResponse }
+type GetVerificationQuery404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteKey404JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationQuery404JSONResponse) VisitGetVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKey500JSONResponse struct{ N500JSONResponse }
+type GetVerificationQuery500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteKey500JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationQuery500JSONResponse) VisitGetVerificationQueryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Id         PathKeyID       `json:"id"`
+type CreateVerificationSessionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
 }
 
-type GetKeyResponseObject interface {
-	VisitGetKeyResponse(w http.ResponseWriter) error
+type CreateVerificationSessionResponseObject interface {
+	VisitCreateVerificationSessionResponse(w http.ResponseWriter) error
 }
 
-type GetKey200JSONResponse Key
+type CreateVerificationSession201JSONResponse CreateVerificationSessionResponse
 
-func (response GetKey200JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+func (response CreateVerificationSession201JSONResponse) VisitCreateVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKey400JSONResponse struct{ N400JSONResponse }
+type CreateVerificationSession400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKey400JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+func (response CreateVerificationSession400JSONResponse) VisitCreateVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKey401JSONResponse struct{ N401JSONResponse }
+type CreateVerificationSession401JSONResponse struct{ N401JSONResponse }
 
-func (response GetKey401JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+func (response CreateVerificationSession401JSONResponse) VisitCreateVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKey404JSONResponse struct{ N404JSONResponse }
+type CreateVerificationSession404JSONResponse struct{ N404JSONResponse }
 
-func (response GetKey404JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+func (response CreateVerificationSession404JSONResponse) VisitCreateVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKey500JSONResponse struct{ N500JSONResponse }
+type CreateVerificationSession500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKey500JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+func (response CreateVerificationSession500JSONResponse) VisitCreateVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKeyRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Id         PathKeyID       `json:"id"`
-	Body       *UpdateKeyJSONRequestBody
+type GetVerificationSessionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
 }
 
-type UpdateKeyResponseObject interface {
-	VisitUpdateKeyResponse(w http.ResponseWriter) error
+type GetVerificationSessionResponseObject interface {
+	VisitGetVerificationSessionResponse(w http.ResponseWriter) error
 }
 
-type UpdateKey200JSONResponse GenericMessage
+type GetVerificationSession200JSONResponse VerificationSession
 
-func (response UpdateKey200JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationSession200JSONResponse) VisitGetVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey400JSONResponse struct{ N400JSONResponse }
+type GetVerificationSession400JSONResponse struct{ N400JSONResponse }
 
-func (response UpdateKey400JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationSession400JSONResponse) VisitGetVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey401JSONResponse struct{ N401JSONResponse }
+type GetVerificationSession401JSONResponse struct{ N401JSONResponse }
 
-func (response UpdateKey401JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationSession401JSONResponse) VisitGetVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey404JSONResponse struct{ N404JSONResponse }
+type GetVerificationSession404JSONResponse struct{ N404JSONResponse }
 
-func (response UpdateKey404JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationSession404JSONResponse) VisitGetVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey500JSONResponse struct{ N500JSONResponse }
+type GetVerificationSession500JSONResponse struct{ N500JSONResponse }
 
-func (response UpdateKey500JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetVerificationSession500JSONResponse) VisitGetVerificationSessionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequestsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetPaymentRequestsParams
+type GetPaymentSettingsRequestObject struct {
 }
 
-type GetPaymentRequestsResponseObject interface {
-	VisitGetPaymentRequestsResponse(w http.ResponseWriter) error
+type GetPaymentSettingsResponseObject interface {
+	VisitGetPaymentSettingsResponse(w http.ResponseWriter) error
 }
 
-type GetPaymentRequests200JSONResponse GetPaymentRequestsResponse
+type GetPaymentSettings200JSONResponse PaymentsConfiguration
 
-func (response GetPaymentRequests200JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+func (response GetPaymentSettings200JSONResponse) VisitGetPaymentSettingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequests400JSONResponse struct{ N400JSONResponse }
+type GetQrFromStoreRequestObject struct {
+	Params GetQrFromStoreParams
+}
 
-func (response GetPaymentRequests400JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+type GetQrFromStoreResponseObject interface {
+	VisitGetQrFromStoreResponse(w http.ResponseWriter) error
+}
+
+type GetQrFromStore200JSONResponse map[string]interface{}
+
+func (response GetQrFromStore200JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrFromStore400JSONResponse struct{ N400JSONResponse }
+
+func (response GetQrFromStore400JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequests401JSONResponse struct{ N401JSONResponse }
+type GetQrFromStore404JSONResponse struct{ N404JSONResponse }
 
-func (response GetPaymentRequests401JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+func (response GetQrFromStore404JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequests500JSONResponse struct{ N500JSONResponse }
+type GetQrFromStore410JSONResponse struct{ N410JSONResponse }
+
+func (response GetQrFromStore410JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQrFromStore500JSONResponse struct{ N500JSONResponse }
 
-func (response GetPaymentRequests500JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+func (response GetQrFromStore500JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentRequestRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreatePaymentRequestJSONRequestBody
+type GetSupportedNetworksRequestObject struct {
 }
 
-type CreatePaymentRequestResponseObject interface {
-	VisitCreatePaymentRequestResponse(w http.ResponseWriter) error
+type GetSupportedNetworksResponseObject interface {
+	VisitGetSupportedNetworksResponse(w http.ResponseWriter) error
 }
 
-type CreatePaymentRequest201JSONResponse CreatePaymentRequestResponse
+type GetSupportedNetworks200JSONResponse []SupportedNetworks
 
-func (response CreatePaymentRequest201JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetSupportedNetworks200JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentRequest400JSONResponse struct{ N400JSONResponse }
+type GetSupportedNetworks400JSONResponse struct{ N400JSONResponse }
 
-func (response CreatePaymentRequest400JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetSupportedNetworks400JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentRequest401JSONResponse struct{ N401JSONResponse }
+type GetSupportedNetworks401JSONResponse struct{ N401JSONResponse }
 
-func (response CreatePaymentRequest401JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetSupportedNetworks401JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentRequest500JSONResponse struct{ N500JSONResponse }
+type GetSupportedNetworks404JSONResponse struct{ N404JSONResponse }
 
-func (response CreatePaymentRequest500JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetSupportedNetworks404JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSupportedNetworks500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSupportedNetworks500JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePaymentRequestRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+type VerificationCallbackRequestObject struct {
+	Params VerificationCallbackParams
+	Body   *VerificationCallbackTextRequestBody
 }
 
-type DeletePaymentRequestResponseObject interface {
-	VisitDeletePaymentRequestResponse(w http.ResponseWriter) error
+type VerificationCallbackResponseObject interface {
+	VisitVerificationCallbackResponse(w http.ResponseWriter) error
 }
 
-type DeletePaymentRequest200JSONResponse GenericMessage
+type VerificationCallback200Response struct {
+}
 
-func (response DeletePaymentRequest200JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response VerificationCallback200Response) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
+	return nil
+}
+
+type VerificationCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response VerificationCallback400JSONResponse) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePaymentRequest400JSONResponse struct{ N400JSONResponse }
+type VerificationCallback404JSONResponse struct{ N404JSONResponse }
 
-func (response DeletePaymentRequest400JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+func (response VerificationCallback404JSONResponse) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePaymentRequest500JSONResponse struct{ N500JSONResponse }
+type VerificationCallback409JSONResponse struct{ N409JSONResponse }
 
-func (response DeletePaymentRequest500JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+func (response VerificationCallback409JSONResponse) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerificationCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response VerificationCallback500JSONResponse) VisitVerificationCallbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequestRequestObject struct {
+type AuthenticationRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+	Params     AuthenticationParams
 }
 
-type GetPaymentRequestResponseObject interface {
-	VisitGetPaymentRequestResponse(w http.ResponseWriter) error
+type AuthenticationResponseObject interface {
+	VisitAuthenticationResponse(w http.ResponseWriter) error
 }
 
-type GetPaymentRequest200JSONResponse CreatePaymentRequestResponse
+type Authentication200JSONResponse AuthenticationResponse
 
-func (response GetPaymentRequest200JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+func (response Authentication200JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequest400JSONResponse struct{ N400JSONResponse }
+type Authentication400JSONResponse struct{ N400JSONResponse }
 
-func (response GetPaymentRequest400JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+func (response Authentication400JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequest404JSONResponse struct{ N404JSONResponse }
+type Authentication500JSONResponse struct{ N500JSONResponse }
 
-func (response GetPaymentRequest404JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+func (response Authentication500JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequest500JSONResponse struct{ N500JSONResponse }
-
-func (response GetPaymentRequest500JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Resolve DID
+	// (GET /1.0/identifiers/{identifier})
+	ResolveDID(ctx context.Context, request ResolveDIDRequestObject) (ResolveDIDResponseObject, error)
+	// Get did:web DID Document
+	// (GET /issuers/{id}/did.json)
+	GetWebDIDDocument(ctx context.Context, request GetWebDIDDocumentRequestObject) (GetWebDIDDocumentResponseObject, error)
+	// Healthcheck
+	// (GET /status)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+	// Agent V1
+	// (POST /v1/agent)
+	AgentV1(ctx context.Context, request AgentV1RequestObject) (AgentV1ResponseObject, error)
+	// Get Revocation Status V1
+	// (GET /v1/{identifier}/claims/revocation/status/{nonce})
+	GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error)
+	// Agent
+	// (POST /v2/agent)
+	Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error)
+	// Get API Keys
+	// (GET /v2/api-keys)
+	GetAPIKeys(ctx context.Context, request GetAPIKeysRequestObject) (GetAPIKeysResponseObject, error)
+	// Create API Key
+	// (POST /v2/api-keys)
+	CreateAPIKey(ctx context.Context, request CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error)
+	// Delete API Key
+	// (DELETE /v2/api-keys/{id})
+	DeleteAPIKey(ctx context.Context, request DeleteAPIKeyRequestObject) (DeleteAPIKeyResponseObject, error)
+	// Get API Key
+	// (GET /v2/api-keys/{id})
+	GetAPIKey(ctx context.Context, request GetAPIKeyRequestObject) (GetAPIKeyResponseObject, error)
+	// Update API Key
+	// (PATCH /v2/api-keys/{id})
+	UpdateAPIKey(ctx context.Context, request UpdateAPIKeyRequestObject) (UpdateAPIKeyResponseObject, error)
+	// Authentication Callback
+	// (POST /v2/authentication/callback)
+	AuthCallback(ctx context.Context, request AuthCallbackRequestObject) (AuthCallbackResponseObject, error)
+	// Get Authentication Connection
+	// (GET /v2/authentication/sessions/{id})
+	GetAuthenticationConnection(ctx context.Context, request GetAuthenticationConnectionRequestObject) (GetAuthenticationConnectionResponseObject, error)
+	// Verify Credential
+	// (POST /v2/credentials/verify)
+	VerifyCredential(ctx context.Context, request VerifyCredentialRequestObject) (VerifyCredentialResponseObject, error)
+	// Get Identities
+	// (GET /v2/identities)
+	GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error)
+	// Create Identity
+	// (POST /v2/identities)
+	CreateIdentity(ctx context.Context, request CreateIdentityRequestObject) (CreateIdentityResponseObject, error)
+	// Get Identity Detail
+	// (GET /v2/identities/{identifier})
+	GetIdentityDetails(ctx context.Context, request GetIdentityDetailsRequestObject) (GetIdentityDetailsResponseObject, error)
+	// Update Identity
+	// (PATCH /v2/identities/{identifier})
+	UpdateIdentity(ctx context.Context, request UpdateIdentityRequestObject) (UpdateIdentityResponseObject, error)
+	// Get Connections
+	// (GET /v2/identities/{identifier}/connections)
+	GetConnections(ctx context.Context, request GetConnectionsRequestObject) (GetConnectionsResponseObject, error)
+	// Create Connection
+	// (POST /v2/identities/{identifier}/connections)
+	CreateConnection(ctx context.Context, request CreateConnectionRequestObject) (CreateConnectionResponseObject, error)
+	// Delete Connection
+	// (DELETE /v2/identities/{identifier}/connections/{id})
+	DeleteConnection(ctx context.Context, request DeleteConnectionRequestObject) (DeleteConnectionResponseObject, error)
+	// Get Connection
+	// (GET /v2/identities/{identifier}/connections/{id})
+	GetConnection(ctx context.Context, request GetConnectionRequestObject) (GetConnectionResponseObject, error)
+	// Delete Connection Credentials
+	// (DELETE /v2/identities/{identifier}/connections/{id}/credentials)
+	DeleteConnectionCredentials(ctx context.Context, request DeleteConnectionCredentialsRequestObject) (DeleteConnectionCredentialsResponseObject, error)
+	// Revoke Connection Credentials
+	// (POST /v2/identities/{identifier}/connections/{id}/credentials/revoke)
+	RevokeConnectionCredentials(ctx context.Context, request RevokeConnectionCredentialsRequestObject) (RevokeConnectionCredentialsResponseObject, error)
+	// Create Auth Credential
+	// (POST /v2/identities/{identifier}/create-auth-credential)
+	CreateAuthCredential(ctx context.Context, request CreateAuthCredentialRequestObject) (CreateAuthCredentialResponseObject, error)
+	// Get Credential Expiration Policies
+	// (GET /v2/identities/{identifier}/credential-expiration-policies)
+	GetCredentialExpirationPolicies(ctx context.Context, request GetCredentialExpirationPoliciesRequestObject) (GetCredentialExpirationPoliciesResponseObject, error)
+	// Create Credential Expiration Policy
+	// (POST /v2/identities/{identifier}/credential-expiration-policies)
+	CreateCredentialExpirationPolicy(ctx context.Context, request CreateCredentialExpirationPolicyRequestObject) (CreateCredentialExpirationPolicyResponseObject, error)
+	// Delete Credential Expiration Policy
+	// (DELETE /v2/identities/{identifier}/credential-expiration-policies/{id})
+	DeleteCredentialExpirationPolicy(ctx context.Context, request DeleteCredentialExpirationPolicyRequestObject) (DeleteCredentialExpirationPolicyResponseObject, error)
+	// Update Credential Expiration Policy
+	// (PUT /v2/identities/{identifier}/credential-expiration-policies/{id})
+	UpdateCredentialExpirationPolicy(ctx context.Context, request UpdateCredentialExpirationPolicyRequestObject) (UpdateCredentialExpirationPolicyResponseObject, error)
+	// Get Credential Templates
+	// (GET /v2/identities/{identifier}/credential-templates)
+	GetCredentialTemplates(ctx context.Context, request GetCredentialTemplatesRequestObject) (GetCredentialTemplatesResponseObject, error)
+	// Create Credential Template
+	// (POST /v2/identities/{identifier}/credential-templates)
+	CreateCredentialTemplate(ctx context.Context, request CreateCredentialTemplateRequestObject) (CreateCredentialTemplateResponseObject, error)
+	// Delete Credential Template
+	// (DELETE /v2/identities/{identifier}/credential-templates/{id})
+	DeleteCredentialTemplate(ctx context.Context, request DeleteCredentialTemplateRequestObject) (DeleteCredentialTemplateResponseObject, error)
+	// Get Credential Template
+	// (GET /v2/identities/{identifier}/credential-templates/{id})
+	GetCredentialTemplate(ctx context.Context, request GetCredentialTemplateRequestObject) (GetCredentialTemplateResponseObject, error)
+	// Update Credential Template
+	// (PUT /v2/identities/{identifier}/credential-templates/{id})
+	UpdateCredentialTemplate(ctx context.Context, request UpdateCredentialTemplateRequestObject) (UpdateCredentialTemplateResponseObject, error)
+	// Create Credential From Template
+	// (POST /v2/identities/{identifier}/credential-templates/{id}/credentials)
+	CreateCredentialFromTemplate(ctx context.Context, request CreateCredentialFromTemplateRequestObject) (CreateCredentialFromTemplateResponseObject, error)
+	// Create Link From Template
+	// (POST /v2/identities/{identifier}/credential-templates/{id}/links)
+	CreateLinkFromTemplate(ctx context.Context, request CreateLinkFromTemplateRequestObject) (CreateLinkFromTemplateResponseObject, error)
+	// Get Credentials
+	// (GET /v2/identities/{identifier}/credentials)
+	GetCredentials(ctx context.Context, request GetCredentialsRequestObject) (GetCredentialsResponseObject, error)
+	// Create Credential
+	// (POST /v2/identities/{identifier}/credentials)
+	CreateCredential(ctx context.Context, request CreateCredentialRequestObject) (CreateCredentialResponseObject, error)
+	// Create Bulk Issuance Job
+	// (POST /v2/identities/{identifier}/credentials/bulk)
+	CreateBulkIssuanceJob(ctx context.Context, request CreateBulkIssuanceJobRequestObject) (CreateBulkIssuanceJobResponseObject, error)
+	// Get Bulk Issuance Job
+	// (GET /v2/identities/{identifier}/credentials/bulk/{id})
+	GetBulkIssuanceJob(ctx context.Context, request GetBulkIssuanceJobRequestObject) (GetBulkIssuanceJobResponseObject, error)
+	// Get Bulk Issuance Job Rows
+	// (GET /v2/identities/{identifier}/credentials/bulk/{id}/rows)
+	GetBulkIssuanceJobRows(ctx context.Context, request GetBulkIssuanceJobRowsRequestObject) (GetBulkIssuanceJobRowsResponseObject, error)
+	// Get Links
+	// (GET /v2/identities/{identifier}/credentials/links)
+	GetLinks(ctx context.Context, request GetLinksRequestObject) (GetLinksResponseObject, error)
+	// Create Link
+	// (POST /v2/identities/{identifier}/credentials/links)
+	CreateLink(ctx context.Context, request CreateLinkRequestObject) (CreateLinkResponseObject, error)
+	// Create Link QR Code Callback
+	// (POST /v2/identities/{identifier}/credentials/links/callback)
+	CreateLinkQrCodeCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject) (CreateLinkQrCodeCallbackResponseObject, error)
+	// Delete Link
+	// (DELETE /v2/identities/{identifier}/credentials/links/{id})
+	DeleteLink(ctx context.Context, request DeleteLinkRequestObject) (DeleteLinkResponseObject, error)
+	// Get Link
+	// (GET /v2/identities/{identifier}/credentials/links/{id})
+	GetLink(ctx context.Context, request GetLinkRequestObject) (GetLinkResponseObject, error)
+	// Activate | Deactivate Link
+	// (PATCH /v2/identities/{identifier}/credentials/links/{id})
+	ActivateLink(ctx context.Context, request ActivateLinkRequestObject) (ActivateLinkResponseObject, error)
+	// Create a credential offer for a link
+	// (POST /v2/identities/{identifier}/credentials/links/{id}/offer)
+	CreateLinkOffer(ctx context.Context, request CreateLinkOfferRequestObject) (CreateLinkOfferResponseObject, error)
+	// Resume Credential
+	// (POST /v2/identities/{identifier}/credentials/resume/{nonce})
+	ResumeCredential(ctx context.Context, request ResumeCredentialRequestObject) (ResumeCredentialResponseObject, error)
+	// Get Revocation Status
+	// (GET /v2/identities/{identifier}/credentials/revocation/status/{nonce})
+	GetRevocationStatusV2(ctx context.Context, request GetRevocationStatusV2RequestObject) (GetRevocationStatusV2ResponseObject, error)
+	// Revoke Credential
+	// (POST /v2/identities/{identifier}/credentials/revoke/{nonce})
+	RevokeCredential(ctx context.Context, request RevokeCredentialRequestObject) (RevokeCredentialResponseObject, error)
+	// Suspend Credential
+	// (POST /v2/identities/{identifier}/credentials/suspend/{nonce})
+	SuspendCredential(ctx context.Context, request SuspendCredentialRequestObject) (SuspendCredentialResponseObject, error)
+	// Delete Credential
+	// (DELETE /v2/identities/{identifier}/credentials/{id})
+	DeleteCredential(ctx context.Context, request DeleteCredentialRequestObject) (DeleteCredentialResponseObject, error)
+	// Get Credential
+	// (GET /v2/identities/{identifier}/credentials/{id})
+	GetCredential(ctx context.Context, request GetCredentialRequestObject) (GetCredentialResponseObject, error)
+	// Reissue Credential
+	// (PATCH /v2/identities/{identifier}/credentials/{id})
+	ReissueCredential(ctx context.Context, request ReissueCredentialRequestObject) (ReissueCredentialResponseObject, error)
+	// Get Credentials Offer
+	// (GET /v2/identities/{identifier}/credentials/{id}/offer)
+	GetCredentialOffer(ctx context.Context, request GetCredentialOfferRequestObject) (GetCredentialOfferResponseObject, error)
+	// Get DID Document
+	// (GET /v2/identities/{identifier}/did.json)
+	GetDIDDocument(ctx context.Context, request GetDIDDocumentRequestObject) (GetDIDDocumentResponseObject, error)
+	// Get All Display Methods
+	// (GET /v2/identities/{identifier}/display-method)
+	GetAllDisplayMethods(ctx context.Context, request GetAllDisplayMethodsRequestObject) (GetAllDisplayMethodsResponseObject, error)
+	// Create Display Method
+	// (POST /v2/identities/{identifier}/display-method)
+	CreateDisplayMethod(ctx context.Context, request CreateDisplayMethodRequestObject) (CreateDisplayMethodResponseObject, error)
+	// Delete Display Method
+	// (DELETE /v2/identities/{identifier}/display-method/{id})
+	DeleteDisplayMethod(ctx context.Context, request DeleteDisplayMethodRequestObject) (DeleteDisplayMethodResponseObject, error)
+	// Get Display Method
+	// (GET /v2/identities/{identifier}/display-method/{id})
+	GetDisplayMethod(ctx context.Context, request GetDisplayMethodRequestObject) (GetDisplayMethodResponseObject, error)
+	// Update Display Method
+	// (PATCH /v2/identities/{identifier}/display-method/{id})
+	UpdateDisplayMethod(ctx context.Context, request UpdateDisplayMethodRequestObject) (UpdateDisplayMethodResponseObject, error)
+	// Get Key Rotations
+	// (GET /v2/identities/{identifier}/key-rotations)
+	GetKeyRotations(ctx context.Context, request GetKeyRotationsRequestObject) (GetKeyRotationsResponseObject, error)
+	// Rotate Auth Key
+	// (POST /v2/identities/{identifier}/key-rotations)
+	CreateKeyRotation(ctx context.Context, request CreateKeyRotationRequestObject) (CreateKeyRotationResponseObject, error)
+	// Get Key Rotation
+	// (GET /v2/identities/{identifier}/key-rotations/{id})
+	GetKeyRotation(ctx context.Context, request GetKeyRotationRequestObject) (GetKeyRotationResponseObject, error)
+	// Get Credentials To Re-sign
+	// (GET /v2/identities/{identifier}/key-rotations/{id}/credentials)
+	GetKeyRotationCredentials(ctx context.Context, request GetKeyRotationCredentialsRequestObject) (GetKeyRotationCredentialsResponseObject, error)
+	// Resume Key Rotation
+	// (POST /v2/identities/{identifier}/key-rotations/{id}/resume)
+	ResumeKeyRotation(ctx context.Context, request ResumeKeyRotationRequestObject) (ResumeKeyRotationResponseObject, error)
+	// Get Keys
+	// (GET /v2/identities/{identifier}/keys)
+	GetKeys(ctx context.Context, request GetKeysRequestObject) (GetKeysResponseObject, error)
+	// Create a Key
+	// (POST /v2/identities/{identifier}/keys)
+	CreateKey(ctx context.Context, request CreateKeyRequestObject) (CreateKeyResponseObject, error)
+	// Delete Key
+	// (DELETE /v2/identities/{identifier}/keys/{id})
+	DeleteKey(ctx context.Context, request DeleteKeyRequestObject) (DeleteKeyResponseObject, error)
+	// Get a Key
+	// (GET /v2/identities/{identifier}/keys/{id})
+	GetKey(ctx context.Context, request GetKeyRequestObject) (GetKeyResponseObject, error)
+	// Update a Key
+	// (PATCH /v2/identities/{identifier}/keys/{id})
+	UpdateKey(ctx context.Context, request UpdateKeyRequestObject) (UpdateKeyResponseObject, error)
+	// Check Merkle Trees Integrity
+	// (POST /v2/identities/{identifier}/merkle-trees/integrity)
+	CheckMerkleTreesIntegrity(ctx context.Context, request CheckMerkleTreesIntegrityRequestObject) (CheckMerkleTreesIntegrityResponseObject, error)
+	// Get Payment Requests
+	// (GET /v2/identities/{identifier}/payment-request)
+	GetPaymentRequests(ctx context.Context, request GetPaymentRequestsRequestObject) (GetPaymentRequestsResponseObject, error)
+	// Create Payment Request
+	// (POST /v2/identities/{identifier}/payment-request)
+	CreatePaymentRequest(ctx context.Context, request CreatePaymentRequestRequestObject) (CreatePaymentRequestResponseObject, error)
+	// Delete Payment Request
+	// (DELETE /v2/identities/{identifier}/payment-request/{id})
+	DeletePaymentRequest(ctx context.Context, request DeletePaymentRequestRequestObject) (DeletePaymentRequestResponseObject, error)
+	// Get Payment Request
+	// (GET /v2/identities/{identifier}/payment-request/{id})
+	GetPaymentRequest(ctx context.Context, request GetPaymentRequestRequestObject) (GetPaymentRequestResponseObject, error)
+	// Get Payment Options
+	// (GET /v2/identities/{identifier}/payment/options)
+	GetPaymentOptions(ctx context.Context, request GetPaymentOptionsRequestObject) (GetPaymentOptionsResponseObject, error)
+	// Create Payment Option
+	// (POST /v2/identities/{identifier}/payment/options)
+	CreatePaymentOption(ctx context.Context, request CreatePaymentOptionRequestObject) (CreatePaymentOptionResponseObject, error)
+	// Delete Payment Option
+	// (DELETE /v2/identities/{identifier}/payment/options/{id})
+	DeletePaymentOption(ctx context.Context, request DeletePaymentOptionRequestObject) (DeletePaymentOptionResponseObject, error)
+	// Get Payment Option
+	// (GET /v2/identities/{identifier}/payment/options/{id})
+	GetPaymentOption(ctx context.Context, request GetPaymentOptionRequestObject) (GetPaymentOptionResponseObject, error)
+	// Update Payment Option
+	// (PATCH /v2/identities/{identifier}/payment/options/{id})
+	UpdatePaymentOption(ctx context.Context, request UpdatePaymentOptionRequestObject) (UpdatePaymentOptionResponseObject, error)
+	// Verify Payment
+	// (POST /v2/identities/{identifier}/payment/verify/{nonce})
+	VerifyPayment(ctx context.Context, request VerifyPaymentRequestObject) (VerifyPaymentResponseObject, error)
+	// Get Schemas
+	// (GET /v2/identities/{identifier}/schemas)
+	GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error)
+	// Import JSON schema
+	// (POST /v2/identities/{identifier}/schemas)
+	ImportSchema(ctx context.Context, request ImportSchemaRequestObject) (ImportSchemaResponseObject, error)
+	// Get Schema
+	// (GET /v2/identities/{identifier}/schemas/{id})
+	GetSchema(ctx context.Context, request GetSchemaRequestObject) (GetSchemaResponseObject, error)
+	// Update Schema
+	// (PATCH /v2/identities/{identifier}/schemas/{id})
+	UpdateSchema(ctx context.Context, request UpdateSchemaRequestObject) (UpdateSchemaResponseObject, error)
+	// Get Identity State Diff
+	// (GET /v2/identities/{identifier}/state/diff)
+	GetStateDiff(ctx context.Context, request GetStateDiffRequestObject) (GetStateDiffResponseObject, error)
+	// Dry Run Publish Identity State
+	// (POST /v2/identities/{identifier}/state/dry-run)
+	DryRunPublishState(ctx context.Context, request DryRunPublishStateRequestObject) (DryRunPublishStateResponseObject, error)
+	// Publish Identity State
+	// (POST /v2/identities/{identifier}/state/publish)
+	PublishIdentityState(ctx context.Context, request PublishIdentityStateRequestObject) (PublishIdentityStateResponseObject, error)
+	// Delete Publish Policy
+	// (DELETE /v2/identities/{identifier}/state/publish-policy)
+	DeletePublishPolicy(ctx context.Context, request DeletePublishPolicyRequestObject) (DeletePublishPolicyResponseObject, error)
+	// Get Publish Policy
+	// (GET /v2/identities/{identifier}/state/publish-policy)
+	GetPublishPolicy(ctx context.Context, request GetPublishPolicyRequestObject) (GetPublishPolicyResponseObject, error)
+	// Set Publish Policy
+	// (PUT /v2/identities/{identifier}/state/publish-policy)
+	UpdatePublishPolicy(ctx context.Context, request UpdatePublishPolicyRequestObject) (UpdatePublishPolicyResponseObject, error)
+	// Retry Publish Identity State
+	// (POST /v2/identities/{identifier}/state/retry)
+	RetryPublishState(ctx context.Context, request RetryPublishStateRequestObject) (RetryPublishStateResponseObject, error)
+	// Get Identity State Status
+	// (GET /v2/identities/{identifier}/state/status)
+	GetStateStatus(ctx context.Context, request GetStateStatusRequestObject) (GetStateStatusResponseObject, error)
+	// Get Identity State Transactions
+	// (GET /v2/identities/{identifier}/state/transactions)
+	GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error)
+	// Get Identity State Details
+	// (GET /v2/identities/{identifier}/states/{state})
+	GetStateDetails(ctx context.Context, request GetStateDetailsRequestObject) (GetStateDetailsResponseObject, error)
+	// Get Status List Credential
+	// (GET /v2/identities/{identifier}/status-lists/{id})
+	GetStatusListCredential(ctx context.Context, request GetStatusListCredentialRequestObject) (GetStatusListCredentialResponseObject, error)
+	// Get Verification Queries
+	// (GET /v2/identities/{identifier}/verification-queries)
+	GetVerificationQueries(ctx context.Context, request GetVerificationQueriesRequestObject) (GetVerificationQueriesResponseObject, error)
+	// Create Verification Query
+	// (POST /v2/identities/{identifier}/verification-queries)
+	CreateVerificationQuery(ctx context.Context, request CreateVerificationQueryRequestObject) (CreateVerificationQueryResponseObject, error)
+	// Delete Verification Query
+	// (DELETE /v2/identities/{identifier}/verification-queries/{id})
+	DeleteVerificationQuery(ctx context.Context, request DeleteVerificationQueryRequestObject) (DeleteVerificationQueryResponseObject, error)
+	// Get Verification Query
+	// (GET /v2/identities/{identifier}/verification-queries/{id})
+	GetVerificationQuery(ctx context.Context, request GetVerificationQueryRequestObject) (GetVerificationQueryResponseObject, error)
+	// Create Verification Session
+	// (POST /v2/identities/{identifier}/verification-queries/{id}/sessions)
+	CreateVerificationSession(ctx context.Context, request CreateVerificationSessionRequestObject) (CreateVerificationSessionResponseObject, error)
+	// Get Verification Session
+	// (GET /v2/identities/{identifier}/verification-sessions/{id})
+	GetVerificationSession(ctx context.Context, request GetVerificationSessionRequestObject) (GetVerificationSessionResponseObject, error)
+	// Payments Configuration
+	// (GET /v2/payment/settings)
+	GetPaymentSettings(ctx context.Context, request GetPaymentSettingsRequestObject) (GetPaymentSettingsResponseObject, error)
+	// Get QrCode from store
+	// (GET /v2/qr-store)
+	GetQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error)
+	// Get Supported Networks
+	// (GET /v2/supported-networks)
+	GetSupportedNetworks(ctx context.Context, request GetSupportedNetworksRequestObject) (GetSupportedNetworksResponseObject, error)
+	// Verification Callback
+	// (POST /v2/verification/callback)
+	VerificationCallback(ctx context.Context, request VerificationCallbackRequestObject) (VerificationCallbackResponseObject, error)
+	// Get Authentication Message
+	// (POST /v2/{identifier}/authentication)
+	Authentication(ctx context.Context, request AuthenticationRequestObject) (AuthenticationResponseObject, error)
 }
 
-type GetPaymentOptionsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-}
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-type GetPaymentOptionsResponseObject interface {
-	VisitGetPaymentOptionsResponse(w http.ResponseWriter) error
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type GetPaymentOptions200JSONResponse PaymentOptionsPaginated
-
-func (response GetPaymentOptions200JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-type GetPaymentOptions400JSONResponse struct{ N400JSONResponse }
-
-func (response GetPaymentOptions400JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type GetPaymentOptions401JSONResponse struct{ N401JSONResponse }
-
-func (response GetPaymentOptions401JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
 }
 
-type GetPaymentOptions500JSONResponse struct{ N500JSONResponse }
+// ResolveDID operation middleware
+func (sh *strictHandler) ResolveDID(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request ResolveDIDRequestObject
 
-func (response GetPaymentOptions500JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveDID(ctx, request.(ResolveDIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveDID")
+	}
 
-type CreatePaymentOptionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreatePaymentOptionJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreatePaymentOptionResponseObject interface {
-	VisitCreatePaymentOptionResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResolveDIDResponseObject); ok {
+		if err := validResponse.VisitResolveDIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreatePaymentOption201JSONResponse UUIDResponse
-
-func (response CreatePaymentOption201JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetWebDIDDocument operation middleware
+func (sh *strictHandler) GetWebDIDDocument(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetWebDIDDocumentRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type CreatePaymentOption400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebDIDDocument(ctx, request.(GetWebDIDDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebDIDDocument")
+	}
 
-func (response CreatePaymentOption400JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebDIDDocumentResponseObject); ok {
+		if err := validResponse.VisitGetWebDIDDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreatePaymentOption401JSONResponse struct{ N401JSONResponse }
-
-func (response CreatePaymentOption401JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// Health operation middleware
+func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
+	var request HealthRequestObject
 
-type CreatePaymentOption409JSONResponse struct{ N409JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Health")
+	}
 
-func (response CreatePaymentOption409JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HealthResponseObject); ok {
+		if err := validResponse.VisitHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreatePaymentOption500JSONResponse struct{ N500JSONResponse }
+// AgentV1 operation middleware
+func (sh *strictHandler) AgentV1(w http.ResponseWriter, r *http.Request) {
+	var request AgentV1RequestObject
 
-func (response CreatePaymentOption500JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AgentV1TextRequestBody(data)
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AgentV1(ctx, request.(AgentV1RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AgentV1")
+	}
 
-type DeletePaymentOptionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeletePaymentOptionResponseObject interface {
-	VisitDeletePaymentOptionResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AgentV1ResponseObject); ok {
+		if err := validResponse.VisitAgentV1Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeletePaymentOption200JSONResponse GenericMessage
-
-func (response DeletePaymentOption200JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetRevocationStatus operation middleware
+func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request GetRevocationStatusRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Nonce = nonce
 
-type DeletePaymentOption400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationStatus")
+	}
 
-func (response DeletePaymentOption400JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
+		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeletePaymentOption500JSONResponse struct{ N500JSONResponse }
-
-func (response DeletePaymentOption500JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// Agent operation middleware
+func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
+	var request AgentRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AgentTextRequestBody(data)
+	request.Body = &body
 
-type GetPaymentOptionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Agent")
+	}
 
-type GetPaymentOptionResponseObject interface {
-	VisitGetPaymentOptionResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AgentResponseObject); ok {
+		if err := validResponse.VisitAgentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPaymentOption200JSONResponse PaymentOption
+// GetAPIKeys operation middleware
+func (sh *strictHandler) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIKeysRequestObject
 
-func (response GetPaymentOption200JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIKeys(ctx, request.(GetAPIKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIKeys")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetPaymentOption400JSONResponse struct{ N400JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIKeysResponseObject); ok {
+		if err := validResponse.VisitGetAPIKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetPaymentOption400JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CreateAPIKey operation middleware
+func (sh *strictHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateAPIKeyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetPaymentOption404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAPIKey(ctx, request.(CreateAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAPIKey")
+	}
 
-func (response GetPaymentOption404JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAPIKeyResponseObject); ok {
+		if err := validResponse.VisitCreateAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPaymentOption500JSONResponse struct{ N500JSONResponse }
+// DeleteAPIKey operation middleware
+func (sh *strictHandler) DeleteAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	var request DeleteAPIKeyRequestObject
 
-func (response GetPaymentOption500JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAPIKey(ctx, request.(DeleteAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAPIKey")
+	}
 
-type UpdatePaymentOptionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-	Body       *UpdatePaymentOptionJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdatePaymentOptionResponseObject interface {
-	VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAPIKeyResponseObject); ok {
+		if err := validResponse.VisitDeleteAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdatePaymentOption200JSONResponse GenericMessage
-
-func (response UpdatePaymentOption200JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetAPIKey operation middleware
+func (sh *strictHandler) GetAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetAPIKeyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type UpdatePaymentOption400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIKey(ctx, request.(GetAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIKey")
+	}
 
-func (response UpdatePaymentOption400JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIKeyResponseObject); ok {
+		if err := validResponse.VisitGetAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdatePaymentOption404JSONResponse struct{ N404JSONResponse }
+// UpdateAPIKey operation middleware
+func (sh *strictHandler) UpdateAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateAPIKeyRequestObject
 
-func (response UpdatePaymentOption404JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdatePaymentOption500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAPIKey(ctx, request.(UpdateAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAPIKey")
+	}
 
-func (response UpdatePaymentOption500JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAPIKeyResponseObject); ok {
+		if err := validResponse.VisitUpdateAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type VerifyPaymentRequestObject struct {
-	Identifier string `json:"identifier"`
-	Nonce      string `json:"nonce"`
-	Body       *VerifyPaymentJSONRequestBody
-}
+// AuthCallback operation middleware
+func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
+	var request AuthCallbackRequestObject
 
-type VerifyPaymentResponseObject interface {
-	VisitVerifyPaymentResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type VerifyPayment200JSONResponse PaymentStatus
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AuthCallbackTextRequestBody(data)
+	request.Body = &body
 
-func (response VerifyPayment200JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthCallback")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type VerifyPayment400JSONResponse struct{ N400JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
+		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response VerifyPayment400JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetAuthenticationConnection operation middleware
+func (sh *strictHandler) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetAuthenticationConnectionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type VerifyPayment401JSONResponse struct{ N401JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthenticationConnection(ctx, request.(GetAuthenticationConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthenticationConnection")
+	}
 
-func (response VerifyPayment401JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthenticationConnectionResponseObject); ok {
+		if err := validResponse.VisitGetAuthenticationConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type VerifyPayment500JSONResponse struct{ N500JSONResponse }
+// VerifyCredential operation middleware
+func (sh *strictHandler) VerifyCredential(w http.ResponseWriter, r *http.Request) {
+	var request VerifyCredentialRequestObject
 
-func (response VerifyPayment500JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body VerifyCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyCredential(ctx, request.(VerifyCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyCredential")
+	}
 
-type GetSchemasRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetSchemasParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSchemasResponseObject interface {
-	VisitGetSchemasResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyCredentialResponseObject); ok {
+		if err := validResponse.VisitVerifyCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchemas200JSONResponse []Schema
+// GetIdentities operation middleware
+func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
+	var request GetIdentitiesRequestObject
 
-func (response GetSchemas200JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIdentities(ctx, request.(GetIdentitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIdentities")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIdentitiesResponseObject); ok {
+		if err := validResponse.VisitGetIdentitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchemas400JSONResponse struct{ N400JSONResponse }
+// CreateIdentity operation middleware
+func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request, params CreateIdentityParams) {
+	var request CreateIdentityRequestObject
 
-func (response GetSchemas400JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateIdentityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSchemas500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateIdentity(ctx, request.(CreateIdentityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateIdentity")
+	}
 
-func (response GetSchemas500JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateIdentityResponseObject); ok {
+		if err := validResponse.VisitCreateIdentityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ImportSchemaRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *ImportSchemaJSONRequestBody
-}
+// GetIdentityDetails operation middleware
+func (sh *strictHandler) GetIdentityDetails(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetIdentityDetailsRequestObject
 
-type ImportSchemaResponseObject interface {
-	VisitImportSchemaResponse(w http.ResponseWriter) error
-}
+	request.Identifier = identifier
 
-type ImportSchema201JSONResponse UUIDResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIdentityDetails(ctx, request.(GetIdentityDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIdentityDetails")
+	}
 
-func (response ImportSchema201JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIdentityDetailsResponseObject); ok {
+		if err := validResponse.VisitGetIdentityDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ImportSchema400JSONResponse struct{ N400JSONResponse }
-
-func (response ImportSchema400JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// UpdateIdentity operation middleware
+func (sh *strictHandler) UpdateIdentity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request UpdateIdentityRequestObject
 
-type ImportSchema500JSONResponse struct{ N500JSONResponse }
+	request.Identifier = identifier
 
-func (response ImportSchema500JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body UpdateIdentityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateIdentity(ctx, request.(UpdateIdentityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateIdentity")
+	}
 
-type GetSchemaRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSchemaResponseObject interface {
-	VisitGetSchemaResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateIdentityResponseObject); ok {
+		if err := validResponse.VisitUpdateIdentityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchema200JSONResponse Schema
-
-func (response GetSchema200JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetConnections operation middleware
+func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetConnectionsParams) {
+	var request GetConnectionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Params = params
 
-type GetSchema400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnections(ctx, request.(GetConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnections")
+	}
 
-func (response GetSchema400JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionsResponseObject); ok {
+		if err := validResponse.VisitGetConnectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchema404JSONResponse struct{ N404JSONResponse }
+// CreateConnection operation middleware
+func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateConnectionRequestObject
 
-func (response GetSchema404JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateConnectionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSchema500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateConnection(ctx, request.(CreateConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateConnection")
+	}
 
-func (response GetSchema500JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateConnectionResponseObject); ok {
+		if err := validResponse.VisitCreateConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSchemaRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-	Body       *UpdateSchemaJSONRequestBody
-}
+// DeleteConnection operation middleware
+func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params DeleteConnectionParams) {
+	var request DeleteConnectionRequestObject
 
-type UpdateSchemaResponseObject interface {
-	VisitUpdateSchemaResponse(w http.ResponseWriter) error
-}
+	request.Identifier = identifier
+	request.Id = id
+	request.Params = params
 
-type UpdateSchema200JSONResponse GenericMessage
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConnection")
+	}
 
-func (response UpdateSchema200JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
+		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSchema400JSONResponse struct{ N400JSONResponse }
-
-func (response UpdateSchema400JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetConnection operation middleware
+func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetConnectionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Id = id
 
-type UpdateSchema404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnection")
+	}
 
-func (response UpdateSchema404JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
+		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSchema500JSONResponse struct{ N500JSONResponse }
+// DeleteConnectionCredentials operation middleware
+func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeleteConnectionCredentialsRequestObject
 
-func (response UpdateSchema500JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConnectionCredentials(ctx, request.(DeleteConnectionCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConnectionCredentials")
+	}
 
-type PublishIdentityStateRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PublishIdentityStateResponseObject interface {
-	VisitPublishIdentityStateResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConnectionCredentialsResponseObject); ok {
+		if err := validResponse.VisitDeleteConnectionCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState200JSONResponse GenericMessage
-
-func (response PublishIdentityState200JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// RevokeConnectionCredentials operation middleware
+func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request RevokeConnectionCredentialsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Id = id
 
-type PublishIdentityState202JSONResponse PublishIdentityStateResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeConnectionCredentials(ctx, request.(RevokeConnectionCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeConnectionCredentials")
+	}
 
-func (response PublishIdentityState202JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeConnectionCredentialsResponseObject); ok {
+		if err := validResponse.VisitRevokeConnectionCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState400JSONResponse struct{ N400JSONResponse }
+// CreateAuthCredential operation middleware
+func (sh *strictHandler) CreateAuthCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+	var request CreateAuthCredentialRequestObject
 
-func (response PublishIdentityState400JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateAuthCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PublishIdentityState401JSONResponse struct{ N401JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAuthCredential(ctx, request.(CreateAuthCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAuthCredential")
+	}
 
-func (response PublishIdentityState401JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAuthCredentialResponseObject); ok {
+		if err := validResponse.VisitCreateAuthCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState500JSONResponse struct{ N500JSONResponse }
+// GetCredentialExpirationPolicies operation middleware
+func (sh *strictHandler) GetCredentialExpirationPolicies(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetCredentialExpirationPoliciesRequestObject
 
-func (response PublishIdentityState500JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialExpirationPolicies(ctx, request.(GetCredentialExpirationPoliciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialExpirationPolicies")
+	}
 
-type RetryPublishStateRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RetryPublishStateResponseObject interface {
-	VisitRetryPublishStateResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialExpirationPoliciesResponseObject); ok {
+		if err := validResponse.VisitGetCredentialExpirationPoliciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RetryPublishState202JSONResponse PublishIdentityStateResponse
+// CreateCredentialExpirationPolicy operation middleware
+func (sh *strictHandler) CreateCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateCredentialExpirationPolicyRequestObject
 
-func (response RetryPublishState202JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateCredentialExpirationPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type RetryPublishState400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCredentialExpirationPolicy(ctx, request.(CreateCredentialExpirationPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCredentialExpirationPolicy")
+	}
 
-func (response RetryPublishState400JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCredentialExpirationPolicyResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialExpirationPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RetryPublishState500JSONResponse struct{ N500JSONResponse }
+// DeleteCredentialExpirationPolicy operation middleware
+func (sh *strictHandler) DeleteCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeleteCredentialExpirationPolicyRequestObject
 
-func (response RetryPublishState500JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCredentialExpirationPolicy(ctx, request.(DeleteCredentialExpirationPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCredentialExpirationPolicy")
+	}
 
-type GetStateStatusRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetStateStatusResponseObject interface {
-	VisitGetStateStatusResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCredentialExpirationPolicyResponseObject); ok {
+		if err := validResponse.VisitDeleteCredentialExpirationPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateStatus200JSONResponse StateStatusResponse
+// UpdateCredentialExpirationPolicy operation middleware
+func (sh *strictHandler) UpdateCredentialExpirationPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request UpdateCredentialExpirationPolicyRequestObject
 
-func (response GetStateStatus200JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateCredentialExpirationPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetStateStatus400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCredentialExpirationPolicy(ctx, request.(UpdateCredentialExpirationPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCredentialExpirationPolicy")
+	}
 
-func (response GetStateStatus400JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCredentialExpirationPolicyResponseObject); ok {
+		if err := validResponse.VisitUpdateCredentialExpirationPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateStatus500JSONResponse struct{ N500JSONResponse }
+// GetCredentialTemplates operation middleware
+func (sh *strictHandler) GetCredentialTemplates(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetCredentialTemplatesParams) {
+	var request GetCredentialTemplatesRequestObject
 
-func (response GetStateStatus500JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialTemplates(ctx, request.(GetCredentialTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialTemplates")
+	}
 
-type GetStateTransactionsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetStateTransactionsParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetStateTransactionsResponseObject interface {
-	VisitGetStateTransactionsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialTemplatesResponseObject); ok {
+		if err := validResponse.VisitGetCredentialTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateTransactions200JSONResponse StateTransactionsPaginated
+// CreateCredentialTemplate operation middleware
+func (sh *strictHandler) CreateCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateCredentialTemplateRequestObject
 
-func (response GetStateTransactions200JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateCredentialTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetStateTransactions400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCredentialTemplate(ctx, request.(CreateCredentialTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCredentialTemplate")
+	}
 
-func (response GetStateTransactions400JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCredentialTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateTransactions500JSONResponse struct{ N500JSONResponse }
+// DeleteCredentialTemplate operation middleware
+func (sh *strictHandler) DeleteCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeleteCredentialTemplateRequestObject
 
-func (response GetStateTransactions500JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCredentialTemplate(ctx, request.(DeleteCredentialTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCredentialTemplate")
+	}
 
-type GetPaymentSettingsRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetPaymentSettingsResponseObject interface {
-	VisitGetPaymentSettingsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCredentialTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteCredentialTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPaymentSettings200JSONResponse PaymentsConfiguration
+// GetCredentialTemplate operation middleware
+func (sh *strictHandler) GetCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetCredentialTemplateRequestObject
 
-func (response GetPaymentSettings200JSONResponse) VisitGetPaymentSettingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentialTemplate(ctx, request.(GetCredentialTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentialTemplate")
+	}
 
-type GetQrFromStoreRequestObject struct {
-	Params GetQrFromStoreParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetQrFromStoreResponseObject interface {
-	VisitGetQrFromStoreResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialTemplateResponseObject); ok {
+		if err := validResponse.VisitGetCredentialTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetQrFromStore200JSONResponse map[string]interface{}
+// UpdateCredentialTemplate operation middleware
+func (sh *strictHandler) UpdateCredentialTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request UpdateCredentialTemplateRequestObject
 
-func (response GetQrFromStore200JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateCredentialTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetQrFromStore400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCredentialTemplate(ctx, request.(UpdateCredentialTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCredentialTemplate")
+	}
 
-func (response GetQrFromStore400JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCredentialTemplateResponseObject); ok {
+		if err := validResponse.VisitUpdateCredentialTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetQrFromStore404JSONResponse struct{ N404JSONResponse }
+// CreateCredentialFromTemplate operation middleware
+func (sh *strictHandler) CreateCredentialFromTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params CreateCredentialFromTemplateParams) {
+	var request CreateCredentialFromTemplateRequestObject
 
-func (response GetQrFromStore404JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Identifier = identifier
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateCredentialFromTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetQrFromStore410JSONResponse struct{ N410JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCredentialFromTemplate(ctx, request.(CreateCredentialFromTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCredentialFromTemplate")
+	}
 
-func (response GetQrFromStore410JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(410)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCredentialFromTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialFromTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetQrFromStore500JSONResponse struct{ N500JSONResponse }
+// CreateLinkFromTemplate operation middleware
+func (sh *strictHandler) CreateLinkFromTemplate(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params CreateLinkFromTemplateParams) {
+	var request CreateLinkFromTemplateRequestObject
 
-func (response GetQrFromStore500JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateLinkFromTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSupportedNetworksRequestObject struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkFromTemplate(ctx, request.(CreateLinkFromTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkFromTemplate")
+	}
 
-type GetSupportedNetworksResponseObject interface {
-	VisitGetSupportedNetworksResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSupportedNetworks200JSONResponse []SupportedNetworks
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkFromTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateLinkFromTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetSupportedNetworks200JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetCredentials operation middleware
+func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetCredentialsParams) {
+	var request GetCredentialsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Params = params
 
-type GetSupportedNetworks400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentials(ctx, request.(GetCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentials")
+	}
 
-func (response GetSupportedNetworks400JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialsResponseObject); ok {
+		if err := validResponse.VisitGetCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSupportedNetworks401JSONResponse struct{ N401JSONResponse }
+// CreateCredential operation middleware
+func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateCredentialParams) {
+	var request CreateCredentialRequestObject
 
-func (response GetSupportedNetworks401JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Identifier = identifier
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSupportedNetworks404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCredential(ctx, request.(CreateCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCredential")
+	}
 
-func (response GetSupportedNetworks404JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCredentialResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSupportedNetworks500JSONResponse struct{ N500JSONResponse }
+// CreateBulkIssuanceJob operation middleware
+func (sh *strictHandler) CreateBulkIssuanceJob(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateBulkIssuanceJobRequestObject
 
-func (response GetSupportedNetworks500JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
 
-type AuthenticationRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     AuthenticationParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateBulkIssuanceJob(ctx, request.(CreateBulkIssuanceJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateBulkIssuanceJob")
+	}
 
-type AuthenticationResponseObject interface {
-	VisitAuthenticationResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateBulkIssuanceJobResponseObject); ok {
+		if err := validResponse.VisitCreateBulkIssuanceJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Authentication200JSONResponse AuthenticationResponse
-
-func (response Authentication200JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetBulkIssuanceJob operation middleware
+func (sh *strictHandler) GetBulkIssuanceJob(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetBulkIssuanceJobRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Id = id
 
-type Authentication400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBulkIssuanceJob(ctx, request.(GetBulkIssuanceJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBulkIssuanceJob")
+	}
 
-func (response Authentication400JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBulkIssuanceJobResponseObject); ok {
+		if err := validResponse.VisitGetBulkIssuanceJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Authentication500JSONResponse struct{ N500JSONResponse }
+// GetBulkIssuanceJobRows operation middleware
+func (sh *strictHandler) GetBulkIssuanceJobRows(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params GetBulkIssuanceJobRowsParams) {
+	var request GetBulkIssuanceJobRowsRequestObject
 
-func (response Authentication500JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBulkIssuanceJobRows(ctx, request.(GetBulkIssuanceJobRowsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBulkIssuanceJobRows")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Healthcheck
-	// (GET /status)
-	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
-	// Agent V1
-	// (POST /v1/agent)
-	AgentV1(ctx context.Context, request AgentV1RequestObject) (AgentV1ResponseObject, error)
-	// Get Revocation Status V1
-	// (GET /v1/{identifier}/claims/revocation/status/{nonce})
-	GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error)
-	// Agent
-	// (POST /v2/agent)
-	Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error)
-	// Authentication Callback
-	// (POST /v2/authentication/callback)
-	AuthCallback(ctx context.Context, request AuthCallbackRequestObject) (AuthCallbackResponseObject, error)
-	// Get Authentication Connection
-	// (GET /v2/authentication/sessions/{id})
-	GetAuthenticationConnection(ctx context.Context, request GetAuthenticationConnectionRequestObject) (GetAuthenticationConnectionResponseObject, error)
-	// Get Identities
-	// (GET /v2/identities)
-	GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error)
-	// Create Identity
-	// (POST /v2/identities)
-	CreateIdentity(ctx context.Context, request CreateIdentityRequestObject) (CreateIdentityResponseObject, error)
-	// Get Identity Detail
-	// (GET /v2/identities/{identifier})
-	GetIdentityDetails(ctx context.Context, request GetIdentityDetailsRequestObject) (GetIdentityDetailsResponseObject, error)
-	// Update Identity
-	// (PATCH /v2/identities/{identifier})
-	UpdateIdentity(ctx context.Context, request UpdateIdentityRequestObject) (UpdateIdentityResponseObject, error)
-	// Get Connections
-	// (GET /v2/identities/{identifier}/connections)
-	GetConnections(ctx context.Context, request GetConnectionsRequestObject) (GetConnectionsResponseObject, error)
-	// Create Connection
-	// (POST /v2/identities/{identifier}/connections)
-	CreateConnection(ctx context.Context, request CreateConnectionRequestObject) (CreateConnectionResponseObject, error)
-	// Delete Connection
-	// (DELETE /v2/identities/{identifier}/connections/{id})
-	DeleteConnection(ctx context.Context, request DeleteConnectionRequestObject) (DeleteConnectionResponseObject, error)
-	// Get Connection
-	// (GET /v2/identities/{identifier}/connections/{id})
-	GetConnection(ctx context.Context, request GetConnectionRequestObject) (GetConnectionResponseObject, error)
-	// Delete Connection Credentials
-	// (DELETE /v2/identities/{identifier}/connections/{id}/credentials)
-	DeleteConnectionCredentials(ctx context.Context, request DeleteConnectionCredentialsRequestObject) (DeleteConnectionCredentialsResponseObject, error)
-	// Revoke Connection Credentials
-	// (POST /v2/identities/{identifier}/connections/{id}/credentials/revoke)
-	RevokeConnectionCredentials(ctx context.Context, request RevokeConnectionCredentialsRequestObject) (RevokeConnectionCredentialsResponseObject, error)
-	// Create Auth Credential
-	// (POST /v2/identities/{identifier}/create-auth-credential)
-	CreateAuthCredential(ctx context.Context, request CreateAuthCredentialRequestObject) (CreateAuthCredentialResponseObject, error)
-	// Get Credentials
-	// (GET /v2/identities/{identifier}/credentials)
-	GetCredentials(ctx context.Context, request GetCredentialsRequestObject) (GetCredentialsResponseObject, error)
-	// Create Credential
-	// (POST /v2/identities/{identifier}/credentials)
-	CreateCredential(ctx context.Context, request CreateCredentialRequestObject) (CreateCredentialResponseObject, error)
-	// Get Links
-	// (GET /v2/identities/{identifier}/credentials/links)
-	GetLinks(ctx context.Context, request GetLinksRequestObject) (GetLinksResponseObject, error)
-	// Create Link
-	// (POST /v2/identities/{identifier}/credentials/links)
-	CreateLink(ctx context.Context, request CreateLinkRequestObject) (CreateLinkResponseObject, error)
-	// Create Link QR Code Callback
-	// (POST /v2/identities/{identifier}/credentials/links/callback)
-	CreateLinkQrCodeCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject) (CreateLinkQrCodeCallbackResponseObject, error)
-	// Delete Link
-	// (DELETE /v2/identities/{identifier}/credentials/links/{id})
-	DeleteLink(ctx context.Context, request DeleteLinkRequestObject) (DeleteLinkResponseObject, error)
-	// Get Link
-	// (GET /v2/identities/{identifier}/credentials/links/{id})
-	GetLink(ctx context.Context, request GetLinkRequestObject) (GetLinkResponseObject, error)
-	// Activate | Deactivate Link
-	// (PATCH /v2/identities/{identifier}/credentials/links/{id})
-	ActivateLink(ctx context.Context, request ActivateLinkRequestObject) (ActivateLinkResponseObject, error)
-	// Create a credential offer for a link
-	// (POST /v2/identities/{identifier}/credentials/links/{id}/offer)
-	CreateLinkOffer(ctx context.Context, request CreateLinkOfferRequestObject) (CreateLinkOfferResponseObject, error)
-	// Get Revocation Status
-	// (GET /v2/identities/{identifier}/credentials/revocation/status/{nonce})
-	GetRevocationStatusV2(ctx context.Context, request GetRevocationStatusV2RequestObject) (GetRevocationStatusV2ResponseObject, error)
-	// Revoke Credential
-	// (POST /v2/identities/{identifier}/credentials/revoke/{nonce})
-	RevokeCredential(ctx context.Context, request RevokeCredentialRequestObject) (RevokeCredentialResponseObject, error)
-	// Delete Credential
-	// (DELETE /v2/identities/{identifier}/credentials/{id})
-	DeleteCredential(ctx context.Context, request DeleteCredentialRequestObject) (DeleteCredentialResponseObject, error)
-	// Get Credential
-	// (GET /v2/identities/{identifier}/credentials/{id})
-	GetCredential(ctx context.Context, request GetCredentialRequestObject) (GetCredentialResponseObject, error)
-	// Get Credentials Offer
-	// (GET /v2/identities/{identifier}/credentials/{id}/offer)
-	GetCredentialOffer(ctx context.Context, request GetCredentialOfferRequestObject) (GetCredentialOfferResponseObject, error)
-	// Get All Display Methods
-	// (GET /v2/identities/{identifier}/display-method)
-	GetAllDisplayMethods(ctx context.Context, request GetAllDisplayMethodsRequestObject) (GetAllDisplayMethodsResponseObject, error)
-	// Create Display Method
-	// (POST /v2/identities/{identifier}/display-method)
-	CreateDisplayMethod(ctx context.Context, request CreateDisplayMethodRequestObject) (CreateDisplayMethodResponseObject, error)
-	// Delete Display Method
-	// (DELETE /v2/identities/{identifier}/display-method/{id})
-	DeleteDisplayMethod(ctx context.Context, request DeleteDisplayMethodRequestObject) (DeleteDisplayMethodResponseObject, error)
-	// Get Display Method
-	// (GET /v2/identities/{identifier}/display-method/{id})
-	GetDisplayMethod(ctx context.Context, request GetDisplayMethodRequestObject) (GetDisplayMethodResponseObject, error)
-	// Update Display Method
-	// (PATCH /v2/identities/{identifier}/display-method/{id})
-	UpdateDisplayMethod(ctx context.Context, request UpdateDisplayMethodRequestObject) (UpdateDisplayMethodResponseObject, error)
-	// Get Keys
-	// (GET /v2/identities/{identifier}/keys)
-	GetKeys(ctx context.Context, request GetKeysRequestObject) (GetKeysResponseObject, error)
-	// Create a Key
-	// (POST /v2/identities/{identifier}/keys)
-	CreateKey(ctx context.Context, request CreateKeyRequestObject) (CreateKeyResponseObject, error)
-	// Delete Key
-	// (DELETE /v2/identities/{identifier}/keys/{id})
-	DeleteKey(ctx context.Context, request DeleteKeyRequestObject) (DeleteKeyResponseObject, error)
-	// Get a Key
-	// (GET /v2/identities/{identifier}/keys/{id})
-	GetKey(ctx context.Context, request GetKeyRequestObject) (GetKeyResponseObject, error)
-	// Update a Key
-	// (PATCH /v2/identities/{identifier}/keys/{id})
-	UpdateKey(ctx context.Context, request UpdateKeyRequestObject) (UpdateKeyResponseObject, error)
-	// Get Payment Requests
-	// (GET /v2/identities/{identifier}/payment-request)
-	GetPaymentRequests(ctx context.Context, request GetPaymentRequestsRequestObject) (GetPaymentRequestsResponseObject, error)
-	// Create Payment Request
-	// (POST /v2/identities/{identifier}/payment-request)
-	CreatePaymentRequest(ctx context.Context, request CreatePaymentRequestRequestObject) (CreatePaymentRequestResponseObject, error)
-	// Delete Payment Request
-	// (DELETE /v2/identities/{identifier}/payment-request/{id})
-	DeletePaymentRequest(ctx context.Context, request DeletePaymentRequestRequestObject) (DeletePaymentRequestResponseObject, error)
-	// Get Payment Request
-	// (GET /v2/identities/{identifier}/payment-request/{id})
-	GetPaymentRequest(ctx context.Context, request GetPaymentRequestRequestObject) (GetPaymentRequestResponseObject, error)
-	// Get Payment Options
-	// (GET /v2/identities/{identifier}/payment/options)
-	GetPaymentOptions(ctx context.Context, request GetPaymentOptionsRequestObject) (GetPaymentOptionsResponseObject, error)
-	// Create Payment Option
-	// (POST /v2/identities/{identifier}/payment/options)
-	CreatePaymentOption(ctx context.Context, request CreatePaymentOptionRequestObject) (CreatePaymentOptionResponseObject, error)
-	// Delete Payment Option
-	// (DELETE /v2/identities/{identifier}/payment/options/{id})
-	DeletePaymentOption(ctx context.Context, request DeletePaymentOptionRequestObject) (DeletePaymentOptionResponseObject, error)
-	// Get Payment Option
-	// (GET /v2/identities/{identifier}/payment/options/{id})
-	GetPaymentOption(ctx context.Context, request GetPaymentOptionRequestObject) (GetPaymentOptionResponseObject, error)
-	// Update Payment Option
-	// (PATCH /v2/identities/{identifier}/payment/options/{id})
-	UpdatePaymentOption(ctx context.Context, request UpdatePaymentOptionRequestObject) (UpdatePaymentOptionResponseObject, error)
-	// Verify Payment
-	// (POST /v2/identities/{identifier}/payment/verify/{nonce})
-	VerifyPayment(ctx context.Context, request VerifyPaymentRequestObject) (VerifyPaymentResponseObject, error)
-	// Get Schemas
-	// (GET /v2/identities/{identifier}/schemas)
-	GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error)
-	// Import JSON schema
-	// (POST /v2/identities/{identifier}/schemas)
-	ImportSchema(ctx context.Context, request ImportSchemaRequestObject) (ImportSchemaResponseObject, error)
-	// Get Schema
-	// (GET /v2/identities/{identifier}/schemas/{id})
-	GetSchema(ctx context.Context, request GetSchemaRequestObject) (GetSchemaResponseObject, error)
-	// Update Schema
-	// (PATCH /v2/identities/{identifier}/schemas/{id})
-	UpdateSchema(ctx context.Context, request UpdateSchemaRequestObject) (UpdateSchemaResponseObject, error)
-	// Publish Identity State
-	// (POST /v2/identities/{identifier}/state/publish)
-	PublishIdentityState(ctx context.Context, request PublishIdentityStateRequestObject) (PublishIdentityStateResponseObject, error)
-	// Retry Publish Identity State
-	// (POST /v2/identities/{identifier}/state/retry)
-	RetryPublishState(ctx context.Context, request RetryPublishStateRequestObject) (RetryPublishStateResponseObject, error)
-	// Get Identity State Status
-	// (GET /v2/identities/{identifier}/state/status)
-	GetStateStatus(ctx context.Context, request GetStateStatusRequestObject) (GetStateStatusResponseObject, error)
-	// Get Identity State Transactions
-	// (GET /v2/identities/{identifier}/state/transactions)
-	GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error)
-	// Payments Configuration
-	// (GET /v2/payment/settings)
-	GetPaymentSettings(ctx context.Context, request GetPaymentSettingsRequestObject) (GetPaymentSettingsResponseObject, error)
-	// Get QrCode from store
-	// (GET /v2/qr-store)
-	GetQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error)
-	// Get Supported Networks
-	// (GET /v2/supported-networks)
-	GetSupportedNetworks(ctx context.Context, request GetSupportedNetworksRequestObject) (GetSupportedNetworksResponseObject, error)
-	// Get Authentication Message
-	// (POST /v2/{identifier}/authentication)
-	Authentication(ctx context.Context, request AuthenticationRequestObject) (AuthenticationResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBulkIssuanceJobRowsResponseObject); ok {
+		if err := validResponse.VisitGetBulkIssuanceJobRowsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetLinks operation middleware
+func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetLinksParams) {
+	var request GetLinksRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Identifier = identifier
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinks(ctx, request.(GetLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinks")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinksResponseObject); ok {
+		if err := validResponse.VisitGetLinksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// CreateLink operation middleware
+func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateLinkParams) {
+	var request CreateLinkRequestObject
 
-// Health operation middleware
-func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
-	var request HealthRequestObject
+	request.Identifier = identifier
+	request.Params = params
+
+	var body CreateLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Health")
+		handler = middleware(handler, "CreateLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(HealthResponseObject); ok {
-		if err := validResponse.VisitHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
+		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7377,31 +14472,34 @@ func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AgentV1 operation middleware
-func (sh *strictHandler) AgentV1(w http.ResponseWriter, r *http.Request) {
-	var request AgentV1RequestObject
+// CreateLinkQrCodeCallback operation middleware
+func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateLinkQrCodeCallbackParams) {
+	var request CreateLinkQrCodeCallbackRequestObject
+
+	request.Identifier = identifier
+	request.Params = params
 
 	data, err := io.ReadAll(r.Body)
 	if err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
 		return
 	}
-	body := AgentV1TextRequestBody(data)
+	body := CreateLinkQrCodeCallbackTextRequestBody(data)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AgentV1(ctx, request.(AgentV1RequestObject))
+		return sh.ssi.CreateLinkQrCodeCallback(ctx, request.(CreateLinkQrCodeCallbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AgentV1")
+		handler = middleware(handler, "CreateLinkQrCodeCallback")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AgentV1ResponseObject); ok {
-		if err := validResponse.VisitAgentV1Response(w); err != nil {
+	} else if validResponse, ok := response.(CreateLinkQrCodeCallbackResponseObject); ok {
+		if err := validResponse.VisitCreateLinkQrCodeCallbackResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7409,26 +14507,26 @@ func (sh *strictHandler) AgentV1(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetRevocationStatus operation middleware
-func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
-	var request GetRevocationStatusRequestObject
+// DeleteLink operation middleware
+func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeleteLinkRequestObject
 
 	request.Identifier = identifier
-	request.Nonce = nonce
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRevocationStatus")
+		handler = middleware(handler, "DeleteLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
-		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
+		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7436,31 +14534,60 @@ func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// Agent operation middleware
-func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
-	var request AgentRequestObject
+// GetLink operation middleware
+func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetLinkRequestObject
+
+	request.Identifier = identifier
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	data, err := io.ReadAll(r.Body)
 	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
+		if err := validResponse.VisitGetLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ActivateLink operation middleware
+func (sh *strictHandler) ActivateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request ActivateLinkRequestObject
+
+	request.Identifier = identifier
+	request.Id = id
+
+	var body ActivateLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
-	body := AgentTextRequestBody(data)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+		return sh.ssi.ActivateLink(ctx, request.(ActivateLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Agent")
+		handler = middleware(handler, "ActivateLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AgentResponseObject); ok {
-		if err := validResponse.VisitAgentResponse(w); err != nil {
+	} else if validResponse, ok := response.(ActivateLinkResponseObject); ok {
+		if err := validResponse.VisitActivateLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7468,33 +14595,53 @@ func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AuthCallback operation middleware
-func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
-	var request AuthCallbackRequestObject
+// CreateLinkOffer operation middleware
+func (sh *strictHandler) CreateLinkOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request CreateLinkOfferRequestObject
 
-	request.Params = params
+	request.Identifier = identifier
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkOffer(ctx, request.(CreateLinkOfferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkOffer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	data, err := io.ReadAll(r.Body)
 	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkOfferResponseObject); ok {
+		if err := validResponse.VisitCreateLinkOfferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	body := AuthCallbackTextRequestBody(data)
-	request.Body = &body
+}
+
+// ResumeCredential operation middleware
+func (sh *strictHandler) ResumeCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request ResumeCredentialRequestObject
+
+	request.Identifier = identifier
+	request.Nonce = nonce
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+		return sh.ssi.ResumeCredential(ctx, request.(ResumeCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthCallback")
+		handler = middleware(handler, "ResumeCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
-		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(ResumeCredentialResponseObject); ok {
+		if err := validResponse.VisitResumeCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7502,25 +14649,26 @@ func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// GetAuthenticationConnection operation middleware
-func (sh *strictHandler) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetAuthenticationConnectionRequestObject
+// GetRevocationStatusV2 operation middleware
+func (sh *strictHandler) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request GetRevocationStatusV2RequestObject
 
-	request.Id = id
+	request.Identifier = identifier
+	request.Nonce = nonce
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthenticationConnection(ctx, request.(GetAuthenticationConnectionRequestObject))
+		return sh.ssi.GetRevocationStatusV2(ctx, request.(GetRevocationStatusV2RequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthenticationConnection")
+		handler = middleware(handler, "GetRevocationStatusV2")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAuthenticationConnectionResponseObject); ok {
-		if err := validResponse.VisitGetAuthenticationConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRevocationStatusV2ResponseObject); ok {
+		if err := validResponse.VisitGetRevocationStatusV2Response(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7528,23 +14676,26 @@ func (sh *strictHandler) GetAuthenticationConnection(w http.ResponseWriter, r *h
 	}
 }
 
-// GetIdentities operation middleware
-func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
-	var request GetIdentitiesRequestObject
+// RevokeCredential operation middleware
+func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request RevokeCredentialRequestObject
+
+	request.Identifier = identifier
+	request.Nonce = nonce
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetIdentities(ctx, request.(GetIdentitiesRequestObject))
+		return sh.ssi.RevokeCredential(ctx, request.(RevokeCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetIdentities")
+		handler = middleware(handler, "RevokeCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetIdentitiesResponseObject); ok {
-		if err := validResponse.VisitGetIdentitiesResponse(w); err != nil {
+	} else if validResponse, ok := response.(RevokeCredentialResponseObject); ok {
+		if err := validResponse.VisitRevokeCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7552,30 +14703,53 @@ func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateIdentity operation middleware
-func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request) {
-	var request CreateIdentityRequestObject
+// SuspendCredential operation middleware
+func (sh *strictHandler) SuspendCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request SuspendCredentialRequestObject
 
-	var body CreateIdentityJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	request.Identifier = identifier
+	request.Nonce = nonce
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SuspendCredential(ctx, request.(SuspendCredentialRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SuspendCredential")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SuspendCredentialResponseObject); ok {
+		if err := validResponse.VisitSuspendCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCredential operation middleware
+func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+	var request DeleteCredentialRequestObject
+
+	request.Identifier = identifier
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateIdentity(ctx, request.(CreateIdentityRequestObject))
+		return sh.ssi.DeleteCredential(ctx, request.(DeleteCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateIdentity")
+		handler = middleware(handler, "DeleteCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateIdentityResponseObject); ok {
-		if err := validResponse.VisitCreateIdentityResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteCredentialResponseObject); ok {
+		if err := validResponse.VisitDeleteCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7583,25 +14757,27 @@ func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetIdentityDetails operation middleware
-func (sh *strictHandler) GetIdentityDetails(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request GetIdentityDetailsRequestObject
+// GetCredential operation middleware
+func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialParams) {
+	var request GetCredentialRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetIdentityDetails(ctx, request.(GetIdentityDetailsRequestObject))
+		return sh.ssi.GetCredential(ctx, request.(GetCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetIdentityDetails")
+		handler = middleware(handler, "GetCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetIdentityDetailsResponseObject); ok {
-		if err := validResponse.VisitGetIdentityDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialResponseObject); ok {
+		if err := validResponse.VisitGetCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7609,13 +14785,14 @@ func (sh *strictHandler) GetIdentityDetails(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// UpdateIdentity operation middleware
-func (sh *strictHandler) UpdateIdentity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request UpdateIdentityRequestObject
+// ReissueCredential operation middleware
+func (sh *strictHandler) ReissueCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+	var request ReissueCredentialRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 
-	var body UpdateIdentityJSONRequestBody
+	var body ReissueCredentialJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7623,18 +14800,18 @@ func (sh *strictHandler) UpdateIdentity(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateIdentity(ctx, request.(UpdateIdentityRequestObject))
+		return sh.ssi.ReissueCredential(ctx, request.(ReissueCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateIdentity")
+		handler = middleware(handler, "ReissueCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateIdentityResponseObject); ok {
-		if err := validResponse.VisitUpdateIdentityResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReissueCredentialResponseObject); ok {
+		if err := validResponse.VisitReissueCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7642,26 +14819,27 @@ func (sh *strictHandler) UpdateIdentity(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetConnections operation middleware
-func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetConnectionsParams) {
-	var request GetConnectionsRequestObject
+// GetCredentialOffer operation middleware
+func (sh *strictHandler) GetCredentialOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialOfferParams) {
+	var request GetCredentialOfferRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConnections(ctx, request.(GetConnectionsRequestObject))
+		return sh.ssi.GetCredentialOffer(ctx, request.(GetCredentialOfferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConnections")
+		handler = middleware(handler, "GetCredentialOffer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConnectionsResponseObject); ok {
-		if err := validResponse.VisitGetConnectionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialOfferResponseObject); ok {
+		if err := validResponse.VisitGetCredentialOfferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7669,32 +14847,25 @@ func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateConnection operation middleware
-func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreateConnectionRequestObject
+// GetDIDDocument operation middleware
+func (sh *strictHandler) GetDIDDocument(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetDIDDocumentRequestObject
 
 	request.Identifier = identifier
 
-	var body CreateConnectionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateConnection(ctx, request.(CreateConnectionRequestObject))
+		return sh.ssi.GetDIDDocument(ctx, request.(GetDIDDocumentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateConnection")
+		handler = middleware(handler, "GetDIDDocument")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateConnectionResponseObject); ok {
-		if err := validResponse.VisitCreateConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetDIDDocumentResponseObject); ok {
+		if err := validResponse.VisitGetDIDDocumentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7702,27 +14873,26 @@ func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeleteConnection operation middleware
-func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params DeleteConnectionParams) {
-	var request DeleteConnectionRequestObject
+// GetAllDisplayMethods operation middleware
+func (sh *strictHandler) GetAllDisplayMethods(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAllDisplayMethodsParams) {
+	var request GetAllDisplayMethodsRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+		return sh.ssi.GetAllDisplayMethods(ctx, request.(GetAllDisplayMethodsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConnection")
+		handler = middleware(handler, "GetAllDisplayMethods")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
-		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetAllDisplayMethodsResponseObject); ok {
+		if err := validResponse.VisitGetAllDisplayMethodsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7730,26 +14900,32 @@ func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetConnection operation middleware
-func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetConnectionRequestObject
+// CreateDisplayMethod operation middleware
+func (sh *strictHandler) CreateDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateDisplayMethodRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+
+	var body CreateDisplayMethodJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+		return sh.ssi.CreateDisplayMethod(ctx, request.(CreateDisplayMethodRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConnection")
+		handler = middleware(handler, "CreateDisplayMethod")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
-		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateDisplayMethodResponseObject); ok {
+		if err := validResponse.VisitCreateDisplayMethodResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7757,26 +14933,26 @@ func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// DeleteConnectionCredentials operation middleware
-func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeleteConnectionCredentialsRequestObject
+// DeleteDisplayMethod operation middleware
+func (sh *strictHandler) DeleteDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeleteDisplayMethodRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConnectionCredentials(ctx, request.(DeleteConnectionCredentialsRequestObject))
+		return sh.ssi.DeleteDisplayMethod(ctx, request.(DeleteDisplayMethodRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConnectionCredentials")
+		handler = middleware(handler, "DeleteDisplayMethod")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConnectionCredentialsResponseObject); ok {
-		if err := validResponse.VisitDeleteConnectionCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteDisplayMethodResponseObject); ok {
+		if err := validResponse.VisitDeleteDisplayMethodResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7784,26 +14960,26 @@ func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *h
 	}
 }
 
-// RevokeConnectionCredentials operation middleware
-func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request RevokeConnectionCredentialsRequestObject
+// GetDisplayMethod operation middleware
+func (sh *strictHandler) GetDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetDisplayMethodRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeConnectionCredentials(ctx, request.(RevokeConnectionCredentialsRequestObject))
+		return sh.ssi.GetDisplayMethod(ctx, request.(GetDisplayMethodRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeConnectionCredentials")
+		handler = middleware(handler, "GetDisplayMethod")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RevokeConnectionCredentialsResponseObject); ok {
-		if err := validResponse.VisitRevokeConnectionCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetDisplayMethodResponseObject); ok {
+		if err := validResponse.VisitGetDisplayMethodResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7811,13 +14987,14 @@ func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *h
 	}
 }
 
-// CreateAuthCredential operation middleware
-func (sh *strictHandler) CreateAuthCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
-	var request CreateAuthCredentialRequestObject
+// UpdateDisplayMethod operation middleware
+func (sh *strictHandler) UpdateDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request UpdateDisplayMethodRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 
-	var body CreateAuthCredentialJSONRequestBody
+	var body UpdateDisplayMethodJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7825,18 +15002,18 @@ func (sh *strictHandler) CreateAuthCredential(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAuthCredential(ctx, request.(CreateAuthCredentialRequestObject))
+		return sh.ssi.UpdateDisplayMethod(ctx, request.(UpdateDisplayMethodRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAuthCredential")
+		handler = middleware(handler, "UpdateDisplayMethod")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAuthCredentialResponseObject); ok {
-		if err := validResponse.VisitCreateAuthCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateDisplayMethodResponseObject); ok {
+		if err := validResponse.VisitUpdateDisplayMethodResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7844,26 +15021,25 @@ func (sh *strictHandler) CreateAuthCredential(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetCredentials operation middleware
-func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetCredentialsParams) {
-	var request GetCredentialsRequestObject
+// GetKeyRotations operation middleware
+func (sh *strictHandler) GetKeyRotations(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+	var request GetKeyRotationsRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredentials(ctx, request.(GetCredentialsRequestObject))
+		return sh.ssi.GetKeyRotations(ctx, request.(GetKeyRotationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredentials")
+		handler = middleware(handler, "GetKeyRotations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialsResponseObject); ok {
-		if err := validResponse.VisitGetCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeyRotationsResponseObject); ok {
+		if err := validResponse.VisitGetKeyRotationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7871,13 +15047,13 @@ func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateCredential operation middleware
-func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreateCredentialRequestObject
+// CreateKeyRotation operation middleware
+func (sh *strictHandler) CreateKeyRotation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+	var request CreateKeyRotationRequestObject
 
 	request.Identifier = identifier
 
-	var body CreateCredentialJSONRequestBody
+	var body CreateKeyRotationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7885,18 +15061,18 @@ func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCredential(ctx, request.(CreateCredentialRequestObject))
+		return sh.ssi.CreateKeyRotation(ctx, request.(CreateKeyRotationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCredential")
+		handler = middleware(handler, "CreateKeyRotation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCredentialResponseObject); ok {
-		if err := validResponse.VisitCreateCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateKeyRotationResponseObject); ok {
+		if err := validResponse.VisitCreateKeyRotationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7904,26 +15080,26 @@ func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetLinks operation middleware
-func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetLinksParams) {
-	var request GetLinksRequestObject
+// GetKeyRotation operation middleware
+func (sh *strictHandler) GetKeyRotation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id Id) {
+	var request GetKeyRotationRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLinks(ctx, request.(GetLinksRequestObject))
+		return sh.ssi.GetKeyRotation(ctx, request.(GetKeyRotationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLinks")
+		handler = middleware(handler, "GetKeyRotation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinksResponseObject); ok {
-		if err := validResponse.VisitGetLinksResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeyRotationResponseObject); ok {
+		if err := validResponse.VisitGetKeyRotationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7931,32 +15107,26 @@ func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, identi
 	}
 }
 
-// CreateLink operation middleware
-func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreateLinkRequestObject
+// GetKeyRotationCredentials operation middleware
+func (sh *strictHandler) GetKeyRotationCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id Id) {
+	var request GetKeyRotationCredentialsRequestObject
 
 	request.Identifier = identifier
-
-	var body CreateLinkJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
+		return sh.ssi.GetKeyRotationCredentials(ctx, request.(GetKeyRotationCredentialsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLink")
+		handler = middleware(handler, "GetKeyRotationCredentials")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
-		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeyRotationCredentialsResponseObject); ok {
+		if err := validResponse.VisitGetKeyRotationCredentialsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7964,34 +15134,53 @@ func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request, iden
 	}
 }
 
-// CreateLinkQrCodeCallback operation middleware
-func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateLinkQrCodeCallbackParams) {
-	var request CreateLinkQrCodeCallbackRequestObject
+// ResumeKeyRotation operation middleware
+func (sh *strictHandler) ResumeKeyRotation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id Id) {
+	var request ResumeKeyRotationRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeKeyRotation(ctx, request.(ResumeKeyRotationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeKeyRotation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	data, err := io.ReadAll(r.Body)
 	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeKeyRotationResponseObject); ok {
+		if err := validResponse.VisitResumeKeyRotationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	body := CreateLinkQrCodeCallbackTextRequestBody(data)
-	request.Body = &body
+}
+
+// GetKeys operation middleware
+func (sh *strictHandler) GetKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params GetKeysParams) {
+	var request GetKeysRequestObject
+
+	request.Identifier = identifier
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLinkQrCodeCallback(ctx, request.(CreateLinkQrCodeCallbackRequestObject))
+		return sh.ssi.GetKeys(ctx, request.(GetKeysRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLinkQrCodeCallback")
+		handler = middleware(handler, "GetKeys")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkQrCodeCallbackResponseObject); ok {
-		if err := validResponse.VisitCreateLinkQrCodeCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeysResponseObject); ok {
+		if err := validResponse.VisitGetKeysResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7999,26 +15188,33 @@ func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http
 	}
 }
 
-// DeleteLink operation middleware
-func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeleteLinkRequestObject
+// CreateKey operation middleware
+func (sh *strictHandler) CreateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params CreateKeyParams) {
+	var request CreateKeyRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+	request.Params = params
+
+	var body CreateKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
+		return sh.ssi.CreateKey(ctx, request.(CreateKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteLink")
+		handler = middleware(handler, "CreateKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
-		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateKeyResponseObject); ok {
+		if err := validResponse.VisitCreateKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8026,26 +15222,26 @@ func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, iden
 	}
 }
 
-// GetLink operation middleware
-func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetLinkRequestObject
+// DeleteKey operation middleware
+func (sh *strictHandler) DeleteKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
+	var request DeleteKeyRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
+		return sh.ssi.DeleteKey(ctx, request.(DeleteKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLink")
+		handler = middleware(handler, "DeleteKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
-		if err := validResponse.VisitGetLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteKeyResponseObject); ok {
+		if err := validResponse.VisitDeleteKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8053,33 +15249,26 @@ func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, identif
 	}
 }
 
-// ActivateLink operation middleware
-func (sh *strictHandler) ActivateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request ActivateLinkRequestObject
+// GetKey operation middleware
+func (sh *strictHandler) GetKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
+	var request GetKeyRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
-	var body ActivateLinkJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ActivateLink(ctx, request.(ActivateLinkRequestObject))
+		return sh.ssi.GetKey(ctx, request.(GetKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ActivateLink")
+		handler = middleware(handler, "GetKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ActivateLinkResponseObject); ok {
-		if err := validResponse.VisitActivateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeyResponseObject); ok {
+		if err := validResponse.VisitGetKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8087,26 +15276,33 @@ func (sh *strictHandler) ActivateLink(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// CreateLinkOffer operation middleware
-func (sh *strictHandler) CreateLinkOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request CreateLinkOfferRequestObject
+// UpdateKey operation middleware
+func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
+	var request UpdateKeyRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
+	var body UpdateKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLinkOffer(ctx, request.(CreateLinkOfferRequestObject))
+		return sh.ssi.UpdateKey(ctx, request.(UpdateKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLinkOffer")
+		handler = middleware(handler, "UpdateKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkOfferResponseObject); ok {
-		if err := validResponse.VisitCreateLinkOfferResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateKeyResponseObject); ok {
+		if err := validResponse.VisitUpdateKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8114,26 +15310,26 @@ func (sh *strictHandler) CreateLinkOffer(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetRevocationStatusV2 operation middleware
-func (sh *strictHandler) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
-	var request GetRevocationStatusV2RequestObject
+// CheckMerkleTreesIntegrity operation middleware
+func (sh *strictHandler) CheckMerkleTreesIntegrity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CheckMerkleTreesIntegrityParams) {
+	var request CheckMerkleTreesIntegrityRequestObject
 
 	request.Identifier = identifier
-	request.Nonce = nonce
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRevocationStatusV2(ctx, request.(GetRevocationStatusV2RequestObject))
+		return sh.ssi.CheckMerkleTreesIntegrity(ctx, request.(CheckMerkleTreesIntegrityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRevocationStatusV2")
+		handler = middleware(handler, "CheckMerkleTreesIntegrity")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRevocationStatusV2ResponseObject); ok {
-		if err := validResponse.VisitGetRevocationStatusV2Response(w); err != nil {
+	} else if validResponse, ok := response.(CheckMerkleTreesIntegrityResponseObject); ok {
+		if err := validResponse.VisitCheckMerkleTreesIntegrityResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8141,26 +15337,26 @@ func (sh *strictHandler) GetRevocationStatusV2(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// RevokeCredential operation middleware
-func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
-	var request RevokeCredentialRequestObject
+// GetPaymentRequests operation middleware
+func (sh *strictHandler) GetPaymentRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetPaymentRequestsParams) {
+	var request GetPaymentRequestsRequestObject
 
 	request.Identifier = identifier
-	request.Nonce = nonce
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeCredential(ctx, request.(RevokeCredentialRequestObject))
+		return sh.ssi.GetPaymentRequests(ctx, request.(GetPaymentRequestsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeCredential")
+		handler = middleware(handler, "GetPaymentRequests")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RevokeCredentialResponseObject); ok {
-		if err := validResponse.VisitRevokeCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPaymentRequestsResponseObject); ok {
+		if err := validResponse.VisitGetPaymentRequestsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8168,26 +15364,33 @@ func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeleteCredential operation middleware
-func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
-	var request DeleteCredentialRequestObject
+// CreatePaymentRequest operation middleware
+func (sh *strictHandler) CreatePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreatePaymentRequestParams) {
+	var request CreatePaymentRequestRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+	request.Params = params
+
+	var body CreatePaymentRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCredential(ctx, request.(DeleteCredentialRequestObject))
+		return sh.ssi.CreatePaymentRequest(ctx, request.(CreatePaymentRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCredential")
+		handler = middleware(handler, "CreatePaymentRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCredentialResponseObject); ok {
-		if err := validResponse.VisitDeleteCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePaymentRequestResponseObject); ok {
+		if err := validResponse.VisitCreatePaymentRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8195,26 +15398,26 @@ func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetCredential operation middleware
-func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
-	var request GetCredentialRequestObject
+// DeletePaymentRequest operation middleware
+func (sh *strictHandler) DeletePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeletePaymentRequestRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredential(ctx, request.(GetCredentialRequestObject))
+		return sh.ssi.DeletePaymentRequest(ctx, request.(DeletePaymentRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredential")
+		handler = middleware(handler, "DeletePaymentRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialResponseObject); ok {
-		if err := validResponse.VisitGetCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePaymentRequestResponseObject); ok {
+		if err := validResponse.VisitDeletePaymentRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8222,27 +15425,26 @@ func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetCredentialOffer operation middleware
-func (sh *strictHandler) GetCredentialOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialOfferParams) {
-	var request GetCredentialOfferRequestObject
+// GetPaymentRequest operation middleware
+func (sh *strictHandler) GetPaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetPaymentRequestRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredentialOffer(ctx, request.(GetCredentialOfferRequestObject))
+		return sh.ssi.GetPaymentRequest(ctx, request.(GetPaymentRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredentialOffer")
+		handler = middleware(handler, "GetPaymentRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialOfferResponseObject); ok {
-		if err := validResponse.VisitGetCredentialOfferResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPaymentRequestResponseObject); ok {
+		if err := validResponse.VisitGetPaymentRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8250,26 +15452,25 @@ func (sh *strictHandler) GetCredentialOffer(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetAllDisplayMethods operation middleware
-func (sh *strictHandler) GetAllDisplayMethods(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAllDisplayMethodsParams) {
-	var request GetAllDisplayMethodsRequestObject
+// GetPaymentOptions operation middleware
+func (sh *strictHandler) GetPaymentOptions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetPaymentOptionsRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllDisplayMethods(ctx, request.(GetAllDisplayMethodsRequestObject))
+		return sh.ssi.GetPaymentOptions(ctx, request.(GetPaymentOptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllDisplayMethods")
+		handler = middleware(handler, "GetPaymentOptions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAllDisplayMethodsResponseObject); ok {
-		if err := validResponse.VisitGetAllDisplayMethodsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPaymentOptionsResponseObject); ok {
+		if err := validResponse.VisitGetPaymentOptionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8277,13 +15478,13 @@ func (sh *strictHandler) GetAllDisplayMethods(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// CreateDisplayMethod operation middleware
-func (sh *strictHandler) CreateDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreateDisplayMethodRequestObject
+// CreatePaymentOption operation middleware
+func (sh *strictHandler) CreatePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreatePaymentOptionRequestObject
 
 	request.Identifier = identifier
 
-	var body CreateDisplayMethodJSONRequestBody
+	var body CreatePaymentOptionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8291,18 +15492,18 @@ func (sh *strictHandler) CreateDisplayMethod(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateDisplayMethod(ctx, request.(CreateDisplayMethodRequestObject))
+		return sh.ssi.CreatePaymentOption(ctx, request.(CreatePaymentOptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateDisplayMethod")
+		handler = middleware(handler, "CreatePaymentOption")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateDisplayMethodResponseObject); ok {
-		if err := validResponse.VisitCreateDisplayMethodResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePaymentOptionResponseObject); ok {
+		if err := validResponse.VisitCreatePaymentOptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8310,26 +15511,26 @@ func (sh *strictHandler) CreateDisplayMethod(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// DeleteDisplayMethod operation middleware
-func (sh *strictHandler) DeleteDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeleteDisplayMethodRequestObject
+// DeletePaymentOption operation middleware
+func (sh *strictHandler) DeletePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeletePaymentOptionRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteDisplayMethod(ctx, request.(DeleteDisplayMethodRequestObject))
+		return sh.ssi.DeletePaymentOption(ctx, request.(DeletePaymentOptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteDisplayMethod")
+		handler = middleware(handler, "DeletePaymentOption")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteDisplayMethodResponseObject); ok {
-		if err := validResponse.VisitDeleteDisplayMethodResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePaymentOptionResponseObject); ok {
+		if err := validResponse.VisitDeletePaymentOptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8337,26 +15538,26 @@ func (sh *strictHandler) DeleteDisplayMethod(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetDisplayMethod operation middleware
-func (sh *strictHandler) GetDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetDisplayMethodRequestObject
+// GetPaymentOption operation middleware
+func (sh *strictHandler) GetPaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetPaymentOptionRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDisplayMethod(ctx, request.(GetDisplayMethodRequestObject))
+		return sh.ssi.GetPaymentOption(ctx, request.(GetPaymentOptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDisplayMethod")
+		handler = middleware(handler, "GetPaymentOption")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDisplayMethodResponseObject); ok {
-		if err := validResponse.VisitGetDisplayMethodResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPaymentOptionResponseObject); ok {
+		if err := validResponse.VisitGetPaymentOptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8364,14 +15565,14 @@ func (sh *strictHandler) GetDisplayMethod(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// UpdateDisplayMethod operation middleware
-func (sh *strictHandler) UpdateDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request UpdateDisplayMethodRequestObject
+// UpdatePaymentOption operation middleware
+func (sh *strictHandler) UpdatePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request UpdatePaymentOptionRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
-	var body UpdateDisplayMethodJSONRequestBody
+	var body UpdatePaymentOptionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8379,18 +15580,18 @@ func (sh *strictHandler) UpdateDisplayMethod(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateDisplayMethod(ctx, request.(UpdateDisplayMethodRequestObject))
+		return sh.ssi.UpdatePaymentOption(ctx, request.(UpdatePaymentOptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateDisplayMethod")
+		handler = middleware(handler, "UpdatePaymentOption")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateDisplayMethodResponseObject); ok {
-		if err := validResponse.VisitUpdateDisplayMethodResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdatePaymentOptionResponseObject); ok {
+		if err := validResponse.VisitUpdatePaymentOptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8398,26 +15599,33 @@ func (sh *strictHandler) UpdateDisplayMethod(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetKeys operation middleware
-func (sh *strictHandler) GetKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params GetKeysParams) {
-	var request GetKeysRequestObject
+// VerifyPayment operation middleware
+func (sh *strictHandler) VerifyPayment(w http.ResponseWriter, r *http.Request, identifier string, nonce string) {
+	var request VerifyPaymentRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
+	request.Nonce = nonce
+
+	var body VerifyPaymentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeys(ctx, request.(GetKeysRequestObject))
+		return sh.ssi.VerifyPayment(ctx, request.(VerifyPaymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeys")
+		handler = middleware(handler, "VerifyPayment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeysResponseObject); ok {
-		if err := validResponse.VisitGetKeysResponse(w); err != nil {
+	} else if validResponse, ok := response.(VerifyPaymentResponseObject); ok {
+		if err := validResponse.VisitVerifyPaymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8425,32 +15633,26 @@ func (sh *strictHandler) GetKeys(w http.ResponseWriter, r *http.Request, identif
 	}
 }
 
-// CreateKey operation middleware
-func (sh *strictHandler) CreateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
-	var request CreateKeyRequestObject
+// GetSchemas operation middleware
+func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetSchemasParams) {
+	var request GetSchemasRequestObject
 
 	request.Identifier = identifier
-
-	var body CreateKeyJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateKey(ctx, request.(CreateKeyRequestObject))
+		return sh.ssi.GetSchemas(ctx, request.(GetSchemasRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateKey")
+		handler = middleware(handler, "GetSchemas")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateKeyResponseObject); ok {
-		if err := validResponse.VisitCreateKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemasResponseObject); ok {
+		if err := validResponse.VisitGetSchemasResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8458,26 +15660,32 @@ func (sh *strictHandler) CreateKey(w http.ResponseWriter, r *http.Request, ident
 	}
 }
 
-// DeleteKey operation middleware
-func (sh *strictHandler) DeleteKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
-	var request DeleteKeyRequestObject
+// ImportSchema operation middleware
+func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request ImportSchemaRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+
+	var body ImportSchemaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteKey(ctx, request.(DeleteKeyRequestObject))
+		return sh.ssi.ImportSchema(ctx, request.(ImportSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteKey")
+		handler = middleware(handler, "ImportSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteKeyResponseObject); ok {
-		if err := validResponse.VisitDeleteKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportSchemaResponseObject); ok {
+		if err := validResponse.VisitImportSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8485,26 +15693,26 @@ func (sh *strictHandler) DeleteKey(w http.ResponseWriter, r *http.Request, ident
 	}
 }
 
-// GetKey operation middleware
-func (sh *strictHandler) GetKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
-	var request GetKeyRequestObject
+// GetSchema operation middleware
+func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetSchemaRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKey(ctx, request.(GetKeyRequestObject))
+		return sh.ssi.GetSchema(ctx, request.(GetSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKey")
+		handler = middleware(handler, "GetSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeyResponseObject); ok {
-		if err := validResponse.VisitGetKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemaResponseObject); ok {
+		if err := validResponse.VisitGetSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8512,14 +15720,14 @@ func (sh *strictHandler) GetKey(w http.ResponseWriter, r *http.Request, identifi
 	}
 }
 
-// UpdateKey operation middleware
-func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
-	var request UpdateKeyRequestObject
+// UpdateSchema operation middleware
+func (sh *strictHandler) UpdateSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request UpdateSchemaRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
-	var body UpdateKeyJSONRequestBody
+	var body UpdateSchemaJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8527,18 +15735,18 @@ func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, ident
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateKey(ctx, request.(UpdateKeyRequestObject))
+		return sh.ssi.UpdateSchema(ctx, request.(UpdateSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateKey")
+		handler = middleware(handler, "UpdateSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateKeyResponseObject); ok {
-		if err := validResponse.VisitUpdateKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSchemaResponseObject); ok {
+		if err := validResponse.VisitUpdateSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8546,26 +15754,26 @@ func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, ident
 	}
 }
 
-// GetPaymentRequests operation middleware
-func (sh *strictHandler) GetPaymentRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetPaymentRequestsParams) {
-	var request GetPaymentRequestsRequestObject
+// GetStateDiff operation middleware
+func (sh *strictHandler) GetStateDiff(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetStateDiffParams) {
+	var request GetStateDiffRequestObject
 
 	request.Identifier = identifier
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPaymentRequests(ctx, request.(GetPaymentRequestsRequestObject))
+		return sh.ssi.GetStateDiff(ctx, request.(GetStateDiffRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPaymentRequests")
+		handler = middleware(handler, "GetStateDiff")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPaymentRequestsResponseObject); ok {
-		if err := validResponse.VisitGetPaymentRequestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateDiffResponseObject); ok {
+		if err := validResponse.VisitGetStateDiffResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8573,32 +15781,25 @@ func (sh *strictHandler) GetPaymentRequests(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// CreatePaymentRequest operation middleware
-func (sh *strictHandler) CreatePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreatePaymentRequestRequestObject
+// DryRunPublishState operation middleware
+func (sh *strictHandler) DryRunPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request DryRunPublishStateRequestObject
 
 	request.Identifier = identifier
 
-	var body CreatePaymentRequestJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePaymentRequest(ctx, request.(CreatePaymentRequestRequestObject))
+		return sh.ssi.DryRunPublishState(ctx, request.(DryRunPublishStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePaymentRequest")
+		handler = middleware(handler, "DryRunPublishState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreatePaymentRequestResponseObject); ok {
-		if err := validResponse.VisitCreatePaymentRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(DryRunPublishStateResponseObject); ok {
+		if err := validResponse.VisitDryRunPublishStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8606,26 +15807,25 @@ func (sh *strictHandler) CreatePaymentRequest(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// DeletePaymentRequest operation middleware
-func (sh *strictHandler) DeletePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeletePaymentRequestRequestObject
+// PublishIdentityState operation middleware
+func (sh *strictHandler) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request PublishIdentityStateRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePaymentRequest(ctx, request.(DeletePaymentRequestRequestObject))
+		return sh.ssi.PublishIdentityState(ctx, request.(PublishIdentityStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePaymentRequest")
+		handler = middleware(handler, "PublishIdentityState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeletePaymentRequestResponseObject); ok {
-		if err := validResponse.VisitDeletePaymentRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(PublishIdentityStateResponseObject); ok {
+		if err := validResponse.VisitPublishIdentityStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8633,26 +15833,25 @@ func (sh *strictHandler) DeletePaymentRequest(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetPaymentRequest operation middleware
-func (sh *strictHandler) GetPaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetPaymentRequestRequestObject
+// DeletePublishPolicy operation middleware
+func (sh *strictHandler) DeletePublishPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request DeletePublishPolicyRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPaymentRequest(ctx, request.(GetPaymentRequestRequestObject))
+		return sh.ssi.DeletePublishPolicy(ctx, request.(DeletePublishPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPaymentRequest")
+		handler = middleware(handler, "DeletePublishPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPaymentRequestResponseObject); ok {
-		if err := validResponse.VisitGetPaymentRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePublishPolicyResponseObject); ok {
+		if err := validResponse.VisitDeletePublishPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8660,25 +15859,25 @@ func (sh *strictHandler) GetPaymentRequest(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetPaymentOptions operation middleware
-func (sh *strictHandler) GetPaymentOptions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request GetPaymentOptionsRequestObject
+// GetPublishPolicy operation middleware
+func (sh *strictHandler) GetPublishPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetPublishPolicyRequestObject
 
 	request.Identifier = identifier
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPaymentOptions(ctx, request.(GetPaymentOptionsRequestObject))
+		return sh.ssi.GetPublishPolicy(ctx, request.(GetPublishPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPaymentOptions")
+		handler = middleware(handler, "GetPublishPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPaymentOptionsResponseObject); ok {
-		if err := validResponse.VisitGetPaymentOptionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPublishPolicyResponseObject); ok {
+		if err := validResponse.VisitGetPublishPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8686,13 +15885,13 @@ func (sh *strictHandler) GetPaymentOptions(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// CreatePaymentOption operation middleware
-func (sh *strictHandler) CreatePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreatePaymentOptionRequestObject
+// UpdatePublishPolicy operation middleware
+func (sh *strictHandler) UpdatePublishPolicy(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request UpdatePublishPolicyRequestObject
 
 	request.Identifier = identifier
 
-	var body CreatePaymentOptionJSONRequestBody
+	var body UpdatePublishPolicyJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8700,45 +15899,18 @@ func (sh *strictHandler) CreatePaymentOption(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePaymentOption(ctx, request.(CreatePaymentOptionRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePaymentOption")
-	}
-
-	response, err := handler(r.Context(), w, r, request)
-
-	if err != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreatePaymentOptionResponseObject); ok {
-		if err := validResponse.VisitCreatePaymentOptionResponse(w); err != nil {
-			sh.options.ResponseErrorHandlerFunc(w, r, err)
-		}
-	} else if response != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
-	}
-}
-
-// DeletePaymentOption operation middleware
-func (sh *strictHandler) DeletePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeletePaymentOptionRequestObject
-
-	request.Identifier = identifier
-	request.Id = id
-
-	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePaymentOption(ctx, request.(DeletePaymentOptionRequestObject))
+		return sh.ssi.UpdatePublishPolicy(ctx, request.(UpdatePublishPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePaymentOption")
+		handler = middleware(handler, "UpdatePublishPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeletePaymentOptionResponseObject); ok {
-		if err := validResponse.VisitDeletePaymentOptionResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdatePublishPolicyResponseObject); ok {
+		if err := validResponse.VisitUpdatePublishPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8746,26 +15918,25 @@ func (sh *strictHandler) DeletePaymentOption(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetPaymentOption operation middleware
-func (sh *strictHandler) GetPaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetPaymentOptionRequestObject
+// RetryPublishState operation middleware
+func (sh *strictHandler) RetryPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request RetryPublishStateRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPaymentOption(ctx, request.(GetPaymentOptionRequestObject))
+		return sh.ssi.RetryPublishState(ctx, request.(RetryPublishStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPaymentOption")
+		handler = middleware(handler, "RetryPublishState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPaymentOptionResponseObject); ok {
-		if err := validResponse.VisitGetPaymentOptionResponse(w); err != nil {
+	} else if validResponse, ok := response.(RetryPublishStateResponseObject); ok {
+		if err := validResponse.VisitRetryPublishStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8773,33 +15944,25 @@ func (sh *strictHandler) GetPaymentOption(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// UpdatePaymentOption operation middleware
-func (sh *strictHandler) UpdatePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request UpdatePaymentOptionRequestObject
+// GetStateStatus operation middleware
+func (sh *strictHandler) GetStateStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetStateStatusRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
-
-	var body UpdatePaymentOptionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdatePaymentOption(ctx, request.(UpdatePaymentOptionRequestObject))
+		return sh.ssi.GetStateStatus(ctx, request.(GetStateStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdatePaymentOption")
+		handler = middleware(handler, "GetStateStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdatePaymentOptionResponseObject); ok {
-		if err := validResponse.VisitUpdatePaymentOptionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateStatusResponseObject); ok {
+		if err := validResponse.VisitGetStateStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8807,33 +15970,26 @@ func (sh *strictHandler) UpdatePaymentOption(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// VerifyPayment operation middleware
-func (sh *strictHandler) VerifyPayment(w http.ResponseWriter, r *http.Request, identifier string, nonce string) {
-	var request VerifyPaymentRequestObject
+// GetStateTransactions operation middleware
+func (sh *strictHandler) GetStateTransactions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetStateTransactionsParams) {
+	var request GetStateTransactionsRequestObject
 
 	request.Identifier = identifier
-	request.Nonce = nonce
-
-	var body VerifyPaymentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.VerifyPayment(ctx, request.(VerifyPaymentRequestObject))
+		return sh.ssi.GetStateTransactions(ctx, request.(GetStateTransactionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "VerifyPayment")
+		handler = middleware(handler, "GetStateTransactions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(VerifyPaymentResponseObject); ok {
-		if err := validResponse.VisitVerifyPaymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateTransactionsResponseObject); ok {
+		if err := validResponse.VisitGetStateTransactionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8841,26 +15997,27 @@ func (sh *strictHandler) VerifyPayment(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetSchemas operation middleware
-func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetSchemasParams) {
-	var request GetSchemasRequestObject
+// GetStateDetails operation middleware
+func (sh *strictHandler) GetStateDetails(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, state string, params GetStateDetailsParams) {
+	var request GetStateDetailsRequestObject
 
 	request.Identifier = identifier
+	request.State = state
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSchemas(ctx, request.(GetSchemasRequestObject))
+		return sh.ssi.GetStateDetails(ctx, request.(GetStateDetailsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSchemas")
+		handler = middleware(handler, "GetStateDetails")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSchemasResponseObject); ok {
-		if err := validResponse.VisitGetSchemasResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateDetailsResponseObject); ok {
+		if err := validResponse.VisitGetStateDetailsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8868,32 +16025,26 @@ func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, iden
 	}
 }
 
-// ImportSchema operation middleware
-func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request ImportSchemaRequestObject
+// GetStatusListCredential operation middleware
+func (sh *strictHandler) GetStatusListCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetStatusListCredentialRequestObject
 
 	request.Identifier = identifier
-
-	var body ImportSchemaJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ImportSchema(ctx, request.(ImportSchemaRequestObject))
+		return sh.ssi.GetStatusListCredential(ctx, request.(GetStatusListCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ImportSchema")
+		handler = middleware(handler, "GetStatusListCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ImportSchemaResponseObject); ok {
-		if err := validResponse.VisitImportSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStatusListCredentialResponseObject); ok {
+		if err := validResponse.VisitGetStatusListCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8901,26 +16052,25 @@ func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetSchema operation middleware
-func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetSchemaRequestObject
+// GetVerificationQueries operation middleware
+func (sh *strictHandler) GetVerificationQueries(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetVerificationQueriesRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSchema(ctx, request.(GetSchemaRequestObject))
+		return sh.ssi.GetVerificationQueries(ctx, request.(GetVerificationQueriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSchema")
+		handler = middleware(handler, "GetVerificationQueries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSchemaResponseObject); ok {
-		if err := validResponse.VisitGetSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVerificationQueriesResponseObject); ok {
+		if err := validResponse.VisitGetVerificationQueriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8928,14 +16078,13 @@ func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, ident
 	}
 }
 
-// UpdateSchema operation middleware
-func (sh *strictHandler) UpdateSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request UpdateSchemaRequestObject
+// CreateVerificationQuery operation middleware
+func (sh *strictHandler) CreateVerificationQuery(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateVerificationQueryRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 
-	var body UpdateSchemaJSONRequestBody
+	var body CreateVerificationQueryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8943,18 +16092,18 @@ func (sh *strictHandler) UpdateSchema(w http.ResponseWriter, r *http.Request, id
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateSchema(ctx, request.(UpdateSchemaRequestObject))
+		return sh.ssi.CreateVerificationQuery(ctx, request.(CreateVerificationQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateSchema")
+		handler = middleware(handler, "CreateVerificationQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateSchemaResponseObject); ok {
-		if err := validResponse.VisitUpdateSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateVerificationQueryResponseObject); ok {
+		if err := validResponse.VisitCreateVerificationQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8962,25 +16111,26 @@ func (sh *strictHandler) UpdateSchema(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// PublishIdentityState operation middleware
-func (sh *strictHandler) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request PublishIdentityStateRequestObject
+// DeleteVerificationQuery operation middleware
+func (sh *strictHandler) DeleteVerificationQuery(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeleteVerificationQueryRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PublishIdentityState(ctx, request.(PublishIdentityStateRequestObject))
+		return sh.ssi.DeleteVerificationQuery(ctx, request.(DeleteVerificationQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PublishIdentityState")
+		handler = middleware(handler, "DeleteVerificationQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PublishIdentityStateResponseObject); ok {
-		if err := validResponse.VisitPublishIdentityStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteVerificationQueryResponseObject); ok {
+		if err := validResponse.VisitDeleteVerificationQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8988,25 +16138,26 @@ func (sh *strictHandler) PublishIdentityState(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// RetryPublishState operation middleware
-func (sh *strictHandler) RetryPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request RetryPublishStateRequestObject
+// GetVerificationQuery operation middleware
+func (sh *strictHandler) GetVerificationQuery(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetVerificationQueryRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RetryPublishState(ctx, request.(RetryPublishStateRequestObject))
+		return sh.ssi.GetVerificationQuery(ctx, request.(GetVerificationQueryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RetryPublishState")
+		handler = middleware(handler, "GetVerificationQuery")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RetryPublishStateResponseObject); ok {
-		if err := validResponse.VisitRetryPublishStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVerificationQueryResponseObject); ok {
+		if err := validResponse.VisitGetVerificationQueryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9014,25 +16165,26 @@ func (sh *strictHandler) RetryPublishState(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetStateStatus operation middleware
-func (sh *strictHandler) GetStateStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request GetStateStatusRequestObject
+// CreateVerificationSession operation middleware
+func (sh *strictHandler) CreateVerificationSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request CreateVerificationSessionRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStateStatus(ctx, request.(GetStateStatusRequestObject))
+		return sh.ssi.CreateVerificationSession(ctx, request.(CreateVerificationSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStateStatus")
+		handler = middleware(handler, "CreateVerificationSession")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStateStatusResponseObject); ok {
-		if err := validResponse.VisitGetStateStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateVerificationSessionResponseObject); ok {
+		if err := validResponse.VisitCreateVerificationSessionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9040,26 +16192,26 @@ func (sh *strictHandler) GetStateStatus(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetStateTransactions operation middleware
-func (sh *strictHandler) GetStateTransactions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetStateTransactionsParams) {
-	var request GetStateTransactionsRequestObject
+// GetVerificationSession operation middleware
+func (sh *strictHandler) GetVerificationSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetVerificationSessionRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStateTransactions(ctx, request.(GetStateTransactionsRequestObject))
+		return sh.ssi.GetVerificationSession(ctx, request.(GetVerificationSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStateTransactions")
+		handler = middleware(handler, "GetVerificationSession")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStateTransactionsResponseObject); ok {
-		if err := validResponse.VisitGetStateTransactionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVerificationSessionResponseObject); ok {
+		if err := validResponse.VisitGetVerificationSessionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9141,6 +16293,40 @@ func (sh *strictHandler) GetSupportedNetworks(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// VerificationCallback operation middleware
+func (sh *strictHandler) VerificationCallback(w http.ResponseWriter, r *http.Request, params VerificationCallbackParams) {
+	var request VerificationCallbackRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := VerificationCallbackTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerificationCallback(ctx, request.(VerificationCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerificationCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerificationCallbackResponseObject); ok {
+		if err := validResponse.VisitVerificationCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Authentication operation middleware
 func (sh *strictHandler) Authentication(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params AuthenticationParams) {
 	var request AuthenticationRequestObject
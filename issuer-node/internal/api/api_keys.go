@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// CreateAPIKey - creates an API key
+func (s *Server) CreateAPIKey(ctx context.Context, request CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error) {
+	identities, err := parseAPIKeyIdentities(request.Body.Identities)
+	if err != nil {
+		return CreateAPIKey400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+
+	apiKey, key, err := s.apiKeyService.Create(ctx, ports.APIKeyRequest{
+		Name:        request.Body.Name,
+		Identities:  identities,
+		Permissions: request.Body.Permissions,
+	})
+	if err != nil {
+		if isInvalidAPIKeyError(err) {
+			return CreateAPIKey400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating api key", "err", err)
+		return CreateAPIKey500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateAPIKey201JSONResponse{ApiKey: toAPIKeyResponse(apiKey), Key: key}, nil
+}
+
+// GetAPIKeys - returns all the API keys
+func (s *Server) GetAPIKeys(ctx context.Context, _ GetAPIKeysRequestObject) (GetAPIKeysResponseObject, error) {
+	apiKeys, err := s.apiKeyService.GetAll(ctx)
+	if err != nil {
+		log.Error(ctx, "getting api keys", "err", err)
+		return GetAPIKeys500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	response := make(GetAPIKeys200JSONResponse, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		response = append(response, toAPIKeyResponse(apiKey))
+	}
+	return response, nil
+}
+
+// GetAPIKey - returns an API key
+func (s *Server) GetAPIKey(ctx context.Context, request GetAPIKeyRequestObject) (GetAPIKeyResponseObject, error) {
+	apiKey, err := s.apiKeyService.GetByID(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyNotFound) {
+			return GetAPIKey404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting api key", "err", err, "id", request.Id)
+		return GetAPIKey500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetAPIKey200JSONResponse(toAPIKeyResponse(apiKey)), nil
+}
+
+// UpdateAPIKey - updates the name, the identities or the permissions of an API key
+func (s *Server) UpdateAPIKey(ctx context.Context, request UpdateAPIKeyRequestObject) (UpdateAPIKeyResponseObject, error) {
+	req := ports.UpdateAPIKeyRequest{Name: request.Body.Name}
+	if request.Body.Identities != nil {
+		identities, err := parseAPIKeyIdentities(*request.Body.Identities)
+		if err != nil {
+			return UpdateAPIKey400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		req.Identities = identities
+	}
+	if request.Body.Permissions != nil {
+		req.Permissions = *request.Body.Permissions
+	}
+
+	apiKey, err := s.apiKeyService.Update(ctx, request.Id, req)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyNotFound) {
+			return UpdateAPIKey404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if isInvalidAPIKeyError(err) {
+			return UpdateAPIKey400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "updating api key", "err", err, "id", request.Id)
+		return UpdateAPIKey500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return UpdateAPIKey200JSONResponse(toAPIKeyResponse(apiKey)), nil
+}
+
+// DeleteAPIKey - deletes an API key
+func (s *Server) DeleteAPIKey(ctx context.Context, request DeleteAPIKeyRequestObject) (DeleteAPIKeyResponseObject, error) {
+	if err := s.apiKeyService.Delete(ctx, request.Id); err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyNotFound) {
+			return DeleteAPIKey404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting api key", "err", err, "id", request.Id)
+		return DeleteAPIKey500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return DeleteAPIKey200JSONResponse{Message: "API key deleted"}, nil
+}
+
+// parseAPIKeyIdentities returns an empty, not nil, slice when there are no identities so that the update
+// request does not take it as unchanged
+func parseAPIKeyIdentities(identities []string) ([]w3c.DID, error) {
+	dids := make([]w3c.DID, 0, len(identities))
+	for _, identity := range identities {
+		did, err := w3c.ParseDID(identity)
+		if err != nil {
+			return nil, errors.New("invalid did: " + identity)
+		}
+		dids = append(dids, *did)
+	}
+	return dids, nil
+}
+
+func isInvalidAPIKeyError(err error) bool {
+	return errors.Is(err, services.ErrAPIKeyEmptyName) ||
+		errors.Is(err, services.ErrAPIKeyNoIdentities) ||
+		errors.Is(err, services.ErrAPIKeyNoPermissions) ||
+		errors.Is(err, services.ErrAPIKeyInvalidPermission) ||
+		errors.Is(err, services.ErrAPIKeyUnknownIdentity)
+}
+
+func toAPIKeyResponse(apiKey *domain.APIKey) APIKey {
+	response := APIKey{
+		Id:          apiKey.ID,
+		Name:        apiKey.Name,
+		Identities:  apiKey.Identities,
+		Permissions: apiKey.Permissions,
+		CreatedAt:   TimeUTC(apiKey.CreatedAt),
+	}
+	if apiKey.LastUsedAt != nil {
+		lastUsedAt := TimeUTC(*apiKey.LastUsedAt)
+		response.LastUsedAt = &lastUsedAt
+	}
+	return response
+}
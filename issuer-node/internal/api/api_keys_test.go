@@ -0,0 +1,203 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_APIKeys(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	otherIden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	do := func(t *testing.T, method string, url string, body any, apiKey string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		var req *http.Request
+		if body != nil {
+			req, err = http.NewRequest(method, url, tests.JSONBody(t, body))
+		} else {
+			req, err = http.NewRequest(method, url, nil)
+		}
+		require.NoError(t, err)
+		if apiKey != "" {
+			req.Header.Set(apiKeyHeader, apiKey)
+		} else {
+			req.SetBasicAuth(authOk())
+		}
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			request CreateAPIKeyRequest
+		}{
+			{
+				name:    "Empty name",
+				request: CreateAPIKeyRequest{Identities: []string{iden.Identifier}, Permissions: []APIKeyPermission{domain.APIKeyPermissionLinksRead}},
+			},
+			{
+				name:    "No identities",
+				request: CreateAPIKeyRequest{Name: "no identities", Identities: []string{}, Permissions: []APIKeyPermission{domain.APIKeyPermissionLinksRead}},
+			},
+			{
+				name:    "Invalid did",
+				request: CreateAPIKeyRequest{Name: "invalid did", Identities: []string{"not a did"}, Permissions: []APIKeyPermission{domain.APIKeyPermissionLinksRead}},
+			},
+			{
+				name:    "Unknown identity",
+				request: CreateAPIKeyRequest{Name: "unknown identity", Identities: []string{"did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"}, Permissions: []APIKeyPermission{domain.APIKeyPermissionLinksRead}},
+			},
+			{
+				name:    "No permissions",
+				request: CreateAPIKeyRequest{Name: "no permissions", Identities: []string{iden.Identifier}, Permissions: []APIKeyPermission{}},
+			},
+			{
+				name:    "Invalid permission",
+				request: CreateAPIKeyRequest{Name: "invalid permission", Identities: []string{iden.Identifier}, Permissions: []APIKeyPermission{"credentials:delete"}},
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := do(t, http.MethodPost, "/v2/api-keys", tc.request, "")
+				require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+			})
+		}
+	})
+
+	rr := do(t, http.MethodPost, "/v2/api-keys", CreateAPIKeyRequest{
+		Name:        "backoffice",
+		Identities:  []string{iden.Identifier},
+		Permissions: []APIKeyPermission{domain.APIKeyPermissionIdentitiesRead, domain.APIKeyPermissionLinksRead},
+	}, "")
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var created CreateAPIKeyResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+	require.NotEmpty(t, created.Key)
+	assert.Equal(t, "backoffice", created.ApiKey.Name)
+	assert.Equal(t, []string{iden.Identifier}, created.ApiKey.Identities)
+	assert.Nil(t, created.ApiKey.LastUsedAt)
+	apiKeyURL := fmt.Sprintf("/v2/api-keys/%s", created.ApiKey.Id)
+
+	t.Run("Authorization with the api key", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			method   string
+			url      string
+			key      string
+			httpCode int
+		}{
+			{
+				name:     "Allowed identity and permission",
+				method:   http.MethodGet,
+				url:      fmt.Sprintf("/v2/identities/%s", iden.Identifier),
+				key:      created.Key,
+				httpCode: http.StatusOK,
+			},
+			{
+				name:     "Other identity",
+				method:   http.MethodGet,
+				url:      fmt.Sprintf("/v2/identities/%s", otherIden.Identifier),
+				key:      created.Key,
+				httpCode: http.StatusForbidden,
+			},
+			{
+				name:     "Missing permission",
+				method:   http.MethodGet,
+				url:      fmt.Sprintf("/v2/identities/%s/credential-templates", iden.Identifier),
+				key:      created.Key,
+				httpCode: http.StatusForbidden,
+			},
+			{
+				name:     "Operation reserved to the basic auth user",
+				method:   http.MethodGet,
+				url:      "/v2/api-keys",
+				key:      created.Key,
+				httpCode: http.StatusForbidden,
+			},
+			{
+				name:     "Unknown key",
+				method:   http.MethodGet,
+				url:      fmt.Sprintf("/v2/identities/%s", iden.Identifier),
+				key:      "isk_unknown",
+				httpCode: http.StatusUnauthorized,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := do(t, tc.method, tc.url, nil, tc.key)
+				assert.Equal(t, tc.httpCode, rr.Code, rr.Body.String())
+			})
+		}
+	})
+
+	t.Run("Get api key", func(t *testing.T) {
+		rr := do(t, http.MethodGet, apiKeyURL, nil, "")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response APIKey
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, created.ApiKey.Id, response.Id)
+		assert.NotNil(t, response.LastUsedAt)
+
+		rr = do(t, http.MethodGet, "/v2/api-keys", nil, "")
+		require.Equal(t, http.StatusOK, rr.Code)
+		var all []APIKey
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &all))
+		assert.NotEmpty(t, all)
+	})
+
+	t.Run("Update api key", func(t *testing.T) {
+		rr := do(t, http.MethodPatch, apiKeyURL, UpdateAPIKeyRequest{
+			Identities:  &[]string{iden.Identifier, otherIden.Identifier},
+			Permissions: &[]APIKeyPermission{domain.APIKeyPermissionCredentialsRead},
+		}, "")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response APIKey
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "backoffice", response.Name)
+		assert.Equal(t, []APIKeyPermission{domain.APIKeyPermissionCredentialsRead}, response.Permissions)
+
+		rr = do(t, http.MethodGet, fmt.Sprintf("/v2/identities/%s/credential-templates", otherIden.Identifier), nil, created.Key)
+		assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		rr = do(t, http.MethodGet, fmt.Sprintf("/v2/identities/%s", iden.Identifier), nil, created.Key)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+
+		rr = do(t, http.MethodPatch, apiKeyURL, UpdateAPIKeyRequest{Name: common.ToPointer(" ")}, "")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Delete api key", func(t *testing.T) {
+		rr := do(t, http.MethodDelete, apiKeyURL, nil, "")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = do(t, http.MethodGet, fmt.Sprintf("/v2/identities/%s/credential-templates", otherIden.Identifier), nil, created.Key)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		rr = do(t, http.MethodGet, apiKeyURL, nil, "")
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		rr = do(t, http.MethodDelete, apiKeyURL, nil, "")
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
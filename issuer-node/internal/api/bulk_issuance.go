@@ -0,0 +1,281 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// bulkIssuanceMaxMemory is the part of the uploaded file kept in memory. The rest is stored in temporary files.
+const bulkIssuanceMaxMemory = 32 << 20
+
+// CreateBulkIssuanceJob - creates a job that issues a credential for every row of the uploaded file
+func (s *Server) CreateBulkIssuanceJob(ctx context.Context, request CreateBulkIssuanceJobRequestObject) (CreateBulkIssuanceJobResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateBulkIssuanceJob400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	form, err := request.Body.ReadForm(bulkIssuanceMaxMemory)
+	if err != nil {
+		log.Error(ctx, "reading bulk issuance form", "err", err)
+		return CreateBulkIssuanceJob400JSONResponse{N400JSONResponse{Message: "invalid multipart form"}}, nil
+	}
+	defer func() {
+		if err := form.RemoveAll(); err != nil {
+			log.Warn(ctx, "removing bulk issuance form temporary files", "err", err)
+		}
+	}()
+
+	req, err := s.bulkIssuanceRequestFromForm(ctx, did, form)
+	if err != nil {
+		if errors.Is(err, repositories.ErrCredentialTemplateNotFound) {
+			return CreateBulkIssuanceJob404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateBulkIssuanceJob400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+
+	files := form.File["file"]
+	if len(files) != 1 {
+		return CreateBulkIssuanceJob400JSONResponse{N400JSONResponse{Message: "exactly one file is required"}}, nil
+	}
+	file, err := files[0].Open()
+	if err != nil {
+		log.Error(ctx, "opening bulk issuance file", "err", err)
+		return CreateBulkIssuanceJob500JSONResponse{N500JSONResponse{Message: "cannot read the uploaded file"}}, nil
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Warn(ctx, "closing bulk issuance file", "err", err)
+		}
+	}()
+	req.Data = file
+	if req.Format == "" {
+		req.Format = bulkIssuanceFormatFromFilename(files[0].Filename)
+	}
+
+	job, err := s.bulkIssuanceService.Create(ctx, req)
+	if err != nil {
+		log.Error(ctx, "creating bulk issuance job", "err", err)
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return CreateBulkIssuanceJob404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		errs := []error{
+			services.ErrBulkIssuanceEmptyFile,
+			services.ErrBulkIssuanceMalformedFile,
+			services.ErrBulkIssuanceUnsupportedFormat,
+			services.ErrLoadingSchema,
+			services.ErrProcessSchema,
+		}
+		for _, e := range errs {
+			if errors.Is(err, e) {
+				return CreateBulkIssuanceJob400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+		}
+		return CreateBulkIssuanceJob500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateBulkIssuanceJob202JSONResponse(toBulkIssuanceJobResponse(job)), nil
+}
+
+// GetBulkIssuanceJob - returns the status of a bulk issuance job
+func (s *Server) GetBulkIssuanceJob(ctx context.Context, request GetBulkIssuanceJobRequestObject) (GetBulkIssuanceJobResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetBulkIssuanceJob400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	job, err := s.bulkIssuanceService.GetByID(ctx, *did, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrBulkIssuanceJobNotFound) {
+			return GetBulkIssuanceJob404JSONResponse{N404JSONResponse{Message: "bulk issuance job not found"}}, nil
+		}
+		log.Error(ctx, "getting bulk issuance job", "err", err, "id", request.Id)
+		return GetBulkIssuanceJob500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetBulkIssuanceJob200JSONResponse(toBulkIssuanceJobResponse(job)), nil
+}
+
+// GetBulkIssuanceJobRows - returns the per row results of a bulk issuance job
+func (s *Server) GetBulkIssuanceJobRows(ctx context.Context, request GetBulkIssuanceJobRowsRequestObject) (GetBulkIssuanceJobRowsResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetBulkIssuanceJobRows400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	filter := getBulkIssuanceRowsFilter(request)
+	rows, total, err := s.bulkIssuanceService.GetRows(ctx, *did, request.Id, filter)
+	if err != nil {
+		if errors.Is(err, repositories.ErrBulkIssuanceJobNotFound) {
+			return GetBulkIssuanceJobRows404JSONResponse{N404JSONResponse{Message: "bulk issuance job not found"}}, nil
+		}
+		log.Error(ctx, "getting bulk issuance job rows", "err", err, "id", request.Id)
+		return GetBulkIssuanceJobRows500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	items := make([]BulkIssuanceJobRow, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, BulkIssuanceJobRow{
+			RowNumber:         row.RowNumber,
+			Status:            BulkIssuanceJobRowStatus(row.Status),
+			CredentialSubject: CredentialSubject(row.CredentialSubject),
+			CredentialID:      row.CredentialID,
+			Error:             row.Error,
+		})
+	}
+	return GetBulkIssuanceJobRows200JSONResponse{
+		Items: items,
+		Meta: PaginatedMetadata{
+			Total:      total,
+			Page:       filter.Page,
+			MaxResults: filter.MaxResults,
+		},
+	}, nil
+}
+
+// bulkIssuanceRequestFromForm builds the service request from the multipart form values. The file is set by the caller.
+func (s *Server) bulkIssuanceRequestFromForm(ctx context.Context, did *w3c.DID, form *multipart.Form) (*ports.CreateBulkIssuanceJobRequest, error) {
+	req := &ports.CreateBulkIssuanceJobRequest{DID: *did}
+
+	var template *ports.CredentialTemplateIssuance
+	if templateID := formValue(form, "templateID"); templateID != "" {
+		id, err := uuid.Parse(templateID)
+		if err != nil {
+			return nil, errors.New("invalid templateID")
+		}
+		template, err = s.credentialTemplateService.Issuance(ctx, *did, id, nil, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		req.SchemaID = template.Schema.ID
+		req.Expiration = template.CredentialExpiration
+		req.ClaimRequestProofs = template.ClaimRequestProofs
+		req.CredentialSubjectDefaults = template.CredentialSubject
+		req.RefreshService = template.RefreshService
+		req.DisplayMethod = template.DisplayMethod
+	}
+
+	if schemaID := formValue(form, "schemaID"); schemaID != "" || template == nil {
+		id, err := uuid.Parse(schemaID)
+		if err != nil {
+			return nil, errors.New("invalid schemaID")
+		}
+		req.SchemaID = id
+	}
+
+	switch format := formValue(form, "format"); format {
+	case "":
+	case string(domain.BulkIssuanceFormatCSV), string(domain.BulkIssuanceFormatNDJSON):
+		req.Format = domain.BulkIssuanceFormat(format)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+
+	if expiration := formValue(form, "expiration"); expiration != "" {
+		ts, err := strconv.ParseInt(expiration, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid expiration")
+		}
+		req.Expiration = common.ToPointer(time.Unix(ts, 0))
+	}
+
+	proofs := make([]string, 0)
+	for _, value := range form.Value["proofs"] {
+		for _, proof := range strings.Split(value, ",") {
+			if proof = strings.TrimSpace(proof); proof != "" {
+				proofs = append(proofs, proof)
+			}
+		}
+	}
+	if len(proofs) == 0 && template == nil {
+		req.ClaimRequestProofs.BJJSignatureProof2021 = true
+		req.ClaimRequestProofs.Iden3SparseMerkleTreeProof = true
+	}
+	if len(proofs) > 0 {
+		req.ClaimRequestProofs = ports.ClaimRequestProofs{}
+	}
+	for _, proof := range proofs {
+		switch proof {
+		case string(verifiable.BJJSignatureProofType):
+			req.ClaimRequestProofs.BJJSignatureProof2021 = true
+		case string(verifiable.Iden3SparseMerkleTreeProofType):
+			req.ClaimRequestProofs.Iden3SparseMerkleTreeProof = true
+		default:
+			return nil, fmt.Errorf("unsupported proof type: %s", proof)
+		}
+	}
+
+	credentialStatusType, err := s.supportedCredentialStatusType(ctx, did, common.ToPointer(formValue(form, "credentialStatusType")))
+	if err != nil {
+		return nil, err
+	}
+	req.CredentialStatusType = *credentialStatusType
+
+	return req, nil
+}
+
+func formValue(form *multipart.Form, key string) string {
+	if values := form.Value[key]; len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+func bulkIssuanceFormatFromFilename(filename string) domain.BulkIssuanceFormat {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".ndjson", ".jsonl":
+		return domain.BulkIssuanceFormatNDJSON
+	default:
+		return domain.BulkIssuanceFormatCSV
+	}
+}
+
+func getBulkIssuanceRowsFilter(req GetBulkIssuanceJobRowsRequestObject) ports.BulkIssuanceRowsFilter {
+	filter := ports.BulkIssuanceRowsFilter{}
+	if req.Params.Status != nil {
+		filter.Status = common.ToPointer(domain.BulkIssuanceRowStatus(*req.Params.Status))
+	}
+	filter.MaxResults = 50
+	if req.Params.MaxResults != nil {
+		if *req.Params.MaxResults <= 0 {
+			filter.MaxResults = 10
+		} else {
+			filter.MaxResults = *req.Params.MaxResults
+		}
+	}
+	filter.Page = uint(1)
+	if req.Params.Page != nil && *req.Params.Page > 0 {
+		filter.Page = *req.Params.Page
+	}
+	return filter
+}
+
+func toBulkIssuanceJobResponse(job *domain.BulkIssuanceJob) BulkIssuanceJob {
+	return BulkIssuanceJob{
+		Id:          job.ID,
+		SchemaID:    job.SchemaID,
+		Format:      BulkIssuanceJobFormat(job.Format),
+		Status:      BulkIssuanceJobStatus(job.Status),
+		TotalRows:   job.TotalRows,
+		PendingRows: job.PendingRows,
+		IssuedRows:  job.IssuedRows,
+		FailedRows:  job.FailedRows,
+		CreatedAt:   TimeUTC(job.CreatedAt),
+		UpdatedAt:   TimeUTC(job.UpdatedAt),
+	}
+}
@@ -0,0 +1,234 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+func TestServer_BulkIssuanceJob(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(url, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription"), nil))
+	require.NoError(t, err)
+
+	type expected struct {
+		httpCode int
+		message  string
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		fields   map[string]string
+		filename string
+		file     string
+		expected expected
+	}
+
+	var jobID uuid.UUID
+	for _, tc := range []testConfig{
+		{
+			name: "No auth header",
+			auth: authWrong,
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:     "Wrong schema id",
+			auth:     authOk,
+			fields:   map[string]string{"schemaID": "wrong"},
+			filename: "rows.csv",
+			file:     "id,birthday,documentType\n",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid schemaID",
+			},
+		},
+		{
+			name:     "Schema not found",
+			auth:     authOk,
+			fields:   map[string]string{"schemaID": uuid.NewString()},
+			filename: "rows.csv",
+			file:     "id,birthday,documentType\n" + userDID + ",19960424,2\n",
+			expected: expected{
+				httpCode: http.StatusNotFound,
+				message:  "schema not found",
+			},
+		},
+		{
+			name:     "Empty file",
+			auth:     authOk,
+			fields:   map[string]string{"schemaID": importedSchema.ID.String()},
+			filename: "rows.csv",
+			file:     "id,birthday,documentType\n",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "bulk issuance file does not contain any row",
+			},
+		},
+		{
+			name:     "Unsupported proof",
+			auth:     authOk,
+			fields:   map[string]string{"schemaID": importedSchema.ID.String(), "proofs": "wrong"},
+			filename: "rows.csv",
+			file:     "id,birthday,documentType\n" + userDID + ",19960424,2\n",
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "unsupported proof type: wrong",
+			},
+		},
+		{
+			name:     "Happy path",
+			auth:     authOk,
+			fields:   map[string]string{"schemaID": importedSchema.ID.String(), "proofs": "BJJSignature2021"},
+			filename: "rows.csv",
+			file:     "id,birthday,documentType\n" + userDID + ",19960424,2\n" + userDID + ",19960425,wrong\n" + userDID + ",19960426,3\n",
+			expected: expected{
+				httpCode: http.StatusAccepted,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			for k, v := range tc.fields {
+				require.NoError(t, writer.WriteField(k, v))
+			}
+			if tc.filename != "" {
+				part, err := writer.CreateFormFile("file", tc.filename)
+				require.NoError(t, err)
+				_, err = part.Write([]byte(tc.file))
+				require.NoError(t, err)
+			}
+			require.NoError(t, writer.Close())
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials/bulk", did), body)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+
+			switch tc.expected.httpCode {
+			case http.StatusAccepted:
+				var response BulkIssuanceJob
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, importedSchema.ID, response.SchemaID)
+				assert.Equal(t, BulkIssuanceJobFormatCsv, response.Format)
+				assert.Equal(t, BulkIssuanceJobStatusPending, response.Status)
+				assert.Equal(t, 3, response.TotalRows)
+				assert.Equal(t, 2, response.PendingRows)
+				assert.Equal(t, 1, response.FailedRows)
+				jobID = response.Id
+			case http.StatusBadRequest, http.StatusNotFound:
+				var response GenericErrorMessage
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+
+	server.Infra.pubSub.Clear(event.CreateCredentialEvent)
+	require.NoError(t, server.Services.bulkIssuance.ProcessPendingJobs(ctx))
+	assert.Len(t, server.Infra.pubSub.AllPublishedEvents(event.CreateCredentialEvent), 1)
+
+	t.Run("Get job", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials/bulk/%s", did, jobID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response BulkIssuanceJob
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, BulkIssuanceJobStatusCompleted, response.Status)
+		assert.Equal(t, 0, response.PendingRows)
+		assert.Equal(t, 2, response.IssuedRows)
+		assert.Equal(t, 1, response.FailedRows)
+	})
+
+	t.Run("Get job not found", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials/bulk/%s", did, uuid.New()), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Get failed rows", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials/bulk/%s/rows?status=failed", did, jobID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response BulkIssuanceJobRowsPaginated
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Items, 1)
+		assert.Equal(t, uint(1), response.Meta.Total)
+		assert.Equal(t, 2, response.Items[0].RowNumber)
+		assert.Equal(t, BulkIssuanceJobRowStatusFailed, response.Items[0].Status)
+		assert.Nil(t, response.Items[0].CredentialID)
+		require.NotNil(t, response.Items[0].Error)
+		assert.Contains(t, *response.Items[0].Error, "column documentType")
+	})
+
+	t.Run("Get issued rows", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials/bulk/%s/rows?status=issued", did, jobID), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response BulkIssuanceJobRowsPaginated
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Items, 2)
+		for _, row := range response.Items {
+			require.NotNil(t, row.CredentialID)
+			credential, err := server.Services.credentials.GetByID(ctx, did, *row.CredentialID)
+			require.NoError(t, err)
+			assert.Equal(t, userDID, credential.OtherIdentifier)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// CreateCredentialExpirationPolicy - creates a credential expiration policy
+func (s *Server) CreateCredentialExpirationPolicy(ctx context.Context, request CreateCredentialExpirationPolicyRequestObject) (CreateCredentialExpirationPolicyResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateCredentialExpirationPolicy400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	policy, err := s.credentialExpirationService.SavePolicy(ctx, *did, toCredentialExpirationPolicyRequest(request.Body))
+	if err != nil {
+		log.Error(ctx, "creating credential expiration policy", "err", err)
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			return CreateCredentialExpirationPolicy404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if isInvalidCredentialExpirationPolicyError(err) {
+			return CreateCredentialExpirationPolicy400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateCredentialExpirationPolicy500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateCredentialExpirationPolicy201JSONResponse(toCredentialExpirationPolicyResponse(policy)), nil
+}
+
+// GetCredentialExpirationPolicies - returns the credential expiration policies of the identity
+func (s *Server) GetCredentialExpirationPolicies(ctx context.Context, request GetCredentialExpirationPoliciesRequestObject) (GetCredentialExpirationPoliciesResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetCredentialExpirationPolicies400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	policies, err := s.credentialExpirationService.GetPolicies(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "getting credential expiration policies", "err", err)
+		return GetCredentialExpirationPolicies500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	response := make(GetCredentialExpirationPolicies200JSONResponse, 0, len(policies))
+	for i := range policies {
+		response = append(response, toCredentialExpirationPolicyResponse(&policies[i]))
+	}
+	return response, nil
+}
+
+// UpdateCredentialExpirationPolicy - replaces the values of a credential expiration policy
+func (s *Server) UpdateCredentialExpirationPolicy(ctx context.Context, request UpdateCredentialExpirationPolicyRequestObject) (UpdateCredentialExpirationPolicyResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return UpdateCredentialExpirationPolicy400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	policy, err := s.credentialExpirationService.UpdatePolicy(ctx, *did, request.Id, toCredentialExpirationPolicyRequest(request.Body))
+	if err != nil {
+		log.Error(ctx, "updating credential expiration policy", "err", err, "id", request.Id)
+		if errors.Is(err, repositories.ErrCredentialExpirationPolicyNotFound) || errors.Is(err, services.ErrSchemaNotFound) {
+			return UpdateCredentialExpirationPolicy404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if isInvalidCredentialExpirationPolicyError(err) {
+			return UpdateCredentialExpirationPolicy400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return UpdateCredentialExpirationPolicy500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return UpdateCredentialExpirationPolicy200JSONResponse(toCredentialExpirationPolicyResponse(policy)), nil
+}
+
+// DeleteCredentialExpirationPolicy - deletes a credential expiration policy
+func (s *Server) DeleteCredentialExpirationPolicy(ctx context.Context, request DeleteCredentialExpirationPolicyRequestObject) (DeleteCredentialExpirationPolicyResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return DeleteCredentialExpirationPolicy400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	if err := s.credentialExpirationService.DeletePolicy(ctx, *did, request.Id); err != nil {
+		if errors.Is(err, repositories.ErrCredentialExpirationPolicyNotFound) {
+			return DeleteCredentialExpirationPolicy404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting credential expiration policy", "err", err, "id", request.Id)
+		return DeleteCredentialExpirationPolicy500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return DeleteCredentialExpirationPolicy200JSONResponse{Message: "credential expiration policy deleted"}, nil
+}
+
+func toCredentialExpirationPolicyRequest(body *CredentialExpirationPolicyRequest) ports.CredentialExpirationPolicyRequest {
+	return ports.CredentialExpirationPolicyRequest{
+		SchemaID:         body.SchemaID,
+		NotifyDaysBefore: body.NotifyDaysBefore,
+		AutoRevoke:       body.AutoRevoke,
+	}
+}
+
+func toCredentialExpirationPolicyResponse(policy *domain.CredentialExpirationPolicy) CredentialExpirationPolicy {
+	return CredentialExpirationPolicy{
+		Id:               policy.ID,
+		SchemaID:         policy.SchemaID,
+		NotifyDaysBefore: policy.NotifyDaysBefore,
+		AutoRevoke:       policy.AutoRevoke,
+		CreatedAt:        TimeUTC(policy.CreatedAt),
+		UpdatedAt:        TimeUTC(policy.UpdatedAt),
+	}
+}
+
+func isInvalidCredentialExpirationPolicyError(err error) bool {
+	return errors.Is(err, services.ErrCredentialExpirationPolicyNoAction) ||
+		errors.Is(err, services.ErrCredentialExpirationPolicyInvalidNotifyDays) ||
+		errors.Is(err, repositories.ErrCredentialExpirationPolicyDuplicated)
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_CredentialExpirationPolicies(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(url, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription"), nil))
+	require.NoError(t, err)
+
+	policiesURL := fmt.Sprintf("/v2/identities/%s/credential-expiration-policies", did)
+	do := func(t *testing.T, method string, url string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		var req *http.Request
+		if body != nil {
+			req, err = http.NewRequest(method, url, tests.JSONBody(t, body))
+		} else {
+			req, err = http.NewRequest(method, url, nil)
+		}
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			request  CredentialExpirationPolicyRequest
+			httpCode int
+			message  string
+		}{
+			{
+				name:     "No action",
+				request:  CredentialExpirationPolicyRequest{},
+				httpCode: http.StatusBadRequest,
+				message:  "the policy should send renewal notices or revoke the expired credentials",
+			},
+			{
+				name:     "Invalid notify days",
+				request:  CredentialExpirationPolicyRequest{NotifyDaysBefore: common.ToPointer(0)},
+				httpCode: http.StatusBadRequest,
+				message:  "notifyDaysBefore must be greater than 0",
+			},
+			{
+				name:     "Schema not found",
+				request:  CredentialExpirationPolicyRequest{SchemaID: common.ToPointer(uuid.New()), AutoRevoke: true},
+				httpCode: http.StatusNotFound,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := do(t, http.MethodPost, policiesURL, tc.request)
+				require.Equal(t, tc.httpCode, rr.Code)
+				if tc.message != "" {
+					var response GenericErrorMessage
+					require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+					assert.Equal(t, tc.message, response.Message)
+				}
+			})
+		}
+	})
+
+	rr := do(t, http.MethodPost, policiesURL, CredentialExpirationPolicyRequest{NotifyDaysBefore: common.ToPointer(7), AutoRevoke: true})
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var identityPolicy CredentialExpirationPolicy
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &identityPolicy))
+	assert.Nil(t, identityPolicy.SchemaID)
+	assert.Equal(t, 7, *identityPolicy.NotifyDaysBefore)
+	assert.True(t, identityPolicy.AutoRevoke)
+
+	t.Run("Duplicated policy", func(t *testing.T) {
+		rr := do(t, http.MethodPost, policiesURL, CredentialExpirationPolicyRequest{AutoRevoke: true})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Schema policy", func(t *testing.T) {
+		rr := do(t, http.MethodPost, policiesURL, CredentialExpirationPolicyRequest{SchemaID: &importedSchema.ID, NotifyDaysBefore: common.ToPointer(30)})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var schemaPolicy CredentialExpirationPolicy
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &schemaPolicy))
+		policyURL := fmt.Sprintf("%s/%s", policiesURL, schemaPolicy.Id)
+
+		rr = do(t, http.MethodPut, policyURL, CredentialExpirationPolicyRequest{SchemaID: &importedSchema.ID, AutoRevoke: true})
+		require.Equal(t, http.StatusOK, rr.Code)
+		var updated CredentialExpirationPolicy
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &updated))
+		assert.Nil(t, updated.NotifyDaysBefore)
+		assert.True(t, updated.AutoRevoke)
+
+		rr = do(t, http.MethodGet, policiesURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var policies []CredentialExpirationPolicy
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &policies))
+		require.Len(t, policies, 2)
+		assert.Equal(t, identityPolicy.Id, policies[0].Id)
+		assert.Equal(t, schemaPolicy.Id, policies[1].Id)
+
+		rr = do(t, http.MethodDelete, policyURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = do(t, http.MethodDelete, policyURL, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Sweep", func(t *testing.T) {
+		createCredential := func(t *testing.T, expiration time.Time) uuid.UUID {
+			t.Helper()
+			rr := do(t, http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials", did), CreateCredentialRequest{
+				CredentialSchema:  url,
+				Type:              schemaType,
+				CredentialSubject: map[string]any{"id": userDID, "birthday": 19960424, "documentType": 2},
+				Expiration:        common.ToPointer(expiration.Unix()),
+			})
+			require.Equal(t, http.StatusCreated, rr.Code)
+			var response CreateCredentialResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			id, err := uuid.Parse(response.Id)
+			require.NoError(t, err)
+			return id
+		}
+		expiringSoon := createCredential(t, time.Now().Add(48*time.Hour))
+		expiringLater := createCredential(t, time.Now().Add(30*24*time.Hour))
+		expired := createCredential(t, time.Now().Add(-time.Hour))
+
+		require.NoError(t, server.Services.credentialExpiration.Sweep(ctx))
+		require.NoError(t, server.Services.credentialExpiration.Sweep(ctx))
+		assert.Equal(t, []uuid.UUID{expiringSoon}, server.Mocks.notification.expirationNotices)
+
+		for id, revoked := range map[uuid.UUID]bool{expiringSoon: false, expiringLater: false, expired: true} {
+			credential, err := server.Services.credentials.GetByID(ctx, did, id)
+			require.NoError(t, err)
+			assert.Equal(t, revoked, credential.Revoked, id)
+		}
+	})
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+func TestServer_ExportCredential(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	createCredential := func(t *testing.T, jwtExport *CredentialJWTExport) *httptest.ResponseRecorder {
+		t.Helper()
+		body := CreateCredentialRequest{
+			CredentialSchema: schemaURL,
+			Type:             schemaType,
+			CredentialSubject: map[string]any{
+				"id":           userDID,
+				"birthday":     19960424,
+				"documentType": 2,
+			},
+			Expiration: common.ToPointer(time.Now().Add(365 * 24 * time.Hour).Unix()),
+			Proofs:     &[]CreateCredentialRequestProofs{"BJJSignature2021"},
+			JwtExport:  jwtExport,
+		}
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials", did), tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	getCredential := func(t *testing.T, id string, query url.Values) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials/%s?%s", did, id, query.Encode()), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := createCredential(t, nil)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var created CreateCredentialResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+	assert.Nil(t, created.Jwt)
+
+	t.Run("Identity without signing key", func(t *testing.T) {
+		rr := getCredential(t, created.Id, url.Values{"format": {"jwt-vc"}})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		var response GetCredential400JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "the identity needs an ETH or Ed25519 key to export credentials as JWT", response.Message)
+
+		rr = createCredential(t, &CredentialJWTExport{Format: CredentialJWTExportFormatJwtVc})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	keyID, err := server.Services.keyService.Create(ctx, did, kms.KeyTypeEthereum, "jwt export")
+	require.NoError(t, err)
+	bjjKeyID, err := server.Services.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, "bjj key")
+	require.NoError(t, err)
+
+	t.Run("Default format", func(t *testing.T) {
+		rr := getCredential(t, created.Id, url.Values{})
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response Credential
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Nil(t, response.Jwt)
+		assert.NotNil(t, response.Vc)
+	})
+
+	t.Run("Invalid key", func(t *testing.T) {
+		rr := getCredential(t, created.Id, url.Values{"format": {"jwt-vc"}, "keyID": {bjjKeyID.ID}})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		var response GetCredential400JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "the key must be an ETH or Ed25519 key of the identity", response.Message)
+	})
+
+	t.Run("Unknown disclosable field", func(t *testing.T) {
+		rr := getCredential(t, created.Id, url.Values{"format": {"sd-jwt-vc"}, "disclosableFields": {"name"}})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("JWT-VC", func(t *testing.T) {
+		rr := getCredential(t, created.Id, url.Values{"format": {"jwt-vc"}, "keyID": {keyID.ID}})
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response Credential
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.Jwt)
+		require.NotNil(t, response.Vc)
+		assert.NotEmpty(t, response.Vc.Proof)
+
+		header, payloadBytes := verifyES256KJWT(t, handler, *response.Jwt)
+		assert.Equal(t, "JWT", header["typ"])
+		var payload struct {
+			Iss string         `json:"iss"`
+			Sub string         `json:"sub"`
+			Jti string         `json:"jti"`
+			Exp int64          `json:"exp"`
+			VC  map[string]any `json:"vc"`
+		}
+		require.NoError(t, json.Unmarshal(payloadBytes, &payload))
+		assert.Equal(t, did.String(), payload.Iss)
+		assert.Equal(t, userDID, payload.Sub)
+		assert.Equal(t, response.Vc.ID, payload.Jti)
+		assert.Equal(t, response.Vc.Expiration.Unix(), payload.Exp)
+		assert.NotContains(t, payload.VC, "proof")
+		credentialSubject, ok := payload.VC["credentialSubject"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, response.Vc.CredentialSubject["birthday"], credentialSubject["birthday"])
+	})
+
+	t.Run("SD-JWT VC", func(t *testing.T) {
+		rr := getCredential(t, created.Id, url.Values{"format": {"sd-jwt-vc"}, "disclosableFields": {"birthday"}})
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response Credential
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.Jwt)
+		assertSDJWTVC(t, handler, *response.Jwt, did.String(), userDID)
+	})
+
+	t.Run("Issue with SD-JWT VC", func(t *testing.T) {
+		rr := createCredential(t, &CredentialJWTExport{Format: CredentialJWTExportFormatSdJwtVc, KeyID: &keyID.ID, DisclosableFields: &[]string{"birthday"}})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response CreateCredentialResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.Jwt)
+		assertSDJWTVC(t, handler, *response.Jwt, did.String(), userDID)
+	})
+}
+
+// assertSDJWTVC checks an SD-JWT VC of a KYCAgeCredential where only the birthday is selectively disclosable
+func assertSDJWTVC(t *testing.T, handler http.Handler, token string, issuer string, subject string) {
+	t.Helper()
+	require.True(t, strings.HasSuffix(token, "~"))
+	parts := strings.Split(strings.TrimSuffix(token, "~"), "~")
+	require.Len(t, parts, 2)
+
+	header, payloadBytes := verifyES256KJWT(t, handler, parts[0])
+	assert.Equal(t, "vc+sd-jwt", header["typ"])
+	var payload struct {
+		Iss          string   `json:"iss"`
+		Sub          string   `json:"sub"`
+		Vct          string   `json:"vct"`
+		SDAlg        string   `json:"_sd_alg"`
+		SD           []string `json:"_sd"`
+		Birthday     *int     `json:"birthday"`
+		DocumentType int      `json:"documentType"`
+	}
+	require.NoError(t, json.Unmarshal(payloadBytes, &payload))
+	assert.Equal(t, issuer, payload.Iss)
+	assert.Equal(t, subject, payload.Sub)
+	assert.Equal(t, "KYCAgeCredential", payload.Vct)
+	assert.Equal(t, "sha-256", payload.SDAlg)
+	assert.Nil(t, payload.Birthday)
+	assert.Equal(t, 2, payload.DocumentType)
+
+	digest := sha256.Sum256([]byte(parts[1]))
+	assert.Equal(t, []string{base64.RawURLEncoding.EncodeToString(digest[:])}, payload.SD)
+	disclosureBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var disclosure []any
+	require.NoError(t, json.Unmarshal(disclosureBytes, &disclosure))
+	require.Len(t, disclosure, 3)
+	assert.Equal(t, "birthday", disclosure[1])
+	assert.Equal(t, float64(19960424), disclosure[2])
+}
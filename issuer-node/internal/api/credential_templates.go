@@ -0,0 +1,269 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// CreateCredentialTemplate - creates a credential template
+func (s *Server) CreateCredentialTemplate(ctx context.Context, request CreateCredentialTemplateRequestObject) (CreateCredentialTemplateResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateCredentialTemplate400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	template, err := s.credentialTemplateService.Save(ctx, *did, toCredentialTemplateRequest(request.Body))
+	if err != nil {
+		log.Error(ctx, "creating credential template", "err", err)
+		if isCredentialTemplateReferenceNotFoundError(err) {
+			return CreateCredentialTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if isInvalidCredentialTemplateError(err) {
+			return CreateCredentialTemplate400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateCredentialTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateCredentialTemplate201JSONResponse(toCredentialTemplateResponse(template)), nil
+}
+
+// GetCredentialTemplates - returns the credential templates of the identity
+func (s *Server) GetCredentialTemplates(ctx context.Context, request GetCredentialTemplatesRequestObject) (GetCredentialTemplatesResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetCredentialTemplates400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	filter := ports.CredentialTemplateFilter{MaxResults: 50, Page: 1}
+	if request.Params.MaxResults != nil && *request.Params.MaxResults > 0 {
+		filter.MaxResults = *request.Params.MaxResults
+	}
+	if request.Params.Page != nil {
+		if *request.Params.Page == 0 {
+			return GetCredentialTemplates400JSONResponse{N400JSONResponse{Message: "page must be greater than 0"}}, nil
+		}
+		filter.Page = *request.Params.Page
+	}
+
+	templates, total, err := s.credentialTemplateService.GetAll(ctx, *did, filter)
+	if err != nil {
+		log.Error(ctx, "getting credential templates", "err", err)
+		return GetCredentialTemplates500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	items := make([]CredentialTemplate, 0, len(templates))
+	for i := range templates {
+		items = append(items, toCredentialTemplateResponse(&templates[i]))
+	}
+	return GetCredentialTemplates200JSONResponse{
+		Items: items,
+		Meta: PaginatedMetadata{
+			Total:      total,
+			Page:       filter.Page,
+			MaxResults: filter.MaxResults,
+		},
+	}, nil
+}
+
+// GetCredentialTemplate - returns a credential template
+func (s *Server) GetCredentialTemplate(ctx context.Context, request GetCredentialTemplateRequestObject) (GetCredentialTemplateResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetCredentialTemplate400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	template, err := s.credentialTemplateService.GetByID(ctx, *did, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrCredentialTemplateNotFound) {
+			return GetCredentialTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting credential template", "err", err, "id", request.Id)
+		return GetCredentialTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetCredentialTemplate200JSONResponse(toCredentialTemplateResponse(template)), nil
+}
+
+// UpdateCredentialTemplate - replaces the values of a credential template
+func (s *Server) UpdateCredentialTemplate(ctx context.Context, request UpdateCredentialTemplateRequestObject) (UpdateCredentialTemplateResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return UpdateCredentialTemplate400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	template, err := s.credentialTemplateService.Update(ctx, *did, request.Id, toCredentialTemplateRequest(request.Body))
+	if err != nil {
+		log.Error(ctx, "updating credential template", "err", err, "id", request.Id)
+		if errors.Is(err, repositories.ErrCredentialTemplateNotFound) || isCredentialTemplateReferenceNotFoundError(err) {
+			return UpdateCredentialTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if isInvalidCredentialTemplateError(err) {
+			return UpdateCredentialTemplate400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return UpdateCredentialTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return UpdateCredentialTemplate200JSONResponse(toCredentialTemplateResponse(template)), nil
+}
+
+// DeleteCredentialTemplate - deletes a credential template
+func (s *Server) DeleteCredentialTemplate(ctx context.Context, request DeleteCredentialTemplateRequestObject) (DeleteCredentialTemplateResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return DeleteCredentialTemplate400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	if err := s.credentialTemplateService.Delete(ctx, *did, request.Id); err != nil {
+		if errors.Is(err, repositories.ErrCredentialTemplateNotFound) {
+			return DeleteCredentialTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting credential template", "err", err, "id", request.Id)
+		return DeleteCredentialTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return DeleteCredentialTemplate200JSONResponse{Message: "credential template deleted"}, nil
+}
+
+// CreateCredentialFromTemplate - creates a credential with the values of a credential template
+func (s *Server) CreateCredentialFromTemplate(ctx context.Context, request CreateCredentialFromTemplateRequestObject) (CreateCredentialFromTemplateResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateCredentialFromTemplate400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	template, err := s.credentialTemplateService.Issuance(ctx, *did, request.Id, request.Body.CredentialSubject, time.Now())
+	if err != nil {
+		if errors.Is(err, repositories.ErrCredentialTemplateNotFound) || isCredentialTemplateReferenceNotFoundError(err) {
+			return CreateCredentialFromTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateCredentialFromTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	credentialStatusType, err := s.supportedCredentialStatusType(ctx, did, (*string)(request.Body.CredentialStatusType))
+	if err != nil {
+		return CreateCredentialFromTemplate400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+
+	req := ports.NewCreateClaimRequest(did, request.Body.ClaimID, template.Schema.URL, template.CredentialSubject, template.CredentialExpiration, template.Schema.Type, nil, nil, nil,
+		template.ClaimRequestProofs, nil, false, *credentialStatusType, template.RefreshService, request.Body.RevNonce, template.DisplayMethod, nil)
+	req.EncryptionPolicy = template.Schema.CredentialEncryption
+	resp, err := s.claimService.Save(ctx, req)
+	if err != nil {
+		log.Error(ctx, "creating credential from template", "err", err, "id", request.Id)
+		if errors.Is(err, services.ErrLoadingSchema) {
+			return CreateCredentialFromTemplate422JSONResponse{N422JSONResponse{Message: err.Error()}}, nil
+		}
+		if isInvalidCredentialRequestError(err) {
+			return CreateCredentialFromTemplate400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateCredentialFromTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateCredentialFromTemplate201JSONResponse{Id: resp.ID.String()}, nil
+}
+
+// CreateLinkFromTemplate - creates a credential link with the values of a credential template
+func (s *Server) CreateLinkFromTemplate(ctx context.Context, request CreateLinkFromTemplateRequestObject) (CreateLinkFromTemplateResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateLinkFromTemplate400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+	if request.Body.Expiration != nil && request.Body.Expiration.Before(time.Now()) {
+		return CreateLinkFromTemplate400JSONResponse{N400JSONResponse{Message: "invalid claimLinkExpiration. Cannot be a date time prior current time."}}, nil
+	}
+	if request.Body.LimitedClaims != nil && *request.Body.LimitedClaims <= 0 {
+		return CreateLinkFromTemplate400JSONResponse{N400JSONResponse{Message: "limitedClaims must be higher than 0"}}, nil
+	}
+
+	var attributes domain.CredentialSubject
+	if request.Body.CredentialSubject != nil {
+		attributes = *request.Body.CredentialSubject
+	}
+	template, err := s.credentialTemplateService.Issuance(ctx, *did, request.Id, attributes, time.Now())
+	if err != nil {
+		if errors.Is(err, repositories.ErrCredentialTemplateNotFound) || isCredentialTemplateReferenceNotFoundError(err) {
+			return CreateLinkFromTemplate404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateLinkFromTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	if len(template.CredentialSubject) == 0 {
+		return CreateLinkFromTemplate400JSONResponse{N400JSONResponse{Message: "you must provide at least one attribute"}}, nil
+	}
+
+	link, err := s.linkService.Save(ctx, *did, request.Body.LimitedClaims, request.Body.Expiration, template.Schema.ID, template.CredentialExpiration,
+		template.ClaimRequestProofs.BJJSignatureProof2021, template.ClaimRequestProofs.Iden3SparseMerkleTreeProof, template.CredentialSubject, template.RefreshService, template.DisplayMethod, nil)
+	if err != nil {
+		log.Error(ctx, "creating link from template", "err", err, "id", request.Id)
+		if errors.Is(err, services.ErrLoadingSchema) {
+			return CreateLinkFromTemplate500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateLinkFromTemplate400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateLinkFromTemplate201JSONResponse{Id: link.ID.String()}, nil
+}
+
+func toCredentialTemplateRequest(body *CredentialTemplateRequest) ports.CredentialTemplateRequest {
+	req := ports.CredentialTemplateRequest{
+		Name:                     body.Name,
+		SchemaID:                 body.SchemaID,
+		CredentialExpiration:     body.CredentialExpiration,
+		CredentialSignatureProof: body.SignatureProof,
+		CredentialMTPProof:       body.MtProof,
+		DisplayMethodID:          body.DisplayMethodID,
+		RefreshService:           toVerifiableRefreshService(body.RefreshService),
+	}
+	if body.CredentialSubject != nil {
+		req.CredentialSubject = *body.CredentialSubject
+	}
+	return req
+}
+
+func toCredentialTemplateResponse(template *domain.CredentialTemplate) CredentialTemplate {
+	var refreshService *RefreshService
+	if template.RefreshService != nil {
+		refreshService = &RefreshService{
+			Id:   template.RefreshService.ID,
+			Type: RefreshServiceType(template.RefreshService.Type),
+		}
+	}
+	return CredentialTemplate{
+		Id:                   template.ID,
+		Name:                 template.Name,
+		SchemaID:             template.SchemaID,
+		CredentialSubject:    template.CredentialSubject,
+		CredentialExpiration: template.CredentialExpiration,
+		SignatureProof:       template.CredentialSignatureProof,
+		MtProof:              template.CredentialMTPProof,
+		DisplayMethodID:      template.DisplayMethodID,
+		RefreshService:       refreshService,
+		CreatedAt:            TimeUTC(template.CreatedAt),
+		UpdatedAt:            TimeUTC(template.UpdatedAt),
+	}
+}
+
+// isCredentialTemplateReferenceNotFoundError returns true if the schema or the display method of the template do not exist
+func isCredentialTemplateReferenceNotFoundError(err error) bool {
+	return errors.Is(err, services.ErrSchemaNotFound) || errors.Is(err, repositories.DisplayMethodNotFoundErr)
+}
+
+func isInvalidCredentialTemplateError(err error) bool {
+	errs := []error{
+		services.ErrCredentialTemplateEmptyName,
+		services.ErrCredentialTemplateNoProofs,
+		services.ErrRefreshServiceLacksExpirationTime,
+		services.ErrRefreshServiceLacksURL,
+		services.ErrUnsupportedRefreshServiceType,
+		domain.ErrInvalidRelativeExpiration,
+		repositories.ErrCredentialTemplateDuplicateName,
+	}
+	for _, e := range errs {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_CredentialTemplates(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	importedSchema, err := server.Services.schema.ImportSchema(ctx, *did, ports.NewImportSchemaRequest(url, schemaType, common.ToPointer("someTitle"), uuid.NewString(), common.ToPointer("someDescription"), nil))
+	require.NoError(t, err)
+
+	templatesURL := fmt.Sprintf("/v2/identities/%s/credential-templates", did)
+	do := func(t *testing.T, method string, url string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		var req *http.Request
+		if body != nil {
+			req, err = http.NewRequest(method, url, tests.JSONBody(t, body))
+		} else {
+			req, err = http.NewRequest(method, url, nil)
+		}
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	validRequest := CredentialTemplateRequest{
+		Name:                 "KYC age",
+		SchemaID:             importedSchema.ID,
+		CredentialSubject:    &map[string]any{"documentType": 2},
+		CredentialExpiration: common.ToPointer("+365d"),
+		SignatureProof:       true,
+	}
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			request  CredentialTemplateRequest
+			httpCode int
+			message  string
+		}{
+			{
+				name:     "Empty name",
+				request:  CredentialTemplateRequest{SchemaID: importedSchema.ID, SignatureProof: true},
+				httpCode: http.StatusBadRequest,
+				message:  "credential template name is required",
+			},
+			{
+				name:     "No proofs",
+				request:  CredentialTemplateRequest{Name: "no proofs", SchemaID: importedSchema.ID},
+				httpCode: http.StatusBadRequest,
+				message:  "at least one proof type should be enabled",
+			},
+			{
+				name:     "Invalid expiration",
+				request:  CredentialTemplateRequest{Name: "invalid expiration", SchemaID: importedSchema.ID, SignatureProof: true, CredentialExpiration: common.ToPointer("2030-01-01")},
+				httpCode: http.StatusBadRequest,
+				message:  "invalid relative expiration, expected a positive amount of hours, days, weeks or years up to 100 years like +365d",
+			},
+			{
+				name:     "Schema not found",
+				request:  CredentialTemplateRequest{Name: "unknown schema", SchemaID: uuid.New(), SignatureProof: true},
+				httpCode: http.StatusNotFound,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := do(t, http.MethodPost, templatesURL, tc.request)
+				require.Equal(t, tc.httpCode, rr.Code)
+				if tc.message != "" {
+					var response GenericErrorMessage
+					require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+					assert.Equal(t, tc.message, response.Message)
+				}
+			})
+		}
+	})
+
+	rr := do(t, http.MethodPost, templatesURL, validRequest)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var template CredentialTemplate
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &template))
+	assert.Equal(t, validRequest.Name, template.Name)
+	assert.Equal(t, importedSchema.ID, template.SchemaID)
+	assert.Equal(t, "+365d", *template.CredentialExpiration)
+	assert.Equal(t, float64(2), template.CredentialSubject["documentType"])
+	templateURL := fmt.Sprintf("%s/%s", templatesURL, template.Id)
+
+	t.Run("Duplicated name", func(t *testing.T) {
+		rr := do(t, http.MethodPost, templatesURL, validRequest)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		rr := do(t, http.MethodGet, templateURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response CredentialTemplate
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, template, response)
+
+		rr = do(t, http.MethodGet, fmt.Sprintf("%s/%s", templatesURL, uuid.New()), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		updated := validRequest
+		updated.Name = "KYC age v2"
+		updated.MtProof = true
+		rr := do(t, http.MethodPut, templateURL, updated)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response CredentialTemplate
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "KYC age v2", response.Name)
+		assert.True(t, response.MtProof)
+		assert.Equal(t, template.CreatedAt, response.CreatedAt)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		otherRequest := validRequest
+		otherRequest.Name = "AAA first"
+		rr := do(t, http.MethodPost, templatesURL, otherRequest)
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		rr = do(t, http.MethodGet, templatesURL+"?max_results=1", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response CredentialTemplatesPaginated
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, uint(2), response.Meta.Total)
+		require.Len(t, response.Items, 1)
+		assert.Equal(t, "AAA first", response.Items[0].Name)
+	})
+
+	t.Run("Create credential from template", func(t *testing.T) {
+		rr := do(t, http.MethodPost, templateURL+"/credentials", CreateCredentialFromTemplateRequest{
+			CredentialSubject: map[string]any{"id": userDID, "birthday": 19960424},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response CreateCredentialResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+
+		credentialID, err := uuid.Parse(response.Id)
+		require.NoError(t, err)
+		claim, err := server.Services.credentials.GetByID(ctx, did, credentialID)
+		require.NoError(t, err)
+		vc, err := claim.GetVerifiableCredential()
+		require.NoError(t, err)
+		assert.Equal(t, float64(2), vc.CredentialSubject["documentType"])
+		assert.Equal(t, float64(19960424), vc.CredentialSubject["birthday"])
+		require.NotNil(t, vc.Expiration)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 365), *vc.Expiration, time.Minute)
+		assert.True(t, claim.MtProof)
+
+		rr = do(t, http.MethodPost, fmt.Sprintf("%s/%s/credentials", templatesURL, uuid.New()), CreateCredentialFromTemplateRequest{
+			CredentialSubject: map[string]any{"id": userDID, "birthday": 19960424},
+		})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Create link from template", func(t *testing.T) {
+		rr := do(t, http.MethodPost, templateURL+"/links", CreateLinkFromTemplateRequest{
+			CredentialSubject: &map[string]any{"birthday": 19960424},
+			LimitedClaims:     common.ToPointer(5),
+		})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response UUIDResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+
+		linkID, err := uuid.Parse(response.Id)
+		require.NoError(t, err)
+		link, err := server.Services.links.GetByID(ctx, *did, linkID, "http://localhost")
+		require.NoError(t, err)
+		assert.Equal(t, importedSchema.ID, link.SchemaID)
+		assert.Equal(t, json.Number("2"), link.CredentialSubject["documentType"])
+		assert.True(t, link.CredentialMTPProof)
+		require.NotNil(t, link.CredentialExpiration)
+
+		rr = do(t, http.MethodPost, templateURL+"/links", CreateLinkFromTemplateRequest{
+			Expiration: common.ToPointer(time.Now().Add(-time.Hour)),
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		rr := do(t, http.MethodDelete, templateURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = do(t, http.MethodDelete, templateURL, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
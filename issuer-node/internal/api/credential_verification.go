@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/pkg/credentials/verifier"
+)
+
+// VerifyCredential is the controller to verify a credential issued by the identities of the node
+func (s *Server) VerifyCredential(ctx context.Context, request VerifyCredentialRequestObject) (VerifyCredentialResponseObject, error) {
+	if request.Body == nil {
+		return VerifyCredential400JSONResponse{N400JSONResponse{"credential is required"}}, nil
+	}
+	report := s.credentialVerificationService.Verify(ctx, *request.Body)
+	return VerifyCredential200JSONResponse(toCredentialVerificationReportResponse(report)), nil
+}
+
+func toCredentialVerificationReportResponse(report *verifier.Report) CredentialVerificationReport {
+	checks := make([]CredentialVerificationCheck, len(report.Checks))
+	for i, check := range report.Checks {
+		checks[i] = CredentialVerificationCheck{
+			Name:   CredentialVerificationCheckName(check.Name),
+			Status: CredentialVerificationCheckStatus(check.Status),
+		}
+		if check.Message != "" {
+			checks[i].Message = &check.Message
+		}
+	}
+	return CredentialVerificationReport{
+		Valid:  report.Valid,
+		Checks: checks,
+	}
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/schema"
+)
+
+func TestServer_VerifyCredential(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	createCredential := func(t *testing.T, expiration time.Time) map[string]any {
+		t.Helper()
+		credential, err := server.Services.credentials.Save(ctx, &ports.CreateClaimRequest{
+			DID:               did,
+			Schema:            url,
+			Type:              schemaType,
+			CredentialSubject: map[string]any{"id": userDID, "birthday": 19960424, "documentType": 2},
+			Expiration:        common.ToPointer(expiration),
+			SignatureProof:    true,
+		})
+		require.NoError(t, err)
+		credential, err = server.Services.credentials.GetByID(ctx, did, credential.ID)
+		require.NoError(t, err)
+		vc, err := schema.FromClaimModelToW3CCredential(*credential)
+		require.NoError(t, err)
+		raw, err := json.Marshal(vc)
+		require.NoError(t, err)
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(raw, &body))
+		return body
+	}
+
+	verify := func(t *testing.T, credential map[string]any) CredentialVerificationReport {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/v2/credentials/verify", tests.JSONBody(t, credential))
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var report CredentialVerificationReport
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+		return report
+	}
+
+	checkStatus := func(report CredentialVerificationReport) map[CredentialVerificationCheckName]CredentialVerificationCheckStatus {
+		statuses := make(map[CredentialVerificationCheckName]CredentialVerificationCheckStatus, len(report.Checks))
+		for _, check := range report.Checks {
+			statuses[check.Name] = check.Status
+		}
+		return statuses
+	}
+
+	t.Run("Valid credential", func(t *testing.T) {
+		report := verify(t, createCredential(t, time.Now().Add(time.Hour)))
+		assert.True(t, report.Valid)
+		assert.Equal(t, map[CredentialVerificationCheckName]CredentialVerificationCheckStatus{
+			Expiration:                 CredentialVerificationCheckStatusPassed,
+			BJJSignatureProof2021:      CredentialVerificationCheckStatusPassed,
+			Iden3SparseMerkleTreeProof: CredentialVerificationCheckStatusSkipped,
+			JsonWebSignature2020:       CredentialVerificationCheckStatusSkipped,
+			CredentialStatus:           CredentialVerificationCheckStatusPassed,
+		}, checkStatus(report))
+	})
+
+	t.Run("Tampered credential", func(t *testing.T) {
+		credential := createCredential(t, time.Now().Add(time.Hour))
+		subject, ok := credential["credentialSubject"].(map[string]any)
+		require.True(t, ok)
+		subject["birthday"] = 20000101
+		report := verify(t, credential)
+		assert.False(t, report.Valid)
+		assert.Equal(t, CredentialVerificationCheckStatusFailed, checkStatus(report)[BJJSignatureProof2021])
+	})
+
+	t.Run("Revoked credential", func(t *testing.T) {
+		credential := createCredential(t, time.Now().Add(time.Hour))
+		status, ok := credential["credentialStatus"].(map[string]any)
+		require.True(t, ok)
+		nonce, ok := status["revocationNonce"].(float64)
+		require.True(t, ok)
+		require.NoError(t, server.Services.credentials.Revoke(ctx, *did, uint64(nonce), "testing"))
+
+		report := verify(t, credential)
+		assert.False(t, report.Valid)
+		for _, check := range report.Checks {
+			if check.Name == CredentialStatus {
+				assert.Equal(t, CredentialVerificationCheckStatusFailed, check.Status)
+				require.NotNil(t, check.Message)
+				assert.Equal(t, "the credential is revoked", *check.Message)
+			}
+		}
+	})
+
+	t.Run("Expired credential", func(t *testing.T) {
+		report := verify(t, createCredential(t, time.Now().Add(-time.Hour)))
+		assert.False(t, report.Valid)
+		assert.Equal(t, CredentialVerificationCheckStatusFailed, checkStatus(report)[Expiration])
+	})
+
+	t.Run("Another issuer", func(t *testing.T) {
+		credential := createCredential(t, time.Now().Add(time.Hour))
+		credential["issuer"] = userDID
+		report := verify(t, credential)
+		assert.False(t, report.Valid)
+	})
+}
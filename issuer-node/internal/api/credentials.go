@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	b64 "encoding/base64"
 	"errors"
 	"fmt"
 	"slices"
@@ -18,6 +19,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
 	"github.com/polygonid/sh-id-platform/internal/schema"
 )
 
@@ -60,8 +62,9 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 
 	claimRequestProofs := ports.ClaimRequestProofs{}
 	if request.Body.Proofs == nil {
+		// did:web issuers sign their credentials with a JsonWebSignature2020 proof and do not have merkle tree proofs
 		claimRequestProofs.BJJSignatureProof2021 = true
-		claimRequestProofs.Iden3SparseMerkleTreeProof = true
+		claimRequestProofs.Iden3SparseMerkleTreeProof = !domain.IsWebDID(*did)
 	} else {
 		for _, proof := range *request.Body.Proofs {
 			if string(proof) == string(verifiable.BJJSignatureProofType) {
@@ -76,29 +79,32 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 		}
 	}
 
-	var credentialStatusType *verifiable.CredentialStatusType
-	credentialStatusType, err = s.validateStatusType(ctx, did, (*string)(request.Body.CredentialStatusType))
+	credentialStatusType, err := s.supportedCredentialStatusType(ctx, did, (*string)(request.Body.CredentialStatusType))
 	if err != nil {
 		return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 	}
 
-	resolverPrefix, err := common.ResolverPrefix(did)
-	if err != nil {
-		return CreateCredential400JSONResponse{N400JSONResponse{Message: "error parsing did"}}, nil
-	}
-
-	rhsSettings, err := s.networkResolver.GetRhsSettings(ctx, resolverPrefix)
-	if err != nil {
-		return CreateCredential400JSONResponse{N400JSONResponse{Message: "error getting reverse hash service settings"}}, nil
-	}
-
-	if !s.networkResolver.IsCredentialStatusTypeSupported(rhsSettings.Mode, *credentialStatusType) {
-		log.Warn(ctx, "unsupported credential status type", "req", request)
-		return CreateCredential400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("Credential Status Type '%s' is not supported by the issuer", *credentialStatusType)}}, nil
+	var exportOpts *ports.CredentialExportOptions
+	if request.Body.JwtExport != nil {
+		opts, err := toCredentialExportOptions(string(request.Body.JwtExport.Format), request.Body.JwtExport.KeyID, request.Body.JwtExport.DisclosableFields)
+		if err != nil {
+			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if err := s.credentialExportService.Validate(ctx, *did, opts); err != nil {
+			if isCredentialExportError(err) {
+				return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+			return CreateCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+		exportOpts = &opts
 	}
 
 	req := ports.NewCreateClaimRequest(did, request.Body.ClaimID, request.Body.CredentialSchema, request.Body.CredentialSubject, expiration, request.Body.Type, request.Body.Version, request.Body.SubjectPosition, request.Body.MerklizedRootPosition, claimRequestProofs, nil, false, *credentialStatusType, toVerifiableRefreshService(request.Body.RefreshService), request.Body.RevNonce,
 		toVerifiableDisplayMethod(request.Body.DisplayMethod), (*ports.EncryptionKey)(request.Body.EncryptionKey))
+	req.EncryptionPolicy, err = s.credentialEncryptionPolicy(ctx, *did, request.Body.EncryptionPolicy, request.Body.CredentialSchema)
+	if err != nil {
+		return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
 
 	resp, err := s.claimService.Save(ctx, req)
 	if err != nil {
@@ -108,29 +114,20 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
 			return CreateCredential500JSONResponse{N500JSONResponse{Message: "if this identity has keyType=ETH you must to publish the state first"}}, nil
 		}
-		errs := []error{
-			services.ErrJSONLdContext,
-			services.ErrProcessSchema,
-			services.ErrMalformedURL,
-			services.ErrParseClaim,
-			services.ErrInvalidCredentialSubject,
-			services.ErrAssigningMTPProof,
-			services.ErrUnsupportedRefreshServiceType,
-			services.ErrRefreshServiceLacksExpirationTime,
-			services.ErrRefreshServiceLacksURL,
-			services.ErrDisplayMethodLacksURL,
-			services.ErrUnsupportedDisplayMethodType,
-			services.ErrWrongCredentialSubjectID,
-			&schema.ParseClaimError{},
-		}
-		for _, e := range errs {
-			if errors.Is(err, e) {
-				return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
-			}
+		if isInvalidCredentialRequestError(err) {
+			return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
 		return CreateCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
-	return CreateCredential201JSONResponse{Id: resp.ID.String()}, nil
+
+	if exportOpts == nil {
+		return CreateCredential201JSONResponse{Id: resp.ID.String()}, nil
+	}
+	token, err := s.credentialExportService.Export(ctx, *did, resp, *exportOpts)
+	if err != nil {
+		return CreateCredential500JSONResponse{N500JSONResponse{Message: fmt.Sprintf("credential %s created but it could not be exported: %s", resp.ID, err)}}, nil
+	}
+	return CreateCredential201JSONResponse{Id: resp.ID.String(), Jwt: &token}, nil
 }
 
 // RevokeCredential is the revocation claim controller
@@ -160,6 +157,98 @@ func (s *Server) RevokeCredential(ctx context.Context, request RevokeCredentialR
 	}, nil
 }
 
+// SuspendCredential is the controller to suspend the credentials with the given nonce
+func (s *Server) SuspendCredential(ctx context.Context, request SuspendCredentialRequestObject) (SuspendCredentialResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return SuspendCredential400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	if err := s.claimService.Suspend(ctx, *did, uint64(request.Nonce)); err != nil {
+		log.Error(ctx, "suspending credential", "err", err, "req", request)
+		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			return SuspendCredential404JSONResponse{N404JSONResponse{Message: "the credential does not exist"}}, nil
+		}
+		if errors.Is(err, services.ErrAuthCredentialCannotBeSuspended) || errors.Is(err, services.ErrCredentialAlreadyRevoked) {
+			return SuspendCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return SuspendCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return SuspendCredential200JSONResponse{Message: "credential suspended"}, nil
+}
+
+// ResumeCredential is the controller to resume the suspended credentials with the given nonce
+func (s *Server) ResumeCredential(ctx context.Context, request ResumeCredentialRequestObject) (ResumeCredentialResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return ResumeCredential400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	if err := s.claimService.Resume(ctx, *did, uint64(request.Nonce)); err != nil {
+		log.Error(ctx, "resuming credential", "err", err, "req", request)
+		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			return ResumeCredential404JSONResponse{N404JSONResponse{Message: "the credential does not exist"}}, nil
+		}
+		if errors.Is(err, services.ErrAuthCredentialCannotBeSuspended) || errors.Is(err, services.ErrCredentialAlreadyRevoked) {
+			return ResumeCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return ResumeCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return ResumeCredential200JSONResponse{Message: "credential resumed"}, nil
+}
+
+// ReissueCredential is the controller to reissue a credential with updated attributes
+func (s *Server) ReissueCredential(ctx context.Context, request ReissueCredentialRequestObject) (ReissueCredentialResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return ReissueCredential400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	clID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return ReissueCredential400JSONResponse{N400JSONResponse{"invalid claim id"}}, nil
+	}
+
+	req := &ports.ReissueCredentialRequest{
+		DID:               *did,
+		CredentialID:      clID,
+		CredentialSubject: request.Body.CredentialSubject,
+	}
+	if request.Body.Expiration != nil {
+		req.Expiration = common.ToPointer(time.Unix(*request.Body.Expiration, 0))
+	}
+
+	credential, err := s.claimService.Reissue(ctx, req)
+	if err != nil {
+		log.Error(ctx, "reissuing credential", "err", err, "req", request)
+		if errors.Is(err, services.ErrCredentialNotFound) {
+			return ReissueCredential404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		errs := []error{
+			services.ErrCredentialAlreadyRevoked,
+			services.ErrCredentialCannotBeReissued,
+			services.ErrInvalidCredentialPatch,
+			services.ErrInvalidCredentialSubject,
+			services.ErrParseClaim,
+			services.ErrRefreshServiceLacksExpirationTime,
+			repositories.ErrClaimDuplication,
+			&schema.ParseClaimError{},
+		}
+		for _, e := range errs {
+			if errors.Is(err, e) {
+				return ReissueCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+		}
+		return ReissueCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return ReissueCredential201JSONResponse{
+		Id:         credential.ID.String(),
+		PreviousID: clID.String(),
+		Version:    credential.Version,
+	}, nil
+}
+
 // GetRevocationStatus is the controller to get revocation status
 func (s *Server) GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error) {
 	issuerDID, err := w3c.ParseDID(request.Identifier)
@@ -182,6 +271,9 @@ func (s *Server) GetRevocationStatus(ctx context.Context, request GetRevocationS
 	response.Issuer.RootOfRoots = rs.Issuer.RootOfRoots
 	response.Issuer.ClaimsTreeRoot = rs.Issuer.ClaimsTreeRoot
 	response.Mtp.Existence = rs.MTP.Existence
+	if rs.Suspended {
+		response.Suspended = common.ToPointer(true)
+	}
 
 	if rs.MTP.NodeAux != nil {
 		key := rs.MTP.NodeAux.Key
@@ -304,6 +396,9 @@ func (s *Server) GetCredential(ctx context.Context, request GetCredentialRequest
 	}
 
 	if claim.HasEncryptedData() {
+		if request.Params.Format != nil && *request.Params.Format != GetCredentialParamsFormatIden3 {
+			return GetCredential400JSONResponse{N400JSONResponse{services.ErrCredentialExportEncrypted.Error()}}, nil
+		}
 		encryptedVC, err := fromClaimModelToEncryptedVC(*claim)
 		if err != nil {
 			return GetCredential500JSONResponse{N500JSONResponse{"invalid encrypted claim format"}}, nil
@@ -315,7 +410,23 @@ func (s *Server) GetCredential(ctx context.Context, request GetCredentialRequest
 	if err != nil {
 		return GetCredential500JSONResponse{N500JSONResponse{"invalid claim format"}}, nil
 	}
-	return GetCredential200JSONResponse(toGetCredential200Response(w3c, claim)), nil
+	response := toGetCredential200Response(w3c, claim)
+
+	if request.Params.Format != nil && *request.Params.Format != GetCredentialParamsFormatIden3 {
+		opts, err := toCredentialExportOptions(string(*request.Params.Format), request.Params.KeyID, request.Params.DisclosableFields)
+		if err != nil {
+			return GetCredential400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		token, err := s.credentialExportService.Export(ctx, *did, claim, opts)
+		if err != nil {
+			if isCredentialExportError(err) {
+				return GetCredential400JSONResponse{N400JSONResponse{err.Error()}}, nil
+			}
+			return GetCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+		}
+		response.Jwt = &token
+	}
+	return GetCredential200JSONResponse(response), nil
 }
 
 // GetCredentialOffer returns a GetCredentialQrCodeResponseObject universalLink, raw or deeplink type based on query parameter `type`
@@ -373,9 +484,9 @@ func (s *Server) GetCredentialOffer(ctx context.Context, request GetCredentialOf
 func (s *Server) validateStatusType(ctx context.Context, did *w3c.DID, credentialStatusTypeRequest *string) (*verifiable.CredentialStatusType, error) {
 	var credentialStatusType verifiable.CredentialStatusType
 	if credentialStatusTypeRequest != nil && *credentialStatusTypeRequest != "" {
-		allowedCredentialStatuses := []string{string(verifiable.Iden3commRevocationStatusV1), string(verifiable.Iden3ReverseSparseMerkleTreeProof), string(verifiable.Iden3OnchainSparseMerkleTreeProof2023)}
+		allowedCredentialStatuses := []string{string(verifiable.Iden3commRevocationStatusV1), string(verifiable.Iden3ReverseSparseMerkleTreeProof), string(verifiable.Iden3OnchainSparseMerkleTreeProof2023), string(revocationstatus.BitstringStatusListEntry)}
 		if !slices.Contains(allowedCredentialStatuses, *credentialStatusTypeRequest) {
-			return nil, fmt.Errorf("Invalid Credential Status Type '%s'. Allowed Iden3commRevocationStatusV1.0, Iden3ReverseSparseMerkleTreeProof or Iden3OnchainSparseMerkleTreeProof2023.", *credentialStatusTypeRequest)
+			return nil, fmt.Errorf("Invalid Credential Status Type '%s'. Allowed Iden3commRevocationStatusV1.0, Iden3ReverseSparseMerkleTreeProof, Iden3OnchainSparseMerkleTreeProof2023 or BitstringStatusListEntry.", *credentialStatusTypeRequest)
 		}
 		credentialStatusType = (verifiable.CredentialStatusType)(*credentialStatusTypeRequest)
 	} else {
@@ -436,6 +547,29 @@ func fromClaimModelToEncryptedVC(claim domain.Claim) (*EncryptedVC, error) {
 	return encryptedVC, nil
 }
 
+// credentialEncryptionPolicy returns the encryption policy of the request or, when it is not set, the policy of the imported schema with the same url
+func (s *Server) credentialEncryptionPolicy(ctx context.Context, did w3c.DID, policy *CredentialEncryptionPolicy, schemaURL string) (domain.CredentialEncryptionPolicy, error) {
+	if policy != nil {
+		p := domain.CredentialEncryptionPolicy(*policy)
+		return p, p.Validate()
+	}
+	schema, err := s.schemaService.GetByURL(ctx, did, schemaURL)
+	if err != nil {
+		if !errors.Is(err, services.ErrSchemaNotFound) {
+			log.Warn(ctx, "getting schema encryption policy", "err", err, "schema", schemaURL)
+		}
+		return domain.CredentialEncryptionNone, nil
+	}
+	return schema.CredentialEncryption.OrDefault(), nil
+}
+
+func toCredentialEncryptionPolicy(p *CredentialEncryptionPolicy) *domain.CredentialEncryptionPolicy {
+	if p == nil {
+		return nil
+	}
+	return common.ToPointer(domain.CredentialEncryptionPolicy(*p))
+}
+
 func toVerifiableRefreshService(s *RefreshService) *verifiable.RefreshService {
 	if s == nil {
 		return nil
@@ -461,6 +595,7 @@ func toGetCredential200Response(w3cCredential *verifiable.W3CCredential, cred *d
 		Vc:         w3cCredential,
 		Id:         cred.ID.String(),
 		Revoked:    cred.Revoked,
+		Suspended:  cred.Suspended,
 		SchemaHash: cred.SchemaHash,
 		ProofTypes: getProofs(cred),
 	}
@@ -471,6 +606,7 @@ func toGetCredentialWithEncryptedVC200Response(encryptedVC *EncryptedVC, cred *d
 		EncryptedVC: encryptedVC,
 		Id:          cred.ID.String(),
 		Revoked:     cred.Revoked,
+		Suspended:   cred.Suspended,
 		SchemaHash:  cred.SchemaHash,
 		ProofTypes:  getProofs(cred),
 	}
@@ -498,10 +634,12 @@ func getCredentialsFilter(ctx context.Context, req GetCredentialsRequestObject)
 			filter.Revoked = common.ToPointer(true)
 		case GetCredentialsParamsStatusExpired:
 			filter.ExpiredOn = common.ToPointer(time.Now())
+		case GetCredentialsParamsStatusSuspended:
+			filter.Suspended = common.ToPointer(true)
 		case GetCredentialsParamsStatusAll:
 			// Nothing to be done
 		default:
-			return nil, errors.New("wrong type value. Allowed values: [all, revoked, expired]")
+			return nil, errors.New("wrong type value. Allowed values: [all, revoked, expired, suspended]")
 		}
 	}
 	if req.Params.Query != nil {
@@ -547,3 +685,99 @@ func getCredentialsFilter(ctx context.Context, req GetCredentialsRequestObject)
 	}
 	return filter, nil
 }
+
+// supportedCredentialStatusType validates the requested credential status type, or returns the default one,
+// and checks that it is supported by the reverse hash service settings of the issuer network.
+// The credentials of did:web issuers are always revoked with a bitstring status list.
+func (s *Server) supportedCredentialStatusType(ctx context.Context, did *w3c.DID, statusType *string) (*verifiable.CredentialStatusType, error) {
+	if domain.IsWebDID(*did) {
+		if statusType != nil && *statusType != "" && *statusType != string(revocationstatus.BitstringStatusListEntry) {
+			return nil, fmt.Errorf("Credential Status Type '%s' is not supported by did:web issuers. Allowed BitstringStatusListEntry.", *statusType)
+		}
+		return common.ToPointer(revocationstatus.BitstringStatusListEntry), nil
+	}
+
+	credentialStatusType, err := s.validateStatusType(ctx, did, statusType)
+	if err != nil {
+		return nil, err
+	}
+
+	resolverPrefix, err := common.ResolverPrefix(did)
+	if err != nil {
+		return nil, errors.New("error parsing did")
+	}
+
+	rhsSettings, err := s.networkResolver.GetRhsSettings(ctx, resolverPrefix)
+	if err != nil {
+		return nil, errors.New("error getting reverse hash service settings")
+	}
+
+	if !s.networkResolver.IsCredentialStatusTypeSupported(rhsSettings.Mode, *credentialStatusType) {
+		log.Warn(ctx, "unsupported credential status type", "type", *credentialStatusType)
+		return nil, fmt.Errorf("Credential Status Type '%s' is not supported by the issuer", *credentialStatusType)
+	}
+	return credentialStatusType, nil
+}
+
+// isInvalidCredentialRequestError returns true if the credential could not be created because of the request values
+func isInvalidCredentialRequestError(err error) bool {
+	errs := []error{
+		services.ErrJSONLdContext,
+		services.ErrProcessSchema,
+		services.ErrMalformedURL,
+		services.ErrParseClaim,
+		services.ErrInvalidCredentialSubject,
+		services.ErrAssigningMTPProof,
+		services.ErrUnsupportedRefreshServiceType,
+		services.ErrRefreshServiceLacksExpirationTime,
+		services.ErrRefreshServiceLacksURL,
+		services.ErrDisplayMethodLacksURL,
+		services.ErrUnsupportedDisplayMethodType,
+		services.ErrWrongCredentialSubjectID,
+		services.ErrWebIssuerMTPProof,
+		services.ErrStatusListSigningKeyNotFound,
+		services.ErrHolderEncryptionKeyNotFound,
+		domain.ErrInvalidCredentialEncryptionPolicy,
+		&schema.ParseClaimError{},
+	}
+	for _, e := range errs {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// toCredentialExportOptions builds the export options of the request. The key id is received base64 encoded as in the keys endpoints.
+func toCredentialExportOptions(format string, keyID *string, disclosableFields *[]string) (ports.CredentialExportOptions, error) {
+	opts := ports.CredentialExportOptions{
+		Format: ports.CredentialExportFormat(format),
+	}
+	if keyID != nil {
+		decodedKeyID, err := b64.StdEncoding.DecodeString(*keyID)
+		if err != nil {
+			return opts, errors.New("the key id can not be decoded from base64")
+		}
+		opts.KeyID = common.ToPointer(string(decodedKeyID))
+	}
+	if disclosableFields != nil {
+		opts.DisclosableFields = *disclosableFields
+	}
+	return opts, nil
+}
+
+func isCredentialExportError(err error) bool {
+	errs := []error{
+		services.ErrCredentialExportInvalidFormat,
+		services.ErrCredentialExportSigningKeyNotFound,
+		services.ErrCredentialExportInvalidKey,
+		services.ErrCredentialExportInvalidField,
+		services.ErrCredentialExportEncrypted,
+	}
+	for _, e := range errs {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+	return false
+}
@@ -504,6 +504,120 @@ func TestServer_CreateCredential(t *testing.T) {
 	}
 }
 
+func TestServer_CreateCredentialEncryptionPolicy(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		holderDID  = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+		noKeyDID   = "did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+	fixture := repositories.NewFixture(storage)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID(holderDID)
+	require.NoError(t, err)
+
+	userDoc := fmt.Sprintf(`{
+		"id": %[1]q,
+		"verificationMethod": [
+			{"id": "%[1]s#sig", "type": "JsonWebKey2020", "publicKeyJwk": {"kty": "EC", "crv": "P-256", "use": "sig", "x": "nw7Ag_FszrDu1uPi2lX3TtbF7FMZoysXZXUzrKxBwiQ", "y": "l1I0EONJmEHMz7Nc4WQULDllKdPdjbTgHS5hCbqv0UQ"}},
+			{"id": "%[1]s#enc", "type": "JsonWebKey2020", "publicKeyJwk": {"kty": "EC", "crv": "P-256", "x": "nw7Ag_FszrDu1uPi2lX3TtbF7FMZoysXZXUzrKxBwiQ", "y": "l1I0EONJmEHMz7Nc4WQULDllKdPdjbTgHS5hCbqv0UQ"}}
+		],
+		"keyAgreement": ["%[1]s#sig", "#enc"]
+	}`, holderDID)
+	fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID:  *did,
+		UserDID:    *userDID,
+		UserDoc:    []byte(userDoc),
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	createCredential := func(t *testing.T, subjectID string, policy *CredentialEncryptionPolicy) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials", did), tests.JSONBody(t, CreateCredentialRequest{
+			CredentialSchema: schemaURL,
+			Type:             "KYCAgeCredential",
+			CredentialSubject: map[string]any{
+				"id":           subjectID,
+				"birthday":     19960425,
+				"documentType": 2,
+			},
+			EncryptionPolicy: policy,
+		}))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	getCredential := func(t *testing.T, rr *httptest.ResponseRecorder) *domain.Claim {
+		t.Helper()
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response CreateCredentialResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		claim, err := server.claimService.GetByID(ctx, did, uuid.MustParse(response.Id))
+		require.NoError(t, err)
+		return claim
+	}
+
+	t.Run("Required without holder key", func(t *testing.T) {
+		rr := createCredential(t, noKeyDID, common.ToPointer(CredentialEncryptionPolicy(domain.CredentialEncryptionRequired)))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		var response CreateCredential400JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Contains(t, response.Message, "the DID document of the holder has no usable encryption key")
+	})
+
+	t.Run("Preferred without holder key", func(t *testing.T) {
+		claim := getCredential(t, createCredential(t, noKeyDID, common.ToPointer(CredentialEncryptionPolicy(domain.CredentialEncryptionPreferred))))
+		assert.Nil(t, claim.EncryptedData)
+	})
+
+	t.Run("Required with the key agreement key of the holder", func(t *testing.T) {
+		claim := getCredential(t, createCredential(t, holderDID, common.ToPointer(CredentialEncryptionPolicy(domain.CredentialEncryptionRequired))))
+		require.NotNil(t, claim.EncryptedData)
+		assert.NotEmpty(t, *claim.EncryptedData)
+	})
+
+	t.Run("Invalid policy", func(t *testing.T) {
+		rr := createCredential(t, holderDID, common.ToPointer(CredentialEncryptionPolicy("always")))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Schema policy", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/schemas", did), tests.JSONBody(t, ImportSchemaRequest{
+			Url:                  schemaURL,
+			SchemaType:           "KYCAgeCredential",
+			Version:              uuid.NewString(),
+			CredentialEncryption: common.ToPointer(CredentialEncryptionPolicy(domain.CredentialEncryptionRequired)),
+		}))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		rr = createCredential(t, noKeyDID, nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+
+		claim := getCredential(t, createCredential(t, holderDID, nil))
+		assert.NotNil(t, claim.EncryptedData)
+
+		claim = getCredential(t, createCredential(t, noKeyDID, common.ToPointer(CredentialEncryptionPolicy(domain.CredentialEncryptionNone))))
+		assert.Nil(t, claim.EncryptedData)
+	})
+}
+
 func TestServer_DeleteCredential(t *testing.T) {
 	server := newTestServer(t, nil)
 	ctx := context.Background()
@@ -586,6 +700,322 @@ func TestServer_DeleteCredential(t *testing.T) {
 	}
 }
 
+func TestServer_ReissueCredential(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	credentialSubject := map[string]any{
+		"id":           userDID,
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	credential, err := server.Services.credentials.Save(ctx, ports.NewCreateClaimRequest(did, nil, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(365*24*time.Hour)), schemaType, nil, nil, nil, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil, nil))
+	require.NoError(t, err)
+
+	type expected struct {
+		httpCode int
+		message  string
+		version  uint32
+	}
+
+	type testConfig struct {
+		name         string
+		auth         func() (string, string)
+		credentialID string
+		body         ReissueCredentialRequest
+		expected     expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:         "No auth header",
+			auth:         authWrong,
+			credentialID: credential.ID.String(),
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:         "Wrong credential id",
+			auth:         authOk,
+			credentialID: "wrong",
+			body:         ReissueCredentialRequest{CredentialSubject: map[string]any{"documentType": 3}},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "invalid claim id",
+			},
+		},
+		{
+			name:         "Credential not found",
+			auth:         authOk,
+			credentialID: uuid.NewString(),
+			body:         ReissueCredentialRequest{CredentialSubject: map[string]any{"documentType": 3}},
+			expected: expected{
+				httpCode: http.StatusNotFound,
+				message:  "credential not found",
+			},
+		},
+		{
+			name:         "Subject id cannot be patched",
+			auth:         authOk,
+			credentialID: credential.ID.String(),
+			body:         ReissueCredentialRequest{CredentialSubject: map[string]any{"id": "did:polygonid:polygon:amoy:2qFpPHotk6oyaX1fcrpQFT4BMnmg8YszUwxYtaoGoe"}},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "credential subject id and type cannot be changed",
+			},
+		},
+		{
+			name:         "Patch does not match the schema",
+			auth:         authOk,
+			credentialID: credential.ID.String(),
+			body:         ReissueCredentialRequest{CredentialSubject: map[string]any{"documentType": "wrong"}},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "credential subject does not match the provided schema",
+			},
+		},
+		{
+			name:         "Happy path",
+			auth:         authOk,
+			credentialID: credential.ID.String(),
+			body:         ReissueCredentialRequest{CredentialSubject: map[string]any{"documentType": 3}},
+			expected: expected{
+				httpCode: http.StatusCreated,
+				version:  1,
+			},
+		},
+		{
+			name:         "Credential already reissued",
+			auth:         authOk,
+			credentialID: credential.ID.String(),
+			body:         ReissueCredentialRequest{CredentialSubject: map[string]any{"documentType": 4}},
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "credential is already revoked",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server.Infra.pubSub.Clear(event.CreateCredentialEvent)
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/credentials/%s", did, tc.credentialID)
+			req, err := http.NewRequest(http.MethodPatch, url, tests.JSONBody(t, tc.body))
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			switch tc.expected.httpCode {
+			case http.StatusCreated:
+				var response ReissueCredentialResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, credential.ID.String(), response.PreviousID)
+				assert.Equal(t, tc.expected.version, response.Version)
+				assert.Len(t, server.Infra.pubSub.AllPublishedEvents(event.CreateCredentialEvent), 1)
+
+				previous, err := server.Services.credentials.GetByID(ctx, did, credential.ID)
+				require.NoError(t, err)
+				assert.True(t, previous.Revoked)
+
+				newCredentialID, err := uuid.Parse(response.Id)
+				require.NoError(t, err)
+				reissued, err := server.Services.credentials.GetByID(ctx, did, newCredentialID)
+				require.NoError(t, err)
+				assert.False(t, reissued.Revoked)
+				assert.Equal(t, tc.expected.version, reissued.Version)
+				assert.NotEqual(t, previous.RevNonce, reissued.RevNonce)
+				assert.Equal(t, userDID, reissued.OtherIdentifier)
+				assert.Equal(t, previous.Expiration, reissued.Expiration)
+				vc, err := reissued.GetVerifiableCredential()
+				require.NoError(t, err)
+				assert.EqualValues(t, 19960424, vc.CredentialSubject["birthday"])
+				assert.EqualValues(t, 3, vc.CredentialSubject["documentType"])
+			case http.StatusBadRequest:
+				var response ReissueCredential400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			case http.StatusNotFound:
+				var response ReissueCredential404JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+}
+
+func TestServer_SuspendCredential(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	credentialSubject := map[string]any{
+		"id":           userDID,
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	credential, err := server.Services.credentials.Save(ctx, ports.NewCreateClaimRequest(did, nil, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(365*24*time.Hour)), schemaType, nil, nil, nil, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil, nil))
+	require.NoError(t, err)
+	revoked, err := server.Services.credentials.Save(ctx, ports.NewCreateClaimRequest(did, nil, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(365*24*time.Hour)), schemaType, nil, nil, nil, ports.ClaimRequestProofs{BJJSignatureProof2021: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil, nil))
+	require.NoError(t, err)
+	require.NoError(t, server.Services.credentials.Revoke(ctx, *did, uint64(revoked.RevNonce), ""))
+
+	type expected struct {
+		httpCode  int
+		message   string
+		suspended bool
+	}
+
+	type testConfig struct {
+		name     string
+		auth     func() (string, string)
+		action   string
+		nonce    uint64
+		expected expected
+	}
+
+	for _, tc := range []testConfig{
+		{
+			name:   "No auth header",
+			auth:   authWrong,
+			action: "suspend",
+			nonce:  uint64(credential.RevNonce),
+			expected: expected{
+				httpCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			name:   "Credential not found",
+			auth:   authOk,
+			action: "suspend",
+			nonce:  123456,
+			expected: expected{
+				httpCode: http.StatusNotFound,
+				message:  "the credential does not exist",
+			},
+		},
+		{
+			name:   "Revoked credential cannot be suspended",
+			auth:   authOk,
+			action: "suspend",
+			nonce:  uint64(revoked.RevNonce),
+			expected: expected{
+				httpCode: http.StatusBadRequest,
+				message:  "credential is already revoked",
+			},
+		},
+		{
+			name:   "Suspend",
+			auth:   authOk,
+			action: "suspend",
+			nonce:  uint64(credential.RevNonce),
+			expected: expected{
+				httpCode:  http.StatusOK,
+				message:   "credential suspended",
+				suspended: true,
+			},
+		},
+		{
+			name:   "Resume",
+			auth:   authOk,
+			action: "resume",
+			nonce:  uint64(credential.RevNonce),
+			expected: expected{
+				httpCode:  http.StatusOK,
+				message:   "credential resumed",
+				suspended: false,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			url := fmt.Sprintf("/v2/identities/%s/credentials/%s/%d", did, tc.action, tc.nonce)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth(tc.auth())
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expected.httpCode, rr.Code)
+			switch tc.expected.httpCode {
+			case http.StatusOK:
+				var response SuspendCredential200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+
+				stored, err := server.Services.credentials.GetByID(ctx, did, credential.ID)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected.suspended, stored.Suspended)
+				assert.False(t, stored.Revoked)
+
+				rr = httptest.NewRecorder()
+				req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials/revocation/status/%d", did, tc.nonce), nil)
+				require.NoError(t, err)
+				req.SetBasicAuth(authOk())
+				handler.ServeHTTP(rr, req)
+				require.Equal(t, http.StatusOK, rr.Code)
+				var status GetRevocationStatusV2200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &status))
+				assert.Equal(t, tc.expected.suspended, status.Suspended != nil && *status.Suspended)
+
+				rr = httptest.NewRecorder()
+				req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/credentials?status=suspended", did), nil)
+				require.NoError(t, err)
+				req.SetBasicAuth(authOk())
+				handler.ServeHTTP(rr, req)
+				require.Equal(t, http.StatusOK, rr.Code)
+				var list GetCredentials200JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+				if tc.expected.suspended {
+					require.Len(t, list.Items, 1)
+					assert.Equal(t, credential.ID, list.Items[0].Id)
+					assert.True(t, list.Items[0].Suspended)
+				} else {
+					assert.Len(t, list.Items, 0)
+				}
+			case http.StatusBadRequest:
+				var response SuspendCredential400JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			case http.StatusNotFound:
+				var response SuspendCredential404JSONResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.expected.message, response.Message)
+			}
+		})
+	}
+}
+
 func TestServer_GetCredentialQrCode(t *testing.T) {
 	const (
 		method     = "polygonid"
@@ -1066,7 +1496,7 @@ func TestServer_GetCredentials(t *testing.T) {
 			status: common.ToPointer("wrong"),
 			expected: expected{
 				httpCode: http.StatusBadRequest,
-				errorMsg: "wrong type value. Allowed values: [all, revoked, expired]",
+				errorMsg: "wrong type value. Allowed values: [all, revoked, expired, suspended]",
 			},
 		},
 		{
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	didResolutionContext = "https://w3id.org/did-resolution/v1"
+	didLDJSONContentType = "application/did+ld+json"
+)
+
+// GetDIDDocument - returns the DID document of an identity of the issuer node
+func (s *Server) GetDIDDocument(ctx context.Context, request GetDIDDocumentRequestObject) (GetDIDDocumentResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetDIDDocument400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	doc, err := s.didDocumentService.Get(ctx, *did)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdentityNotFound) {
+			return GetDIDDocument404JSONResponse{N404JSONResponse{Message: "identity not found"}}, nil
+		}
+		log.Error(ctx, "getting did document", "err", err, "did", request.Identifier)
+		return GetDIDDocument500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetDIDDocument200JSONResponse(*doc), nil
+}
+
+// GetWebDIDDocument - returns the DID document of a did:web identity of the issuer node, at the path where did:web resolvers look for it
+func (s *Server) GetWebDIDDocument(ctx context.Context, request GetWebDIDDocumentRequestObject) (GetWebDIDDocumentResponseObject, error) {
+	did, err := domain.NewWebDID(s.cfg.ServerUrl, request.Id)
+	if err != nil {
+		return GetWebDIDDocument404JSONResponse{N404JSONResponse{Message: "identity not found"}}, nil
+	}
+
+	doc, err := s.didDocumentService.Get(ctx, *did)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdentityNotFound) {
+			return GetWebDIDDocument404JSONResponse{N404JSONResponse{Message: "identity not found"}}, nil
+		}
+		log.Error(ctx, "getting did:web document", "err", err, "did", did.String())
+		return GetWebDIDDocument500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetWebDIDDocument200JSONResponse(*doc), nil
+}
+
+// ResolveDID - returns the DID resolution result of an identity of the issuer node, like the universal resolver
+func (s *Server) ResolveDID(ctx context.Context, request ResolveDIDRequestObject) (ResolveDIDResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return ResolveDID400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	doc, err := s.didDocumentService.Get(ctx, *did)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdentityNotFound) {
+			return ResolveDID404JSONResponse{N404JSONResponse{Message: "identity not found"}}, nil
+		}
+		log.Error(ctx, "resolving did", "err", err, "did", request.Identifier)
+		return ResolveDID500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	response := ResolveDID200JSONResponse{
+		Context:             didResolutionContext,
+		DidDocument:         *doc,
+		DidDocumentMetadata: map[string]interface{}{},
+	}
+	response.DidResolutionMetadata.ContentType = didLDJSONContentType
+	return response, nil
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+func TestServer_DIDDocuments(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	created, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(created.Identifier)
+	require.NoError(t, err)
+	iden, err := server.Services.identity.GetByDID(ctx, *did)
+	require.NoError(t, err)
+	require.Len(t, iden.AuthCredentialsIDs, 1)
+
+	get := func(t *testing.T, url string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	checkDocument := func(t *testing.T, doc domain.DIDDocument) {
+		t.Helper()
+		assert.Equal(t, iden.Identifier, doc.ID)
+		assert.Contains(t, doc.Context, domain.DIDDocumentContext)
+		require.Len(t, doc.VerificationMethod, 1)
+		keyID := fmt.Sprintf("%s#%s", iden.Identifier, iden.AuthCredentialsIDs[0])
+		assert.Equal(t, keyID, doc.VerificationMethod[0].ID)
+		assert.Equal(t, domain.JSONWebKey2020, doc.VerificationMethod[0].Type)
+		assert.Equal(t, iden.Identifier, doc.VerificationMethod[0].Controller)
+		assert.Equal(t, "BJJ", doc.VerificationMethod[0].PublicKeyJwk["crv"])
+		assert.NotEmpty(t, doc.VerificationMethod[0].PublicKeyJwk["x"])
+		assert.NotEmpty(t, doc.VerificationMethod[0].PublicKeyJwk["y"])
+		assert.Equal(t, []string{keyID}, doc.Authentication)
+
+		require.Len(t, doc.Service, 3)
+		assert.Equal(t, domain.DIDService{ID: iden.Identifier + "#Iden3CommServiceV1", Type: domain.Iden3CommServiceType, ServiceEndpoint: "https://testing.env/v2/agent"}, doc.Service[0])
+		assert.Equal(t, domain.PushNotificationServiceType, doc.Service[1].Type)
+		assert.Equal(t, "https://push.testing.env/api/v1", doc.Service[1].ServiceEndpoint)
+		assert.Equal(t, domain.Iden3RefreshServiceType, doc.Service[2].Type)
+	}
+
+	t.Run("DID document", func(t *testing.T) {
+		rr := get(t, fmt.Sprintf("/v2/identities/%s/did.json", iden.Identifier))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var doc domain.DIDDocument
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &doc))
+		checkDocument(t, doc)
+	})
+
+	t.Run("Universal resolver", func(t *testing.T) {
+		rr := get(t, fmt.Sprintf("/1.0/identifiers/%s", iden.Identifier))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response DIDResolutionResult
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "https://w3id.org/did-resolution/v1", response.Context)
+		assert.Equal(t, "application/did+ld+json", response.DidResolutionMetadata.ContentType)
+		checkDocument(t, response.DidDocument)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			url      string
+			httpCode int
+		}{
+			{name: "Invalid did", url: "/v2/identities/not-a-did/did.json", httpCode: http.StatusBadRequest},
+			{name: "Unknown identity", url: "/v2/identities/did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi/did.json", httpCode: http.StatusNotFound},
+			{name: "Unknown identity in universal resolver", url: "/1.0/identifiers/did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi", httpCode: http.StatusNotFound},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.httpCode, get(t, tc.url).Code)
+			})
+		}
+	})
+}
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/internal/schema"
+)
+
+func TestServer_WebIdentities(t *testing.T) {
+	const (
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	createIdentity := func(t *testing.T, keyType CreateIdentityRequestDidMetadataType) *httptest.ResponseRecorder {
+		t.Helper()
+		body := CreateIdentityRequest{}
+		body.DidMetadata.Method = string(domain.DIDMethodWeb)
+		body.DidMetadata.Type = keyType
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/v2/identities", tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	get := func(t *testing.T, url string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("BJJ keys are not supported", func(t *testing.T) {
+		rr := createIdentity(t, BJJ)
+		assert.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	rr := createIdentity(t, Ed25519Key)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var created CreateIdentityResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+	require.NotNil(t, created.Identifier)
+	assert.True(t, strings.HasPrefix(*created.Identifier, "did:web:testing.env:issuers:"))
+	assert.Equal(t, string(revocationstatus.BitstringStatusListEntry), string(created.CredentialStatusType))
+	assert.Equal(t, "Ed25519", created.KeyType)
+	did, err := w3c.ParseDID(*created.Identifier)
+	require.NoError(t, err)
+	name := (*created.Identifier)[strings.LastIndex(*created.Identifier, ":")+1:]
+
+	var doc domain.DIDDocument
+	t.Run("DID document", func(t *testing.T) {
+		rr := get(t, fmt.Sprintf("/issuers/%s/did.json", name))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &doc))
+		assert.Equal(t, did.String(), doc.ID)
+		require.Len(t, doc.VerificationMethod, 1)
+		assert.True(t, strings.HasPrefix(doc.VerificationMethod[0].ID, did.String()+"#"))
+		assert.Equal(t, "OKP", doc.VerificationMethod[0].PublicKeyJwk["kty"])
+		assert.Equal(t, "Ed25519", doc.VerificationMethod[0].PublicKeyJwk["crv"])
+		assert.Equal(t, []string{doc.VerificationMethod[0].ID}, doc.AssertionMethod)
+		assert.Empty(t, doc.Service)
+
+		assert.Equal(t, http.StatusNotFound, get(t, fmt.Sprintf("/issuers/%s/did.json", uuid.NewString())).Code)
+	})
+	require.Len(t, doc.VerificationMethod, 1)
+
+	t.Run("Identity details", func(t *testing.T) {
+		rr := get(t, fmt.Sprintf("/v2/identities/%s", did))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var details GetIdentityDetailsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &details))
+		assert.Equal(t, did.String(), details.Identifier)
+		assert.Equal(t, string(revocationstatus.BitstringStatusListEntry), string(details.CredentialStatusType))
+	})
+
+	createCredential := func(t *testing.T, proofs *[]CreateCredentialRequestProofs) *httptest.ResponseRecorder {
+		t.Helper()
+		body := CreateCredentialRequest{
+			CredentialSchema: schemaURL,
+			Type:             schemaType,
+			CredentialSubject: map[string]any{
+				"id":           userDID,
+				"birthday":     19960424,
+				"documentType": 2,
+			},
+			Expiration: common.ToPointer(time.Now().Add(365 * 24 * time.Hour).Unix()),
+			Proofs:     proofs,
+		}
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials", did), tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Merkle tree proofs are not supported", func(t *testing.T) {
+		rr := createCredential(t, &[]CreateCredentialRequestProofs{"Iden3SparseMerkleTreeProof"})
+		assert.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	rr = createCredential(t, nil)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var createdCredential CreateCredentialResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &createdCredential))
+	credential, err := server.Services.credentials.GetByID(ctx, did, uuid.MustParse(createdCredential.Id))
+	require.NoError(t, err)
+
+	t.Run("JsonWebSignature2020 proof", func(t *testing.T) {
+		assert.False(t, credential.MtProof)
+		var proof domain.JSONWebSignature2020Proof
+		require.NoError(t, json.Unmarshal(credential.SignatureProof.Bytes, &proof))
+		assert.Equal(t, domain.JSONWebSignature2020ProofType, proof.Type)
+		assert.Equal(t, "assertionMethod", proof.ProofPurpose)
+		assert.Equal(t, doc.VerificationMethod[0].ID, proof.VerificationMethod)
+
+		vc, err := credential.GetVerifiableCredential()
+		require.NoError(t, err)
+		assert.Equal(t, did.String(), vc.Issuer)
+		assert.Contains(t, vc.Context, domain.JSONWebKey2020Context)
+		vc.Proof = nil
+		proofOptions := map[string]any{
+			"@context":           vc.Context,
+			"type":               proof.Type,
+			"created":            proof.Created.Format(time.RFC3339),
+			"verificationMethod": proof.VerificationMethod,
+			"proofPurpose":       proof.ProofPurpose,
+		}
+		payload := append(canonicalSHA256(t, proofOptions), canonicalSHA256(t, vc)...)
+
+		parts := strings.Split(proof.JWS, ".")
+		require.Len(t, parts, 3)
+		assert.Empty(t, parts[1])
+		var header map[string]any
+		headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(headerBytes, &header))
+		assert.Equal(t, "EdDSA", header["alg"])
+		assert.Equal(t, false, header["b64"])
+		assert.Equal(t, proof.VerificationMethod, header["kid"])
+		verifyEdDSA(t, doc.VerificationMethod[0].PublicKeyJwk, append([]byte(parts[0]+"."), payload...), parts[2])
+	})
+
+	t.Run("Credential verification", func(t *testing.T) {
+		vc, err := schema.FromClaimModelToW3CCredential(*credential)
+		require.NoError(t, err)
+		raw, err := json.Marshal(vc)
+		require.NoError(t, err)
+		verify := func(t *testing.T, body map[string]any) map[CredentialVerificationCheckName]CredentialVerificationCheckStatus {
+			t.Helper()
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, "/v2/credentials/verify", tests.JSONBody(t, body))
+			require.NoError(t, err)
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			var report CredentialVerificationReport
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+			statuses := make(map[CredentialVerificationCheckName]CredentialVerificationCheckStatus, len(report.Checks))
+			for _, check := range report.Checks {
+				statuses[check.Name] = check.Status
+			}
+			assert.Equal(t, statuses[JsonWebSignature2020] == CredentialVerificationCheckStatusPassed, report.Valid)
+			return statuses
+		}
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(raw, &body))
+		statuses := verify(t, body)
+		assert.Equal(t, CredentialVerificationCheckStatusPassed, statuses[JsonWebSignature2020])
+		assert.Equal(t, CredentialVerificationCheckStatusSkipped, statuses[BJJSignatureProof2021])
+		assert.Equal(t, CredentialVerificationCheckStatusSkipped, statuses[Iden3SparseMerkleTreeProof])
+
+		var tampered map[string]any
+		require.NoError(t, json.Unmarshal(raw, &tampered))
+		subject, ok := tampered["credentialSubject"].(map[string]any)
+		require.True(t, ok)
+		subject["birthday"] = 20000101
+		assert.Equal(t, CredentialVerificationCheckStatusFailed, verify(t, tampered)[JsonWebSignature2020])
+	})
+
+	var credentialStatuses []revocationstatus.BitstringStatusListEntryStatus
+	require.NoError(t, json.Unmarshal(credential.CredentialStatus.Bytes, &credentialStatuses))
+	require.Len(t, credentialStatuses, 2)
+	credentialStatus := credentialStatuses[0]
+	assert.Equal(t, "revocation", credentialStatus.StatusPurpose)
+	assert.Equal(t, "suspension", credentialStatuses[1].StatusPurpose)
+	assert.Equal(t, revocationstatus.BitstringStatusListEntry, credentialStatus.Type)
+	assert.True(t, strings.HasPrefix(credentialStatus.StatusListCredential, fmt.Sprintf("https://testing.env/v2/identities/%s/status-lists/", did)))
+	statusListID := credentialStatus.StatusListCredential[strings.LastIndex(credentialStatus.StatusListCredential, "/")+1:]
+
+	require.NoError(t, server.Services.credentials.Revoke(ctx, *did, uint64(credential.RevNonce), ""))
+
+	t.Run("Revoked in the status list", func(t *testing.T) {
+		rr := get(t, fmt.Sprintf("/v2/identities/%s/status-lists/%s", did, statusListID))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		parts := strings.Split(rr.Body.String(), ".")
+		require.Len(t, parts, 3)
+		verifyEdDSA(t, doc.VerificationMethod[0].PublicKeyJwk, []byte(parts[0]+"."+parts[1]), parts[2])
+		payloadBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+		var payload struct {
+			CredentialSubject map[string]any `json:"credentialSubject"`
+		}
+		require.NoError(t, json.Unmarshal(payloadBytes, &payload))
+		assert.True(t, statusListBit(t, payload.CredentialSubject["encodedList"], 0))
+	})
+
+	t.Run("Identities list", func(t *testing.T) {
+		rr := get(t, "/v2/identities")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response GetIdentities200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		var found bool
+		for _, identity := range response {
+			if identity.Identifier == did.String() {
+				found = true
+				assert.Equal(t, "web", identity.Method)
+			}
+		}
+		assert.True(t, found)
+	})
+}
+
+// canonicalSHA256 returns the SHA-256 hash of the URDNA2015 canonical form of the JSON-LD document
+func canonicalSHA256(t *testing.T, document any) []byte {
+	t.Helper()
+	b, err := json.Marshal(document)
+	require.NoError(t, err)
+	var doc any
+	require.NoError(t, json.Unmarshal(b, &doc))
+	options := ld.NewJsonLdOptions("")
+	options.Algorithm = ld.AlgorithmURDNA2015
+	options.Format = "application/n-quads"
+	options.DocumentLoader = schemaLoader
+	normalized, err := ld.NewJsonLdProcessor().Normalize(doc, options)
+	require.NoError(t, err)
+	nquads, ok := normalized.(string)
+	require.True(t, ok)
+	require.NotEmpty(t, nquads)
+	hash := sha256.Sum256([]byte(nquads))
+	return hash[:]
+}
+
+// verifyEdDSA checks the EdDSA signature of the signing input with the Ed25519 JWK
+func verifyEdDSA(t *testing.T, jwk map[string]any, signingInput []byte, encodedSignature string) {
+	t.Helper()
+	x, ok := jwk["x"].(string)
+	require.True(t, ok)
+	pubKey, err := base64.RawURLEncoding.DecodeString(x)
+	require.NoError(t, err)
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(pubKey, signingInput, signature))
+}
@@ -15,11 +15,13 @@ import (
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
 )
 
 // CreateIdentity is created identity controller
@@ -30,7 +32,15 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 	keyType := request.Body.DidMetadata.Type
 	credentialStatusTypeRequest := request.Body.CredentialStatusType
 
-	if keyType != "BJJ" && keyType != "ETH" {
+	if request.Body.DisplayName != nil {
+		request.Body.DisplayName = common.ToPointer(strings.TrimSpace(*request.Body.DisplayName))
+	}
+
+	if core.DIDMethod(method) == domain.DIDMethodWeb {
+		return s.createWebIdentity(ctx, request)
+	}
+
+	if keyType != BJJ && keyType != ETH {
 		return CreateIdentity400JSONResponse{
 			N400JSONResponse{
 				Message: "Type must be BJJ or ETH",
@@ -38,10 +48,6 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 		}, nil
 	}
 
-	if request.Body.DisplayName != nil {
-		request.Body.DisplayName = common.ToPointer(strings.TrimSpace(*request.Body.DisplayName))
-	}
-
 	var credentialStatusType *verifiable.CredentialStatusType
 	credentialStatusType, err := validateStatusType((*string)(credentialStatusTypeRequest))
 	if err != nil {
@@ -113,6 +119,36 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 		return nil, err
 	}
 
+	return newCreateIdentityResponse(identity, CreateIdentityResponseCredentialStatusType(identity.AuthCoreClaimRevocationStatus.Type)), nil
+}
+
+// createWebIdentity creates a did:web identity. Its credentials are always revoked with a bitstring status list.
+func (s *Server) createWebIdentity(ctx context.Context, request CreateIdentityRequestObject) (CreateIdentityResponseObject, error) {
+	keyType := request.Body.DidMetadata.Type
+	if keyType != ETH && keyType != Ed25519Key {
+		return CreateIdentity400JSONResponse{N400JSONResponse{Message: "Type must be ETH or Ed25519 for did:web identities"}}, nil
+	}
+
+	identity, err := s.identityService.Create(ctx, s.cfg.ServerUrl, &ports.DIDCreationOptions{
+		Method:      domain.DIDMethodWeb,
+		KeyType:     kms.KeyType(keyType),
+		DisplayName: request.Body.DisplayName,
+	})
+	if err != nil {
+		log.Error(ctx, "creating did:web identity", "err", err)
+		if errors.Is(err, services.ErrWrongDIDMetada) {
+			return CreateIdentity400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrIdentityDisplayNameDuplicated) {
+			return CreateIdentity409JSONResponse{N409JSONResponse{Message: fmt.Sprintf("display name field already exists: <%s>", *request.Body.DisplayName)}}, nil
+		}
+		return CreateIdentity500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return newCreateIdentityResponse(identity, CreateIdentityResponseCredentialStatusType(revocationstatus.BitstringStatusListEntry)), nil
+}
+
+func newCreateIdentityResponse(identity *domain.Identity, credentialStatusType CreateIdentityResponseCredentialStatusType) CreateIdentity201JSONResponse {
 	var responseAddress *string
 	if identity.Address != nil && *identity.Address != "" {
 		responseAddress = identity.Address
@@ -137,8 +173,8 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 		Address:              responseAddress,
 		KeyType:              identity.KeyType,
 		Balance:              nil,
-		CredentialStatusType: CreateIdentityResponseCredentialStatusType(identity.AuthCoreClaimRevocationStatus.Type),
-	}, nil
+		CredentialStatusType: credentialStatusType,
+	}
 }
 
 // validateStatusType - validate credential status type.
@@ -202,6 +238,16 @@ func (s *Server) GetIdentities(ctx context.Context, request GetIdentitiesRequest
 			}}, nil
 		}
 
+		if domain.IsWebDID(*did) {
+			response = append(response, GetIdentitiesResponse{
+				Identifier:           identity.Identifier,
+				Method:               string(domain.DIDMethodWeb),
+				CredentialStatusType: common.ToPointer(GetIdentitiesResponseCredentialStatusType(revocationstatus.BitstringStatusListEntry)),
+				DisplayName:          identity.DisplayName,
+			})
+			continue
+		}
+
 		var authBjjCredStatus *GetIdentitiesResponseCredentialStatusType
 		authClaim, _ := s.claimService.GetAuthClaim(ctx, did)
 		if authClaim != nil {
@@ -284,8 +330,13 @@ func (s *Server) GetIdentityDetails(ctx context.Context, request GetIdentityDeta
 		}, err
 	}
 
+	credentialStatusType := GetIdentityDetailsResponseCredentialStatusType(identity.AuthCoreClaimRevocationStatus.Type)
+	if domain.IsWebDID(*userDID) {
+		credentialStatusType = GetIdentityDetailsResponseCredentialStatusType(revocationstatus.BitstringStatusListEntry)
+	}
+
 	var balance *big.Int
-	if identity.KeyType == string(kms.KeyTypeEthereum) {
+	if identity.KeyType == string(kms.KeyTypeEthereum) && !domain.IsWebDID(*userDID) {
 		did, err := w3c.ParseDID(identity.Identifier)
 		if err != nil {
 			log.Error(ctx, "get identity details. Parsing did", "err", err)
@@ -328,7 +379,7 @@ func (s *Server) GetIdentityDetails(ctx context.Context, request GetIdentityDeta
 		DisplayName:          identity.DisplayName,
 		Address:              responseAddress,
 		Balance:              responseBalance,
-		CredentialStatusType: GetIdentityDetailsResponseCredentialStatusType(identity.AuthCoreClaimRevocationStatus.Type),
+		CredentialStatusType: credentialStatusType,
 		AuthCredentialsIDs:   identity.AuthCredentialsIDs,
 	}
 
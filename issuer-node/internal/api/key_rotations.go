@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"errors"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/schema"
+)
+
+// CreateKeyRotation - starts the rotation of an auth key of the identity
+func (s *Server) CreateKeyRotation(ctx context.Context, request CreateKeyRotationRequestObject) (CreateKeyRotationResponseObject, error) {
+	req := &ports.StartKeyRotationRequest{
+		DID:              *request.Identifier.did(),
+		KeyName:          request.Body.KeyName,
+		AuthCredentialID: request.Body.AuthCredentialID,
+	}
+	if request.Body.ResignCredentials != nil {
+		req.ResignCredentials = *request.Body.ResignCredentials
+	}
+
+	rotation, err := s.keyRotationService.Start(ctx, req)
+	if err != nil {
+		log.Error(ctx, "starting key rotation", "err", err)
+		if errors.Is(err, services.ErrKeyRotationInProgress) || errors.Is(err, services.ErrDuplicateKeyName) {
+			return CreateKeyRotation409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrKeyRotationEmptyKeyName) || errors.Is(err, services.ErrKeyRotationInvalidAuthCredential) {
+			return CreateKeyRotation400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateKeyRotation500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateKeyRotation201JSONResponse(toKeyRotationResponse(rotation)), nil
+}
+
+// GetKeyRotations - returns the key rotations of the identity
+func (s *Server) GetKeyRotations(ctx context.Context, request GetKeyRotationsRequestObject) (GetKeyRotationsResponseObject, error) {
+	rotations, err := s.keyRotationService.GetAll(ctx, *request.Identifier.did())
+	if err != nil {
+		log.Error(ctx, "getting key rotations", "err", err)
+		return GetKeyRotations500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	response := make(GetKeyRotations200JSONResponse, 0, len(rotations))
+	for _, rotation := range rotations {
+		response = append(response, toKeyRotationResponse(rotation))
+	}
+	return response, nil
+}
+
+// GetKeyRotation - returns a key rotation of the identity
+func (s *Server) GetKeyRotation(ctx context.Context, request GetKeyRotationRequestObject) (GetKeyRotationResponseObject, error) {
+	rotation, err := s.keyRotationService.GetByID(ctx, *request.Identifier.did(), request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrKeyRotationNotFound) {
+			return GetKeyRotation404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting key rotation", "err", err, "id", request.Id)
+		return GetKeyRotation500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetKeyRotation200JSONResponse(toKeyRotationResponse(rotation)), nil
+}
+
+// ResumeKeyRotation - runs the pending steps of a key rotation
+func (s *Server) ResumeKeyRotation(ctx context.Context, request ResumeKeyRotationRequestObject) (ResumeKeyRotationResponseObject, error) {
+	rotation, err := s.keyRotationService.Resume(ctx, *request.Identifier.did(), request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrKeyRotationNotFound) {
+			return ResumeKeyRotation404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrKeyRotationLocked) {
+			return ResumeKeyRotation409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "resuming key rotation", "err", err, "id", request.Id)
+		return ResumeKeyRotation500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return ResumeKeyRotation200JSONResponse(toKeyRotationResponse(rotation)), nil
+}
+
+// GetKeyRotationCredentials - returns the credentials signed with the auth credential replaced by a key rotation
+func (s *Server) GetKeyRotationCredentials(ctx context.Context, request GetKeyRotationCredentialsRequestObject) (GetKeyRotationCredentialsResponseObject, error) {
+	credentials, err := s.keyRotationService.GetCredentialsToResign(ctx, *request.Identifier.did(), request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrKeyRotationNotFound) {
+			return GetKeyRotationCredentials404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting key rotation credentials", "err", err, "id", request.Id)
+		return GetKeyRotationCredentials500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	response := make(GetKeyRotationCredentials200JSONResponse, len(credentials))
+	for i, credential := range credentials {
+		if credential.HasEncryptedData() {
+			encryptedVC, err := fromClaimModelToEncryptedVC(*credential)
+			if err != nil {
+				log.Error(ctx, "creating credentials response with encrypted vc", "err", err, "id", request.Id)
+				return GetKeyRotationCredentials500JSONResponse{N500JSONResponse{"Invalid encrypted claim format"}}, nil
+			}
+			response[i] = toGetCredentialWithEncryptedVC200Response(encryptedVC, credential)
+			continue
+		}
+		w3c, err := schema.FromClaimModelToW3CCredential(*credential)
+		if err != nil {
+			log.Error(ctx, "creating credentials response", "err", err, "id", request.Id)
+			return GetKeyRotationCredentials500JSONResponse{N500JSONResponse{"Invalid claim format"}}, nil
+		}
+		response[i] = toGetCredential200Response(w3c, credential)
+	}
+	return response, nil
+}
+
+func toKeyRotationResponse(rotation *domain.KeyRotation) KeyRotation {
+	response := KeyRotation{
+		Id:                  rotation.ID,
+		KeyName:             rotation.KeyName,
+		ResignCredentials:   rotation.ResignCredentials,
+		OldAuthCredentialID: rotation.OldAuthCredentialID,
+		NewAuthCredentialID: rotation.NewAuthCredentialID,
+		PublishedState:      rotation.PublishedState,
+		Step:                KeyRotationStep(rotation.Step),
+		Error:               rotation.Error,
+		ResignedCredentials: rotation.ResignedCredentials,
+		CreatedAt:           TimeUTC(rotation.CreatedAt),
+		UpdatedAt:           TimeUTC(rotation.UpdatedAt),
+	}
+	if rotation.NewKeyID != nil {
+		response.NewKeyID = common.ToPointer(b64.StdEncoding.EncodeToString([]byte(*rotation.NewKeyID)))
+	}
+	return response
+}
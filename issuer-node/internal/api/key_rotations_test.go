@@ -0,0 +1,274 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_KeyRotation(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		url        = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	oldAuthCredential, err := server.Services.credentials.GetAuthClaim(ctx, did)
+	require.NoError(t, err)
+
+	credential, err := server.Services.credentials.Save(ctx, &ports.CreateClaimRequest{
+		DID:               did,
+		Schema:            url,
+		Type:              schemaType,
+		CredentialSubject: map[string]any{"id": userDID, "birthday": 19960424, "documentType": 2},
+		SignatureProof:    true,
+	})
+	require.NoError(t, err)
+
+	rotationsURL := fmt.Sprintf("/v2/identities/%s/key-rotations", did)
+
+	doRequest := func(t *testing.T, method string, url string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		var req *http.Request
+		var err error
+		if body != nil {
+			req, err = http.NewRequest(method, url, tests.JSONBody(t, body))
+		} else {
+			req, err = http.NewRequest(method, url, nil)
+		}
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	getCredentialsToResign := func(t *testing.T, id uuid.UUID) GetKeyRotationCredentials200JSONResponse {
+		t.Helper()
+		rr := doRequest(t, http.MethodGet, fmt.Sprintf("%s/%s/credentials", rotationsURL, id), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetKeyRotationCredentials200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+
+	var rotation KeyRotation
+	t.Run("Start key rotation", func(t *testing.T) {
+		rr := doRequest(t, http.MethodPost, rotationsURL, CreateKeyRotationRequest{KeyName: "rotated auth key", ResignCredentials: common.ToPointer(true)})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rotation))
+		assert.Equal(t, WaitConfirmation, rotation.Step)
+		assert.Equal(t, oldAuthCredential.ID, rotation.OldAuthCredentialID)
+		assert.Nil(t, rotation.Error)
+		require.NotNil(t, rotation.NewKeyID)
+		require.NotNil(t, rotation.NewAuthCredentialID)
+		require.NotNil(t, rotation.PublishedState)
+	})
+
+	t.Run("Another rotation in progress", func(t *testing.T) {
+		rr := doRequest(t, http.MethodPost, rotationsURL, CreateKeyRotationRequest{KeyName: "another auth key"})
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Credentials signed with the old key", func(t *testing.T) {
+		credentials := getCredentialsToResign(t, rotation.Id)
+		require.Len(t, credentials, 1)
+		assert.Equal(t, credential.ID.String(), credentials[0].Id)
+	})
+
+	t.Run("Resume waits for the state confirmation", func(t *testing.T) {
+		rr := doRequest(t, http.MethodPost, fmt.Sprintf("%s/%s/resume", rotationsURL, rotation.Id), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response KeyRotation
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, WaitConfirmation, response.Step)
+
+		authCredential, err := server.Services.credentials.GetByID(ctx, did, oldAuthCredential.ID)
+		require.NoError(t, err)
+		assert.False(t, authCredential.Revoked)
+	})
+
+	t.Run("Resume after the state confirmation", func(t *testing.T) {
+		state, err := server.Repos.identityState.GetLatestStateByIdentifier(ctx, storage.Pgx, did)
+		require.NoError(t, err)
+		require.Equal(t, *rotation.PublishedState, *state.State)
+		state.Status = domain.StatusConfirmed
+		require.NoError(t, server.Services.credentials.UpdateClaimsMTPAndState(ctx, state))
+
+		rr := doRequest(t, http.MethodPost, fmt.Sprintf("%s/%s/resume", rotationsURL, rotation.Id), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response KeyRotation
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, Completed, response.Step)
+		assert.Equal(t, 1, response.ResignedCredentials)
+		assert.Nil(t, response.Error)
+
+		authCredential, err := server.Services.credentials.GetByID(ctx, did, oldAuthCredential.ID)
+		require.NoError(t, err)
+		assert.True(t, authCredential.Revoked)
+		assert.Empty(t, getCredentialsToResign(t, rotation.Id))
+
+		resigned, err := server.Services.credentials.GetByID(ctx, did, credential.ID)
+		require.NoError(t, err)
+		newAuthCredential, err := server.Services.credentials.GetByID(ctx, did, *rotation.NewAuthCredentialID)
+		require.NoError(t, err)
+		newAuthCoreClaim, err := newAuthCredential.CoreClaim.Get().Hex()
+		require.NoError(t, err)
+		var signatureProof map[string]any
+		require.NoError(t, json.Unmarshal(resigned.SignatureProof.Bytes, &signatureProof))
+		issuerData, ok := signatureProof["issuerData"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, newAuthCoreClaim, issuerData["authCoreClaim"])
+	})
+
+	t.Run("Get key rotations", func(t *testing.T) {
+		rr := doRequest(t, http.MethodGet, rotationsURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response GetKeyRotations200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		assert.Equal(t, rotation.Id, response[0].Id)
+
+		rr = doRequest(t, http.MethodGet, fmt.Sprintf("%s/%s", rotationsURL, rotation.Id), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = doRequest(t, http.MethodGet, fmt.Sprintf("%s/%s", rotationsURL, uuid.New()), nil)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Duplicated key name", func(t *testing.T) {
+		rr := doRequest(t, http.MethodPost, rotationsURL, CreateKeyRotationRequest{KeyName: "rotated auth key"})
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Invalid auth credential", func(t *testing.T) {
+		rr := doRequest(t, http.MethodPost, rotationsURL, CreateKeyRotationRequest{KeyName: "other auth key", AuthCredentialID: common.ToPointer(credential.ID)})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, rotationsURL, nil)
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestServer_KeyRotationStateFailed(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	resume := func(t *testing.T, id uuid.UUID) KeyRotation {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/key-rotations/%s/resume", did, id), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response KeyRotation
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+
+	t.Run("Concurrent starts", func(t *testing.T) {
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = server.Services.keyRotation.Start(ctx, &ports.StartKeyRotationRequest{DID: *did, KeyName: fmt.Sprintf("rotated auth key %d", i)})
+			}(i)
+		}
+		wg.Wait()
+
+		inProgress := 0
+		for _, err := range errs {
+			if errors.Is(err, services.ErrKeyRotationInProgress) {
+				inProgress++
+			} else {
+				require.NoError(t, err)
+			}
+		}
+		assert.Equal(t, 1, inProgress)
+	})
+
+	rotations, err := server.Services.keyRotation.GetAll(ctx, *did)
+	require.NoError(t, err)
+	require.Len(t, rotations, 1)
+	rotation := rotations[0]
+	require.Equal(t, domain.KeyRotationStepWaitConfirmation, rotation.Step)
+	require.NotNil(t, rotation.PublishedState)
+
+	_, err = storage.Pgx.Exec(ctx, `UPDATE identity_states SET status = $1, failure_reason = $2 WHERE identifier = $3 AND state = $4`,
+		domain.StatusFailed, "execution reverted", did.String(), *rotation.PublishedState)
+	require.NoError(t, err)
+
+	t.Run("Published state failed", func(t *testing.T) {
+		response := resume(t, rotation.ID)
+		assert.Equal(t, RepublishState, response.Step)
+		require.NotNil(t, response.Error)
+		assert.Contains(t, *response.Error, "execution reverted")
+	})
+
+	t.Run("The worker does not publish the failed state again", func(t *testing.T) {
+		require.NoError(t, server.Services.keyRotation.ProcessPending(ctx))
+		stored, err := server.Services.keyRotation.GetByID(ctx, *did, rotation.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.KeyRotationStepRepublishState, stored.Step)
+	})
+
+	t.Run("Resume publishes the state again", func(t *testing.T) {
+		response := resume(t, rotation.ID)
+		assert.Equal(t, WaitConfirmation, response.Step)
+		assert.Nil(t, response.Error)
+		require.NotNil(t, response.PublishedState)
+		assert.Equal(t, *rotation.PublishedState, *response.PublishedState)
+
+		state, err := server.Repos.identityState.GetByState(ctx, storage.Pgx, *did, *rotation.PublishedState)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusTransacted, state.Status)
+	})
+}
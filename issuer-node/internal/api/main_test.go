@@ -5,9 +5,12 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/hashicorp/vault/api"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/iden3comm/v2"
 	"github.com/iden3/iden3comm/v2/packers"
@@ -18,11 +21,13 @@ import (
 	cache2 "github.com/polygonid/sh-id-platform/internal/cache"
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/db/tests"
 	"github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
@@ -127,12 +132,16 @@ func TestMain(m *testing.M) {
 }
 
 func getHandler(ctx context.Context, server StrictServerInterface) http.Handler {
+	return getHandlerWithMiddlewares(server, middlewares(ctx))
+}
+
+func getHandlerWithMiddlewares(server StrictServerInterface, middlewares []StrictMiddlewareFunc) http.Handler {
 	mux := chi.NewRouter()
 	RegisterStatic(mux)
 	return HandlerWithOptions(
 		NewStrictHandlerWithOptions(
 			server,
-			middlewares(ctx),
+			middlewares,
 			StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
@@ -148,7 +157,8 @@ func middlewares(ctx context.Context) []StrictMiddlewareFunc {
 	usr, pass := authOk()
 	return []StrictMiddlewareFunc{
 		LogMiddleware(ctx),
-		BasicAuthMiddleware(ctx, usr, pass),
+		IdempotencyMiddleware(services.NewIdempotency(repositories.NewIdempotencyKey(*storage), time.Hour)),
+		AuthMiddleware(ctx, usr, pass, services.NewAPIKey(storage, repositories.NewAPIKey(*storage), repositories.NewIdentity()), nil),
 	}
 }
 
@@ -212,6 +222,58 @@ func NewPublisherMock() ports.Publisher {
 
 func NewIdentityMock() ports.IdentityService { return nil }
 
+// publisherMock creates the new state of the identity without sending it on chain
+type publisherMock struct {
+	ports.Publisher
+	identityService ports.IdentityService
+}
+
+func (p *publisherMock) PublishState(ctx context.Context, identity *w3c.DID) (*domain.PublishedState, error) {
+	state, err := p.identityService.UpdateState(ctx, *identity)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.PublishedState{State: state.State, ClaimsTreeRoot: state.ClaimsTreeRoot, RevocationTreeRoot: state.RevocationTreeRoot, RootOfRoots: state.RootOfRoots}, nil
+}
+
+// RetryPublishState moves the failed state of the identity back to transacted without sending it on chain
+func (p *publisherMock) RetryPublishState(ctx context.Context, identity *w3c.DID) (*domain.PublishedState, error) {
+	state, err := p.identityService.GetFailedState(ctx, *identity)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, gateways.ErrNoFailedStatesToProcess
+	}
+	state.Status = domain.StatusTransacted
+	state.FailureReason = nil
+	if err := p.identityService.UpdateIdentityState(ctx, state); err != nil {
+		return nil, err
+	}
+	return &domain.PublishedState{State: state.State, ClaimsTreeRoot: state.ClaimsTreeRoot, RevocationTreeRoot: state.RevocationTreeRoot, RootOfRoots: state.RootOfRoots}, nil
+}
+
+// notificationMock records the renewal notices instead of sending them
+type notificationMock struct {
+	ports.NotificationService
+	expirationNotices []uuid.UUID
+}
+
+func (n *notificationMock) SendExpirationNotice(_ context.Context, credential *domain.Claim) error {
+	n.expirationNotices = append(n.expirationNotices, credential.ID)
+	return nil
+}
+
+// authVerifierMock returns the configured authorization response instead of verifying the proofs
+type authVerifierMock struct {
+	response *protocol.AuthorizationResponseMessage
+	err      error
+}
+
+func (a *authVerifierMock) FullVerify(_ context.Context, _ string, _ protocol.AuthorizationRequestMessage, _ ...pubsignals.VerifyOpt) (*protocol.AuthorizationResponseMessage, error) {
+	return a.response, a.err
+}
+
 func NewClaimsMock() ports.ClaimService {
 	return nil
 }
@@ -241,17 +303,27 @@ type repos struct {
 	revocation     ports.RevocationRepository
 	displayMethod  ports.DisplayMethodRepository
 	keyRepository  ports.KeyRepository
+	bulkIssuance   ports.BulkIssuanceRepository
 }
 
 type servicex struct {
-	credentials   ports.ClaimService
-	identity      ports.IdentityService
-	schema        ports.SchemaService
-	links         ports.LinkService
-	payments      ports.PaymentService
-	qrs           ports.QrStoreService
-	displayMethod ports.DisplayMethodService
-	keyService    ports.KeyService
+	credentials          ports.ClaimService
+	identity             ports.IdentityService
+	schema               ports.SchemaService
+	links                ports.LinkService
+	payments             ports.PaymentService
+	qrs                  ports.QrStoreService
+	displayMethod        ports.DisplayMethodService
+	keyService           ports.KeyService
+	bulkIssuance         ports.BulkIssuanceService
+	credentialExpiration ports.CredentialExpirationService
+	keyRotation          ports.KeyRotationService
+	publishPolicy        ports.PublishPolicyService
+}
+
+type mocks struct {
+	notification *notificationMock
+	authVerifier *authVerifierMock
 }
 
 type infra struct {
@@ -263,6 +335,7 @@ type testServer struct {
 	*Server
 	Repos    repos
 	Services servicex
+	Mocks    mocks
 	Infra    infra
 }
 
@@ -284,6 +357,7 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 		revocation:     repositories.NewRevocation(),
 		displayMethod:  repositories.NewDisplayMethod(*st),
 		keyRepository:  repositories.NewKey(*st),
+		bulkIssuance:   repositories.NewBulkIssuance(*st),
 	}
 
 	pubSub := pubsub.NewMock()
@@ -362,31 +436,57 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
 			protocol.RevocationStatusRequestMessageType: {"*"},
 			protocol.DiscoverFeatureQueriesMessageType:  {"*"},
+			protocol.CredentialRefreshMessageType:       {string(packers.MediaTypeZKPMessage)},
 		},
 		true,
 	)
 
 	packageManager, err := NewPackageManagerMock()
 	require.NoError(t, err)
-	claimsService := services.NewClaim(repos.claims, identityService, qrService, mtService, repos.identityState, schemaLoader, st, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	statusListRepository := repositories.NewStatusList(*st)
+	statusListService := services.NewStatusList(st, statusListRepository, keyStore, revocationStatusResolver)
+	claimsService := services.NewClaim(repos.claims, identityService, qrService, mtService, repos.identityState, schemaLoader, st, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, statusListService, mediaTypeManager, cfg.UniversalLinks, services.NewEncryptionKeyResolver(repos.connection, st, nil))
 	accountService := services.NewAccountService(*networkResolver)
 	linkService := services.NewLinkService(storage, claimsService, qrService, repos.claims, repos.links, repos.schemas, schemaLoader, repos.sessions, pubSub, identityService, *networkResolver, cfg.UniversalLinks)
 	keyService := services.NewKey(keyStore, claimsService, repos.keyRepository)
+	credentialExportService := services.NewCredentialExport(keyService, keyStore)
+	credentialTemplateService := services.NewCredentialTemplate(repositories.NewCredentialTemplate(*st), schemaService, displayMethodService)
 	discoveryService := services.NewDiscovery(mediaTypeManager, packageManager, mediaTypeManager.GetSupportedProtocolMessages())
-	server := NewServer(&cfg, identityService, accountService, connectionService, claimsService, qrService, NewPublisherMock(), packageManager, *networkResolver, nil, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService)
+	bulkIssuanceService := services.NewBulkIssuance(st, repos.bulkIssuance, schemaService, claimsService, repos.claims, schemaLoader, pubSub, services.DefaultBulkIssuanceBatchSize)
+	notificationService := &notificationMock{}
+	credentialExpirationService := services.NewCredentialExpiration(repositories.NewCredentialExpirationPolicy(*st), schemaService, repos.claims, claimsService, notificationService, st)
+	refreshService := services.NewRefresh(claimsService, nil, mediaTypeManager)
+	authVerifier := &authVerifierMock{}
+	verificationService := services.NewVerification(repositories.NewVerification(*st), authVerifier, qrService, cfg.UniversalLinks)
+	keyRotationService := services.NewKeyRotation(st, repositories.NewKeyRotation(*st), keyService, identityService, claimsService, repos.claims, &publisherMock{identityService: identityService})
+	apiKeyService := services.NewAPIKey(st, repositories.NewAPIKey(*st), repos.identity)
+	didDocumentService := services.NewDIDDocument(st, repos.identity, repos.claims, keyStore, cfg.ServerUrl, config.DIDDocument{PushServiceURL: "https://push.testing.env/api/v1", RefreshServiceURL: "https://refresh.testing.env"})
+	credentialVerificationService := services.NewCredentialVerification(repos.identityState, claimsService, statusListRepository, didDocumentService, st, schemaLoader)
+	merkleTreeIntegrityService := services.NewMerkleTreeIntegrity(st, mtService, repos.identityState, repos.claims, repos.revocation, *networkResolver)
+	publishPolicyService := services.NewPublishPolicy(repositories.NewPublishPolicy(*st), repos.identity, &publisherMock{identityService: identityService}, st)
+	stateReplicationService := services.NewStateReplication(repositories.NewStateReplication(), repos.identityState, nil, *networkResolver, st)
+	server := NewServer(&cfg, identityService, accountService, connectionService, claimsService, qrService, NewPublisherMock(), packageManager, *networkResolver, nil, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService, bulkIssuanceService, statusListService, credentialExportService, credentialTemplateService, credentialExpirationService, refreshService, credentialVerificationService, verificationService, keyRotationService, apiKeyService, didDocumentService, merkleTreeIntegrityService, publishPolicyService, stateReplicationService)
 
 	return &testServer{
 		Server: server,
 		Repos:  repos,
 		Services: servicex{
-			credentials:   claimsService,
-			identity:      identityService,
-			links:         linkService,
-			payments:      paymentService,
-			qrs:           qrService,
-			schema:        schemaService,
-			displayMethod: displayMethodService,
-			keyService:    keyService,
+			credentials:          claimsService,
+			identity:             identityService,
+			links:                linkService,
+			payments:             paymentService,
+			qrs:                  qrService,
+			schema:               schemaService,
+			displayMethod:        displayMethodService,
+			keyService:           keyService,
+			bulkIssuance:         bulkIssuanceService,
+			credentialExpiration: credentialExpirationService,
+			keyRotation:          keyRotationService,
+			publishPolicy:        publishPolicyService,
+		},
+		Mocks: mocks{
+			notification: notificationService,
+			authVerifier: authVerifier,
 		},
 		Infra: infra{
 			db:     st,
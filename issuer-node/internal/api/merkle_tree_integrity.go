@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// CheckMerkleTreesIntegrity - rebuild the merkle trees of the identity and report the divergences and stale proofs
+func (s *Server) CheckMerkleTreesIntegrity(ctx context.Context, request CheckMerkleTreesIntegrityRequestObject) (CheckMerkleTreesIntegrityResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CheckMerkleTreesIntegrity400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	report, err := s.merkleTreeIntegrityService.Check(ctx, *did, request.Params.Repair != nil && *request.Params.Repair)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrMerkleTreeIntegrityWebIdentity):
+			return CheckMerkleTreesIntegrity400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, repositories.ErrIdentityNotFound):
+			return CheckMerkleTreesIntegrity404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrMerkleTreeRepairDiverged):
+			return CheckMerkleTreesIntegrity409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "checking merkle trees integrity", "err", err, "did", did)
+		return CheckMerkleTreesIntegrity500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return CheckMerkleTreesIntegrity200JSONResponse(merkleTreeIntegrityReportResponse(report)), nil
+}
+
+func merkleTreeIntegrityReportResponse(report *domain.MerkleTreeIntegrityReport) MerkleTreeIntegrityReport {
+	resp := MerkleTreeIntegrityReport{
+		Identifier:      report.Identifier,
+		CheckedAt:       TimeUTC(report.CheckedAt),
+		Healthy:         report.Healthy(),
+		LatestState:     report.LatestState,
+		ConfirmedState:  report.ConfirmedState,
+		RecomputedState: report.RecomputedState,
+		OnChainState:    report.OnChainState,
+		OnChainError:    report.OnChainError,
+		Divergences:     make([]MerkleTreeDivergence, len(report.Divergences)),
+		StaleProofs:     make([]StaleMTPProof, len(report.StaleProofs)),
+		RepairedProofs:  report.RepairedProofs,
+	}
+	for i, divergence := range report.Divergences {
+		resp.Divergences[i] = MerkleTreeDivergence{
+			Source:   MerkleTreeDivergenceSource(divergence.Source),
+			Tree:     MerkleTreeDivergenceTree(divergence.Tree),
+			State:    divergence.State,
+			Expected: divergence.Expected,
+			Actual:   divergence.Actual,
+		}
+	}
+	for i, stale := range report.StaleProofs {
+		resp.StaleProofs[i] = StaleMTPProof{
+			CredentialID: stale.ClaimID,
+			State:        stale.State,
+			Reason:       StaleMTPProofReason(stale.Reason),
+		}
+	}
+	return resp
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_CheckMerkleTreesIntegrity(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	credentialSubject := map[string]any{
+		"id":           userDID,
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	credential, err := server.Services.credentials.Save(ctx, ports.NewCreateClaimRequest(did, nil, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(365*24*time.Hour)), schemaType, nil, nil, nil, ports.ClaimRequestProofs{Iden3SparseMerkleTreeProof: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil, nil))
+	require.NoError(t, err)
+	state, err := server.Services.identity.UpdateState(ctx, *did)
+	require.NoError(t, err)
+	_, err = storage.Pgx.Exec(ctx, `UPDATE identity_states SET status = $1 WHERE identifier = $2 AND state = $3`, domain.StatusConfirmed, did.String(), *state.State)
+	require.NoError(t, err)
+
+	check := func(t *testing.T, did string, repair bool) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/merkle-trees/integrity?repair=%t", did, repair), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	report := func(t *testing.T, rr *httptest.ResponseRecorder) MerkleTreeIntegrityReport {
+		t.Helper()
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response CheckMerkleTreesIntegrity200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return MerkleTreeIntegrityReport(response)
+	}
+
+	t.Run("Missing proof of a confirmed state", func(t *testing.T) {
+		response := report(t, check(t, did.String(), false))
+		assert.Equal(t, did.String(), response.Identifier)
+		assert.Equal(t, state.State, response.LatestState)
+		assert.Equal(t, state.State, response.ConfirmedState)
+		assert.Equal(t, *state.State, response.RecomputedState)
+		assert.Empty(t, response.Divergences)
+		assert.Equal(t, []StaleMTPProof{{CredentialID: credential.ID, State: state.State, Reason: StaleMTPProofReasonMissing}}, response.StaleProofs)
+		assert.Empty(t, response.RepairedProofs)
+		assert.False(t, response.Healthy)
+	})
+
+	t.Run("Repair", func(t *testing.T) {
+		response := report(t, check(t, did.String(), true))
+		require.Len(t, response.StaleProofs, 1)
+		assert.Equal(t, []uuid.UUID{credential.ID}, response.RepairedProofs)
+
+		response = report(t, check(t, did.String(), false))
+		assert.Empty(t, response.Divergences)
+		assert.Empty(t, response.StaleProofs)
+		assert.True(t, response.Healthy)
+	})
+
+	t.Run("Credential not published yet", func(t *testing.T) {
+		pending, err := server.Services.credentials.Save(ctx, ports.NewCreateClaimRequest(did, nil, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(365*24*time.Hour)), schemaType, nil, nil, nil, ports.ClaimRequestProofs{Iden3SparseMerkleTreeProof: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil, nil))
+		require.NoError(t, err)
+		require.True(t, pending.MtProof)
+		require.Nil(t, pending.IdentityState)
+
+		response := report(t, check(t, did.String(), false))
+		assert.Equal(t, *state.State, response.RecomputedState)
+		assert.Empty(t, response.Divergences)
+		assert.Empty(t, response.StaleProofs)
+		assert.True(t, response.Healthy)
+	})
+
+	t.Run("Stored root diverges", func(t *testing.T) {
+		_, err := storage.Pgx.Exec(ctx, `UPDATE identity_states SET claims_tree_root = $1 WHERE identifier = $2 AND state = $3`, merkletree.HashZero.Hex(), did.String(), *state.State)
+		require.NoError(t, err)
+
+		response := report(t, check(t, did.String(), false))
+		assert.False(t, response.Healthy)
+		assert.Contains(t, response.Divergences, MerkleTreeDivergence{
+			Source:   MerkleTreeDivergenceSourceState,
+			Tree:     MerkleTreeDivergenceTreeClaims,
+			State:    state.State,
+			Expected: merkletree.HashZero.Hex(),
+			Actual:   *state.ClaimsTreeRoot,
+		})
+
+		rr := check(t, did.String(), true)
+		assert.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("did:web identity", func(t *testing.T) {
+		body := CreateIdentityRequest{}
+		body.DidMetadata.Method = string(domain.DIDMethodWeb)
+		body.DidMetadata.Type = Ed25519Key
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/v2/identities", tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var created CreateIdentityResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+		require.NotNil(t, created.Identifier)
+
+		assert.Equal(t, http.StatusBadRequest, check(t, *created.Identifier, false).Code)
+	})
+
+	t.Run("Unknown identity", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, check(t, "did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu", false).Code)
+	})
+
+	t.Run("Invalid did", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, check(t, "wrong", false).Code)
+	})
+
+	t.Run("Requires admin credentials", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/merkle-trees/integrity", did), nil)
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
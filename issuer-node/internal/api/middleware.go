@@ -1,15 +1,27 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
 	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/oidc"
 )
 
 // LogMiddleware returns a middleware that adds general log configuration to each context request
@@ -24,14 +36,140 @@ func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 	}
 }
 
-// BasicAuthMiddleware returns a middleware that performs an http basic authorization for endpoints configured with
-// basic auth in the api spec.
-// In uses the BasicAuthScopes value in context to figure if and endpoint needs authorization or not, because this
-// value is injected automatically by openapi when basic auth is selected
-func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewareFunc {
+const apiKeyHeader = "X-API-Key"
+
+// apiKeyPermissions is the permission that API keys and bearer tokens need for each operation. Operations that are
+// not listed here can only be performed with the basic auth credentials or an admin bearer token.
+var apiKeyPermissions = map[string]domain.APIKeyPermission{
+	"GetSupportedNetworks": domain.APIKeyPermissionIdentitiesRead,
+	"UpdateIdentity":       domain.APIKeyPermissionIdentitiesWrite,
+	"GetIdentityDetails":   domain.APIKeyPermissionIdentitiesRead,
+	"RetryPublishState":    domain.APIKeyPermissionIdentitiesWrite,
+	"DryRunPublishState":   domain.APIKeyPermissionIdentitiesWrite,
+	"PublishIdentityState": domain.APIKeyPermissionIdentitiesWrite,
+	"GetStateTransactions": domain.APIKeyPermissionIdentitiesRead,
+	"GetStateStatus":       domain.APIKeyPermissionIdentitiesRead,
+	"GetStateDetails":      domain.APIKeyPermissionIdentitiesRead,
+	"GetStateDiff":         domain.APIKeyPermissionIdentitiesRead,
+	"GetPublishPolicy":     domain.APIKeyPermissionIdentitiesRead,
+	"UpdatePublishPolicy":  domain.APIKeyPermissionIdentitiesWrite,
+	"DeletePublishPolicy":  domain.APIKeyPermissionIdentitiesWrite,
+	"CreateAuthCredential": domain.APIKeyPermissionIdentitiesWrite,
+
+	"getConnection":               domain.APIKeyPermissionConnectionsRead,
+	"getConnections":              domain.APIKeyPermissionConnectionsRead,
+	"createConnection":            domain.APIKeyPermissionConnectionsWrite,
+	"deleteConnection":            domain.APIKeyPermissionConnectionsWrite,
+	"deleteConnectionCredentials": domain.APIKeyPermissionConnectionsWrite,
+	"revokeConnectionCredentials": domain.APIKeyPermissionConnectionsWrite,
+
+	"CreateCredential":                 domain.APIKeyPermissionCredentialsWrite,
+	"GetCredentials":                   domain.APIKeyPermissionCredentialsRead,
+	"GetCredential":                    domain.APIKeyPermissionCredentialsRead,
+	"ReissueCredential":                domain.APIKeyPermissionCredentialsWrite,
+	"DeleteCredential":                 domain.APIKeyPermissionCredentialsWrite,
+	"RevokeCredential":                 domain.APIKeyPermissionCredentialsWrite,
+	"SuspendCredential":                domain.APIKeyPermissionCredentialsWrite,
+	"ResumeCredential":                 domain.APIKeyPermissionCredentialsWrite,
+	"CreateBulkIssuanceJob":            domain.APIKeyPermissionCredentialsWrite,
+	"GetBulkIssuanceJob":               domain.APIKeyPermissionCredentialsRead,
+	"GetBulkIssuanceJobRows":           domain.APIKeyPermissionCredentialsRead,
+	"GetCredentialOffer":               domain.APIKeyPermissionCredentialsRead,
+	"CreateCredentialExpirationPolicy": domain.APIKeyPermissionCredentialsWrite,
+	"GetCredentialExpirationPolicies":  domain.APIKeyPermissionCredentialsRead,
+	"UpdateCredentialExpirationPolicy": domain.APIKeyPermissionCredentialsWrite,
+	"DeleteCredentialExpirationPolicy": domain.APIKeyPermissionCredentialsWrite,
+	"CreateCredentialTemplate":         domain.APIKeyPermissionCredentialsWrite,
+	"GetCredentialTemplates":           domain.APIKeyPermissionCredentialsRead,
+	"GetCredentialTemplate":            domain.APIKeyPermissionCredentialsRead,
+	"UpdateCredentialTemplate":         domain.APIKeyPermissionCredentialsWrite,
+	"DeleteCredentialTemplate":         domain.APIKeyPermissionCredentialsWrite,
+	"CreateCredentialFromTemplate":     domain.APIKeyPermissionCredentialsWrite,
+
+	"ImportSchema":         domain.APIKeyPermissionSchemasWrite,
+	"GetSchemas":           domain.APIKeyPermissionSchemasRead,
+	"GetSchema":            domain.APIKeyPermissionSchemasRead,
+	"UpdateSchema":         domain.APIKeyPermissionSchemasWrite,
+	"CreateDisplayMethod":  domain.APIKeyPermissionSchemasWrite,
+	"GetAllDisplayMethods": domain.APIKeyPermissionSchemasRead,
+	"GetDisplayMethod":     domain.APIKeyPermissionSchemasRead,
+	"UpdateDisplayMethod":  domain.APIKeyPermissionSchemasWrite,
+	"DeleteDisplayMethod":  domain.APIKeyPermissionSchemasWrite,
+
+	"GetLinks":               domain.APIKeyPermissionLinksRead,
+	"CreateLink":             domain.APIKeyPermissionLinksWrite,
+	"GetLink":                domain.APIKeyPermissionLinksRead,
+	"ActivateLink":           domain.APIKeyPermissionLinksWrite,
+	"DeleteLink":             domain.APIKeyPermissionLinksWrite,
+	"CreateLinkFromTemplate": domain.APIKeyPermissionLinksWrite,
+
+	"CreateVerificationQuery":   domain.APIKeyPermissionVerificationsWrite,
+	"GetVerificationQueries":    domain.APIKeyPermissionVerificationsRead,
+	"GetVerificationQuery":      domain.APIKeyPermissionVerificationsRead,
+	"DeleteVerificationQuery":   domain.APIKeyPermissionVerificationsWrite,
+	"CreateVerificationSession": domain.APIKeyPermissionVerificationsWrite,
+	"GetVerificationSession":    domain.APIKeyPermissionVerificationsRead,
+
+	"CreateKey":                 domain.APIKeyPermissionKeysAdmin,
+	"GetKeys":                   domain.APIKeyPermissionKeysAdmin,
+	"GetKey":                    domain.APIKeyPermissionKeysAdmin,
+	"UpdateKey":                 domain.APIKeyPermissionKeysAdmin,
+	"DeleteKey":                 domain.APIKeyPermissionKeysAdmin,
+	"CreateKeyRotation":         domain.APIKeyPermissionKeysAdmin,
+	"GetKeyRotations":           domain.APIKeyPermissionKeysAdmin,
+	"GetKeyRotation":            domain.APIKeyPermissionKeysAdmin,
+	"ResumeKeyRotation":         domain.APIKeyPermissionKeysAdmin,
+	"GetKeyRotationCredentials": domain.APIKeyPermissionKeysAdmin,
+
+	"GetPaymentSettings":   domain.APIKeyPermissionPaymentsRead,
+	"GetPaymentRequests":   domain.APIKeyPermissionPaymentsRead,
+	"CreatePaymentRequest": domain.APIKeyPermissionPaymentsWrite,
+	"GetPaymentRequest":    domain.APIKeyPermissionPaymentsRead,
+	"DeletePaymentRequest": domain.APIKeyPermissionPaymentsWrite,
+	"GetPaymentOptions":    domain.APIKeyPermissionPaymentsRead,
+	"CreatePaymentOption":  domain.APIKeyPermissionPaymentsWrite,
+	"GetPaymentOption":     domain.APIKeyPermissionPaymentsRead,
+	"DeletePaymentOption":  domain.APIKeyPermissionPaymentsWrite,
+	"UpdatePaymentOption":  domain.APIKeyPermissionPaymentsWrite,
+	"VerifyPayment":        domain.APIKeyPermissionPaymentsWrite,
+}
+
+// scopedCredentials are credentials that are only allowed to perform some operations on some identities
+type scopedCredentials interface {
+	HasPermission(permission domain.APIKeyPermission) bool
+	HasIdentity(did string) bool
+}
+
+// AuthMiddleware returns a middleware that authorizes the requests to the endpoints configured with basic auth in the
+// api spec. In uses the BasicAuthScopes value in context to figure if and endpoint needs authorization or not, because
+// this value is injected automatically by openapi when basic auth is selected.
+// Requests with an X-API-Key header are authorized with the API key, and requests with a bearer token are authorized
+// with the roles of the token when tokenAuthenticator is not nil. In both cases the credentials must have the permission
+// required by the operation and, for the endpoints with an {identifier} path parameter, must be allowed to use that identity.
+// Any other request is authorized with the basic auth user and password, that are allowed to perform every operation.
+// The authenticated principal is added to the context of the handler, so that the next middlewares can scope their
+// data by caller, see principalFromContext.
+func AuthMiddleware(ctx context.Context, user, pass string, apiKeyService ports.APIKeyService, tokenAuthenticator *oidc.Authenticator) StrictMiddlewareFunc {
 	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
 		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
-			if ctxReq.Value(BasicAuthScopes) != nil && user != "" && pass != "" {
+			if ctxReq.Value(BasicAuthScopes) == nil {
+				return f(ctx, w, r, args)
+			}
+			if key := r.Header.Get(apiKeyHeader); key != "" {
+				principal, err := authorizeAPIKey(ctxReq, apiKeyService, key, operationID, r)
+				if err != nil {
+					return nil, err
+				}
+				return f(withPrincipal(ctx, principal), w, r, args)
+			}
+			if token, ok := bearerToken(r); ok && tokenAuthenticator != nil {
+				principal, err := authorizeBearerToken(ctxReq, tokenAuthenticator, token, operationID, r)
+				if err != nil {
+					return nil, err
+				}
+				return f(withPrincipal(ctx, principal), w, r, args)
+			}
+			if user != "" && pass != "" {
 				userReq, passReq, ok := r.BasicAuth()
 				if !ok {
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
@@ -40,7 +178,230 @@ func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewar
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
 				}
 			}
-			return f(ctx, w, r, args)
+			return f(withPrincipal(ctx, "basic:"+user), w, r, args)
+		}
+	}
+}
+
+func authorizeAPIKey(ctx context.Context, apiKeyService ports.APIKeyService, key string, operationID string, r *http.Request) (string, error) {
+	apiKey, err := apiKeyService.Authenticate(ctx, key)
+	if err != nil {
+		if errors.Is(err, services.ErrAPIKeyInvalid) {
+			return "", apiErrors.AuthError{Err: errors.New("unauthorized")}
+		}
+		return "", err
+	}
+	if err := authorizeScope(apiKey, operationID, r); err != nil {
+		return "", err
+	}
+	return "api-key:" + apiKey.ID.String(), nil
+}
+
+func authorizeBearerToken(ctx context.Context, tokenAuthenticator *oidc.Authenticator, token string, operationID string, r *http.Request) (string, error) {
+	principal, err := tokenAuthenticator.Authenticate(ctx, token)
+	if err != nil {
+		switch {
+		case errors.Is(err, oidc.ErrInvalidToken):
+			return "", apiErrors.AuthError{Err: errors.New("unauthorized")}
+		case errors.Is(err, oidc.ErrNoRoles):
+			return "", apiErrors.ForbiddenError{Err: err}
+		}
+		return "", err
+	}
+	if !principal.Admin {
+		if err := authorizeScope(principal, operationID, r); err != nil {
+			return "", err
+		}
+	}
+	return "oidc:" + principal.Subject, nil
+}
+
+// principalCtxKey is the context key of the authenticated principal
+type principalCtxKey struct{}
+
+// withPrincipal returns a copy of the context with the authenticated principal, which is "api-key:<id>" for API keys,
+// "oidc:<subject>" for bearer tokens and "basic:<user>" for basic auth
+func withPrincipal(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, principal)
+}
+
+// principalFromContext returns the authenticated principal of the request or an empty string if there is none
+func principalFromContext(ctx context.Context) string {
+	principal, _ := ctx.Value(principalCtxKey{}).(string)
+	return principal
+}
+
+// authorizeScope checks that the credentials have the permission required by the operation and that they are allowed
+// to use the identity of the {identifier} path parameter
+func authorizeScope(credentials scopedCredentials, operationID string, r *http.Request) error {
+	permission, ok := apiKeyPermissions[operationID]
+	if !ok {
+		return apiErrors.ForbiddenError{Err: errors.New("the operation can only be performed by an administrator")}
+	}
+	if !credentials.HasPermission(permission) {
+		return apiErrors.ForbiddenError{Err: fmt.Errorf("the %s permission is required", permission)}
+	}
+	if identifier := chi.URLParam(r, "identifier"); identifier != "" {
+		did, err := url.PathUnescape(identifier)
+		if err != nil {
+			did = identifier
+		}
+		if !credentials.HasIdentity(did) {
+			return apiErrors.ForbiddenError{Err: errors.New("not allowed to use this identity")}
+		}
+	}
+	return nil
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+	header := r.Header.Get("Authorization")
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(header[len(prefix):]), true
+}
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	defaultResponseStatusCode = http.StatusOK
+)
+
+// responseVisitor writes a strict handler response to the response writer
+type responseVisitor func(response interface{}, w http.ResponseWriter) error
+
+// idempotentOperations are the operations that support the Idempotency-Key header
+var idempotentOperations = map[string]responseVisitor{
+	"CreateIdentity":               visitor(CreateIdentityResponseObject.VisitCreateIdentityResponse),
+	"CreateCredential":             visitor(CreateCredentialResponseObject.VisitCreateCredentialResponse),
+	"CreateCredentialFromTemplate": visitor(CreateCredentialFromTemplateResponseObject.VisitCreateCredentialFromTemplateResponse),
+	"CreateLink":                   visitor(CreateLinkResponseObject.VisitCreateLinkResponse),
+	"CreateLinkFromTemplate":       visitor(CreateLinkFromTemplateResponseObject.VisitCreateLinkFromTemplateResponse),
+	"CreatePaymentRequest":         visitor(CreatePaymentRequestResponseObject.VisitCreatePaymentRequestResponse),
+	"CreateKey":                    visitor(CreateKeyResponseObject.VisitCreateKeyResponse),
+}
+
+func visitor[T any](visit func(T, http.ResponseWriter) error) responseVisitor {
+	return func(response interface{}, w http.ResponseWriter) error {
+		validResponse, ok := response.(T)
+		if !ok {
+			return fmt.Errorf("unexpected response type: %T", response)
+		}
+		return visit(validResponse, w)
+	}
+}
+
+// IdempotencyMiddleware returns a middleware that makes the creation endpoints idempotent for requests with an
+// Idempotency-Key header. The first response is stored and replayed for repeated requests with the same key and request.
+// A request that reuses a key with a different request gets a conflict error.
+// Server errors are not stored so that the request can be retried. Keys are scoped by the authenticated principal, so
+// different API keys, token subjects or users can use the same key without seeing each other's responses.
+// It must be placed before AuthMiddleware in the middlewares list so that it runs after the authorization.
+func IdempotencyMiddleware(idempotencyService ports.IdempotencyService) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		visit, ok := idempotentOperations[operationID]
+		if !ok {
+			return f
+		}
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			key := r.Header.Get(idempotencyKeyHeader)
+			if key == "" {
+				return f(ctxReq, w, r, args)
+			}
+
+			principal := principalFromContext(ctxReq)
+			requestHash, err := idempotencyRequestHash(operationID, args)
+			if err != nil {
+				return nil, err
+			}
+			stored, err := idempotencyService.Start(ctxReq, principal, key, requestHash)
+			if err != nil {
+				if errors.Is(err, services.ErrIdempotencyKeyMismatch) || errors.Is(err, services.ErrIdempotencyKeyInProgress) {
+					return nil, apiErrors.ConflictError{Err: err}
+				}
+				return nil, err
+			}
+			if stored != nil {
+				if stored.ContentType != nil {
+					w.Header().Set("Content-Type", *stored.ContentType)
+				}
+				w.Header().Set(idempotentReplayedHeader, "true")
+				w.WriteHeader(*stored.StatusCode)
+				_, _ = w.Write(stored.ResponseBody)
+				return nil, nil
+			}
+
+			response, err := f(ctxReq, w, r, args)
+			if err != nil {
+				if err := idempotencyService.Release(ctxReq, principal, key); err != nil {
+					log.Error(ctxReq, "releasing idempotency key", "err", err)
+				}
+				return nil, err
+			}
+
+			recorder := newBufferedResponseWriter()
+			if err := visit(response, recorder); err != nil {
+				if err := idempotencyService.Release(ctxReq, principal, key); err != nil {
+					log.Error(ctxReq, "releasing idempotency key", "err", err)
+				}
+				return nil, err
+			}
+			if recorder.status >= http.StatusInternalServerError {
+				err = idempotencyService.Release(ctxReq, principal, key)
+			} else {
+				err = idempotencyService.Complete(ctxReq, principal, key, recorder.status, recorder.Header().Get("Content-Type"), recorder.body.Bytes())
+			}
+			if err != nil {
+				log.Error(ctxReq, "storing idempotent response", "err", err)
+			}
+			recorder.writeTo(w)
+			return nil, nil
+		}
+	}
+}
+
+// idempotencyRequestHash returns the hash of the decoded request, which includes the path parameters and the body
+func idempotencyRequestHash(operationID string, args interface{}) (string, error) {
+	request, err := json.Marshal(args)
+	if err != nil {
+		return "", fmt.Errorf("hashing request: %w", err)
+	}
+	hash := sha256.New()
+	hash.Write([]byte(operationID))
+	hash.Write(request)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// bufferedResponseWriter keeps the response in memory so that it can be stored before it is sent
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedResponseWriter() *bufferedResponseWriter {
+	return &bufferedResponseWriter{header: http.Header{}, status: defaultResponseStatusCode}
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
+
+func (b *bufferedResponseWriter) WriteHeader(statusCode int) {
+	b.status = statusCode
+}
+
+func (b *bufferedResponseWriter) writeTo(w http.ResponseWriter) {
+	for name, values := range b.header {
+		for _, value := range values {
+			w.Header().Add(name, value)
 		}
 	}
+	w.WriteHeader(b.status)
+	_, _ = w.Write(b.body.Bytes())
 }
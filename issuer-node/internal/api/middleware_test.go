@@ -0,0 +1,283 @@
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/oidc"
+)
+
+func TestServer_IdempotencyMiddleware(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	body := func(birthday int) CreateCredentialRequest {
+		return CreateCredentialRequest{
+			CredentialSchema: schemaURL,
+			Type:             schemaType,
+			CredentialSubject: map[string]any{
+				"id":           userDID,
+				"birthday":     birthday,
+				"documentType": 2,
+			},
+			Expiration: common.ToPointer(time.Now().Add(365 * 24 * time.Hour).Unix()),
+		}
+	}
+	createCredential := func(t *testing.T, auth func() (string, string), key string, request CreateCredentialRequest) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials", did), tests.JSONBody(t, request))
+		require.NoError(t, err)
+		req.SetBasicAuth(auth())
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	credentialID := func(t *testing.T, rr *httptest.ResponseRecorder) string {
+		t.Helper()
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response CreateCredentialResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response.Id
+	}
+
+	t.Run("Without key", func(t *testing.T) {
+		first := credentialID(t, createCredential(t, authOk, "", body(19960424)))
+		second := credentialID(t, createCredential(t, authOk, "", body(19960424)))
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("Same key and body", func(t *testing.T) {
+		key := uuid.NewString()
+		rr := createCredential(t, authOk, key, body(19960424))
+		first := credentialID(t, rr)
+		assert.Empty(t, rr.Header().Get("Idempotent-Replayed"))
+
+		rr = createCredential(t, authOk, key, body(19960424))
+		assert.Equal(t, first, credentialID(t, rr))
+		assert.Equal(t, "true", rr.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	})
+
+	t.Run("Same key and different body", func(t *testing.T) {
+		key := uuid.NewString()
+		credentialID(t, createCredential(t, authOk, key, body(19960424)))
+
+		rr := createCredential(t, authOk, key, body(19970101))
+		require.Equal(t, http.StatusConflict, rr.Code)
+		var response GenericErrorMessage
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "the idempotency key has already been used with a different request", response.Message)
+	})
+
+	t.Run("Client errors are replayed", func(t *testing.T) {
+		key := uuid.NewString()
+		invalid := body(19960424)
+		invalid.CredentialStatusType = common.ToPointer(CreateCredentialRequestCredentialStatusType("wrong"))
+		rr := createCredential(t, authOk, key, invalid)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = createCredential(t, authOk, key, invalid)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "true", rr.Header().Get("Idempotent-Replayed"))
+	})
+
+	t.Run("Keys are scoped by principal", func(t *testing.T) {
+		_, apiKey, err := server.apiKeyService.Create(ctx, ports.APIKeyRequest{
+			Name:        "idempotency",
+			Identities:  []w3c.DID{*did},
+			Permissions: []domain.APIKeyPermission{domain.APIKeyPermissionCredentialsWrite},
+		})
+		require.NoError(t, err)
+
+		key := uuid.NewString()
+		first := credentialID(t, createCredential(t, authOk, key, body(19960424)))
+
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials", did), tests.JSONBody(t, body(19970101)))
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, apiKey)
+		req.Header.Set("Idempotency-Key", key)
+		handler.ServeHTTP(rr, req)
+		assert.NotEqual(t, first, credentialID(t, rr))
+		assert.Empty(t, rr.Header().Get("Idempotent-Replayed"))
+	})
+
+	t.Run("Unauthorized requests do not use the key", func(t *testing.T) {
+		key := uuid.NewString()
+		rr := createCredential(t, authWrong, key, body(19960424))
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		rr = createCredential(t, authOk, key, body(19960424))
+		credentialID(t, rr)
+		assert.Empty(t, rr.Header().Get("Idempotent-Replayed"))
+	})
+}
+
+func TestServer_BearerAuthMiddleware(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		issuer     = "https://idp.example.com/realms/issuer"
+		audience   = "issuer-node"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	otherIden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	jwksFile := filepath.Join(dir, "jwks.json")
+	jwks, err := json.Marshal(map[string]any{
+		"keys": []map[string]any{{
+			"kty": "RSA",
+			"kid": "test-key",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(jwksFile, jwks, 0o600))
+	rolesFile := filepath.Join(dir, "roles.yaml")
+	roles := fmt.Sprintf("roles:\n  admins:\n    admin: true\n  operators:\n    identities: [\"%s\"]\n    permissions: [\"identities:read\"]\n", iden.Identifier)
+	require.NoError(t, os.WriteFile(rolesFile, []byte(roles), 0o600))
+
+	tokenAuthenticator, err := oidc.NewAuthenticator(ctx, config.OIDC{JWKSFile: jwksFile, Issuer: issuer, Audience: audience, RolesClaim: "groups", RolesPath: rolesFile})
+	require.NoError(t, err)
+	usr, pass := authOk()
+	handler := getHandlerWithMiddlewares(server, []StrictMiddlewareFunc{
+		LogMiddleware(ctx),
+		AuthMiddleware(ctx, usr, pass, server.apiKeyService, tokenAuthenticator),
+	})
+
+	token := func(t *testing.T, groups ...string) string {
+		t.Helper()
+		header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": "test-key"})
+		require.NoError(t, err)
+		payload, err := json.Marshal(map[string]any{"iss": issuer, "aud": audience, "sub": "alice", "exp": time.Now().Add(time.Hour).Unix(), "groups": groups})
+		require.NoError(t, err)
+		signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+		hash := sha256.Sum256([]byte(signingInput))
+		signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hash[:])
+		require.NoError(t, err)
+		return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		url      string
+		token    string
+		httpCode int
+	}{
+		{name: "Operator on its identity", url: fmt.Sprintf("/v2/identities/%s", iden.Identifier), token: token(t, "operators"), httpCode: http.StatusOK},
+		{name: "Operator on another identity", url: fmt.Sprintf("/v2/identities/%s", otherIden.Identifier), token: token(t, "operators"), httpCode: http.StatusForbidden},
+		{name: "Operator without permission", url: fmt.Sprintf("/v2/identities/%s/credentials", iden.Identifier), token: token(t, "operators"), httpCode: http.StatusForbidden},
+		{name: "Operator on an admin operation", url: "/v2/identities", token: token(t, "operators"), httpCode: http.StatusForbidden},
+		{name: "Admin", url: "/v2/identities", token: token(t, "admins"), httpCode: http.StatusOK},
+		{name: "No mapped roles", url: fmt.Sprintf("/v2/identities/%s", iden.Identifier), token: token(t, "others"), httpCode: http.StatusForbidden},
+		{name: "Invalid token", url: fmt.Sprintf("/v2/identities/%s", iden.Identifier), token: "invalid", httpCode: http.StatusUnauthorized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tc.httpCode, rr.Code, rr.Body.String())
+		})
+	}
+
+	t.Run("Basic auth still works", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/v2/identities", nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Authenticated principal", func(t *testing.T) {
+		did, err := w3c.ParseDID(iden.Identifier)
+		require.NoError(t, err)
+		apiKey, secret, err := server.apiKeyService.Create(ctx, ports.APIKeyRequest{
+			Name:        "principal",
+			Identities:  []w3c.DID{*did},
+			Permissions: []domain.APIKeyPermission{domain.APIKeyPermissionIdentitiesRead},
+		})
+		require.NoError(t, err)
+
+		var principal string
+		authorized := AuthMiddleware(ctx, usr, pass, server.apiKeyService, tokenAuthenticator)(func(ctx context.Context, _ http.ResponseWriter, _ *http.Request, _ interface{}) (interface{}, error) {
+			principal = principalFromContext(ctx)
+			return nil, nil
+		}, "GetSupportedNetworks")
+
+		for _, tc := range []struct {
+			name      string
+			auth      func(req *http.Request)
+			principal string
+		}{
+			{name: "API key", auth: func(req *http.Request) { req.Header.Set(apiKeyHeader, secret) }, principal: "api-key:" + apiKey.ID.String()},
+			{name: "Bearer token", auth: func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+token(t, "operators")) }, principal: "oidc:alice"},
+			{name: "Basic auth", auth: func(req *http.Request) { req.SetBasicAuth(authOk()) }, principal: "basic:" + usr},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				principal = ""
+				req, err := http.NewRequest(http.MethodGet, "/v2/supported-networks", nil)
+				require.NoError(t, err)
+				tc.auth(req)
+				_, err = authorized(context.WithValue(ctx, BasicAuthScopes, []string{}), httptest.NewRecorder(), req, nil)
+				require.NoError(t, err)
+				assert.Equal(t, tc.principal, principal)
+			})
+		}
+	})
+}
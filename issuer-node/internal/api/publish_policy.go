@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// GetPublishPolicy - returns the publish policy of the identity
+func (s *Server) GetPublishPolicy(ctx context.Context, request GetPublishPolicyRequestObject) (GetPublishPolicyResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetPublishPolicy400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	policy, err := s.publishPolicyService.GetPolicy(ctx, *did)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPublishPolicyNotFound) {
+			return GetPublishPolicy404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting publish policy", "err", err, "did", did)
+		return GetPublishPolicy500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetPublishPolicy200JSONResponse(toPublishPolicyResponse(policy)), nil
+}
+
+// UpdatePublishPolicy - sets the publish policy of the identity
+func (s *Server) UpdatePublishPolicy(ctx context.Context, request UpdatePublishPolicyRequestObject) (UpdatePublishPolicyResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return UpdatePublishPolicy400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	policy, err := s.publishPolicyService.SavePolicy(ctx, *did, toPublishPolicyRequest(request.Body))
+	if err != nil {
+		log.Error(ctx, "setting publish policy", "err", err, "did", did)
+		if errors.Is(err, repositories.ErrIdentityNotFound) {
+			return UpdatePublishPolicy404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if isInvalidPublishPolicyError(err) {
+			return UpdatePublishPolicy400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return UpdatePublishPolicy500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return UpdatePublishPolicy200JSONResponse(toPublishPolicyResponse(policy)), nil
+}
+
+// DeletePublishPolicy - deletes the publish policy of the identity
+func (s *Server) DeletePublishPolicy(ctx context.Context, request DeletePublishPolicyRequestObject) (DeletePublishPolicyResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return DeletePublishPolicy400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	if err := s.publishPolicyService.DeletePolicy(ctx, *did); err != nil {
+		if errors.Is(err, repositories.ErrPublishPolicyNotFound) {
+			return DeletePublishPolicy404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting publish policy", "err", err, "did", did)
+		return DeletePublishPolicy500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return DeletePublishPolicy200JSONResponse{Message: "publish policy deleted"}, nil
+}
+
+func toPublishPolicyRequest(body *PublishPolicyRequest) ports.PublishPolicyRequest {
+	return ports.PublishPolicyRequest{
+		MinPendingChanges:   body.MinPendingChanges,
+		MinIntervalMinutes:  body.MinIntervalMinutes,
+		MaxDelayMinutes:     body.MaxDelayMinutes,
+		WindowStart:         body.WindowStart,
+		WindowEnd:           body.WindowEnd,
+		PublishOnRevocation: body.PublishOnRevocation != nil && *body.PublishOnRevocation,
+	}
+}
+
+func toPublishPolicyResponse(policy *domain.PublishPolicy) PublishPolicy {
+	return PublishPolicy{
+		MinPendingChanges:   policy.MinPendingChanges,
+		MinIntervalMinutes:  policy.MinIntervalMinutes,
+		MaxDelayMinutes:     policy.MaxDelayMinutes,
+		WindowStart:         policy.WindowStart,
+		WindowEnd:           policy.WindowEnd,
+		PublishOnRevocation: policy.PublishOnRevocation,
+		CreatedAt:           TimeUTC(policy.CreatedAt),
+		UpdatedAt:           TimeUTC(policy.UpdatedAt),
+	}
+}
+
+func isInvalidPublishPolicyError(err error) bool {
+	return errors.Is(err, services.ErrPublishPolicyInvalidMinPendingChanges) ||
+		errors.Is(err, services.ErrPublishPolicyInvalidMinutes) ||
+		errors.Is(err, services.ErrPublishPolicyInvalidWindow) ||
+		errors.Is(err, services.ErrPublishPolicyWebIdentity) ||
+		errors.Is(err, domain.ErrInvalidPublishWindowTime)
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_PublishPolicy(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	policyURL := fmt.Sprintf("/v2/identities/%s/state/publish-policy", did)
+	do := func(t *testing.T, method string, url string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		var req *http.Request
+		if body != nil {
+			req, err = http.NewRequest(method, url, tests.JSONBody(t, body))
+		} else {
+			req, err = http.NewRequest(method, url, nil)
+		}
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("No policy", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, do(t, http.MethodGet, policyURL, nil).Code)
+		assert.Equal(t, http.StatusNotFound, do(t, http.MethodDelete, policyURL, nil).Code)
+	})
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			request  PublishPolicyRequest
+			httpCode int
+			message  string
+		}{
+			{
+				name:     "Invalid min pending changes",
+				request:  PublishPolicyRequest{MinPendingChanges: 0},
+				httpCode: http.StatusBadRequest,
+				message:  "minPendingChanges must be greater than 0",
+			},
+			{
+				name:     "Invalid interval",
+				request:  PublishPolicyRequest{MinPendingChanges: 1, MinIntervalMinutes: common.ToPointer(0)},
+				httpCode: http.StatusBadRequest,
+				message:  "minIntervalMinutes and maxDelayMinutes must be greater than 0",
+			},
+			{
+				name:     "Window without end",
+				request:  PublishPolicyRequest{MinPendingChanges: 1, WindowStart: common.ToPointer("22:00")},
+				httpCode: http.StatusBadRequest,
+				message:  "the publish window needs a different windowStart and windowEnd",
+			},
+			{
+				name:     "Invalid window time",
+				request:  PublishPolicyRequest{MinPendingChanges: 1, WindowStart: common.ToPointer("22h"), WindowEnd: common.ToPointer("06:00")},
+				httpCode: http.StatusBadRequest,
+				message:  "publish window times must be in the HH:MM format",
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := do(t, http.MethodPut, policyURL, tc.request)
+				require.Equal(t, tc.httpCode, rr.Code, rr.Body.String())
+				var response GenericErrorMessage
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.message, response.Message)
+			})
+		}
+	})
+
+	t.Run("Unknown identity", func(t *testing.T) {
+		url := "/v2/identities/did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu/state/publish-policy"
+		rr := do(t, http.MethodPut, url, PublishPolicyRequest{MinPendingChanges: 1})
+		assert.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Set, update and delete", func(t *testing.T) {
+		rr := do(t, http.MethodPut, policyURL, PublishPolicyRequest{
+			MinPendingChanges:   20,
+			MaxDelayMinutes:     common.ToPointer(1440),
+			WindowStart:         common.ToPointer("22:00"),
+			WindowEnd:           common.ToPointer("06:00"),
+			PublishOnRevocation: common.ToPointer(true),
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var created PublishPolicy
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+		assert.Equal(t, 20, created.MinPendingChanges)
+		assert.Nil(t, created.MinIntervalMinutes)
+		assert.Equal(t, common.ToPointer(1440), created.MaxDelayMinutes)
+		assert.Equal(t, common.ToPointer("22:00"), created.WindowStart)
+		assert.Equal(t, common.ToPointer("06:00"), created.WindowEnd)
+		assert.True(t, created.PublishOnRevocation)
+
+		rr = do(t, http.MethodPut, policyURL, PublishPolicyRequest{MinPendingChanges: 5, MinIntervalMinutes: common.ToPointer(60)})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = do(t, http.MethodGet, policyURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var updated PublishPolicy
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &updated))
+		assert.Equal(t, 5, updated.MinPendingChanges)
+		assert.Equal(t, common.ToPointer(60), updated.MinIntervalMinutes)
+		assert.Nil(t, updated.MaxDelayMinutes)
+		assert.Nil(t, updated.WindowStart)
+		assert.False(t, updated.PublishOnRevocation)
+		assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+
+		assert.Equal(t, http.StatusOK, do(t, http.MethodDelete, policyURL, nil).Code)
+		assert.Equal(t, http.StatusNotFound, do(t, http.MethodGet, policyURL, nil).Code)
+	})
+
+	t.Run("Publish due", func(t *testing.T) {
+		credentialSubject := map[string]any{"id": userDID, "birthday": 19960424, "documentType": 2}
+		credential, err := server.Services.credentials.Save(ctx, ports.NewCreateClaimRequest(did, nil, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(365*24*time.Hour)), schemaType, nil, nil, nil, ports.ClaimRequestProofs{Iden3SparseMerkleTreeProof: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil, nil))
+		require.NoError(t, err)
+
+		require.NoError(t, server.Services.publishPolicy.PublishDue(ctx))
+		pending, err := server.Services.credentials.GetByID(ctx, did, credential.ID)
+		require.NoError(t, err)
+		assert.Nil(t, pending.IdentityState, "the identities without policy are published on demand")
+
+		require.Equal(t, http.StatusOK, do(t, http.MethodPut, policyURL, PublishPolicyRequest{MinPendingChanges: 1}).Code)
+		require.NoError(t, server.Services.publishPolicy.PublishDue(ctx))
+		published, err := server.Services.credentials.GetByID(ctx, did, credential.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, published.IdentityState)
+	})
+
+	t.Run("Invalid did", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, do(t, http.MethodGet, "/v2/identities/wrong/state/publish-policy", nil).Code)
+	})
+}
@@ -95,6 +95,7 @@ func getLinkResponse(link *domain.Link) Link {
 		CredentialExpiration: credentialExpiration,
 		RefreshService:       refreshService,
 		DisplayMethod:        displayMethod,
+		CredentialEncryption: CredentialEncryptionPolicy(link.CredentialEncryption.OrDefault()),
 		DeepLink:             link.DeepLink,
 		UniversalLink:        link.UniversalLink,
 	}
@@ -127,7 +128,11 @@ func getLinkSimpleResponse(link domain.Link) LinkSimple {
 func getProofs(credential *domain.Claim) []string {
 	proofs := make([]string, 0)
 	if credential.SignatureProof.Bytes != nil {
-		proofs = append(proofs, string(verifiable.BJJSignatureProofType))
+		if proofType, _ := credential.SignatureProofType(); proofType == domain.JSONWebSignature2020ProofType {
+			proofs = append(proofs, string(domain.JSONWebSignature2020ProofType))
+		} else {
+			proofs = append(proofs, string(verifiable.BJJSignatureProofType))
+		}
 	}
 
 	if credential.MtProof {
@@ -140,17 +145,18 @@ func getProofs(credential *domain.Claim) []string {
 func schemaResponse(s *domain.Schema) Schema {
 	hash, _ := s.Hash.MarshalText()
 	return Schema{
-		Id:              s.ID.String(),
-		Type:            s.Type,
-		ContextURL:      s.ContextURL,
-		Url:             s.URL,
-		BigInt:          s.Hash.BigInt().String(),
-		Hash:            string(hash),
-		CreatedAt:       TimeUTC(s.CreatedAt),
-		Version:         s.Version,
-		Title:           s.Title,
-		Description:     s.Description,
-		DisplayMethodID: s.DisplayMethodID,
+		Id:                   s.ID.String(),
+		Type:                 s.Type,
+		ContextURL:           s.ContextURL,
+		Url:                  s.URL,
+		BigInt:               s.Hash.BigInt().String(),
+		Hash:                 string(hash),
+		CreatedAt:            TimeUTC(s.CreatedAt),
+		Version:              s.Version,
+		Title:                s.Title,
+		Description:          s.Description,
+		DisplayMethodID:      s.DisplayMethodID,
+		CredentialEncryption: CredentialEncryptionPolicy(s.CredentialEncryption.OrDefault()),
 	}
 }
 
@@ -268,13 +274,100 @@ func toStateTransaction(state domain.IdentityState) StateTransaction {
 	if state.TxID != nil {
 		txID = *state.TxID
 	}
-	return StateTransaction{
+	resp := StateTransaction{
 		Id:          state.StateID,
 		PublishDate: TimeUTC(state.ModifiedAt),
 		State:       stateTran,
 		Status:      getTransactionStatus(state.Status),
 		TxID:        txID,
 	}
+	if len(state.ReplacementTxIDs) > 0 {
+		resp.ReplacementTxIDs = &state.ReplacementTxIDs
+	}
+	resp.FailureReason = state.FailureReason
+	return resp
+}
+
+func toStateReplications(replications []domain.StateReplication) []StateReplication {
+	resp := make([]StateReplication, 0, len(replications))
+	for _, replication := range replications {
+		resp = append(resp, StateReplication{
+			Chain:          replication.Chain,
+			State:          replication.State,
+			Status:         StateReplicationStatus(replication.Status),
+			TxID:           replication.TxID,
+			BlockNumber:    replication.BlockNumber,
+			BlockTimestamp: replication.BlockTimestamp,
+			FailureReason:  replication.FailureReason,
+		})
+	}
+	return resp
+}
+
+func stateDetailsResponse(state domain.IdentityState, changes []domain.IdentityStateChange, pagFilter pagination.Filter, total uint) StateDetailsResponse {
+	items := make([]StateChange, len(changes))
+	for i, change := range changes {
+		items[i] = toStateChange(change)
+	}
+	resp := StateDetailsResponse{
+		State:              toStateTransaction(state),
+		PreviousState:      state.PreviousState,
+		ClaimsTreeRoot:     state.ClaimsTreeRoot,
+		RevocationTreeRoot: state.RevocationTreeRoot,
+		RootOfRoots:        state.RootOfRoots,
+		Changes: StateChangesPaginated{
+			Items: items,
+			Meta: PaginatedMetadata{
+				MaxResults: pagFilter.MaxResults,
+				Page:       1, // default
+				Total:      total,
+			},
+		},
+	}
+	if pagFilter.Page != nil {
+		resp.Changes.Meta.Page = *pagFilter.Page
+	}
+	return resp
+}
+
+func stateDiffResponse(diff domain.IdentityStateDiff) StateDiffResponse {
+	resp := StateDiffResponse{
+		States:           make([]StateTransaction, len(diff.States)),
+		Credentials:      make([]uuid.UUID, 0),
+		RevocationNonces: make([]uint64, 0),
+		Changes:          make([]StateChange, len(diff.Changes)),
+	}
+	if diff.From.State != nil {
+		resp.From = *diff.From.State
+	}
+	if diff.To.State != nil {
+		resp.To = *diff.To.State
+	}
+	for i, state := range diff.States {
+		resp.States[i] = toStateTransaction(state)
+	}
+	for i, change := range diff.Changes {
+		resp.Changes[i] = toStateChange(change)
+		if change.ClaimID != nil {
+			resp.Credentials = append(resp.Credentials, *change.ClaimID)
+		}
+		if change.RevocationNonce != nil {
+			resp.RevocationNonces = append(resp.RevocationNonces, uint64(*change.RevocationNonce))
+		}
+	}
+	return resp
+}
+
+func toStateChange(change domain.IdentityStateChange) StateChange {
+	resp := StateChange{
+		State:        change.State,
+		Type:         StateChangeType(change.Type),
+		CredentialID: change.ClaimID,
+	}
+	if change.RevocationNonce != nil {
+		resp.RevocationNonce = common.ToPointer(uint64(*change.RevocationNonce))
+	}
+	return resp
 }
 
 func getTransactionStatus(status domain.IdentityStatus) StateTransactionStatus {
@@ -30,12 +30,18 @@ func (s *Server) ImportSchema(ctx context.Context, request ImportSchemaRequestOb
 	}
 
 	iReq := ports.NewImportSchemaRequest(req.Url, req.SchemaType, req.Title, req.Version, req.Description, req.DisplayMethodID)
+	if req.CredentialEncryption != nil {
+		iReq.CredentialEncryption = domain.CredentialEncryptionPolicy(*req.CredentialEncryption)
+	}
 	schema, err := s.schemaService.ImportSchema(ctx, *issuerDID, iReq)
 	if err != nil {
 		log.Error(ctx, "Importing schema", "err", err, "req", req)
 		if errors.Is(err, repositories.ErrDisplayMethodNotFound) || errors.Is(err, services.ErrDisplayMethodNotFound) {
 			return ImportSchema400JSONResponse{N400JSONResponse{Message: "display method not found"}}, nil
 		}
+		if errors.Is(err, domain.ErrInvalidCredentialEncryptionPolicy) {
+			return ImportSchema400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 
 		return ImportSchema500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
@@ -85,13 +91,21 @@ func (s *Server) UpdateSchema(ctx context.Context, request UpdateSchemaRequestOb
 		return UpdateSchema400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
 	}
 
-	if err := s.schemaService.Update(ctx, &domain.Schema{
+	schema := &domain.Schema{
 		ID:              request.Id,
 		IssuerDID:       *issuerDID,
 		DisplayMethodID: request.Body.DisplayMethodID,
-	}); err != nil {
+	}
+	if request.Body.CredentialEncryption != nil {
+		schema.CredentialEncryption = domain.CredentialEncryptionPolicy(*request.Body.CredentialEncryption)
+	}
+	if err := s.schemaService.Update(ctx, schema); err != nil {
 		log.Error(ctx, "updating schema", "err", err)
 
+		if errors.Is(err, domain.ErrInvalidCredentialEncryptionPolicy) {
+			return UpdateSchema400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+
 		if errors.Is(err, repositories.ErrDisplayMethodNotFound) || errors.Is(err, services.ErrDisplayMethodNotFound) {
 			return UpdateSchema404JSONResponse{N404JSONResponse{Message: "display method not found"}}, nil
 		}
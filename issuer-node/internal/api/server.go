@@ -17,43 +17,71 @@ import (
 // Server implements StrictServerInterface and holds the implementation of all API controllers
 // This is the glue to the API autogenerated code
 type Server struct {
-	cfg                  *config.Configuration
-	accountService       ports.AccountService
-	claimService         ports.ClaimService
-	connectionsService   ports.ConnectionService
-	health               *health.Status
-	identityService      ports.IdentityService
-	linkService          ports.LinkService
-	networkResolver      network.Resolver
-	packageManager       *iden3comm.PackageManager
-	publisherGateway     ports.Publisher
-	qrService            ports.QrStoreService
-	schemaService        ports.SchemaService
-	paymentService       ports.PaymentService
-	displayMethodService ports.DisplayMethodService
-	keyService           ports.KeyService
-	discoveryService     ports.DiscoveryService
+	cfg                           *config.Configuration
+	accountService                ports.AccountService
+	claimService                  ports.ClaimService
+	connectionsService            ports.ConnectionService
+	health                        *health.Status
+	identityService               ports.IdentityService
+	linkService                   ports.LinkService
+	networkResolver               network.Resolver
+	packageManager                *iden3comm.PackageManager
+	publisherGateway              ports.Publisher
+	qrService                     ports.QrStoreService
+	schemaService                 ports.SchemaService
+	paymentService                ports.PaymentService
+	displayMethodService          ports.DisplayMethodService
+	keyService                    ports.KeyService
+	discoveryService              ports.DiscoveryService
+	bulkIssuanceService           ports.BulkIssuanceService
+	statusListService             ports.StatusListService
+	credentialExportService       ports.CredentialExportService
+	credentialTemplateService     ports.CredentialTemplateService
+	credentialExpirationService   ports.CredentialExpirationService
+	refreshService                ports.RefreshService
+	credentialVerificationService ports.CredentialVerificationService
+	verificationService           ports.VerificationService
+	keyRotationService            ports.KeyRotationService
+	apiKeyService                 ports.APIKeyService
+	didDocumentService            ports.DIDDocumentService
+	merkleTreeIntegrityService    ports.MerkleTreeIntegrityService
+	publishPolicyService          ports.PublishPolicyService
+	stateReplicationService       ports.StateReplicationService
 }
 
 // NewServer is a Server constructor
-func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, connectionsService ports.ConnectionService, claimsService ports.ClaimService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, networkResolver network.Resolver, health *health.Status, schemaService ports.SchemaService, linkService ports.LinkService, displayMethodService ports.DisplayMethodService, keyService ports.KeyService, paymentService ports.PaymentService, discoveryService ports.DiscoveryService) *Server {
+func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, connectionsService ports.ConnectionService, claimsService ports.ClaimService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, networkResolver network.Resolver, health *health.Status, schemaService ports.SchemaService, linkService ports.LinkService, displayMethodService ports.DisplayMethodService, keyService ports.KeyService, paymentService ports.PaymentService, discoveryService ports.DiscoveryService, bulkIssuanceService ports.BulkIssuanceService, statusListService ports.StatusListService, credentialExportService ports.CredentialExportService, credentialTemplateService ports.CredentialTemplateService, credentialExpirationService ports.CredentialExpirationService, refreshService ports.RefreshService, credentialVerificationService ports.CredentialVerificationService, verificationService ports.VerificationService, keyRotationService ports.KeyRotationService, apiKeyService ports.APIKeyService, didDocumentService ports.DIDDocumentService, merkleTreeIntegrityService ports.MerkleTreeIntegrityService, publishPolicyService ports.PublishPolicyService, stateReplicationService ports.StateReplicationService) *Server {
 	return &Server{
-		cfg:                  cfg,
-		accountService:       accountService,
-		claimService:         claimsService,
-		connectionsService:   connectionsService,
-		health:               health,
-		identityService:      identityService,
-		linkService:          linkService,
-		networkResolver:      networkResolver,
-		publisherGateway:     publisherGateway,
-		packageManager:       packageManager,
-		qrService:            qrService,
-		schemaService:        schemaService,
-		displayMethodService: displayMethodService,
-		keyService:           keyService,
-		discoveryService:     discoveryService,
-		paymentService:       paymentService,
+		cfg:                           cfg,
+		accountService:                accountService,
+		claimService:                  claimsService,
+		connectionsService:            connectionsService,
+		health:                        health,
+		identityService:               identityService,
+		linkService:                   linkService,
+		networkResolver:               networkResolver,
+		publisherGateway:              publisherGateway,
+		packageManager:                packageManager,
+		qrService:                     qrService,
+		schemaService:                 schemaService,
+		displayMethodService:          displayMethodService,
+		keyService:                    keyService,
+		discoveryService:              discoveryService,
+		paymentService:                paymentService,
+		bulkIssuanceService:           bulkIssuanceService,
+		statusListService:             statusListService,
+		credentialExportService:       credentialExportService,
+		credentialTemplateService:     credentialTemplateService,
+		credentialExpirationService:   credentialExpirationService,
+		refreshService:                refreshService,
+		credentialVerificationService: credentialVerificationService,
+		verificationService:           verificationService,
+		keyRotationService:            keyRotationService,
+		apiKeyService:                 apiKeyService,
+		didDocumentService:            didDocumentService,
+		merkleTreeIntegrityService:    merkleTreeIntegrityService,
+		publishPolicyService:          publishPolicyService,
+		stateReplicationService:       stateReplicationService,
 	}
 }
 
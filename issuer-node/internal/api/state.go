@@ -8,10 +8,12 @@ import (
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/sqltools"
 )
 
@@ -69,6 +71,28 @@ func (s *Server) RetryPublishState(ctx context.Context, request RetryPublishStat
 	}, nil
 }
 
+// DryRunPublishState - simulates the transition to the failed or unpublished state of the identity without publishing it
+func (s *Server) DryRunPublishState(ctx context.Context, request DryRunPublishStateRequestObject) (DryRunPublishStateResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return DryRunPublishState400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	simulation, err := s.publisherGateway.DryRunPublishState(ctx, did)
+	if err != nil {
+		if errors.Is(err, gateways.ErrNoStateToSimulate) {
+			return DryRunPublishState404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "error simulating the state transition", "err", err)
+		return DryRunPublishState500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return DryRunPublishState200JSONResponse{
+		State:        simulation.State,
+		Success:      simulation.Succeeded,
+		RevertReason: simulation.RevertReason,
+	}, nil
+}
+
 // GetStateTransactions - get state transactions
 func (s *Server) GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error) {
 	filter, err := getStateTransitionsFilter(request)
@@ -100,8 +124,58 @@ func (s *Server) GetStateStatus(ctx context.Context, request GetStateStatusReque
 		log.Error(ctx, "get state status", "err", err)
 		return GetStateStatus500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
+	replications, err := s.stateReplicationService.GetLatestReplications(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "get state replications", "err", err)
+		return GetStateStatus500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return GetStateStatus200JSONResponse{PendingActions: pendingActions, Replications: toStateReplications(replications)}, nil
+}
+
+// GetStateDetails - get a state and the credentials and revocations it anchored
+func (s *Server) GetStateDetails(ctx context.Context, request GetStateDetailsRequestObject) (GetStateDetailsResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetStateDetails400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+	if request.Params.Page != nil && *request.Params.Page <= 0 {
+		return GetStateDetails400JSONResponse{N400JSONResponse{"page must be greater than 0"}}, nil
+	}
+
+	filter := pagination.NewFilter(request.Params.MaxResults, request.Params.Page)
+	state, changes, total, err := s.identityService.GetStateChanges(ctx, *did, request.State, *filter)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdentityStateNotFound) {
+			return GetStateDetails404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "get state details", "err", err)
+		return GetStateDetails500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return GetStateDetails200JSONResponse(stateDetailsResponse(*state, changes, *filter, total)), nil
+}
+
+// GetStateDiff - get the credentials and revocations anchored between two states
+func (s *Server) GetStateDiff(ctx context.Context, request GetStateDiffRequestObject) (GetStateDiffResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetStateDiff400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	diff, err := s.identityService.GetStateDiff(ctx, *did, request.Params.From, request.Params.To)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdentityStateNotFound) {
+			return GetStateDiff404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrInvalidStateDiffRange) {
+			return GetStateDiff400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "get state diff", "err", err)
+		return GetStateDiff500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
 
-	return GetStateStatus200JSONResponse{PendingActions: pendingActions}, nil
+	return GetStateDiff200JSONResponse(stateDiffResponse(*diff)), nil
 }
 
 func getStateTransitionsFilter(req GetStateTransactionsRequestObject) (request *ports.GetStateTransactionsRequest, err error) {
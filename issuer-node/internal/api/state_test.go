@@ -9,12 +9,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/gateways"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
 
 func TestServer_GetStateStatus(t *testing.T) {
@@ -386,3 +391,277 @@ func TestServer_GetStateTransactions(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_StateChanges(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+	genesis, err := server.Services.identity.GetLatestStateByID(ctx, *did)
+	require.NoError(t, err)
+
+	credentialSubject := map[string]any{
+		"id":           userDID,
+		"birthday":     19960424,
+		"documentType": 2,
+	}
+	createCredential := func(t *testing.T) *domain.Claim {
+		t.Helper()
+		credential, err := server.Services.credentials.Save(ctx, ports.NewCreateClaimRequest(did, nil, schemaURL, credentialSubject, common.ToPointer(time.Now().Add(365*24*time.Hour)), schemaType, nil, nil, nil, ports.ClaimRequestProofs{Iden3SparseMerkleTreeProof: true}, nil, false, verifiable.Iden3commRevocationStatusV1, nil, nil, nil, nil))
+		require.NoError(t, err)
+		return credential
+	}
+
+	credential1 := createCredential(t)
+	credential2 := createCredential(t)
+	state1, err := server.Services.identity.UpdateState(ctx, *did)
+	require.NoError(t, err)
+
+	credential3 := createCredential(t)
+	require.NoError(t, server.Services.credentials.Revoke(ctx, *did, uint64(credential1.RevNonce), ""))
+	state2, err := server.Services.identity.UpdateState(ctx, *did)
+	require.NoError(t, err)
+
+	get := func(t *testing.T, url string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("State details", func(t *testing.T) {
+		rr := get(t, fmt.Sprintf("/v2/identities/%s/states/%s", did, *state1.State))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response GetStateDetails200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, *state1.State, response.State.State)
+		assert.Equal(t, genesis.State, response.PreviousState)
+		assert.Equal(t, uint(2), response.Changes.Meta.Total)
+		require.Len(t, response.Changes.Items, 2)
+		assert.Equal(t, StateChange{State: *state1.State, Type: StateChangeTypeCredential, CredentialID: &credential1.ID}, response.Changes.Items[0])
+		assert.Equal(t, StateChange{State: *state1.State, Type: StateChangeTypeCredential, CredentialID: &credential2.ID}, response.Changes.Items[1])
+	})
+
+	t.Run("State details paginated", func(t *testing.T) {
+		rr := get(t, fmt.Sprintf("/v2/identities/%s/states/%s?page=2&max_results=1", did, *state2.State))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response GetStateDetails200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, PaginatedMetadata{MaxResults: 1, Page: 2, Total: 2}, response.Changes.Meta)
+		require.Len(t, response.Changes.Items, 1)
+		assert.Equal(t, StateChange{State: *state2.State, Type: StateChangeTypeRevocation, RevocationNonce: common.ToPointer(uint64(credential1.RevNonce))}, response.Changes.Items[0])
+	})
+
+	t.Run("State diff", func(t *testing.T) {
+		rr := get(t, fmt.Sprintf("/v2/identities/%s/state/diff?from=%s&to=%s", did, *genesis.State, *state2.State))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response GetStateDiff200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, *genesis.State, response.From)
+		assert.Equal(t, *state2.State, response.To)
+		require.Len(t, response.States, 2)
+		assert.Equal(t, *state1.State, response.States[0].State)
+		assert.Equal(t, *state2.State, response.States[1].State)
+		assert.Equal(t, []uuid.UUID{credential1.ID, credential2.ID, credential3.ID}, response.Credentials)
+		assert.Equal(t, []uint64{uint64(credential1.RevNonce)}, response.RevocationNonces)
+		assert.Len(t, response.Changes, 4)
+
+		rr = get(t, fmt.Sprintf("/v2/identities/%s/state/diff?from=%s&to=%s", did, *state1.State, *state2.State))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.States, 1)
+		assert.Equal(t, []uuid.UUID{credential3.ID}, response.Credentials)
+		assert.Equal(t, []uint64{uint64(credential1.RevNonce)}, response.RevocationNonces)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			url      string
+			httpCode int
+		}{
+			{name: "Invalid did", url: fmt.Sprintf("/v2/identities/not-a-did/states/%s", *state1.State), httpCode: http.StatusBadRequest},
+			{name: "Unknown state", url: fmt.Sprintf("/v2/identities/%s/states/%s", did, "0000000000000000000000000000000000000000000000000000000000000000"), httpCode: http.StatusNotFound},
+			{name: "Invalid page", url: fmt.Sprintf("/v2/identities/%s/states/%s?page=0", did, *state1.State), httpCode: http.StatusBadRequest},
+			{name: "Diff with unknown state", url: fmt.Sprintf("/v2/identities/%s/state/diff?from=%s&to=%s", did, *state1.State, "0000000000000000000000000000000000000000000000000000000000000000"), httpCode: http.StatusNotFound},
+			{name: "Diff in reverse order", url: fmt.Sprintf("/v2/identities/%s/state/diff?from=%s&to=%s", did, *state2.State, *state1.State), httpCode: http.StatusBadRequest},
+			{name: "Diff without to", url: fmt.Sprintf("/v2/identities/%s/state/diff?from=%s", did, *state1.State), httpCode: http.StatusBadRequest},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.httpCode, get(t, tc.url).Code)
+			})
+		}
+	})
+}
+
+// dryRunPublisherMock returns the configured simulation instead of calling the state contract
+type dryRunPublisherMock struct {
+	ports.Publisher
+	simulation *domain.StateTransitionSimulation
+	err        error
+}
+
+func (p *dryRunPublisherMock) DryRunPublishState(_ context.Context, _ *w3c.DID) (*domain.StateTransitionSimulation, error) {
+	return p.simulation, p.err
+}
+
+func TestServer_DryRunPublishState(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	state, err := server.Services.identity.UpdateState(ctx, *did)
+	require.NoError(t, err)
+	state.Status = domain.StatusFailed
+	state.FailureReason = common.ToPointer("Old state does not match the latest state")
+	require.NoError(t, server.Services.identity.UpdateIdentityState(ctx, state))
+
+	post := func(t *testing.T, identifier string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/state/dry-run", identifier), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Reverted", func(t *testing.T) {
+		server.publisherGateway = &dryRunPublisherMock{simulation: &domain.StateTransitionSimulation{Identifier: did.String(), State: *state.State, Succeeded: false, RevertReason: state.FailureReason}}
+		rr := post(t, did.String())
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response DryRunPublishState200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, DryRunPublishState200JSONResponse{State: *state.State, Success: false, RevertReason: state.FailureReason}, response)
+	})
+
+	t.Run("Succeeded", func(t *testing.T) {
+		server.publisherGateway = &dryRunPublisherMock{simulation: &domain.StateTransitionSimulation{Identifier: did.String(), State: *state.State, Succeeded: true}}
+		rr := post(t, did.String())
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response DryRunPublishState200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, DryRunPublishState200JSONResponse{State: *state.State, Success: true}, response)
+	})
+
+	t.Run("No failed state", func(t *testing.T) {
+		server.publisherGateway = &dryRunPublisherMock{err: gateways.ErrNoStateToSimulate}
+		rr := post(t, did.String())
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Invalid did", func(t *testing.T) {
+		rr := post(t, "did:polygonid:wrong")
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Failure reason of the state", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/states/%s", did, *state.State), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response GetStateDetails200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, state.FailureReason, response.State.FailureReason)
+	})
+}
+
+func TestServer_GetStateStatusReplications(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		chain      = "privado:main"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	getStatus := func(t *testing.T) GetStateStatus200JSONResponse {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/state/status", did), nil)
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response GetStateStatus200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+
+	t.Run("No replications", func(t *testing.T) {
+		assert.Empty(t, getStatus(t).Replications)
+	})
+
+	state, err := server.Services.identity.UpdateState(ctx, *did)
+	require.NoError(t, err)
+	state.Status = domain.StatusConfirmed
+	state.BlockNumber = common.ToPointer(100)
+	state.BlockTimestamp = common.ToPointer(1700000000)
+	require.NoError(t, server.Services.identity.UpdateIdentityState(ctx, state))
+
+	repo := repositories.NewStateReplication()
+	_, err = repo.Schedule(ctx, storage.Pgx, blockchain, network, chain)
+	require.NoError(t, err)
+
+	t.Run("Pending replication", func(t *testing.T) {
+		assert.Equal(t, []StateReplication{{Chain: chain, State: *state.State, Status: StateReplicationStatusPending}}, getStatus(t).Replications)
+	})
+
+	t.Run("Confirmed replication", func(t *testing.T) {
+		replications, err := repo.GetLatestByIdentifier(ctx, storage.Pgx, *did)
+		require.NoError(t, err)
+		require.Len(t, replications, 1)
+		replication := replications[0]
+		replication.Status = domain.StateReplicationConfirmed
+		replication.TxID = common.ToPointer("0x8f271174b45ba7892d83")
+		replication.BlockNumber = common.ToPointer(200)
+		replication.BlockTimestamp = common.ToPointer(1700000100)
+		require.NoError(t, repo.Update(ctx, storage.Pgx, &replication))
+
+		assert.Equal(t, []StateReplication{{
+			Chain:          chain,
+			State:          *state.State,
+			Status:         StateReplicationStatusConfirmed,
+			TxID:           replication.TxID,
+			BlockNumber:    replication.BlockNumber,
+			BlockTimestamp: replication.BlockTimestamp,
+		}}, getStatus(t).Replications)
+	})
+}
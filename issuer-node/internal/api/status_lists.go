@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// GetStatusListCredential - returns the signed bitstring status list credential of the identity
+func (s *Server) GetStatusListCredential(ctx context.Context, request GetStatusListCredentialRequestObject) (GetStatusListCredentialResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetStatusListCredential400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	credential, err := s.statusListService.GetCredential(ctx, *did, request.Id)
+	if err != nil {
+		log.Error(ctx, "getting status list credential", "err", err, "id", request.Id)
+		if errors.Is(err, services.ErrStatusListNotFound) {
+			return GetStatusListCredential404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrStatusListSigningKeyNotFound) {
+			return GetStatusListCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return GetStatusListCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return GetStatusListCredential200ApplicationvcJwtResponse{
+		Body:          strings.NewReader(credential),
+		ContentLength: int64(len(credential)),
+	}, nil
+}
@@ -0,0 +1,255 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+)
+
+func TestServer_GetStatusListCredential(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		schemaURL  = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
+		schemaType = "KYCAgeCredential"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "http://polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	createCredentialWithBirthday := func(t *testing.T, birthday any) *httptest.ResponseRecorder {
+		t.Helper()
+		body := CreateCredentialRequest{
+			CredentialSchema: schemaURL,
+			Type:             schemaType,
+			CredentialSubject: map[string]any{
+				"id":           userDID,
+				"birthday":     birthday,
+				"documentType": 2,
+			},
+			Expiration:           common.ToPointer(time.Now().Add(365 * 24 * time.Hour).Unix()),
+			CredentialStatusType: common.ToPointer(CreateCredentialRequestCredentialStatusTypeBitstringStatusListEntry),
+			Proofs:               &[]CreateCredentialRequestProofs{"BJJSignature2021"},
+		}
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/identities/%s/credentials", did), tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	createCredential := func(t *testing.T) *httptest.ResponseRecorder {
+		t.Helper()
+		return createCredentialWithBirthday(t, 19960424)
+	}
+
+	getStatusListCredential := func(t *testing.T, id string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/status-lists/%s", did, id), nil)
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Identity without signing key", func(t *testing.T) {
+		rr := createCredential(t)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		var response CreateCredential400JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "the identity needs an ETH or Ed25519 key to sign its status lists", response.Message)
+	})
+
+	_, err = server.Services.keyService.Create(ctx, did, kms.KeyTypeEthereum, "status lists")
+	require.NoError(t, err)
+
+	t.Run("Invalid credential subject", func(t *testing.T) {
+		// the entries allocated to the credential are released and allocated to the next one
+		rr := createCredentialWithBirthday(t, "not a date")
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	rr := createCredential(t)
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var created CreateCredentialResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+
+	credential, err := server.Services.credentials.GetByID(ctx, did, uuid.MustParse(created.Id))
+	require.NoError(t, err)
+	var credentialStatuses []revocationstatus.BitstringStatusListEntryStatus
+	require.NoError(t, json.Unmarshal(credential.CredentialStatus.Bytes, &credentialStatuses))
+	require.Len(t, credentialStatuses, 2)
+	credentialStatus, suspensionStatus := credentialStatuses[0], credentialStatuses[1]
+	assert.Equal(t, revocationstatus.BitstringStatusListEntry, credentialStatus.Type)
+	assert.Equal(t, "revocation", credentialStatus.StatusPurpose)
+	assert.Equal(t, "0", credentialStatus.StatusListIndex)
+	assert.Equal(t, credentialStatus.StatusListCredential+"#0", credentialStatus.ID)
+	statusListID := credentialStatus.StatusListCredential[strings.LastIndex(credentialStatus.StatusListCredential, "/")+1:]
+	assert.Equal(t, revocationstatus.BitstringStatusListEntry, suspensionStatus.Type)
+	assert.Equal(t, "suspension", suspensionStatus.StatusPurpose)
+	assert.Equal(t, "0", suspensionStatus.StatusListIndex)
+	assert.NotEqual(t, credentialStatus.StatusListCredential, suspensionStatus.StatusListCredential)
+	suspensionListID := suspensionStatus.StatusListCredential[strings.LastIndex(suspensionStatus.StatusListCredential, "/")+1:]
+
+	t.Run("Status list not found", func(t *testing.T) {
+		rr := getStatusListCredential(t, uuid.NewString())
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Active credential", func(t *testing.T) {
+		rr := getStatusListCredential(t, statusListID)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/vc+jwt", rr.Header().Get("Content-Type"))
+		subject := verifyStatusListCredential(t, handler, rr.Body.String(), credentialStatus.StatusListCredential, "revocation")
+		assert.False(t, statusListBit(t, subject["encodedList"], 0))
+	})
+
+	require.NoError(t, server.Services.credentials.Suspend(ctx, *did, uint64(credential.RevNonce)))
+
+	t.Run("Suspended credential", func(t *testing.T) {
+		rr := getStatusListCredential(t, suspensionListID)
+		require.Equal(t, http.StatusOK, rr.Code)
+		subject := verifyStatusListCredential(t, handler, rr.Body.String(), suspensionStatus.StatusListCredential, "suspension")
+		assert.True(t, statusListBit(t, subject["encodedList"], 0))
+
+		rr = getStatusListCredential(t, statusListID)
+		require.Equal(t, http.StatusOK, rr.Code)
+		subject = verifyStatusListCredential(t, handler, rr.Body.String(), credentialStatus.StatusListCredential, "revocation")
+		assert.False(t, statusListBit(t, subject["encodedList"], 0))
+	})
+
+	require.NoError(t, server.Services.credentials.Resume(ctx, *did, uint64(credential.RevNonce)))
+
+	t.Run("Resumed credential", func(t *testing.T) {
+		rr := getStatusListCredential(t, suspensionListID)
+		require.Equal(t, http.StatusOK, rr.Code)
+		subject := verifyStatusListCredential(t, handler, rr.Body.String(), suspensionStatus.StatusListCredential, "suspension")
+		assert.False(t, statusListBit(t, subject["encodedList"], 0))
+	})
+
+	require.NoError(t, server.Services.credentials.Revoke(ctx, *did, uint64(credential.RevNonce), ""))
+
+	t.Run("Revoked credential", func(t *testing.T) {
+		rr := getStatusListCredential(t, statusListID)
+		require.Equal(t, http.StatusOK, rr.Code)
+		subject := verifyStatusListCredential(t, handler, rr.Body.String(), credentialStatus.StatusListCredential, "revocation")
+		assert.True(t, statusListBit(t, subject["encodedList"], 0))
+	})
+}
+
+// verifyStatusListCredential checks the ES256K signature of the vc+jwt and returns its credential subject
+func verifyStatusListCredential(t *testing.T, handler http.Handler, token string, id string, purpose string) map[string]any {
+	t.Helper()
+	header, payloadBytes := verifyES256KJWT(t, handler, token)
+	assert.Equal(t, "vc+jwt", header["typ"])
+
+	var payload struct {
+		ID                string         `json:"id"`
+		Type              []string       `json:"type"`
+		CredentialSubject map[string]any `json:"credentialSubject"`
+	}
+	require.NoError(t, json.Unmarshal(payloadBytes, &payload))
+	assert.Equal(t, id, payload.ID)
+	assert.Equal(t, []string{"VerifiableCredential", "BitstringStatusListCredential"}, payload.Type)
+	assert.Equal(t, "BitstringStatusList", payload.CredentialSubject["type"])
+	assert.Equal(t, purpose, payload.CredentialSubject["statusPurpose"])
+	return payload.CredentialSubject
+}
+
+// verifyES256KJWT checks the ES256K signature of the jwt with the key of its kid, that must be an assertion method of
+// the DID document of the issuer, and returns the header and the payload
+func verifyES256KJWT(t *testing.T, handler http.Handler, token string) (map[string]any, []byte) {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	var header struct {
+		Alg string         `json:"alg"`
+		Kid string         `json:"kid"`
+		JWK map[string]any `json:"jwk"`
+	}
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(headerBytes, &header))
+	assert.Equal(t, "ES256K", header.Alg)
+	assert.Nil(t, header.JWK)
+
+	did, _, found := strings.Cut(header.Kid, "#")
+	require.True(t, found)
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/identities/%s/did.json", did), nil)
+	require.NoError(t, err)
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var doc domain.DIDDocument
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &doc))
+	require.Contains(t, doc.AssertionMethod, header.Kid)
+	var jwk map[string]any
+	for _, method := range doc.VerificationMethod {
+		if method.ID == header.Kid {
+			jwk = method.PublicKeyJwk
+		}
+	}
+	require.NotNil(t, jwk)
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk["x"].(string))
+	require.NoError(t, err)
+	y, err := base64.RawURLEncoding.DecodeString(jwk["y"].(string))
+	require.NoError(t, err)
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	pubKey := append(append([]byte{0x04}, x...), y...)
+	assert.True(t, crypto.VerifySignature(pubKey, digest[:], signature))
+
+	var fullHeader map[string]any
+	require.NoError(t, json.Unmarshal(headerBytes, &fullHeader))
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	return fullHeader, payload
+}
+
+func statusListBit(t *testing.T, value any, index int) bool {
+	t.Helper()
+	encodedList, ok := value.(string)
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(encodedList, "u"))
+	compressed, err := base64.RawURLEncoding.DecodeString(encodedList[1:])
+	require.NoError(t, err)
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	bits, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return bits[index/8]&(0x80>>(index%8)) != 0
+}
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// CreateVerificationQuery - creates a verification query
+func (s *Server) CreateVerificationQuery(ctx context.Context, request CreateVerificationQueryRequestObject) (CreateVerificationQueryResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateVerificationQuery400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	req := ports.VerificationQueryRequest{
+		Name:  request.Body.Name,
+		Scope: request.Body.Scope,
+	}
+	if request.Body.Reason != nil {
+		req.Reason = *request.Body.Reason
+	}
+	query, err := s.verificationService.CreateQuery(ctx, *did, req)
+	if err != nil {
+		log.Error(ctx, "creating verification query", "err", err)
+		if isInvalidVerificationQueryError(err) {
+			return CreateVerificationQuery400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateVerificationQuery500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateVerificationQuery201JSONResponse(toVerificationQueryResponse(query)), nil
+}
+
+// GetVerificationQueries - returns the verification queries of the identity
+func (s *Server) GetVerificationQueries(ctx context.Context, request GetVerificationQueriesRequestObject) (GetVerificationQueriesResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetVerificationQueries400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	queries, err := s.verificationService.GetQueries(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "getting verification queries", "err", err)
+		return GetVerificationQueries500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	response := make(GetVerificationQueries200JSONResponse, 0, len(queries))
+	for i := range queries {
+		response = append(response, toVerificationQueryResponse(&queries[i]))
+	}
+	return response, nil
+}
+
+// GetVerificationQuery - returns a verification query
+func (s *Server) GetVerificationQuery(ctx context.Context, request GetVerificationQueryRequestObject) (GetVerificationQueryResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetVerificationQuery400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	query, err := s.verificationService.GetQuery(ctx, *did, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrVerificationQueryNotFound) {
+			return GetVerificationQuery404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting verification query", "err", err, "id", request.Id)
+		return GetVerificationQuery500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetVerificationQuery200JSONResponse(toVerificationQueryResponse(query)), nil
+}
+
+// DeleteVerificationQuery - deletes a verification query
+func (s *Server) DeleteVerificationQuery(ctx context.Context, request DeleteVerificationQueryRequestObject) (DeleteVerificationQueryResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return DeleteVerificationQuery400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	if err := s.verificationService.DeleteQuery(ctx, *did, request.Id); err != nil {
+		if errors.Is(err, repositories.ErrVerificationQueryNotFound) {
+			return DeleteVerificationQuery404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "deleting verification query", "err", err, "id", request.Id)
+		return DeleteVerificationQuery500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return DeleteVerificationQuery200JSONResponse{Message: "verification query deleted"}, nil
+}
+
+// CreateVerificationSession - creates the authorization request of a verification query for a holder
+func (s *Server) CreateVerificationSession(ctx context.Context, request CreateVerificationSessionRequestObject) (CreateVerificationSessionResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateVerificationSession400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	resp, err := s.verificationService.CreateSession(ctx, *did, request.Id, s.cfg.ServerUrl)
+	if err != nil {
+		if errors.Is(err, repositories.ErrVerificationQueryNotFound) {
+			return CreateVerificationSession404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating verification session", "err", err, "id", request.Id)
+		return CreateVerificationSession500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	body, err := s.qrService.Find(ctx, resp.QrID)
+	if err != nil {
+		log.Error(ctx, "qr store. Finding qr", "err", err, "QrID", resp.QrID)
+		return CreateVerificationSession500JSONResponse{N500JSONResponse{Message: "error looking for qr body"}}, nil
+	}
+	return CreateVerificationSession201JSONResponse{
+		SessionID:     resp.Session.ID,
+		Message:       string(body),
+		DeepLink:      resp.DeepLink,
+		UniversalLink: resp.UniversalLink,
+	}, nil
+}
+
+// GetVerificationSession - returns the status and the result of a verification session
+func (s *Server) GetVerificationSession(ctx context.Context, request GetVerificationSessionRequestObject) (GetVerificationSessionResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetVerificationSession400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	session, err := s.verificationService.GetSession(ctx, *did, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrVerificationSessionNotFound) {
+			return GetVerificationSession404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting verification session", "err", err, "id", request.Id)
+		return GetVerificationSession500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetVerificationSession200JSONResponse(toVerificationSessionResponse(session)), nil
+}
+
+// VerificationCallback receives the proofs of a holder for a verification session
+func (s *Server) VerificationCallback(ctx context.Context, request VerificationCallbackRequestObject) (VerificationCallbackResponseObject, error) {
+	if request.Body == nil || *request.Body == "" {
+		log.Debug(ctx, "empty request body verification callback request")
+		return VerificationCallback400JSONResponse{N400JSONResponse{"Cannot proceed with empty body"}}, nil
+	}
+
+	if _, err := s.verificationService.Callback(ctx, request.Params.SessionID, *request.Body); err != nil {
+		log.Error(ctx, "verification callback", "err", err, "session", request.Params.SessionID)
+		switch {
+		case errors.Is(err, repositories.ErrVerificationSessionNotFound):
+			return VerificationCallback404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrVerificationSessionCompleted):
+			return VerificationCallback409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrVerificationFailed):
+			return VerificationCallback400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return VerificationCallback500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return VerificationCallback200Response{}, nil
+}
+
+func isInvalidVerificationQueryError(err error) bool {
+	return errors.Is(err, services.ErrVerificationQueryEmptyName) ||
+		errors.Is(err, services.ErrVerificationQueryEmptyScope) ||
+		errors.Is(err, services.ErrVerificationQueryDuplicateRequestID) ||
+		errors.Is(err, services.ErrVerificationQueryUnsupportedCircuit) ||
+		errors.Is(err, repositories.ErrVerificationQueryDuplicateName)
+}
+
+func toVerificationQueryResponse(query *domain.VerificationQuery) VerificationQuery {
+	return VerificationQuery{
+		Id:        query.ID,
+		Name:      query.Name,
+		Reason:    query.Reason,
+		Scope:     query.Scope,
+		CreatedAt: TimeUTC(query.CreatedAt),
+	}
+}
+
+func toVerificationSessionResponse(session *domain.VerificationSession) VerificationSession {
+	response := VerificationSession{
+		Id:                  session.ID,
+		VerificationQueryID: session.VerificationQueryID,
+		Status:              VerificationSessionStatus(session.Status),
+		UserDID:             session.UserDID,
+		Error:               session.Error,
+		CreatedAt:           TimeUTC(session.CreatedAt),
+		UpdatedAt:           TimeUTC(session.UpdatedAt),
+	}
+	if session.Response != nil {
+		response.Response = &session.Response
+	}
+	return response
+}
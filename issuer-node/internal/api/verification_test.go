@@ -0,0 +1,250 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-rapidsnark/types"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_Verification(t *testing.T) {
+	const (
+		method     = "polygonid"
+		blockchain = "polygon"
+		network    = "amoy"
+		BJJ        = "BJJ"
+		userDID    = "did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+	)
+	ctx := context.Background()
+	server := newTestServer(t, nil)
+	handler := getHandler(ctx, server)
+
+	iden, err := server.Services.identity.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	queriesURL := fmt.Sprintf("/v2/identities/%s/verification-queries", did)
+	do := func(t *testing.T, method string, url string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		var req *http.Request
+		if body != nil {
+			req, err = http.NewRequest(method, url, tests.JSONBody(t, body))
+		} else {
+			req, err = http.NewRequest(method, url, nil)
+		}
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	callback := func(t *testing.T, sessionID uuid.UUID, token string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/v2/verification/callback?sessionID="+sessionID.String(), strings.NewReader(token))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "text/plain")
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	proofRequest := protocol.ZeroKnowledgeProofRequest{
+		ID:        1,
+		CircuitID: "credentialAtomicQuerySigV2",
+		Query: map[string]any{
+			"allowedIssuers":    []any{"*"},
+			"context":           "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+			"type":              "KYCAgeCredential",
+			"credentialSubject": map[string]any{"birthday": map[string]any{"$lt": 20000101}},
+		},
+	}
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			request VerificationQueryRequest
+			message string
+		}{
+			{
+				name:    "Empty name",
+				request: VerificationQueryRequest{Scope: []ZeroKnowledgeProofRequest{proofRequest}},
+				message: "verification query name is required",
+			},
+			{
+				name:    "Empty scope",
+				request: VerificationQueryRequest{Name: "empty scope"},
+				message: "at least one proof request is required",
+			},
+			{
+				name:    "Unsupported circuit",
+				request: VerificationQueryRequest{Name: "auth", Scope: []ZeroKnowledgeProofRequest{{ID: 1, CircuitID: "authV2"}}},
+				message: "unsupported circuit: authV2",
+			},
+			{
+				name:    "Duplicated request id",
+				request: VerificationQueryRequest{Name: "duplicated", Scope: []ZeroKnowledgeProofRequest{proofRequest, proofRequest}},
+				message: "the ids of the proof requests should be unique",
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := do(t, http.MethodPost, queriesURL, tc.request)
+				require.Equal(t, http.StatusBadRequest, rr.Code)
+				var response GenericErrorMessage
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, tc.message, response.Message)
+			})
+		}
+	})
+
+	rr := do(t, http.MethodPost, queriesURL, VerificationQueryRequest{
+		Name:   "Adults only",
+		Reason: common.ToPointer("age verification"),
+		Scope:  []ZeroKnowledgeProofRequest{proofRequest},
+	})
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var query VerificationQuery
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &query))
+	assert.Equal(t, "Adults only", query.Name)
+	assert.Equal(t, "age verification", query.Reason)
+	require.Len(t, query.Scope, 1)
+	assert.Equal(t, "credentialAtomicQuerySigV2", query.Scope[0].CircuitID)
+	queryURL := fmt.Sprintf("%s/%s", queriesURL, query.Id)
+
+	t.Run("Get and list", func(t *testing.T) {
+		rr := do(t, http.MethodGet, queryURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response VerificationQuery
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, query, response)
+
+		rr = do(t, http.MethodGet, queriesURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list []VerificationQuery
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list, 1)
+
+		rr = do(t, http.MethodGet, fmt.Sprintf("%s/%s", queriesURL, uuid.New()), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	createSession := func(t *testing.T) CreateVerificationSessionResponse {
+		t.Helper()
+		rr := do(t, http.MethodPost, queryURL+"/sessions", nil)
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response CreateVerificationSessionResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+	getSession := func(t *testing.T, id uuid.UUID) VerificationSession {
+		t.Helper()
+		rr := do(t, http.MethodGet, fmt.Sprintf("/v2/identities/%s/verification-sessions/%s", did, id), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response VerificationSession
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+
+	t.Run("Create session", func(t *testing.T) {
+		session := createSession(t)
+		assert.True(t, strings.HasPrefix(session.DeepLink, "iden3comm://?request_uri="))
+		assert.NotEmpty(t, session.UniversalLink)
+
+		var request protocol.AuthorizationRequestMessage
+		require.NoError(t, json.Unmarshal([]byte(session.Message), &request))
+		assert.Equal(t, did.String(), request.From)
+		assert.Equal(t, protocol.AuthorizationRequestMessageType, request.Type)
+		assert.Equal(t, fmt.Sprintf(ports.VerificationRequestCallbackURL, cfg.ServerUrl, session.SessionID), request.Body.CallbackURL)
+		assert.Equal(t, "age verification", request.Body.Reason)
+		require.Len(t, request.Body.Scope, 1)
+
+		assert.Equal(t, VerificationSessionStatusPending, getSession(t, session.SessionID).Status)
+
+		rr := do(t, http.MethodPost, fmt.Sprintf("%s/%s/sessions", queriesURL, uuid.New()), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Verified callback", func(t *testing.T) {
+		session := createSession(t)
+		server.Mocks.authVerifier.err = nil
+		server.Mocks.authVerifier.response = &protocol.AuthorizationResponseMessage{
+			From: userDID,
+			Body: protocol.AuthorizationMessageResponseBody{
+				Scope: []protocol.ZeroKnowledgeProofResponse{
+					{ID: 1, CircuitID: "credentialAtomicQuerySigV2", ZKProof: types.ZKProof{PubSignals: []string{"1", "2"}}},
+				},
+			},
+		}
+
+		rr := callback(t, session.SessionID, "jwz-token")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		result := getSession(t, session.SessionID)
+		assert.Equal(t, VerificationSessionStatusVerified, result.Status)
+		require.NotNil(t, result.UserDID)
+		assert.Equal(t, userDID, *result.UserDID)
+		require.NotNil(t, result.Response)
+		require.Len(t, *result.Response, 1)
+		assert.Equal(t, []string{"1", "2"}, (*result.Response)[0].PubSignals)
+
+		rr = callback(t, session.SessionID, "jwz-token")
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Failed callback leaves the session pending", func(t *testing.T) {
+		session := createSession(t)
+		server.Mocks.authVerifier.response = nil
+		server.Mocks.authVerifier.err = errors.New("proof is not valid")
+
+		rr := callback(t, session.SessionID, "jwz-token")
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+
+		result := getSession(t, session.SessionID)
+		assert.Equal(t, VerificationSessionStatusPending, result.Status)
+		assert.Nil(t, result.Error)
+		assert.Nil(t, result.Response)
+
+		server.Mocks.authVerifier.err = nil
+		server.Mocks.authVerifier.response = &protocol.AuthorizationResponseMessage{
+			From: userDID,
+			Body: protocol.AuthorizationMessageResponseBody{
+				Scope: []protocol.ZeroKnowledgeProofResponse{
+					{ID: 1, CircuitID: "credentialAtomicQuerySigV2", ZKProof: types.ZKProof{PubSignals: []string{"1", "2"}}},
+				},
+			},
+		}
+		rr = callback(t, session.SessionID, "jwz-token")
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, VerificationSessionStatusVerified, getSession(t, session.SessionID).Status)
+	})
+
+	t.Run("Unknown session", func(t *testing.T) {
+		rr := callback(t, uuid.New(), "jwz-token")
+		require.Equal(t, http.StatusNotFound, rr.Code)
+
+		rr = do(t, http.MethodGet, fmt.Sprintf("/v2/identities/%s/verification-sessions/%s", did, uuid.New()), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		rr := do(t, http.MethodDelete, queryURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = do(t, http.MethodDelete, queryURL, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -43,28 +43,38 @@ const (
 
 // Configuration holds the project configuration
 type Configuration struct {
-	ServerUrl                   string        `env:"ISSUER_SERVER_URL" envDefault:"http://localhost"`
-	ServerPort                  int           `env:"ISSUER_SERVER_PORT" envDefault:"3001"`
-	PublishingKeyPath           string        `env:"ISSUER_PUBLISH_KEY_PATH" envDefault:"pbkey"`
-	SchemaCache                 bool          `env:"ISSUER_SCHEMA_CACHE" envDefault:"false"`
-	OnChainCheckStatusFrequency time.Duration `env:"ISSUER_ONCHAIN_CHECK_STATUS_FREQUENCY"`
-	NetworkResolverPath         string        `env:"ISSUER_RESOLVER_PATH"`
-	NetworkResolverFile         *string       `env:"ISSUER_RESOLVER_FILE"`
-	IssuerName                  string        `env:"ISSUER_ISSUER_NAME"`
-	IssuerLogo                  string        `env:"ISSUER_ISSUER_LOGO"`
-	Database                    Database
-	Cache                       Cache
-	HTTPBasicAuth               HTTPBasicAuth
-	KeyStore                    KeyStore
-	Log                         Log
-	Ethereum                    Ethereum
-	Circuit                     Circuit
-	IPFS                        IPFS
-	CustomDIDMethods            []CustomDIDMethods `mapstructure:"-"`
-	MediaTypeManager            MediaTypeManager
-	UniversalLinks              UniversalLinks
-	UniversalDIDResolver        UniversalDIDResolver
-	Payments                    Payments
+	ServerUrl                    string        `env:"ISSUER_SERVER_URL" envDefault:"http://localhost"`
+	ServerPort                   int           `env:"ISSUER_SERVER_PORT" envDefault:"3001"`
+	PublishingKeyPath            string        `env:"ISSUER_PUBLISH_KEY_PATH" envDefault:"pbkey"`
+	PublishingKeyPool            []string      `env:"ISSUER_PUBLISH_KEY_POOL" envSeparator:","`
+	PublishingAccountSelection   string        `env:"ISSUER_PUBLISH_ACCOUNT_SELECTION" envDefault:"least-pending"`
+	SchemaCache                  bool          `env:"ISSUER_SCHEMA_CACHE" envDefault:"false"`
+	OnChainPublishStateFrequency time.Duration `env:"ISSUER_ONCHAIN_PUBLISH_STATE_FREQUENCY" envDefault:"1m"`
+	OnChainCheckStatusFrequency  time.Duration `env:"ISSUER_ONCHAIN_CHECK_STATUS_FREQUENCY"`
+	NetworkResolverPath          string        `env:"ISSUER_RESOLVER_PATH"`
+	NetworkResolverFile          *string       `env:"ISSUER_RESOLVER_FILE"`
+	IssuerName                   string        `env:"ISSUER_ISSUER_NAME"`
+	IssuerLogo                   string        `env:"ISSUER_ISSUER_LOGO"`
+	Database                     Database
+	Cache                        Cache
+	HTTPBasicAuth                HTTPBasicAuth
+	OIDC                         OIDC
+	KeyStore                     KeyStore
+	Log                          Log
+	Ethereum                     Ethereum
+	Circuit                      Circuit
+	IPFS                         IPFS
+	CustomDIDMethods             []CustomDIDMethods `mapstructure:"-"`
+	MediaTypeManager             MediaTypeManager
+	UniversalLinks               UniversalLinks
+	UniversalDIDResolver         UniversalDIDResolver
+	Payments                     Payments
+	BulkIssuance                 BulkIssuance
+	IdempotencyKeys              IdempotencyKeys
+	CredentialExpiration         CredentialExpiration
+	RefreshService               RefreshService
+	KeyRotation                  KeyRotation
+	DIDDocument                  DIDDocument
 }
 
 // Payments configurations
@@ -73,6 +83,45 @@ type Payments struct {
 	SettingsFile *string `env:"ISSUER_PAYMENTS_SETTINGS_FILE"`
 }
 
+// BulkIssuance configures the worker that issues the credentials of bulk issuance jobs
+// WorkerFrequency: How often the worker looks for pending jobs
+// BatchSize: Number of credentials issued in the same transaction and notified in the same event
+type BulkIssuance struct {
+	WorkerFrequency time.Duration `env:"ISSUER_BULK_ISSUANCE_WORKER_FREQUENCY" envDefault:"10s"`
+	BatchSize       int           `env:"ISSUER_BULK_ISSUANCE_BATCH_SIZE" envDefault:"100"`
+}
+
+// IdempotencyKeys configures how long the responses of the requests sent with an Idempotency-Key header are replayed
+type IdempotencyKeys struct {
+	TTL time.Duration `env:"ISSUER_IDEMPOTENCY_KEYS_TTL" envDefault:"24h"`
+}
+
+// CredentialExpiration configures the worker that applies the credential expiration policies of the identities
+// SweeperFrequency: How often the worker looks for credentials about to expire or expired. 0 disables the worker
+type CredentialExpiration struct {
+	SweeperFrequency time.Duration `env:"ISSUER_CREDENTIAL_EXPIRATION_SWEEPER_FREQUENCY" envDefault:"1h"`
+}
+
+// KeyRotation configures the worker that resumes the auth key rotations of the identities
+// WorkerFrequency: How often the worker checks the state confirmations and retries the failed steps. 0 disables the worker
+type KeyRotation struct {
+	WorkerFrequency time.Duration `env:"ISSUER_KEY_ROTATION_WORKER_FREQUENCY" envDefault:"30s"`
+}
+
+// RefreshService configures the refresh of the credentials issued with an Iden3RefreshService2023
+// DataSourceURL: Endpoint that provides the up-to-date attributes of the credentials to refresh. If empty, the refreshed credentials keep their attributes
+type RefreshService struct {
+	DataSourceURL string `env:"ISSUER_REFRESH_SERVICE_DATA_SOURCE_URL"`
+}
+
+// DIDDocument configures the services added to the DID documents served for the issuer identities, besides the agent
+// PushServiceURL: Endpoint of the push notifications service of the issuer. Not added if empty
+// RefreshServiceURL: Endpoint of the credentials refresh service of the issuer. Not added if empty
+type DIDDocument struct {
+	PushServiceURL    string `env:"ISSUER_DID_DOCUMENT_PUSH_SERVICE_URL"`
+	RefreshServiceURL string `env:"ISSUER_DID_DOCUMENT_REFRESH_SERVICE_URL"`
+}
+
 // Database has the database configuration
 // URL: The database connection string
 type Database struct {
@@ -187,6 +236,28 @@ type HTTPBasicAuth struct {
 	Password string `env:"ISSUER_API_AUTH_PASSWORD" envDefault:""`
 }
 
+// OIDC configures the authentication with bearer JWTs issued by an OpenID Connect provider. It is enabled when
+// JWKSURL or JWKSFile is set and works alongside the basic auth user and password.
+// JWKSURL: URL of the JSON Web Key Set used to verify the tokens. It is fetched again every JWKSRefresh
+// JWKSFile: Local JSON Web Key Set file, used instead of JWKSURL
+// Issuer and Audience: Values required in the iss and aud claims of the tokens
+// RolesClaim: Claim with the roles or groups of the user. Nested claims are separated by dots, like realm_access.roles
+// RolesPath: YAML file that maps the roles to permissions and identities
+type OIDC struct {
+	JWKSURL     string        `env:"ISSUER_API_AUTH_OIDC_JWKS_URL"`
+	JWKSFile    string        `env:"ISSUER_API_AUTH_OIDC_JWKS_FILE"`
+	JWKSRefresh time.Duration `env:"ISSUER_API_AUTH_OIDC_JWKS_REFRESH" envDefault:"1h"`
+	Issuer      string        `env:"ISSUER_API_AUTH_OIDC_ISSUER"`
+	Audience    string        `env:"ISSUER_API_AUTH_OIDC_AUDIENCE"`
+	RolesClaim  string        `env:"ISSUER_API_AUTH_OIDC_ROLES_CLAIM" envDefault:"roles"`
+	RolesPath   string        `env:"ISSUER_API_AUTH_OIDC_ROLES_PATH" envDefault:"./oidc_roles.yaml"`
+}
+
+// Enabled returns true if the bearer JWT authentication is configured
+func (o OIDC) Enabled() bool {
+	return o.JWKSURL != "" || o.JWKSFile != ""
+}
+
 // MediaTypeManager enables or disables the media types manager
 type MediaTypeManager struct {
 	Enabled *bool `env:"ISSUER_MEDIA_TYPE_MANAGER_ENABLED"`
@@ -264,6 +335,15 @@ func lookupVaultTokenFromFile(pathVaultConfig string) (string, error) {
 	return matches[0], nil
 }
 
+// PublishingKeyPaths returns the keys of the accounts that publish the states of the baby jubjub identities.
+// These are the keys of the pool, or the publishing key when no pool is configured.
+func (c *Configuration) PublishingKeyPaths() []string {
+	if len(c.PublishingKeyPool) > 0 {
+		return c.PublishingKeyPool
+	}
+	return []string{c.PublishingKeyPath}
+}
+
 // nolint:gocyclo,gocognit
 func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 	const defResolverPath = "./resolvers_settings.yaml"
@@ -286,6 +366,16 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 		log.Info(ctx, "ISSUER_PUBLISH_KEY_PATH value is missing")
 	}
 
+	if cfg.PublishingAccountSelection != "round-robin" && cfg.PublishingAccountSelection != "least-pending" {
+		log.Error(ctx, "ISSUER_PUBLISH_ACCOUNT_SELECTION value is invalid", "value", cfg.PublishingAccountSelection)
+		return errors.New("ISSUER_PUBLISH_ACCOUNT_SELECTION must be round-robin or least-pending")
+	}
+
+	if cfg.OnChainPublishStateFrequency <= 0 {
+		log.Error(ctx, "ISSUER_ONCHAIN_PUBLISH_STATE_FREQUENCY value is invalid", "value", cfg.OnChainPublishStateFrequency)
+		return errors.New("ISSUER_ONCHAIN_PUBLISH_STATE_FREQUENCY must be greater than 0")
+	}
+
 	if cfg.OnChainCheckStatusFrequency == 0 {
 		log.Info(ctx, "ISSUER_ONCHAIN_CHECK_STATUS_FREQUENCY value is missing")
 	}
@@ -302,6 +392,11 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 		log.Info(ctx, "ISSUER_API_AUTH_PASSWORD value is missing")
 	}
 
+	if cfg.OIDC.Enabled() && (cfg.OIDC.Issuer == "" || cfg.OIDC.Audience == "") {
+		log.Error(ctx, "ISSUER_API_AUTH_OIDC_ISSUER and ISSUER_API_AUTH_OIDC_AUDIENCE are required when the OIDC authentication is enabled")
+		return errors.New("ISSUER_API_AUTH_OIDC_ISSUER and ISSUER_API_AUTH_OIDC_AUDIENCE are required when the OIDC authentication is enabled")
+	}
+
 	if cfg.KeyStore.Address == "" {
 		log.Info(ctx, "ISSUER_KEY_STORE_ADDRESS value is missing")
 	}
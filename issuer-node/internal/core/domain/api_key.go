@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyPermission is an action that an API key is allowed to do on the identities it is scoped to
+type APIKeyPermission string
+
+const (
+	APIKeyPermissionIdentitiesRead     APIKeyPermission = "identities:read"     // APIKeyPermissionIdentitiesRead read the identity details and its states
+	APIKeyPermissionIdentitiesWrite    APIKeyPermission = "identities:write"    // APIKeyPermissionIdentitiesWrite update the identity and publish its state
+	APIKeyPermissionConnectionsRead    APIKeyPermission = "connections:read"    // APIKeyPermissionConnectionsRead read the connections
+	APIKeyPermissionConnectionsWrite   APIKeyPermission = "connections:write"   // APIKeyPermissionConnectionsWrite create and delete connections
+	APIKeyPermissionCredentialsRead    APIKeyPermission = "credentials:read"    // APIKeyPermissionCredentialsRead read credentials, templates and expiration policies
+	APIKeyPermissionCredentialsWrite   APIKeyPermission = "credentials:write"   // APIKeyPermissionCredentialsWrite issue, revoke and delete credentials, manage templates and expiration policies
+	APIKeyPermissionSchemasRead        APIKeyPermission = "schemas:read"        // APIKeyPermissionSchemasRead read schemas and display methods
+	APIKeyPermissionSchemasWrite       APIKeyPermission = "schemas:write"       // APIKeyPermissionSchemasWrite import schemas and manage display methods
+	APIKeyPermissionLinksRead          APIKeyPermission = "links:read"          // APIKeyPermissionLinksRead read credential links
+	APIKeyPermissionLinksWrite         APIKeyPermission = "links:write"         // APIKeyPermissionLinksWrite create, activate and delete credential links
+	APIKeyPermissionVerificationsRead  APIKeyPermission = "verifications:read"  // APIKeyPermissionVerificationsRead read verification queries and sessions
+	APIKeyPermissionVerificationsWrite APIKeyPermission = "verifications:write" // APIKeyPermissionVerificationsWrite manage verification queries and start sessions
+	APIKeyPermissionKeysAdmin          APIKeyPermission = "keys:admin"          // APIKeyPermissionKeysAdmin manage the keys of the identity and rotate them
+	APIKeyPermissionPaymentsRead       APIKeyPermission = "payments:read"       // APIKeyPermissionPaymentsRead read payment options and requests
+	APIKeyPermissionPaymentsWrite      APIKeyPermission = "payments:write"      // APIKeyPermissionPaymentsWrite manage payment options and requests and verify payments
+)
+
+// APIKeyPermissions are all the valid permissions
+var APIKeyPermissions = []APIKeyPermission{
+	APIKeyPermissionIdentitiesRead,
+	APIKeyPermissionIdentitiesWrite,
+	APIKeyPermissionConnectionsRead,
+	APIKeyPermissionConnectionsWrite,
+	APIKeyPermissionCredentialsRead,
+	APIKeyPermissionCredentialsWrite,
+	APIKeyPermissionSchemasRead,
+	APIKeyPermissionSchemasWrite,
+	APIKeyPermissionLinksRead,
+	APIKeyPermissionLinksWrite,
+	APIKeyPermissionVerificationsRead,
+	APIKeyPermissionVerificationsWrite,
+	APIKeyPermissionKeysAdmin,
+	APIKeyPermissionPaymentsRead,
+	APIKeyPermissionPaymentsWrite,
+}
+
+// IsValid returns true if the permission is one of APIKeyPermissions
+func (p APIKeyPermission) IsValid() bool {
+	return slices.Contains(APIKeyPermissions, p)
+}
+
+// APIKey gives access to the API to a client that is not the admin user.
+// Only the hash of the key is stored, the key is returned once when it is created.
+type APIKey struct {
+	ID          uuid.UUID
+	Name        string
+	KeyHash     string
+	Identities  []string // DIDs of the identities the key can act on
+	Permissions []APIKeyPermission
+	CreatedAt   time.Time
+	LastUsedAt  *time.Time
+}
+
+// NewAPIKey - Constructor
+func NewAPIKey(name string, keyHash string, identities []string, permissions []APIKeyPermission) *APIKey {
+	return &APIKey{
+		ID:          uuid.New(),
+		Name:        name,
+		KeyHash:     keyHash,
+		Identities:  identities,
+		Permissions: permissions,
+	}
+}
+
+// HasPermission returns true if the key has been granted the permission
+func (k *APIKey) HasPermission(permission APIKeyPermission) bool {
+	return slices.Contains(k.Permissions, permission)
+}
+
+// HasIdentity returns true if the key is scoped to the identity
+func (k *APIKey) HasIdentity(did string) bool {
+	return slices.Contains(k.Identities, did)
+}
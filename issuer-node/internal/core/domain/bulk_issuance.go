@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+// BulkIssuanceFormat is the format of the file uploaded to create a bulk issuance job
+type BulkIssuanceFormat string
+
+// BulkIssuanceJobStatus is the status of a bulk issuance job
+type BulkIssuanceJobStatus string
+
+// BulkIssuanceRowStatus is the status of a row of a bulk issuance job
+type BulkIssuanceRowStatus string
+
+const (
+	BulkIssuanceFormatCSV    BulkIssuanceFormat = "csv"    // BulkIssuanceFormatCSV comma separated values with a header line
+	BulkIssuanceFormatNDJSON BulkIssuanceFormat = "ndjson" // BulkIssuanceFormatNDJSON one json object per line
+
+	BulkIssuanceJobStatusPending    BulkIssuanceJobStatus = "pending"    // BulkIssuanceJobStatusPending the job has not been picked up by the worker yet
+	BulkIssuanceJobStatusProcessing BulkIssuanceJobStatus = "processing" // BulkIssuanceJobStatusProcessing the worker is issuing the job credentials
+	BulkIssuanceJobStatusCompleted  BulkIssuanceJobStatus = "completed"  // BulkIssuanceJobStatusCompleted all the rows have been processed
+
+	BulkIssuanceRowStatusPending BulkIssuanceRowStatus = "pending" // BulkIssuanceRowStatusPending the row has not been processed yet
+	BulkIssuanceRowStatusIssued  BulkIssuanceRowStatus = "issued"  // BulkIssuanceRowStatusIssued a credential has been issued for the row
+	BulkIssuanceRowStatusFailed  BulkIssuanceRowStatus = "failed"  // BulkIssuanceRowStatusFailed the row could not be issued. See the row error
+)
+
+// BulkIssuanceJobCoreDID - represents the issuer of a bulk issuance job
+type BulkIssuanceJobCoreDID w3c.DID
+
+// BulkIssuanceJob represents an asynchronous issuance of credentials of the same schema, one for each row.
+// Row counters are calculated from the job rows when the job is fetched.
+type BulkIssuanceJob struct {
+	ID                       uuid.UUID
+	IssuerDID                BulkIssuanceJobCoreDID
+	SchemaID                 uuid.UUID
+	Format                   BulkIssuanceFormat
+	Status                   BulkIssuanceJobStatus
+	CredentialExpiration     *time.Time
+	CredentialSignatureProof bool
+	CredentialMTPProof       bool
+	CredentialStatusType     verifiable.CredentialStatusType
+	CredentialRefreshService *verifiable.RefreshService
+	CredentialDisplayMethod  *verifiable.DisplayMethod
+	TotalRows                int
+	PendingRows              int
+	IssuedRows               int
+	FailedRows               int
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
+
+// BulkIssuanceRow represents a credential subject of a bulk issuance job and the result of its issuance
+type BulkIssuanceRow struct {
+	JobID             uuid.UUID
+	RowNumber         int
+	CredentialSubject CredentialSubject
+	Status            BulkIssuanceRowStatus
+	CredentialID      *uuid.UUID
+	Error             *string
+}
+
+// NewBulkIssuanceJob - Constructor
+func NewBulkIssuanceJob(
+	issuerDID w3c.DID,
+	schemaID uuid.UUID,
+	format BulkIssuanceFormat,
+	credentialExpiration *time.Time,
+	credentialSignatureProof bool,
+	credentialMTPProof bool,
+	credentialStatusType verifiable.CredentialStatusType,
+	credentialRefreshService *verifiable.RefreshService,
+	credentialDisplayMethod *verifiable.DisplayMethod,
+) *BulkIssuanceJob {
+	return &BulkIssuanceJob{
+		ID:                       uuid.New(),
+		IssuerDID:                BulkIssuanceJobCoreDID(issuerDID),
+		SchemaID:                 schemaID,
+		Format:                   format,
+		Status:                   BulkIssuanceJobStatusPending,
+		CredentialExpiration:     credentialExpiration,
+		CredentialSignatureProof: credentialSignatureProof,
+		CredentialMTPProof:       credentialMTPProof,
+		CredentialStatusType:     credentialStatusType,
+		CredentialRefreshService: credentialRefreshService,
+		CredentialDisplayMethod:  credentialDisplayMethod,
+	}
+}
+
+// IssuerCoreDID - return the Core DID value
+func (j *BulkIssuanceJob) IssuerCoreDID() *w3c.DID {
+	return common.ToPointer(w3c.DID(j.IssuerDID))
+}
+
+// Issued marks the row as issued with the given credential
+func (r *BulkIssuanceRow) Issued(credentialID uuid.UUID) {
+	r.Status = BulkIssuanceRowStatusIssued
+	r.CredentialID = &credentialID
+	r.Error = nil
+}
+
+// Failed marks the row as failed with the given error
+func (r *BulkIssuanceRow) Failed(err error) {
+	r.Status = BulkIssuanceRowStatusFailed
+	r.CredentialID = nil
+	r.Error = common.ToPointer(err.Error())
+}
+
+// Scan - scan the value for BulkIssuanceJobCoreDID
+func (d *BulkIssuanceJobCoreDID) Scan(value interface{}) error {
+	didStr, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("invalid value type, expected string")
+	}
+	did, err := w3c.ParseDID(didStr)
+	if err != nil {
+		return err
+	}
+	*d = BulkIssuanceJobCoreDID(*did)
+	return nil
+}
@@ -37,6 +37,7 @@ type Claim struct {
 	Version          uint32          `json:"version"`
 	RevNonce         RevNonceUint64  `json:"rev_nonce"`
 	Revoked          bool            `json:"revoked"`
+	Suspended        bool            `json:"suspended"`
 	Data             pgtype.JSONB    `json:"data"`
 	CoreClaim        CoreClaim       `json:"core_claim"`
 	MTPProof         pgtype.JSONB    `json:"mtp_proof"`
@@ -58,6 +59,14 @@ type Claim struct {
 // Credentials is the type of array of credential
 type Credentials []*Claim
 
+// CredentialReissue links a credential with the credential that replaced it when it was reissued
+type CredentialReissue struct {
+	Issuer        string
+	PredecessorID uuid.UUID
+	SuccessorID   uuid.UUID
+	CreatedAt     time.Time
+}
+
 // FromClaimer TODO add description
 func FromClaimer(claim *core.Claim, schemaURL, schemaType string) (*Claim, error) {
 	otherIdentifier := ""
@@ -238,8 +247,14 @@ func NewClaimModel(jsonSchemaURL string, credentialType string, coreClaim core.C
 	return &claimModel, nil
 }
 
-// GetCredentialStatus returns CredentialStatus deserialized object
+// GetCredentialStatus returns CredentialStatus deserialized object.
+// When the credential has several statuses, like the revocation and suspension entries of the bitstring status lists,
+// the first one is returned.
 func (c *Claim) GetCredentialStatus() (*verifiable.CredentialStatus, error) {
+	var statuses []verifiable.CredentialStatus
+	if err := c.CredentialStatus.AssignTo(&statuses); err == nil && len(statuses) > 0 {
+		return &statuses[0], nil
+	}
 	cStatus := new(verifiable.CredentialStatus)
 	err := c.CredentialStatus.AssignTo(cStatus)
 	if err != nil {
@@ -258,20 +273,45 @@ func (c *Claim) HasEncryptedData() bool {
 	return c.Data.Status == pgtype.Null && c.EncryptedData != nil
 }
 
+// SignatureProofType returns the type of the signature proof of the claim. Credentials issued by did:web identities
+// have a JsonWebSignature2020 proof instead of a BJJSignature2021 one.
+func (c *Claim) SignatureProofType() (verifiable.ProofType, error) {
+	var proof struct {
+		Type verifiable.ProofType `json:"type"`
+	}
+	if err := c.SignatureProof.AssignTo(&proof); err != nil {
+		return "", err
+	}
+	return proof.Type, nil
+}
+
 // GetVerifiableProofs returns the verifiable proofs of the claim
 func (c *Claim) GetVerifiableProofs() (verifiable.CredentialProofs, error) {
 	var (
 		err            error
 		signatureProof *verifiable.BJJSignatureProof2021
+		jwsProof       *JSONWebSignature2020Proof
 		mtpProof       *verifiable.Iden3SparseMerkleTreeProof
 	)
 	proofs := make(verifiable.CredentialProofs, 0)
 	if c.SignatureProof.Status != pgtype.Null {
-		err = c.SignatureProof.AssignTo(&signatureProof)
+		proofType, err := c.SignatureProofType()
 		if err != nil {
 			return nil, err
 		}
-		proofs = append(proofs, signatureProof)
+		if proofType == JSONWebSignature2020ProofType {
+			err = c.SignatureProof.AssignTo(&jwsProof)
+			if err != nil {
+				return nil, err
+			}
+			proofs = append(proofs, jwsProof)
+		} else {
+			err = c.SignatureProof.AssignTo(&signatureProof)
+			if err != nil {
+				return nil, err
+			}
+			proofs = append(proofs, signatureProof)
+		}
 	}
 	if c.MTPProof.Status != pgtype.Null {
 		err = c.MTPProof.AssignTo(&mtpProof)
@@ -299,3 +339,28 @@ func (c *Claim) GetEncryptedDataAsMap() (protocol.JWEJSONEncryption, error) {
 	}
 	return data, nil
 }
+
+// JSONWebSignature2020ProofType is the type of the proofs of the credentials issued by did:web identities
+const JSONWebSignature2020ProofType verifiable.ProofType = "JsonWebSignature2020"
+
+// ErrJSONWebSignatureProofWithoutCoreClaim is returned when the core claim is requested to a JsonWebSignature2020 proof
+var ErrJSONWebSignatureProofWithoutCoreClaim = errors.New("JsonWebSignature2020 proofs do not include the core claim")
+
+// JSONWebSignature2020Proof is a linked data proof with a detached JWS signed with an ETH or Ed25519 key of the issuer
+type JSONWebSignature2020Proof struct {
+	Type               verifiable.ProofType `json:"type"`
+	Created            time.Time            `json:"created"`
+	VerificationMethod string               `json:"verificationMethod"`
+	ProofPurpose       string               `json:"proofPurpose"`
+	JWS                string               `json:"jws"`
+}
+
+// ProofType returns the JsonWebSignature2020 proof type
+func (p *JSONWebSignature2020Proof) ProofType() verifiable.ProofType {
+	return p.Type
+}
+
+// GetCoreClaim returns an error, the proof signs the credential instead of its core claim
+func (p *JSONWebSignature2020Proof) GetCoreClaim() (*core.Claim, error) {
+	return nil, ErrJSONWebSignatureProofWithoutCoreClaim
+}
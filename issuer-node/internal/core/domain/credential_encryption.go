@@ -0,0 +1,40 @@
+package domain
+
+import "errors"
+
+// ErrInvalidCredentialEncryptionPolicy means that the credential encryption policy is not supported
+var ErrInvalidCredentialEncryptionPolicy = errors.New("invalid credential encryption policy, expected none, preferred or required")
+
+// CredentialEncryptionPolicy defines when credentials are encrypted with a key agreement key of the DID document of the holder
+type CredentialEncryptionPolicy string
+
+const (
+	// CredentialEncryptionNone means that credentials are only encrypted when the request includes an encryption key
+	CredentialEncryptionNone CredentialEncryptionPolicy = "none"
+	// CredentialEncryptionPreferred means that credentials are encrypted when the holder has a usable key and issued in plain text otherwise
+	CredentialEncryptionPreferred CredentialEncryptionPolicy = "preferred"
+	// CredentialEncryptionRequired means that credentials are not issued when the holder has no usable key
+	CredentialEncryptionRequired CredentialEncryptionPolicy = "required"
+)
+
+// Validate returns an error if the policy is not supported. An empty policy is the same as none.
+func (p CredentialEncryptionPolicy) Validate() error {
+	switch p {
+	case "", CredentialEncryptionNone, CredentialEncryptionPreferred, CredentialEncryptionRequired:
+		return nil
+	}
+	return ErrInvalidCredentialEncryptionPolicy
+}
+
+// Enabled returns true if the credentials have to be encrypted with a key of the holder
+func (p CredentialEncryptionPolicy) Enabled() bool {
+	return p == CredentialEncryptionPreferred || p == CredentialEncryptionRequired
+}
+
+// OrDefault returns the policy or none when it is empty
+func (p CredentialEncryptionPolicy) OrDefault() CredentialEncryptionPolicy {
+	if p == "" {
+		return CredentialEncryptionNone
+	}
+	return p
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+// CredentialExpirationPolicyCoreDID - represents the issuer of a credential expiration policy
+type CredentialExpirationPolicyCoreDID w3c.DID
+
+// CredentialExpirationPolicy defines what happens with the credentials of an identity when they are about to expire and
+// once they have expired. A policy without schema applies to the credentials of the schemas without their own policy.
+type CredentialExpirationPolicy struct {
+	ID               uuid.UUID
+	IssuerDID        CredentialExpirationPolicyCoreDID
+	SchemaID         *uuid.UUID
+	SchemaURL        *string // url of the schema, filled when the policy is fetched
+	NotifyDaysBefore *int
+	AutoRevoke       bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// NewCredentialExpirationPolicy - Constructor
+func NewCredentialExpirationPolicy(issuerDID w3c.DID, schemaID *uuid.UUID, notifyDaysBefore *int, autoRevoke bool) *CredentialExpirationPolicy {
+	return &CredentialExpirationPolicy{
+		ID:               uuid.New(),
+		IssuerDID:        CredentialExpirationPolicyCoreDID(issuerDID),
+		SchemaID:         schemaID,
+		NotifyDaysBefore: notifyDaysBefore,
+		AutoRevoke:       autoRevoke,
+	}
+}
+
+// IssuerCoreDID - return the Core DID value
+func (p *CredentialExpirationPolicy) IssuerCoreDID() *w3c.DID {
+	return common.ToPointer(w3c.DID(p.IssuerDID))
+}
+
+// NotifyUntil returns the expiration time up to which the credentials get a renewal notice at the given time,
+// or nil if the policy does not send renewal notices
+func (p *CredentialExpirationPolicy) NotifyUntil(now time.Time) *time.Time {
+	if p.NotifyDaysBefore == nil {
+		return nil
+	}
+	return common.ToPointer(now.AddDate(0, 0, *p.NotifyDaysBefore))
+}
+
+// Scan - scan the value for CredentialExpirationPolicyCoreDID
+func (d *CredentialExpirationPolicyCoreDID) Scan(value interface{}) error {
+	didStr, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("invalid value type, expected string")
+	}
+	did, err := w3c.ParseDID(didStr)
+	if err != nil {
+		return err
+	}
+	*d = CredentialExpirationPolicyCoreDID(*did)
+	return nil
+}
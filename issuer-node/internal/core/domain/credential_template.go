@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+// ErrInvalidRelativeExpiration means that the relative expiration of a template is malformed
+var ErrInvalidRelativeExpiration = errors.New("invalid relative expiration, expected a positive amount of hours, days, weeks or years up to 100 years like +365d")
+
+const (
+	daysPerWeek = 7
+	// maxRelativeExpirationYears bounds the relative expirations so that they can not overflow
+	maxRelativeExpirationYears = 100
+)
+
+var relativeExpirationRegexp = regexp.MustCompile(`^\+([1-9][0-9]*)([hdwy])$`)
+
+// CredentialTemplateCoreDID - represents the issuer of a credential template
+type CredentialTemplateCoreDID w3c.DID
+
+// CredentialTemplate holds the issuance options shared by the credentials of the same kind so that
+// credentials, links and bulk issuance jobs can be created providing only the attributes of each subject.
+type CredentialTemplate struct {
+	ID                       uuid.UUID
+	IssuerDID                CredentialTemplateCoreDID
+	Name                     string
+	SchemaID                 uuid.UUID
+	CredentialSubject        CredentialSubject
+	CredentialExpiration     *string
+	CredentialSignatureProof bool
+	CredentialMTPProof       bool
+	DisplayMethodID          *uuid.UUID
+	RefreshService           *verifiable.RefreshService
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
+
+// NewCredentialTemplate - Constructor
+func NewCredentialTemplate(
+	issuerDID w3c.DID,
+	name string,
+	schemaID uuid.UUID,
+	credentialSubject CredentialSubject,
+	credentialExpiration *string,
+	credentialSignatureProof bool,
+	credentialMTPProof bool,
+	displayMethodID *uuid.UUID,
+	refreshService *verifiable.RefreshService,
+) *CredentialTemplate {
+	if credentialSubject == nil {
+		credentialSubject = CredentialSubject{}
+	}
+	return &CredentialTemplate{
+		ID:                       uuid.New(),
+		IssuerDID:                CredentialTemplateCoreDID(issuerDID),
+		Name:                     name,
+		SchemaID:                 schemaID,
+		CredentialSubject:        credentialSubject,
+		CredentialExpiration:     credentialExpiration,
+		CredentialSignatureProof: credentialSignatureProof,
+		CredentialMTPProof:       credentialMTPProof,
+		DisplayMethodID:          displayMethodID,
+		RefreshService:           refreshService,
+	}
+}
+
+// IssuerCoreDID - return the Core DID value
+func (t *CredentialTemplate) IssuerCoreDID() *w3c.DID {
+	return common.ToPointer(w3c.DID(t.IssuerDID))
+}
+
+// CredentialSubjectWith returns the default attributes of the template overridden by the given ones
+func (t *CredentialTemplate) CredentialSubjectWith(attributes CredentialSubject) CredentialSubject {
+	credentialSubject := make(CredentialSubject, len(t.CredentialSubject)+len(attributes))
+	for key, value := range t.CredentialSubject {
+		credentialSubject[key] = value
+	}
+	for key, value := range attributes {
+		credentialSubject[key] = value
+	}
+	return credentialSubject
+}
+
+// CredentialExpirationFrom returns the expiration of a credential issued at the given time or nil if the template credentials do not expire
+func (t *CredentialTemplate) CredentialExpirationFrom(issuedAt time.Time) (*time.Time, error) {
+	if t.CredentialExpiration == nil {
+		return nil, nil
+	}
+	return RelativeExpiration(*t.CredentialExpiration, issuedAt)
+}
+
+// RelativeExpiration returns the time after the given one described by an expression like +12h, +30d, +4w or +1y.
+// The expression can not go beyond 100 years.
+func RelativeExpiration(expression string, from time.Time) (*time.Time, error) {
+	matches := relativeExpirationRegexp.FindStringSubmatch(expression)
+	if matches == nil {
+		return nil, ErrInvalidRelativeExpiration
+	}
+	amount, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return nil, ErrInvalidRelativeExpiration
+	}
+
+	maxHours := int(from.AddDate(maxRelativeExpirationYears, 0, 0).Sub(from) / time.Hour)
+	var expiration time.Time
+	switch matches[2] {
+	case "h":
+		if amount > maxHours {
+			return nil, ErrInvalidRelativeExpiration
+		}
+		expiration = from.Add(time.Duration(amount) * time.Hour)
+	case "d":
+		if amount > maxHours/24 {
+			return nil, ErrInvalidRelativeExpiration
+		}
+		expiration = from.AddDate(0, 0, amount)
+	case "w":
+		if amount > maxHours/(24*daysPerWeek) {
+			return nil, ErrInvalidRelativeExpiration
+		}
+		expiration = from.AddDate(0, 0, amount*daysPerWeek)
+	case "y":
+		if amount > maxRelativeExpirationYears {
+			return nil, ErrInvalidRelativeExpiration
+		}
+		expiration = from.AddDate(amount, 0, 0)
+	}
+	return &expiration, nil
+}
+
+// Scan - scan the value for CredentialTemplateCoreDID
+func (d *CredentialTemplateCoreDID) Scan(value interface{}) error {
+	didStr, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("invalid value type, expected string")
+	}
+	did, err := w3c.ParseDID(didStr)
+	if err != nil {
+		return err
+	}
+	*d = CredentialTemplateCoreDID(*did)
+	return nil
+}
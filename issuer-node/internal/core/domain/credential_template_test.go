@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestRelativeExpiration(t *testing.T) {
+	from := time.Date(2024, time.February, 29, 10, 0, 0, 0, time.UTC)
+	type testConfig struct {
+		name       string
+		expression string
+		expected   *time.Time
+		err        error
+	}
+	for _, tc := range []testConfig{
+		{name: "hours", expression: "+12h", expected: common.ToPointer(time.Date(2024, time.February, 29, 22, 0, 0, 0, time.UTC))},
+		{name: "days", expression: "+365d", expected: common.ToPointer(time.Date(2025, time.February, 28, 10, 0, 0, 0, time.UTC))},
+		{name: "weeks", expression: "+2w", expected: common.ToPointer(time.Date(2024, time.March, 14, 10, 0, 0, 0, time.UTC))},
+		{name: "years", expression: "+1y", expected: common.ToPointer(time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC))},
+		{name: "missing sign", expression: "365d", err: ErrInvalidRelativeExpiration},
+		{name: "zero", expression: "+0d", err: ErrInvalidRelativeExpiration},
+		{name: "negative", expression: "-1d", err: ErrInvalidRelativeExpiration},
+		{name: "unknown unit", expression: "+1m", err: ErrInvalidRelativeExpiration},
+		{name: "100 years", expression: "+100y", expected: common.ToPointer(time.Date(2124, time.February, 29, 10, 0, 0, 0, time.UTC))},
+		{name: "hours over 100 years", expression: "+876601h", err: ErrInvalidRelativeExpiration},
+		{name: "hours overflow", expression: "+3000000h", err: ErrInvalidRelativeExpiration},
+		{name: "days over 100 years", expression: "+36526d", err: ErrInvalidRelativeExpiration},
+		{name: "weeks over 100 years", expression: "+5218w", err: ErrInvalidRelativeExpiration},
+		{name: "years over 100 years", expression: "+101y", err: ErrInvalidRelativeExpiration},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			expiration, err := RelativeExpiration(tc.expression, from)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, expiration)
+		})
+	}
+}
+
+func TestCredentialTemplate_CredentialSubjectWith(t *testing.T) {
+	template := CredentialTemplate{CredentialSubject: CredentialSubject{"documentType": 2, "country": "IN"}}
+	credentialSubject := template.CredentialSubjectWith(CredentialSubject{"id": "did:example:123", "documentType": 3})
+	assert.Equal(t, CredentialSubject{"id": "did:example:123", "documentType": 3, "country": "IN"}, credentialSubject)
+	assert.Equal(t, CredentialSubject{"documentType": 2, "country": "IN"}, template.CredentialSubject)
+}
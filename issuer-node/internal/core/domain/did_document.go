@@ -0,0 +1,95 @@
+package domain
+
+// DID document contexts, verification method and service types
+const (
+	DIDDocumentContext          = "https://www.w3.org/ns/did/v1"
+	JSONWebKey2020Context       = "https://w3id.org/security/suites/jws-2020/v1"
+	JSONWebKey2020              = "JsonWebKey2020"
+	Iden3CommServiceType        = "Iden3CommServiceV1"
+	PushNotificationServiceType = "push-notification"
+	Iden3RefreshServiceType     = "Iden3RefreshService2023"
+	bjjJWKCurve                 = "BJJ"
+	bjjJWKKeyType               = "EC"
+)
+
+// DIDDocument is the DID document of an identity of the issuer node
+type DIDDocument struct {
+	Context            []string                `json:"@context"`
+	ID                 string                  `json:"id"`
+	VerificationMethod []DIDVerificationMethod `json:"verificationMethod,omitempty"`
+	Authentication     []string                `json:"authentication,omitempty"`
+	AssertionMethod    []string                `json:"assertionMethod,omitempty"`
+	Service            []DIDService            `json:"service,omitempty"`
+}
+
+// DIDVerificationMethod is a public key of the identity
+type DIDVerificationMethod struct {
+	ID           string         `json:"id"`
+	Type         string         `json:"type"`
+	Controller   string         `json:"controller"`
+	PublicKeyJwk map[string]any `json:"publicKeyJwk"`
+}
+
+// DIDService is a service endpoint of the identity
+type DIDService struct {
+	ID              string `json:"id"`
+	Type            string `json:"type"`
+	ServiceEndpoint string `json:"serviceEndpoint"`
+}
+
+// NewDIDDocument returns a DID document without keys nor services
+func NewDIDDocument(did string) *DIDDocument {
+	return &DIDDocument{
+		Context: []string{DIDDocumentContext},
+		ID:      did,
+	}
+}
+
+// AddBJJKey adds a Baby JubJub public key that can be used to authenticate and to sign credentials.
+// x and y are the base64url encoded coordinates of the key.
+func (d *DIDDocument) AddBJJKey(id string, x string, y string) {
+	d.AddJWK(id, map[string]any{
+		"kty": bjjJWKKeyType,
+		"crv": bjjJWKCurve,
+		"x":   x,
+		"y":   y,
+	})
+}
+
+// AddJWK adds a public key, as a JSON Web Key, that can be used to authenticate and to sign credentials
+func (d *DIDDocument) AddJWK(id string, jwk map[string]any) {
+	if len(d.VerificationMethod) == 0 {
+		d.Context = append(d.Context, JSONWebKey2020Context)
+	}
+	d.VerificationMethod = append(d.VerificationMethod, DIDVerificationMethod{
+		ID:           id,
+		Type:         JSONWebKey2020,
+		Controller:   d.ID,
+		PublicKeyJwk: jwk,
+	})
+	d.Authentication = append(d.Authentication, id)
+	d.AssertionMethod = append(d.AssertionMethod, id)
+}
+
+// AddAssertionJWK adds a public key, as a JSON Web Key, that can only be used to sign credentials
+func (d *DIDDocument) AddAssertionJWK(id string, jwk map[string]any) {
+	if len(d.VerificationMethod) == 0 {
+		d.Context = append(d.Context, JSONWebKey2020Context)
+	}
+	d.VerificationMethod = append(d.VerificationMethod, DIDVerificationMethod{
+		ID:           id,
+		Type:         JSONWebKey2020,
+		Controller:   d.ID,
+		PublicKeyJwk: jwk,
+	})
+	d.AssertionMethod = append(d.AssertionMethod, id)
+}
+
+// AddService adds a service endpoint. The id of the service is the DID with the given fragment.
+func (d *DIDDocument) AddService(fragment string, serviceType string, endpoint string) {
+	d.Service = append(d.Service, DIDService{
+		ID:              d.ID + "#" + fragment,
+		Type:            serviceType,
+		ServiceEndpoint: endpoint,
+	})
+}
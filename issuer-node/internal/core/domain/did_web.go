@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+const (
+	// DIDMethodWeb is the method of the did:web identities. They are not backed by merkle trees nor published on chain,
+	// the issuer node serves their DID document and they sign credentials with a JsonWebSignature2020 proof.
+	DIDMethodWeb core.DIDMethod = "web"
+
+	// WebIssuersPath is the path where the DID documents of the did:web identities are served
+	WebIssuersPath = "issuers"
+
+	webDIDPrefix = "did:web:"
+)
+
+// ErrInvalidWebDIDHost is returned when the server url cannot be used as the host of a did:web identity
+var ErrInvalidWebDIDHost = errors.New("the server url cannot be used as the host of a did:web identity")
+
+// ErrInvalidWebDID is returned when the did:web was not created by the issuer node
+var ErrInvalidWebDID = errors.New("invalid did:web identity")
+
+// IsWebDID returns true if the DID is a did:web identity
+func IsWebDID(did w3c.DID) bool {
+	return strings.HasPrefix(did.String(), webDIDPrefix)
+}
+
+// NewWebDID returns the did:web of the identity with the given name.
+// The DID document of did:web:example.com:issuers:name is resolved at https://example.com/issuers/name/did.json,
+// so the path of the server url, if any, is kept in the DID.
+func NewWebDID(serverURL string, name string) (*w3c.DID, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil || u.Host == "" {
+		return nil, ErrInvalidWebDIDHost
+	}
+
+	segments := []string{encodeWebDIDSegment(u.Host)}
+	for _, segment := range strings.Split(strings.Trim(u.Path, "/"), "/") {
+		if segment != "" {
+			segments = append(segments, encodeWebDIDSegment(segment))
+		}
+	}
+	segments = append(segments, WebIssuersPath, encodeWebDIDSegment(name))
+	return w3c.ParseDID(webDIDPrefix + strings.Join(segments, ":"))
+}
+
+// WebDIDBaseURL returns the url of the server that hosts the DID document of a did:web identity created by NewWebDID
+func WebDIDBaseURL(did w3c.DID) (string, error) {
+	segments := strings.Split(strings.TrimPrefix(did.String(), webDIDPrefix), ":")
+	if !IsWebDID(did) || len(segments) < 3 || segments[len(segments)-2] != WebIssuersPath {
+		return "", ErrInvalidWebDID
+	}
+	for i, segment := range segments[:len(segments)-2] {
+		decoded, err := url.PathUnescape(segment)
+		if err != nil {
+			return "", ErrInvalidWebDID
+		}
+		segments[i] = decoded
+	}
+	return "https://" + strings.Join(segments[:len(segments)-2], "/"), nil
+}
+
+// encodeWebDIDSegment percent encodes the segment, including the colons of the ports that would be taken as path separators
+func encodeWebDIDSegment(segment string) string {
+	return strings.ReplaceAll(url.PathEscape(segment), ":", "%3A")
+}
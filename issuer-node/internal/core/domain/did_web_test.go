@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebDID(t *testing.T) {
+	for _, tc := range []struct {
+		serverURL string
+		did       string
+		baseURL   string
+	}{
+		{serverURL: "https://issuer.example.com", did: "did:web:issuer.example.com:issuers:acme", baseURL: "https://issuer.example.com"},
+		{serverURL: "https://issuer.example.com/", did: "did:web:issuer.example.com:issuers:acme", baseURL: "https://issuer.example.com"},
+		{serverURL: "https://example.com/node/v1", did: "did:web:example.com:node:v1:issuers:acme", baseURL: "https://example.com/node/v1"},
+		{serverURL: "http://localhost:3001", did: "did:web:localhost%3A3001:issuers:acme", baseURL: "https://localhost:3001"},
+	} {
+		t.Run(tc.serverURL, func(t *testing.T) {
+			did, err := NewWebDID(tc.serverURL, "acme")
+			require.NoError(t, err)
+			assert.Equal(t, tc.did, did.String())
+			assert.True(t, IsWebDID(*did))
+			baseURL, err := WebDIDBaseURL(*did)
+			require.NoError(t, err)
+			assert.Equal(t, tc.baseURL, baseURL)
+		})
+	}
+
+	_, err := NewWebDID("not a url", "acme")
+	assert.ErrorIs(t, err, ErrInvalidWebDIDHost)
+
+	did, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu")
+	require.NoError(t, err)
+	assert.False(t, IsWebDID(*did))
+	_, err = WebDIDBaseURL(*did)
+	assert.ErrorIs(t, err, ErrInvalidWebDID)
+}
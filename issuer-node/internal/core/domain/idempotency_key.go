@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// IdempotencyKey holds the response of the first request sent with an Idempotency-Key header so that it can be
+// replayed when the client retries the same request. A key without status code is still being processed.
+// Keys are scoped by the authenticated principal that sent the request.
+type IdempotencyKey struct {
+	Principal    string
+	Key          string
+	RequestHash  string
+	StatusCode   *int
+	ContentType  *string
+	ResponseBody []byte
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// NewIdempotencyKey - Constructor
+func NewIdempotencyKey(principal string, key string, requestHash string, expiresAt time.Time) *IdempotencyKey {
+	return &IdempotencyKey{
+		Principal:   principal,
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   expiresAt,
+	}
+}
+
+// Completed returns true if the response of the request has been stored
+func (k *IdempotencyKey) Completed() bool {
+	return k.StatusCode != nil
+}
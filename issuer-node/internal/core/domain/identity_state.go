@@ -34,12 +34,22 @@ type IdentityState struct {
 	BlockTimestamp     *int           `json:"block_timestamp,omitempty"`
 	BlockNumber        *int           `json:"block_number,omitempty"`
 	TxID               *string        `json:"tx_id,omitempty"`
+	ReplacementTxIDs   []string       `json:"replacement_tx_ids,omitempty"`
 	PreviousState      *string        `json:"previous_state,omitempty"`
 	Status             IdentityStatus `json:"status,omitempty"`
+	FailureReason      *string        `json:"failure_reason,omitempty"`
 	ModifiedAt         time.Time      `json:"modified_at,omitempty"`
 	CreatedAt          time.Time      `json:"created_at,omitempty"`
 }
 
+// StateTransitionSimulation is the result of simulating the transition to a state of an identity without publishing it
+type StateTransitionSimulation struct {
+	Identifier   string
+	State        string
+	Succeeded    bool
+	RevertReason *string
+}
+
 // PublishedState defines the domain object of publish state on chain
 type PublishedState struct {
 	TxID               *string
@@ -64,6 +74,33 @@ func (i *IdentityState) TreeState() circuits.TreeState {
 	}
 }
 
+// TxIDs returns the hash of the transaction that published the state followed by the hashes of the
+// transactions that replaced it with higher fees, in the order they were sent
+func (i *IdentityState) TxIDs() []string {
+	return sentTxIDs(i.TxID, i.ReplacementTxIDs)
+}
+
+// sentTxIDs returns txID followed by the replacementTxIDs, in the order they were sent.
+// Once a replacement is mined it becomes the txID, so it is not returned twice.
+func sentTxIDs(txID *string, replacementTxIDs []string) []string {
+	txIDs := make([]string, 0, len(replacementTxIDs)+1)
+	if txID != nil {
+		txIDs = append(txIDs, *txID)
+	}
+	for _, replacementTxID := range replacementTxIDs {
+		if txID == nil || replacementTxID != *txID {
+			txIDs = append(txIDs, replacementTxID)
+		}
+	}
+	return txIDs
+}
+
+// IsTxReplacementDue returns true if the last transaction sent, at lastSentAt, has been pending for longer than
+// gasBumpInterval. Zero gasBumpInterval means that the transactions are not replaced.
+func IsTxReplacementDue(lastSentAt time.Time, gasBumpInterval time.Duration) bool {
+	return gasBumpInterval > 0 && time.Now().After(lastSentAt.Add(gasBumpInterval))
+}
+
 // ContainsID check if states contains id
 func ContainsID(states []IdentityState, id *w3c.DID) bool {
 	for i := range states {
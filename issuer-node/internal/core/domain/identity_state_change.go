@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdentityStateChangeType is the kind of change anchored by an identity state
+type IdentityStateChangeType string
+
+const (
+	// IdentityStateChangeCredential is a credential added to the claims tree
+	IdentityStateChangeCredential IdentityStateChangeType = "credential"
+	// IdentityStateChangeRevocation is a revocation nonce added to the revocation tree
+	IdentityStateChangeRevocation IdentityStateChangeType = "revocation"
+)
+
+// IdentityStateChange is a credential or a revocation that went into an identity state
+type IdentityStateChange struct {
+	ID              int64
+	Identifier      string
+	State           string
+	Type            IdentityStateChangeType
+	ClaimID         *uuid.UUID
+	RevocationNonce *RevNonceUint64
+	CreatedAt       time.Time
+}
+
+// NewCredentialStateChange returns the change of a credential added in the given state
+func NewCredentialStateChange(identifier string, state string, claimID uuid.UUID) IdentityStateChange {
+	return IdentityStateChange{
+		Identifier: identifier,
+		State:      state,
+		Type:       IdentityStateChangeCredential,
+		ClaimID:    &claimID,
+	}
+}
+
+// NewRevocationStateChange returns the change of a revocation nonce included in the given state
+func NewRevocationStateChange(identifier string, state string, nonce RevNonceUint64) IdentityStateChange {
+	return IdentityStateChange{
+		Identifier:      identifier,
+		State:           state,
+		Type:            IdentityStateChangeRevocation,
+		RevocationNonce: &nonce,
+	}
+}
+
+// IdentityStateDiff holds the changes anchored by the states published after From, up to and including To
+type IdentityStateDiff struct {
+	From    IdentityState
+	To      IdentityState
+	States  []IdentityState
+	Changes []IdentityStateChange
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestIdentityState_TxIDs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		state    IdentityState
+		expected []string
+	}{
+		{name: "not published", state: IdentityState{}, expected: []string{}},
+		{name: "published", state: IdentityState{TxID: common.ToPointer("0x01")}, expected: []string{"0x01"}},
+		{
+			name:     "replaced",
+			state:    IdentityState{TxID: common.ToPointer("0x01"), ReplacementTxIDs: []string{"0x02", "0x03"}},
+			expected: []string{"0x01", "0x02", "0x03"},
+		},
+		{
+			name:     "replacement mined",
+			state:    IdentityState{TxID: common.ToPointer("0x02"), ReplacementTxIDs: []string{"0x02", "0x03"}},
+			expected: []string{"0x02", "0x03"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.state.TxIDs())
+		})
+	}
+}
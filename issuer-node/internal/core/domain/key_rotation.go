@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+// KeyRotationStep is the next step of a key rotation
+type KeyRotationStep string
+
+const (
+	KeyRotationStepCreateKey            KeyRotationStep = "create_key"             // KeyRotationStepCreateKey the new BJJ key has not been created yet
+	KeyRotationStepCreateAuthCredential KeyRotationStep = "create_auth_credential" // KeyRotationStepCreateAuthCredential the auth credential of the new key has not been created yet
+	KeyRotationStepPublishState         KeyRotationStep = "publish_state"          // KeyRotationStepPublishState the state with the new auth credential has not been published yet
+	KeyRotationStepWaitConfirmation     KeyRotationStep = "wait_confirmation"      // KeyRotationStepWaitConfirmation the published state has not been confirmed on chain yet
+	KeyRotationStepRepublishState       KeyRotationStep = "republish_state"        // KeyRotationStepRepublishState the published state failed and has to be published again when the rotation is resumed
+	KeyRotationStepRevokeAuthCredential KeyRotationStep = "revoke_auth_credential" // KeyRotationStepRevokeAuthCredential the old auth credential has not been revoked yet
+	KeyRotationStepResignCredentials    KeyRotationStep = "resign_credentials"     // KeyRotationStepResignCredentials the credentials signed with the old key have not been signed again yet
+	KeyRotationStepCompleted            KeyRotationStep = "completed"              // KeyRotationStepCompleted all the steps have been done
+)
+
+// KeyRotationCoreDID - represents the issuer of a key rotation
+type KeyRotationCoreDID w3c.DID
+
+// KeyRotation replaces the auth credential of an issuer with a new one of a new BJJ key.
+// The rotation is done in steps that are stored so that it can be resumed after a failure.
+// The old auth credential is only revoked once the state that includes the new one is confirmed.
+type KeyRotation struct {
+	ID                  uuid.UUID
+	IssuerDID           KeyRotationCoreDID
+	KeyName             string
+	ResignCredentials   bool
+	OldAuthCredentialID uuid.UUID
+	NewKeyID            *string
+	NewAuthCredentialID *uuid.UUID
+	PublishedState      *string
+	Step                KeyRotationStep
+	Error               *string
+	ResignedCredentials int
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// NewKeyRotation - Constructor
+func NewKeyRotation(issuerDID w3c.DID, keyName string, oldAuthCredentialID uuid.UUID, resignCredentials bool) *KeyRotation {
+	return &KeyRotation{
+		ID:                  uuid.New(),
+		IssuerDID:           KeyRotationCoreDID(issuerDID),
+		KeyName:             keyName,
+		ResignCredentials:   resignCredentials,
+		OldAuthCredentialID: oldAuthCredentialID,
+		Step:                KeyRotationStepCreateKey,
+	}
+}
+
+// IssuerCoreDID - return the Core DID value
+func (r *KeyRotation) IssuerCoreDID() *w3c.DID {
+	return common.ToPointer(w3c.DID(r.IssuerDID))
+}
+
+// Completed returns true if all the steps of the rotation have been done
+func (r *KeyRotation) Completed() bool {
+	return r.Step == KeyRotationStepCompleted
+}
+
+// Next moves the rotation to the given step and clears the error of the previous one
+func (r *KeyRotation) Next(step KeyRotationStep) {
+	r.Step = step
+	r.Error = nil
+}
+
+// Failed records the error of the current step. The step is retried when the rotation is resumed.
+func (r *KeyRotation) Failed(err error) {
+	r.Error = common.ToPointer(err.Error())
+}
+
+// Scan - scan the value for KeyRotationCoreDID
+func (d *KeyRotationCoreDID) Scan(value interface{}) error {
+	didStr, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("invalid value type, expected string")
+	}
+	did, err := w3c.ParseDID(didStr)
+	if err != nil {
+		return err
+	}
+	*d = KeyRotationCoreDID(*did)
+	return nil
+}
@@ -78,6 +78,7 @@ type Link struct {
 	IssuedClaims                int // TODO: Give a value when link redemption is implemented
 	RefreshService              *verifiable.RefreshService
 	DisplayMethod               *verifiable.DisplayMethod
+	CredentialEncryption        CredentialEncryptionPolicy
 	AuthorizationRequestMessage *pgtype.JSONB `json:"authorization_request_message"`
 	DeepLink                    string
 	UniversalLink               string
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MerkleTreeIntegritySource is what the roots recomputed from the credentials and revocations are compared with
+type MerkleTreeIntegritySource string
+
+const (
+	// MerkleTreeIntegritySourceDatabase is the merkle tree stored in the database
+	MerkleTreeIntegritySourceDatabase MerkleTreeIntegritySource = "database"
+	// MerkleTreeIntegritySourceState is a state stored in identity_states
+	MerkleTreeIntegritySourceState MerkleTreeIntegritySource = "state"
+	// MerkleTreeIntegritySourceOnChain is the latest state published in the state contract
+	MerkleTreeIntegritySourceOnChain MerkleTreeIntegritySource = "onchain"
+)
+
+// MerkleTreeIntegrityTree is the root that diverges
+type MerkleTreeIntegrityTree string
+
+const (
+	// MerkleTreeIntegrityTreeClaims is the root of the claims tree
+	MerkleTreeIntegrityTreeClaims MerkleTreeIntegrityTree = "claims"
+	// MerkleTreeIntegrityTreeRevocations is the root of the revocations tree
+	MerkleTreeIntegrityTreeRevocations MerkleTreeIntegrityTree = "revocations"
+	// MerkleTreeIntegrityTreeRoots is the root of the roots tree
+	MerkleTreeIntegrityTreeRoots MerkleTreeIntegrityTree = "roots"
+	// MerkleTreeIntegrityTreeState is the identity state, the hash of the three roots
+	MerkleTreeIntegrityTreeState MerkleTreeIntegrityTree = "state"
+)
+
+// StaleMTPProofReason is why the merkle tree proof of a credential cannot be used
+type StaleMTPProofReason string
+
+const (
+	// StaleMTPProofMissing is a credential included in a confirmed state without a merkle tree proof
+	StaleMTPProofMissing StaleMTPProofReason = "missing"
+	// StaleMTPProofUnconfirmedState is a proof for a state that is unknown or was not confirmed
+	StaleMTPProofUnconfirmedState StaleMTPProofReason = "unconfirmedState"
+	// StaleMTPProofInvalid is a proof that does not verify against the claims tree root of its state
+	StaleMTPProofInvalid StaleMTPProofReason = "invalid"
+)
+
+// MerkleTreeDivergence is a root that does not match the one of the source.
+// Expected is the root in the source and Actual the one recomputed from the credentials and revocations,
+// except for on-chain divergences, where Actual is the last confirmed state stored by the issuer node.
+type MerkleTreeDivergence struct {
+	Source   MerkleTreeIntegritySource
+	Tree     MerkleTreeIntegrityTree
+	State    *string
+	Expected string
+	Actual   string
+}
+
+// StaleMTPProof is a credential whose merkle tree proof cannot be used
+type StaleMTPProof struct {
+	ClaimID uuid.UUID
+	State   *string
+	Reason  StaleMTPProofReason
+}
+
+// MerkleTreeIntegrityReport is the result of rebuilding the merkle trees of an identity from its credentials and revocations
+type MerkleTreeIntegrityReport struct {
+	Identifier      string
+	CheckedAt       time.Time
+	LatestState     *string
+	ConfirmedState  *string
+	RecomputedState string
+	OnChainState    *string
+	OnChainError    *string
+	Divergences     []MerkleTreeDivergence
+	StaleProofs     []StaleMTPProof
+	RepairedProofs  []uuid.UUID
+}
+
+// Healthy returns true if no divergences nor stale proofs were found
+func (r *MerkleTreeIntegrityReport) Healthy() bool {
+	return len(r.Divergences) == 0 && len(r.StaleProofs) == 0
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidPublishWindowTime means that a time of the publish window is not in the HH:MM format
+var ErrInvalidPublishWindowTime = errors.New("publish window times must be in the HH:MM format")
+
+const publishWindowTimeLayout = "15:04"
+
+// PublishDecisionReason explains why the pending changes of an identity are published or not
+type PublishDecisionReason string
+
+const (
+	// PublishDecisionNoChanges means that the identity has no credentials nor revocations to publish
+	PublishDecisionNoChanges PublishDecisionReason = "noPendingChanges"
+	// PublishDecisionRevocation means that a pending revocation is published immediately
+	PublishDecisionRevocation PublishDecisionReason = "revocation"
+	// PublishDecisionOutsideWindow means that the current time is not in the publish window
+	PublishDecisionOutsideWindow PublishDecisionReason = "outsideWindow"
+	// PublishDecisionTooSoon means that the previous state was published less than MinIntervalMinutes ago
+	PublishDecisionTooSoon PublishDecisionReason = "tooSoon"
+	// PublishDecisionThreshold means that MinPendingChanges credentials and revocations have accumulated
+	PublishDecisionThreshold PublishDecisionReason = "threshold"
+	// PublishDecisionMaxDelay means that the oldest pending change has waited MaxDelayMinutes
+	PublishDecisionMaxDelay PublishDecisionReason = "maxDelay"
+	// PublishDecisionBelowThreshold means that there are not enough pending changes yet
+	PublishDecisionBelowThreshold PublishDecisionReason = "belowThreshold"
+)
+
+// PublishPolicy defines when the pending publisher publishes the credentials and revocations of an identity.
+// The pending publisher does not publish the identities without policy, that publish their states on demand.
+// Window times are HH:MM in UTC. The window ends the next day when WindowEnd is before WindowStart.
+type PublishPolicy struct {
+	Identifier          string
+	MinPendingChanges   int
+	MinIntervalMinutes  *int
+	MaxDelayMinutes     *int
+	WindowStart         *string
+	WindowEnd           *string
+	PublishOnRevocation bool
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// PendingPublication holds the changes of an identity that are not included in any state yet
+type PendingPublication struct {
+	Identifier      string
+	Credentials     int
+	Revocations     int
+	OldestChangeAt  *time.Time
+	LastPublishedAt *time.Time
+	Policy          PublishPolicy
+}
+
+// Changes returns the number of pending credentials and revocations
+func (p *PendingPublication) Changes() int {
+	return p.Credentials + p.Revocations
+}
+
+// ShouldPublish evaluates the policy for the pending changes at the given time.
+// A pending revocation is published immediately when PublishOnRevocation is set, regardless of the window and the interval.
+// Otherwise the changes are published within the window, once MinIntervalMinutes have passed since the previous publication,
+// when MinPendingChanges have accumulated or the oldest one has waited MaxDelayMinutes.
+func (p *PublishPolicy) ShouldPublish(now time.Time, pending PendingPublication) (bool, PublishDecisionReason) {
+	if pending.Changes() == 0 {
+		return false, PublishDecisionNoChanges
+	}
+	if p.PublishOnRevocation && pending.Revocations > 0 {
+		return true, PublishDecisionRevocation
+	}
+	if !p.inWindow(now) {
+		return false, PublishDecisionOutsideWindow
+	}
+	if p.MinIntervalMinutes != nil && pending.LastPublishedAt != nil &&
+		now.Before(pending.LastPublishedAt.Add(time.Duration(*p.MinIntervalMinutes)*time.Minute)) {
+		return false, PublishDecisionTooSoon
+	}
+	if pending.Changes() >= p.MinPendingChanges {
+		return true, PublishDecisionThreshold
+	}
+	if p.MaxDelayMinutes != nil && pending.OldestChangeAt != nil &&
+		!now.Before(pending.OldestChangeAt.Add(time.Duration(*p.MaxDelayMinutes)*time.Minute)) {
+		return true, PublishDecisionMaxDelay
+	}
+	return false, PublishDecisionBelowThreshold
+}
+
+// inWindow returns true if the policy has no window or the time is within it
+func (p *PublishPolicy) inWindow(now time.Time) bool {
+	if p.WindowStart == nil || p.WindowEnd == nil {
+		return true
+	}
+	start, err := PublishWindowMinute(*p.WindowStart)
+	if err != nil {
+		return false
+	}
+	end, err := PublishWindowMinute(*p.WindowEnd)
+	if err != nil {
+		return false
+	}
+	now = now.UTC()
+	minute := now.Hour()*60 + now.Minute()
+	if start <= end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// PublishWindowMinute returns the minute of the day of a HH:MM publish window time
+func PublishWindowMinute(value string) (int, error) {
+	t, err := time.Parse(publishWindowTimeLayout, value)
+	if err != nil {
+		return 0, ErrInvalidPublishWindowTime
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestPublishPolicy_ShouldPublish(t *testing.T) {
+	now := time.Date(2026, 10, 16, 23, 30, 0, 0, time.UTC)
+	ago := func(d time.Duration) *time.Time { return common.ToPointer(now.Add(-d)) }
+
+	for _, tc := range []struct {
+		name     string
+		policy   *PublishPolicy
+		pending  PendingPublication
+		publish  bool
+		expected PublishDecisionReason
+	}{
+		{
+			name:     "no changes",
+			policy:   &PublishPolicy{MinPendingChanges: 1},
+			pending:  PendingPublication{},
+			expected: PublishDecisionNoChanges,
+		},
+		{
+			name:     "single change threshold",
+			policy:   &PublishPolicy{MinPendingChanges: 1},
+			pending:  PendingPublication{Credentials: 1, OldestChangeAt: ago(time.Minute)},
+			publish:  true,
+			expected: PublishDecisionThreshold,
+		},
+		{
+			name:     "below threshold",
+			policy:   &PublishPolicy{MinPendingChanges: 10},
+			pending:  PendingPublication{Credentials: 5, Revocations: 4, OldestChangeAt: ago(time.Hour)},
+			expected: PublishDecisionBelowThreshold,
+		},
+		{
+			name:     "threshold counts credentials and revocations",
+			policy:   &PublishPolicy{MinPendingChanges: 10},
+			pending:  PendingPublication{Credentials: 5, Revocations: 5, OldestChangeAt: ago(time.Hour)},
+			publish:  true,
+			expected: PublishDecisionThreshold,
+		},
+		{
+			name:     "max delay reached",
+			policy:   &PublishPolicy{MinPendingChanges: 10, MaxDelayMinutes: common.ToPointer(60)},
+			pending:  PendingPublication{Credentials: 1, OldestChangeAt: ago(time.Hour)},
+			publish:  true,
+			expected: PublishDecisionMaxDelay,
+		},
+		{
+			name:     "too soon",
+			policy:   &PublishPolicy{MinPendingChanges: 1, MinIntervalMinutes: common.ToPointer(30)},
+			pending:  PendingPublication{Credentials: 3, OldestChangeAt: ago(time.Hour), LastPublishedAt: ago(10 * time.Minute)},
+			expected: PublishDecisionTooSoon,
+		},
+		{
+			name:     "interval passed",
+			policy:   &PublishPolicy{MinPendingChanges: 1, MinIntervalMinutes: common.ToPointer(30)},
+			pending:  PendingPublication{Credentials: 3, OldestChangeAt: ago(time.Hour), LastPublishedAt: ago(30 * time.Minute)},
+			publish:  true,
+			expected: PublishDecisionThreshold,
+		},
+		{
+			name:     "inside a window ending the next day",
+			policy:   &PublishPolicy{MinPendingChanges: 1, WindowStart: common.ToPointer("22:00"), WindowEnd: common.ToPointer("06:00")},
+			pending:  PendingPublication{Credentials: 1, OldestChangeAt: ago(time.Minute)},
+			publish:  true,
+			expected: PublishDecisionThreshold,
+		},
+		{
+			name:     "outside the window",
+			policy:   &PublishPolicy{MinPendingChanges: 1, WindowStart: common.ToPointer("08:00"), WindowEnd: common.ToPointer("20:00")},
+			pending:  PendingPublication{Credentials: 1, OldestChangeAt: ago(48 * time.Hour)},
+			expected: PublishDecisionOutsideWindow,
+		},
+		{
+			name: "revocation published immediately",
+			policy: &PublishPolicy{MinPendingChanges: 100, MinIntervalMinutes: common.ToPointer(60), PublishOnRevocation: true,
+				WindowStart: common.ToPointer("08:00"), WindowEnd: common.ToPointer("20:00")},
+			pending:  PendingPublication{Revocations: 1, OldestChangeAt: ago(time.Minute), LastPublishedAt: ago(time.Minute)},
+			publish:  true,
+			expected: PublishDecisionRevocation,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			publish, reason := tc.policy.ShouldPublish(now, tc.pending)
+			assert.Equal(t, tc.publish, publish)
+			assert.Equal(t, tc.expected, reason)
+		})
+	}
+}
+
+func TestPublishWindowMinute(t *testing.T) {
+	minute, err := PublishWindowMinute("06:30")
+	assert.NoError(t, err)
+	assert.Equal(t, 390, minute)
+	_, err = PublishWindowMinute("6h30")
+	assert.ErrorIs(t, err, ErrInvalidPublishWindowTime)
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+)
+
+// PublishingNonceStatus is the state of a nonce allocated to an account of the publishing pool
+type PublishingNonceStatus string
+
+const (
+	// PublishingNonceAllocated is a nonce given to a transaction that has not been sent yet
+	PublishingNonceAllocated PublishingNonceStatus = "allocated"
+	// PublishingNonceSent is a nonce of a transaction accepted by the node
+	PublishingNonceSent PublishingNonceStatus = "sent"
+	// PublishingNonceReleased is a nonce of a transaction that could not be sent, that has to be used by the next one
+	PublishingNonceReleased PublishingNonceStatus = "released"
+)
+
+// PublishingNonce is a nonce of an account that sends state transitions
+type PublishingNonce struct {
+	ChainID    int64
+	Address    string
+	Nonce      uint64
+	Status     PublishingNonceStatus
+	TxID       *string
+	CreatedAt  time.Time
+	ModifiedAt time.Time
+}
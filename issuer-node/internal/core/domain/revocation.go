@@ -47,3 +47,10 @@ func RevocationStatusToTreeState(status verifiable.RevocationStatus) circuits.Tr
 		RootOfRoots:    common.StrMTHex(status.Issuer.RootOfRoots),
 	}
 }
+
+// RevocationStatus is the revocation status of a nonce together with the suspension of the credentials that use it.
+// Suspension is reversible and is not stored in the revocation tree, so the MTP of a suspended nonce is a non revocation proof.
+type RevocationStatus struct {
+	verifiable.RevocationStatus
+	Suspended bool `json:"suspended,omitempty"`
+}
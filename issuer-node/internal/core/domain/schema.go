@@ -63,5 +63,7 @@ type Schema struct {
 	Hash            core.SchemaHash
 	Words           SchemaWords
 	DisplayMethodID *uuid.UUID
-	CreatedAt       time.Time
+	// CredentialEncryption is the default encryption policy of the credentials issued with the schema
+	CredentialEncryption CredentialEncryptionPolicy
+	CreatedAt            time.Time
 }
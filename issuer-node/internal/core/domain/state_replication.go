@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+// StateReplicationStatus is the status of the replication of an identity state to another chain
+type StateReplicationStatus string
+
+const (
+	// StateReplicationPending means that the state has not been sent to the bridge contract yet
+	StateReplicationPending StateReplicationStatus = "pending"
+	// StateReplicationTransacted means that the state was sent to the bridge contract but the transaction is not confirmed
+	StateReplicationTransacted StateReplicationStatus = "transacted"
+	// StateReplicationConfirmed means that the transaction that replicated the state is confirmed
+	StateReplicationConfirmed StateReplicationStatus = "confirmed"
+	// StateReplicationFailed means that the bridge contract rejected the state
+	StateReplicationFailed StateReplicationStatus = "failed"
+	// StateReplicationSuperseded means that a newer state of the identity was confirmed before this one was replicated,
+	// so the newer one is replicated instead
+	StateReplicationSuperseded StateReplicationStatus = "superseded"
+)
+
+// StateReplication is the replication of a confirmed state of an identity to another chain, identified by its
+// resolver prefix, through the bridge contract of that chain
+type StateReplication struct {
+	ID         int64
+	Identifier string
+	State      string
+	Chain      string
+	Status     StateReplicationStatus
+	TxID       *string
+	// ReplacementTxIDs are the transactions sent with higher fees to replace the one of TxID while it was pending
+	ReplacementTxIDs []string
+	BlockNumber      *int
+	BlockTimestamp   *int
+	FailureReason    *string
+	CreatedAt        time.Time
+	ModifiedAt       time.Time
+}
+
+// TxIDs returns the transaction that replicated the state and the ones that replaced it, in the order they were sent
+func (r *StateReplication) TxIDs() []string {
+	return sentTxIDs(r.TxID, r.ReplacementTxIDs)
+}
+
+// StateReplicationReceipt is the result of the transaction that replicated a state
+// A pending status means that the node does not know the transaction, because it was dropped from the mempool.
+type StateReplicationReceipt struct {
+	Status         StateReplicationStatus
+	BlockNumber    *int
+	BlockTimestamp *int
+}
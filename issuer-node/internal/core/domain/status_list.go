@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// StatusListSize is the number of entries of a status list. It is the minimum size recommended by the
+	// W3C Bitstring Status List specification to provide group privacy to the holders.
+	StatusListSize = 131072
+
+	// StatusPurposeRevocation is the purpose of the status lists whose bits are set when credentials are revoked
+	StatusPurposeRevocation = "revocation"
+	// StatusPurposeSuspension is the purpose of the status lists whose bits are set while credentials are suspended
+	StatusPurposeSuspension = "suspension"
+)
+
+// ErrStatusListIndexOutOfRange is returned when the index does not belong to the status list
+var ErrStatusListIndexOutOfRange = errors.New("status list index out of range")
+
+// StatusList is a W3C Bitstring Status List of an identity.
+// Indexes are allocated sequentially and a list is never reused once it is full.
+type StatusList struct {
+	ID        uuid.UUID
+	IssuerID  string
+	Purpose   string
+	Size      int
+	NextIndex int
+	Bits      []byte
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// StatusListEntry is the index allocated in a status list for the credentials with the given revocation nonce
+type StatusListEntry struct {
+	StatusListID uuid.UUID
+	Index        int
+	IssuerID     string
+	RevNonce     RevNonceUint64
+}
+
+// NewStatusList - Constructor
+func NewStatusList(issuerID string, purpose string) *StatusList {
+	return &StatusList{
+		ID:       uuid.New(),
+		IssuerID: issuerID,
+		Purpose:  purpose,
+		Size:     StatusListSize,
+		Bits:     make([]byte, StatusListSize/8),
+	}
+}
+
+// Status returns the status of the given index. Index 0 is the leftmost bit of the list.
+func (s *StatusList) Status(index int) (bool, error) {
+	if index < 0 || index >= s.Size || index/8 >= len(s.Bits) {
+		return false, ErrStatusListIndexOutOfRange
+	}
+	return s.Bits[index/8]&(0x80>>(index%8)) != 0, nil
+}
+
+// SetStatus changes the status of the given index. Index 0 is the leftmost bit of the list.
+func (s *StatusList) SetStatus(index int, status bool) error {
+	if index < 0 || index >= s.Size || index/8 >= len(s.Bits) {
+		return ErrStatusListIndexOutOfRange
+	}
+	if status {
+		s.Bits[index/8] |= 0x80 >> (index % 8)
+	} else {
+		s.Bits[index/8] &^= 0x80 >> (index % 8)
+	}
+	return nil
+}
+
+// EncodedList returns the list as expected in the encodedList property of a BitstringStatusList:
+// the GZIP compressed bitstring encoded as a base64url multibase string.
+func (s *StatusList) EncodedList() (string, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(s.Bits); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return "u" + base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
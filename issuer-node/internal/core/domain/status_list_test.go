@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusList_SetStatus(t *testing.T) {
+	statusList := NewStatusList("did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu", StatusPurposeRevocation)
+	require.Len(t, statusList.Bits, StatusListSize/8)
+
+	require.NoError(t, statusList.SetStatus(0, true))
+	require.NoError(t, statusList.SetStatus(9, true))
+	require.NoError(t, statusList.SetStatus(StatusListSize-1, true))
+	assert.Equal(t, byte(0x80), statusList.Bits[0])
+	assert.Equal(t, byte(0x40), statusList.Bits[1])
+	assert.Equal(t, byte(0x01), statusList.Bits[len(statusList.Bits)-1])
+
+	status, err := statusList.Status(9)
+	require.NoError(t, err)
+	assert.True(t, status)
+	status, err = statusList.Status(8)
+	require.NoError(t, err)
+	assert.False(t, status)
+
+	require.NoError(t, statusList.SetStatus(9, false))
+	assert.Equal(t, byte(0x00), statusList.Bits[1])
+
+	assert.ErrorIs(t, statusList.SetStatus(-1, true), ErrStatusListIndexOutOfRange)
+	assert.ErrorIs(t, statusList.SetStatus(StatusListSize, true), ErrStatusListIndexOutOfRange)
+}
+
+func TestStatusList_EncodedList(t *testing.T) {
+	statusList := NewStatusList("did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu", StatusPurposeRevocation)
+	require.NoError(t, statusList.SetStatus(94567, true))
+
+	encoded, err := statusList.EncodedList()
+	require.NoError(t, err)
+	require.Equal(t, "u", encoded[:1])
+
+	compressed, err := base64.RawURLEncoding.DecodeString(encoded[1:])
+	require.NoError(t, err)
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	bits, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, statusList.Bits, bits)
+}
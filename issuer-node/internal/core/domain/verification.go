@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+// VerificationSessionStatus is the status of a verification session
+type VerificationSessionStatus string
+
+const (
+	// VerificationSessionStatusPending means that the holder has not sent the proofs yet
+	VerificationSessionStatusPending VerificationSessionStatus = "pending"
+	// VerificationSessionStatusVerified means that the proofs sent by the holder are valid
+	VerificationSessionStatusVerified VerificationSessionStatus = "verified"
+	// VerificationSessionStatusFailed means that the proofs sent by the holder are not valid
+	VerificationSessionStatusFailed VerificationSessionStatus = "failed"
+)
+
+// VerificationCoreDID - represents the verifier of a verification query or session
+type VerificationCoreDID w3c.DID
+
+// VerificationQuery is a set of zero knowledge proof requests that an identity asks the holders to prove
+type VerificationQuery struct {
+	ID        uuid.UUID
+	IssuerDID VerificationCoreDID
+	Name      string
+	Reason    string
+	Scope     []protocol.ZeroKnowledgeProofRequest
+	CreatedAt time.Time
+}
+
+// NewVerificationQuery - Constructor
+func NewVerificationQuery(issuerDID w3c.DID, name string, reason string, scope []protocol.ZeroKnowledgeProofRequest) *VerificationQuery {
+	return &VerificationQuery{
+		ID:        uuid.New(),
+		IssuerDID: VerificationCoreDID(issuerDID),
+		Name:      name,
+		Reason:    reason,
+		Scope:     scope,
+	}
+}
+
+// IssuerCoreDID - return the Core DID value
+func (q *VerificationQuery) IssuerCoreDID() *w3c.DID {
+	return common.ToPointer(w3c.DID(q.IssuerDID))
+}
+
+// VerificationSession is an authorization request of a verification query sent to a holder and its result
+type VerificationSession struct {
+	ID                  uuid.UUID
+	VerificationQueryID uuid.UUID
+	IssuerDID           VerificationCoreDID
+	Request             protocol.AuthorizationRequestMessage
+	Status              VerificationSessionStatus
+	UserDID             *string
+	Response            []protocol.ZeroKnowledgeProofResponse
+	Error               *string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// NewVerificationSession - Constructor
+func NewVerificationSession(id uuid.UUID, query *VerificationQuery, request protocol.AuthorizationRequestMessage) *VerificationSession {
+	return &VerificationSession{
+		ID:                  id,
+		VerificationQueryID: query.ID,
+		IssuerDID:           query.IssuerDID,
+		Request:             request,
+		Status:              VerificationSessionStatusPending,
+	}
+}
+
+// IssuerCoreDID - return the Core DID value
+func (s *VerificationSession) IssuerCoreDID() *w3c.DID {
+	return common.ToPointer(w3c.DID(s.IssuerDID))
+}
+
+// Verified records the proofs of the holder that passed the verification
+func (s *VerificationSession) Verified(userDID string, response []protocol.ZeroKnowledgeProofResponse) {
+	s.Status = VerificationSessionStatusVerified
+	s.UserDID = &userDID
+	s.Response = response
+	s.Error = nil
+}
+
+// Scan - scan the value for VerificationCoreDID
+func (d *VerificationCoreDID) Scan(value interface{}) error {
+	didStr, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("invalid value type, expected string")
+	}
+	did, err := w3c.ParseDID(didStr)
+	if err != nil {
+		return err
+	}
+	*d = VerificationCoreDID(*did)
+	return nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"math/big"
 
+	ethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 )
 
 // AccountService is a service for account operations
 type AccountService interface {
 	GetBalanceByDID(ctx context.Context, did *w3c.DID) (*big.Int, error)
+	GetBalanceByAddress(ctx context.Context, resolverPrefix string, address ethCommon.Address) (*big.Int, error)
 }
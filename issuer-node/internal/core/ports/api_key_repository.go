@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// APIKeyRepository is the interface implemented by the API keys repository
+type APIKeyRepository interface {
+	Save(ctx context.Context, key *domain.APIKey) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error)
+	GetAll(ctx context.Context) ([]*domain.APIKey, error)
+	UpdateLastUsed(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// APIKeyRequest holds the values of an API key to create.
+type APIKeyRequest struct {
+	Name        string
+	Identities  []w3c.DID
+	Permissions []domain.APIKeyPermission
+}
+
+// UpdateAPIKeyRequest holds the values of an API key to change. Nil values are not changed.
+type UpdateAPIKeyRequest struct {
+	Name        *string
+	Identities  []w3c.DID
+	Permissions []domain.APIKeyPermission
+}
+
+// APIKeyService is the interface implemented by the API keys service
+type APIKeyService interface {
+	// Create stores a new API key and returns it together with the key in clear, which is not stored.
+	Create(ctx context.Context, req APIKeyRequest) (*domain.APIKey, string, error)
+	Update(ctx context.Context, id uuid.UUID, req UpdateAPIKeyRequest) (*domain.APIKey, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error)
+	GetAll(ctx context.Context) ([]*domain.APIKey, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Authenticate returns the API key that matches the key sent by a client
+	Authenticate(ctx context.Context, key string) (*domain.APIKey, error)
+}
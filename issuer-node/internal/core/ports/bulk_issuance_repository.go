@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// BulkIssuanceRepository is the interface implemented by the bulk issuance jobs repository
+type BulkIssuanceRepository interface {
+	Save(ctx context.Context, conn db.Querier, job *domain.BulkIssuanceJob) error
+	SaveRows(ctx context.Context, conn db.Querier, rows []domain.BulkIssuanceRow) error
+	UpdateRow(ctx context.Context, conn db.Querier, row *domain.BulkIssuanceRow) error
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.BulkIssuanceJob, error)
+	GetRows(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, filter BulkIssuanceRowsFilter) ([]domain.BulkIssuanceRow, uint, error)
+	GetUnfinished(ctx context.Context) ([]*domain.BulkIssuanceJob, error)
+	LockPendingRows(ctx context.Context, tx db.Querier, id uuid.UUID, limit int) ([]domain.BulkIssuanceRow, error)
+}
@@ -0,0 +1,44 @@
+package ports
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CreateBulkIssuanceJobRequest is the request to create a bulk issuance job.
+// Data contains the file with the credential subjects, one per row, in the given Format.
+// CredentialSubjectDefaults are added to the rows that do not set them, e.g. the default attributes of a credential template.
+type CreateBulkIssuanceJobRequest struct {
+	DID                       w3c.DID
+	SchemaID                  uuid.UUID
+	Format                    domain.BulkIssuanceFormat
+	Data                      io.Reader
+	Expiration                *time.Time
+	ClaimRequestProofs        ClaimRequestProofs
+	CredentialStatusType      verifiable.CredentialStatusType
+	CredentialSubjectDefaults domain.CredentialSubject
+	RefreshService            *verifiable.RefreshService
+	DisplayMethod             *verifiable.DisplayMethod
+}
+
+// BulkIssuanceRowsFilter is the filter used to fetch the rows of a bulk issuance job
+type BulkIssuanceRowsFilter struct {
+	Status     *domain.BulkIssuanceRowStatus
+	MaxResults uint
+	Page       uint
+}
+
+// BulkIssuanceService is the interface implemented by the bulk issuance service
+type BulkIssuanceService interface {
+	Create(ctx context.Context, req *CreateBulkIssuanceJobRequest) (*domain.BulkIssuanceJob, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.BulkIssuanceJob, error)
+	GetRows(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, filter BulkIssuanceRowsFilter) ([]domain.BulkIssuanceRow, uint, error)
+	ProcessPendingJobs(ctx context.Context) error
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
@@ -11,6 +12,16 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
+// ExpiringClaimsFilter selects the non revoked credentials of an issuer that expire before ExpiresBefore
+type ExpiringClaimsFilter struct {
+	SchemaURL          *string  // only the credentials of this schema
+	ExcludedSchemaURLs []string // skip the credentials of these schemas
+	ExpiresBefore      time.Time
+	ExpiresAfter       *time.Time
+	NotNotified        bool // only the credentials issued to a holder whose renewal notice has not been sent
+	MaxResults         uint
+}
+
 // ClaimRepository is the interface that defines the available methods
 type ClaimRepository interface {
 	Save(ctx context.Context, conn db.Querier, claim *domain.Claim) (uuid.UUID, error)
@@ -28,9 +39,16 @@ type ClaimRepository interface {
 	UpdateState(ctx context.Context, conn db.Querier, claim *domain.Claim) (int64, error)
 	GetAuthClaimsForPublishing(ctx context.Context, conn db.Querier, identifier *w3c.DID, publishingState string, schemaHash string) ([]*domain.Claim, error)
 	UpdateClaimMTP(ctx context.Context, conn db.Querier, claim *domain.Claim) (int64, error)
+	GetAllInMerkleTree(ctx context.Context, conn db.Querier, issuer w3c.DID) ([]*domain.Claim, error)
 	Delete(ctx context.Context, conn db.Querier, id uuid.UUID) error
 	GetClaimsIssuedForUser(ctx context.Context, conn db.Querier, identifier w3c.DID, userDID w3c.DID, linkID uuid.UUID) ([]*domain.Claim, error)
 	GetClaimsOfAConnection(ctx context.Context, conn db.Querier, identifier w3c.DID, userDID w3c.DID) ([]*domain.Claim, error)
 	GetByStateIDWithMTPProof(ctx context.Context, conn db.Querier, did *w3c.DID, state string) (claims []*domain.Claim, err error)
 	GetAuthCoreClaims(ctx context.Context, conn db.Querier, identifier *w3c.DID, schemaHash string) ([]*domain.Claim, error)
+	SaveReissue(ctx context.Context, conn db.Querier, reissue *domain.CredentialReissue) error
+	UpdateSuspended(ctx context.Context, conn db.Querier, identifier *w3c.DID, nonce domain.RevNonceUint64, suspended bool) (int64, error)
+	IsSuspended(ctx context.Context, conn db.Querier, identifier *w3c.DID, nonce domain.RevNonceUint64) (bool, error)
+	GetExpiring(ctx context.Context, conn db.Querier, identifier w3c.DID, filter ExpiringClaimsFilter) ([]*domain.Claim, error)
+	UpdateExpirationNotified(ctx context.Context, conn db.Querier, identifier w3c.DID, id uuid.UUID) error
+	GetSignedWithAuthClaim(ctx context.Context, conn db.Querier, identifier w3c.DID, authCoreClaim string) ([]*domain.Claim, error)
 }
@@ -45,6 +45,18 @@ type CreateClaimRequest struct {
 	RevNonce              *uint64
 	DisplayMethod         *verifiable.DisplayMethod
 	EncryptionKey         EncryptionKey
+	// EncryptionPolicy tells if the credential has to be encrypted with a key of the DID document of the holder when no EncryptionKey is given
+	EncryptionPolicy domain.CredentialEncryptionPolicy
+}
+
+// ReissueCredentialRequest struct
+type ReissueCredentialRequest struct {
+	DID          w3c.DID
+	CredentialID uuid.UUID
+	// CredentialSubject contains the attributes to change. A nil value removes the attribute.
+	CredentialSubject map[string]any
+	// Expiration of the new credential. If nil, the expiration of the reissued credential is kept.
+	Expiration *time.Time
 }
 
 // AgentRequest struct
@@ -75,6 +87,7 @@ const (
 type ClaimsFilter struct {
 	Self            *bool
 	Revoked         *bool
+	Suspended       *bool
 	ExpiredOn       *time.Time
 	SchemaHash      string
 	SchemaType      string
@@ -140,10 +153,11 @@ type ClaimService interface {
 	Save(ctx context.Context, claimReq *CreateClaimRequest) (*domain.Claim, error)
 	GetRevoked(ctx context.Context, currentState string) ([]*domain.Claim, error)
 	CreateCredential(ctx context.Context, req *CreateClaimRequest) (*domain.Claim, error)
+	ReleaseCredentialStatus(ctx context.Context, credential *domain.Claim)
 	Revoke(ctx context.Context, id w3c.DID, nonce uint64, description string) error
 	GetAll(ctx context.Context, did w3c.DID, filter *ClaimsFilter) ([]*domain.Claim, uint, error)
 	RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, issuerID w3c.DID) error
-	GetRevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.RevocationStatus, error)
+	GetRevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*domain.RevocationStatus, error)
 	GetByID(ctx context.Context, issID *w3c.DID, id uuid.UUID) (*domain.Claim, error)
 	GetCredentialQrCode(ctx context.Context, issID *w3c.DID, id uuid.UUID, hostURL string) (*GetCredentialQrCodeResponse, error)
 	Agent(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*iden3comm.BasicMessage, error)
@@ -155,4 +169,8 @@ type ClaimService interface {
 	GetByStateIDWithMTPProof(ctx context.Context, did *w3c.DID, state string) ([]*domain.Claim, error)
 	GetAuthCredentials(ctx context.Context, identifier *w3c.DID) ([]*domain.Claim, error)
 	GetAuthCredentialByPublicKey(ctx context.Context, identifier *w3c.DID, pubKey []byte) (*domain.Claim, error)
+	Reissue(ctx context.Context, req *ReissueCredentialRequest) (*domain.Claim, error)
+	Resign(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID, authCredentialID uuid.UUID) (*domain.Claim, error)
+	Suspend(ctx context.Context, id w3c.DID, nonce uint64) error
+	Resume(ctx context.Context, id w3c.DID, nonce uint64) error
 }
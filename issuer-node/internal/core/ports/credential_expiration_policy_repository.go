@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CredentialExpirationPolicyRepository is the interface implemented by the credential expiration policies repository
+type CredentialExpirationPolicyRepository interface {
+	Save(ctx context.Context, policy *domain.CredentialExpirationPolicy) error
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.CredentialExpirationPolicy, error)
+	GetByIssuer(ctx context.Context, issuerDID w3c.DID) ([]domain.CredentialExpirationPolicy, error)
+	GetAll(ctx context.Context) ([]domain.CredentialExpirationPolicy, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+}
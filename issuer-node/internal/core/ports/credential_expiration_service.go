@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CredentialExpirationPolicyRequest holds the values of a credential expiration policy.
+// SchemaID is nil for the policy of the whole identity.
+type CredentialExpirationPolicyRequest struct {
+	SchemaID         *uuid.UUID
+	NotifyDaysBefore *int
+	AutoRevoke       bool
+}
+
+// CredentialExpirationService is the interface implemented by the service that manages the credential expiration policies
+// and applies them to the credentials that are about to expire or have expired
+type CredentialExpirationService interface {
+	SavePolicy(ctx context.Context, issuerDID w3c.DID, req CredentialExpirationPolicyRequest) (*domain.CredentialExpirationPolicy, error)
+	UpdatePolicy(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, req CredentialExpirationPolicyRequest) (*domain.CredentialExpirationPolicy, error)
+	GetPolicies(ctx context.Context, issuerDID w3c.DID) ([]domain.CredentialExpirationPolicy, error)
+	DeletePolicy(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+	Sweep(ctx context.Context) error
+}
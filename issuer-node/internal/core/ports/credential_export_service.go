@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CredentialExportFormat is the JWT encoding a credential can be exported to
+type CredentialExportFormat string
+
+const (
+	// CredentialExportFormatJWTVC exports the credential as a JWT-VC
+	CredentialExportFormatJWTVC CredentialExportFormat = "jwt-vc"
+	// CredentialExportFormatSDJWTVC exports the credential as an SD-JWT VC
+	CredentialExportFormatSDJWTVC CredentialExportFormat = "sd-jwt-vc"
+)
+
+// CredentialExportOptions are the options used to export a credential
+type CredentialExportOptions struct {
+	Format CredentialExportFormat
+	// KeyID is the kms id of the ETH or Ed25519 key used to sign. When nil a key of the issuer is picked, preferring Ed25519.
+	KeyID *string
+	// DisclosableFields are the top level credentialSubject attributes that can be selectively disclosed in an SD-JWT VC.
+	// When nil all of them are selectively disclosable.
+	DisclosableFields []string
+}
+
+// CredentialExportService is the service that encodes the credentials of an issuer as JWTs
+type CredentialExportService interface {
+	Validate(ctx context.Context, issuerDID w3c.DID, opts CredentialExportOptions) error
+	Export(ctx context.Context, issuerDID w3c.DID, credential *domain.Claim, opts CredentialExportOptions) (string, error)
+}
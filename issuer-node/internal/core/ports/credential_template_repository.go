@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CredentialTemplateRepository is the interface implemented by the credential template repository
+type CredentialTemplateRepository interface {
+	Save(ctx context.Context, template *domain.CredentialTemplate) error
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.CredentialTemplate, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, filter CredentialTemplateFilter) ([]domain.CredentialTemplate, uint, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+}
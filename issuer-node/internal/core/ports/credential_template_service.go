@@ -0,0 +1,52 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CredentialTemplateFilter is the filter for credential templates
+type CredentialTemplateFilter struct {
+	MaxResults uint
+	Page       uint
+}
+
+// CredentialTemplateRequest holds the values of a credential template to create or replace.
+// CredentialExpiration is relative to the issuance time, like +365d.
+type CredentialTemplateRequest struct {
+	Name                     string
+	SchemaID                 uuid.UUID
+	CredentialSubject        domain.CredentialSubject
+	CredentialExpiration     *string
+	CredentialSignatureProof bool
+	CredentialMTPProof       bool
+	DisplayMethodID          *uuid.UUID
+	RefreshService           *verifiable.RefreshService
+}
+
+// CredentialTemplateIssuance holds the values of a template resolved to issue a credential for a subject
+type CredentialTemplateIssuance struct {
+	Template             *domain.CredentialTemplate
+	Schema               *domain.Schema
+	CredentialSubject    domain.CredentialSubject
+	CredentialExpiration *time.Time
+	ClaimRequestProofs   ClaimRequestProofs
+	DisplayMethod        *verifiable.DisplayMethod
+	RefreshService       *verifiable.RefreshService
+}
+
+// CredentialTemplateService is the interface implemented by the credential template service
+type CredentialTemplateService interface {
+	Save(ctx context.Context, issuerDID w3c.DID, req CredentialTemplateRequest) (*domain.CredentialTemplate, error)
+	Update(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, req CredentialTemplateRequest) (*domain.CredentialTemplate, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.CredentialTemplate, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, filter CredentialTemplateFilter) ([]domain.CredentialTemplate, uint, error)
+	Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+	Issuance(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, attributes domain.CredentialSubject, issuedAt time.Time) (*CredentialTemplateIssuance, error)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/pkg/credentials/verifier"
+)
+
+// CredentialVerificationService is the interface implemented by the service that verifies credentials issued by the identities of the node
+type CredentialVerificationService interface {
+	Verify(ctx context.Context, credential verifiable.W3CCredential) *verifier.Report
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// DIDDocumentService is the interface implemented by the service that builds the DID documents of the issuer identities
+type DIDDocumentService interface {
+	Get(ctx context.Context, did w3c.DID) (*domain.DIDDocument, error)
+}
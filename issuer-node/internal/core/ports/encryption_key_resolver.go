@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// EncryptionKeyResolver finds the key to encrypt the credentials issued to a holder
+type EncryptionKeyResolver interface {
+	Resolve(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) (EncryptionKey, error)
+}
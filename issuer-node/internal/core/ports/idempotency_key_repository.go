@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// IdempotencyKeyRepository is the interface implemented by the idempotency keys repository
+type IdempotencyKeyRepository interface {
+	Reserve(ctx context.Context, key *domain.IdempotencyKey, staleBefore time.Time) (bool, error)
+	GetByKey(ctx context.Context, principal string, key string) (*domain.IdempotencyKey, error)
+	SaveResponse(ctx context.Context, key *domain.IdempotencyKey) error
+	Delete(ctx context.Context, principal string, key string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
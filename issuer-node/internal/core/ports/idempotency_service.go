@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// IdempotencyService is the interface implemented by the service that stores the responses of the requests sent with an idempotency key
+type IdempotencyService interface {
+	Start(ctx context.Context, principal string, key string, requestHash string) (*domain.IdempotencyKey, error)
+	Complete(ctx context.Context, principal string, key string, statusCode int, contentType string, body []byte) error
+	Release(ctx context.Context, principal string, key string) error
+	DeleteExpired(ctx context.Context) error
+}
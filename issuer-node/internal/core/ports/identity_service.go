@@ -11,6 +11,8 @@ import (
 	"github.com/iden3/iden3comm/v2/protocol"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
+	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 )
 
@@ -43,6 +45,7 @@ type IdentityService interface {
 	SignClaimEntry(ctx context.Context, authClaim *domain.Claim, claimEntry *core.Claim) (*verifiable.BJJSignatureProof2021, error)
 	Get(ctx context.Context) (identities []domain.IdentityDisplayName, err error)
 	UpdateState(ctx context.Context, did w3c.DID) (*domain.IdentityState, error)
+	PendingState(ctx context.Context, conn db.Querier, did w3c.DID) (*domain.IdentityState, error)
 	Exists(ctx context.Context, identifier w3c.DID) (bool, error)
 	GetLatestStateByID(ctx context.Context, identifier w3c.DID) (*domain.IdentityState, error)
 	GetKeyIDFromAuthClaim(ctx context.Context, authClaim *domain.Claim) (kms.KeyID, error)
@@ -51,8 +54,11 @@ type IdentityService interface {
 	HasUnprocessedAndFailedStatesByID(ctx context.Context, identifier w3c.DID) (bool, error)
 	GetNonTransactedStates(ctx context.Context) ([]domain.IdentityState, error)
 	UpdateIdentityState(ctx context.Context, state *domain.IdentityState) error
+	AddStateReplacementTxID(ctx context.Context, state *domain.IdentityState, txID string) error
 	GetTransactedStates(ctx context.Context) ([]domain.IdentityState, error)
 	GetStates(ctx context.Context, issuerDID w3c.DID, filter *GetStateTransactionsRequest) ([]domain.IdentityState, uint, error)
+	GetStateChanges(ctx context.Context, issuerDID w3c.DID, state string, filter pagination.Filter) (*domain.IdentityState, []domain.IdentityStateChange, uint, error)
+	GetStateDiff(ctx context.Context, issuerDID w3c.DID, fromState string, toState string) (*domain.IdentityStateDiff, error)
 	CreateAuthenticationQRCode(ctx context.Context, serverURL string, issuerDID w3c.DID) (*CreateAuthenticationQRCodeResponse, error)
 	Authenticate(ctx context.Context, message string, sessionID uuid.UUID, serverURL string) (*protocol.AuthorizationResponseMessage, error)
 	AuthenticateWithRequest(ctx context.Context, sessionID *uuid.UUID, authReq protocol.AuthorizationRequestMessage, message string, serverURL string) (*protocol.AuthorizationResponseMessage, error)
@@ -60,4 +66,6 @@ type IdentityService interface {
 	PublishGenesisStateToRHS(ctx context.Context, did *w3c.DID) error
 	UpdateIdentityDisplayName(ctx context.Context, did w3c.DID, displayName string) error
 	CreateAuthCredential(ctx context.Context, did *w3c.DID, keyID string, revNonce *uint64, expiration *time.Time, version *uint32, credentialStatusType verifiable.CredentialStatusType) (uuid.UUID, error)
+	GetJWSVerificationMethod(ctx context.Context, did w3c.DID) (string, error)
+	SignDetachedJWS(ctx context.Context, did w3c.DID, verificationMethod string, payload []byte) (string, error)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
 	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
@@ -23,5 +24,11 @@ type IdentityStateRepository interface {
 	GetStates(ctx context.Context, conn db.Querier, issuerDID w3c.DID, filter *GetStateTransactionsRequest) ([]domain.IdentityState, uint, error)
 	GetStatesByStatusAndIssuerID(ctx context.Context, conn db.Querier, status domain.IdentityStatus, issuerID w3c.DID) ([]domain.IdentityState, error)
 	UpdateState(ctx context.Context, conn db.Querier, state *domain.IdentityState) (int64, error)
+	AddReplacementTxID(ctx context.Context, conn db.Querier, state string, txID string) (int64, error)
 	GetGenesisState(ctx context.Context, conn db.Querier, identifier string) (*domain.IdentityState, error)
+	GetAllByIdentifier(ctx context.Context, conn db.Querier, identifier w3c.DID) ([]domain.IdentityState, error)
+	GetByState(ctx context.Context, conn db.Querier, identifier w3c.DID, state string) (*domain.IdentityState, error)
+	SaveChanges(ctx context.Context, conn db.Querier, changes []domain.IdentityStateChange) error
+	GetChanges(ctx context.Context, conn db.Querier, identifier w3c.DID, state string, filter pagination.Filter) ([]domain.IdentityStateChange, uint, error)
+	GetChangesBetween(ctx context.Context, conn db.Querier, identifier w3c.DID, fromStateID int64, toStateID int64) ([]domain.IdentityState, []domain.IdentityStateChange, error)
 }
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// KeyRotationRepository is the interface implemented by the key rotations repository
+type KeyRotationRepository interface {
+	Save(ctx context.Context, conn db.Querier, rotation *domain.KeyRotation) error
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyRotation, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]*domain.KeyRotation, error)
+	GetUnfinished(ctx context.Context) ([]*domain.KeyRotation, error)
+	Lock(ctx context.Context, id uuid.UUID, duration time.Duration) (*domain.KeyRotation, error)
+}
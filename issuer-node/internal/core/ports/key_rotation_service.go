@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// StartKeyRotationRequest is the request to rotate the auth key of an issuer.
+// KeyName is the name of the new BJJ key. If AuthCredentialID is nil the current auth credential of the issuer is replaced.
+// With ResignCredentials the credentials signed with the replaced auth credential get a new BJJSignatureProof2021.
+type StartKeyRotationRequest struct {
+	DID               w3c.DID
+	KeyName           string
+	AuthCredentialID  *uuid.UUID
+	ResignCredentials bool
+}
+
+// KeyRotationService is the interface implemented by the key rotation service
+type KeyRotationService interface {
+	Start(ctx context.Context, req *StartKeyRotationRequest) (*domain.KeyRotation, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyRotation, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]*domain.KeyRotation, error)
+	Resume(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyRotation, error)
+	GetCredentialsToResign(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) ([]*domain.Claim, error)
+	ProcessPending(ctx context.Context) error
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// MerkleTreeIntegrityService is the interface implemented by the merkle tree integrity service
+type MerkleTreeIntegrityService interface {
+	Check(ctx context.Context, did w3c.DID, repair bool) (*domain.MerkleTreeIntegrityReport, error)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NonceAllocator gives the nonces of the transactions sent by the publishing accounts, shared by all the processes using the database
+type NonceAllocator interface {
+	Allocate(ctx context.Context, chainID int64, address common.Address, confirmedNonce uint64, pendingNonce uint64) (uint64, error)
+	MarkSent(ctx context.Context, chainID int64, address common.Address, nonce uint64, txID string) error
+	Release(ctx context.Context, chainID int64, address common.Address, nonce uint64) error
+}
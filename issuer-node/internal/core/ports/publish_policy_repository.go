@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// PublishPolicyRepository is the interface implemented by the publish policies repository
+type PublishPolicyRepository interface {
+	Save(ctx context.Context, policy *domain.PublishPolicy) error
+	Get(ctx context.Context, identifier w3c.DID) (*domain.PublishPolicy, error)
+	Delete(ctx context.Context, identifier w3c.DID) error
+	GetPendingPublications(ctx context.Context) ([]domain.PendingPublication, error)
+}
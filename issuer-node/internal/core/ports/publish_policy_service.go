@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// PublishPolicyRequest holds the values of a publish policy
+type PublishPolicyRequest struct {
+	MinPendingChanges   int
+	MinIntervalMinutes  *int
+	MaxDelayMinutes     *int
+	WindowStart         *string
+	WindowEnd           *string
+	PublishOnRevocation bool
+}
+
+// PublishPolicyService is the interface implemented by the service that manages the publish policies of the identities
+// and publishes the states of the identities whose policies are met
+type PublishPolicyService interface {
+	SavePolicy(ctx context.Context, identifier w3c.DID, req PublishPolicyRequest) (*domain.PublishPolicy, error)
+	GetPolicy(ctx context.Context, identifier w3c.DID) (*domain.PublishPolicy, error)
+	DeletePolicy(ctx context.Context, identifier w3c.DID) error
+	PublishDue(ctx context.Context) error
+}
@@ -13,4 +13,5 @@ type Publisher interface {
 	PublishState(ctx context.Context, identity *w3c.DID) (*domain.PublishedState, error)
 	RetryPublishState(ctx context.Context, identifier *w3c.DID) (*domain.PublishedState, error)
 	CheckTransactionStatus(ctx context.Context, identity *domain.Identity)
+	DryRunPublishState(ctx context.Context, identifier *w3c.DID) (*domain.StateTransitionSimulation, error)
 }
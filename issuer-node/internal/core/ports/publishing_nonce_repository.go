@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// PublishingNonceRepository is the interface implemented by the publishing accounts nonces repository
+type PublishingNonceRepository interface {
+	LockAccount(ctx context.Context, tx db.Querier, chainID int64, address string) (uint64, error)
+	SetNextNonce(ctx context.Context, tx db.Querier, chainID int64, address string, nextNonce uint64) error
+	DeleteMined(ctx context.Context, tx db.Querier, chainID int64, address string, confirmedNonce uint64) error
+	GetReusable(ctx context.Context, tx db.Querier, chainID int64, address string, pendingNonce uint64, staleBefore time.Time) (*domain.PublishingNonce, error)
+	Save(ctx context.Context, conn db.Querier, nonce domain.PublishingNonce) error
+}
@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// RefreshDataSourceRequest describes the credential whose attributes are being refreshed
+type RefreshDataSourceRequest struct {
+	IssuerDID         w3c.DID
+	UserDID           w3c.DID
+	CredentialID      uuid.UUID
+	CredentialType    string
+	CredentialSchema  string
+	CredentialSubject map[string]any
+}
+
+// RefreshDataSource provides the up-to-date attributes of a credential that is being refreshed
+type RefreshDataSource interface {
+	Fetch(ctx context.Context, req RefreshDataSourceRequest) (map[string]any, error)
+}
+
+// RefreshService is the interface implemented by the credential refresh service (Iden3RefreshService2023)
+type RefreshService interface {
+	Agent(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*iden3comm.BasicMessage, error)
+	Refresh(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, credentialID uuid.UUID) (*domain.Claim, error)
+}
@@ -12,4 +12,5 @@ import (
 // RevocationRepository interface that defines the available methods
 type RevocationRepository interface {
 	UpdateStatus(ctx context.Context, conn db.Querier, did *w3c.DID) ([]*domain.Revocation, error)
+	GetAll(ctx context.Context, conn db.Querier, did *w3c.DID) ([]*domain.Revocation, error)
 }
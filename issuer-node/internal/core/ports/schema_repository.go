@@ -14,5 +14,6 @@ type SchemaRepository interface {
 	Save(ctx context.Context, schema *domain.Schema) error
 	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error)
 	GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error)
+	GetByURL(ctx context.Context, issuerDID w3c.DID, url string) (*domain.Schema, error)
 	Update(ctx context.Context, schema *domain.Schema) error
 }
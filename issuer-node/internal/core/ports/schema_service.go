@@ -14,6 +14,7 @@ type SchemaService interface {
 	ImportSchema(ctx context.Context, issuerDID w3c.DID, req *ImportSchemaRequest) (*domain.Schema, error)
 	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error)
 	GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error)
+	GetByURL(ctx context.Context, issuerDID w3c.DID, url string) (*domain.Schema, error)
 	Update(ctx context.Context, schema *domain.Schema) error
 }
 
@@ -25,6 +26,8 @@ type ImportSchemaRequest struct {
 	Description     *string
 	Version         string
 	DisplayMethodID *uuid.UUID
+	// CredentialEncryption is the default encryption policy of the credentials issued with the schema
+	CredentialEncryption domain.CredentialEncryptionPolicy
 }
 
 // NewImportSchemaRequest creates a new ImportSchemaRequest
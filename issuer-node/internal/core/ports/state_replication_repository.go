@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// StateReplicationRepository is the interface implemented by the identity state replications repository
+type StateReplicationRepository interface {
+	Schedule(ctx context.Context, conn db.Querier, blockchain string, network string, chain string) (int64, error)
+	GetByStatus(ctx context.Context, conn db.Querier, status domain.StateReplicationStatus) ([]domain.StateReplication, error)
+	Update(ctx context.Context, conn db.Querier, replication *domain.StateReplication) error
+	GetLatestByIdentifier(ctx context.Context, conn db.Querier, identifier w3c.DID) ([]domain.StateReplication, error)
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// StateBridgeGateway is the interface implemented by the gateway that sends the identity states to the bridge
+// contracts of other chains, identified by their resolver prefix
+type StateBridgeGateway interface {
+	ReplicateState(ctx context.Context, chain string, state domain.IdentityState) (*string, error)
+	ReplicationReceipt(ctx context.Context, chain string, txID string) (*domain.StateReplicationReceipt, error)
+	ReplaceReplication(ctx context.Context, chain string, txID string) (*string, error)
+}
+
+// StateReplicationService is the interface implemented by the service that replicates the confirmed identity states
+// to the chains configured in the network resolver settings
+type StateReplicationService interface {
+	Replicate(ctx context.Context) error
+	GetLatestReplications(ctx context.Context, identifier w3c.DID) ([]domain.StateReplication, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// StatusListRepository is the interface implemented by the bitstring status lists repository
+type StatusListRepository interface {
+	Save(ctx context.Context, conn db.Querier, statusList *domain.StatusList) error
+	GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.StatusList, error)
+	GetAvailableForUpdate(ctx context.Context, tx db.Querier, issuerDID w3c.DID, purpose string) (*domain.StatusList, error)
+	SaveEntry(ctx context.Context, conn db.Querier, entry *domain.StatusListEntry) error
+	ReuseEntry(ctx context.Context, tx db.Querier, issuerDID w3c.DID, purpose string, nonce domain.RevNonceUint64) (*domain.StatusListEntry, error)
+	ReleaseEntries(ctx context.Context, conn db.Querier, issuerDID w3c.DID, nonce domain.RevNonceUint64) (int64, error)
+	SetStatus(ctx context.Context, conn db.Querier, issuerDID w3c.DID, nonce domain.RevNonceUint64, purpose string, status bool) (int64, error)
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+)
+
+// StatusListService is the service that manages the W3C Bitstring Status Lists of the identities
+type StatusListService interface {
+	CreateEntry(ctx context.Context, issuerDID w3c.DID, nonce uint64) ([]*revocationstatus.BitstringStatusListEntryStatus, error)
+	ReleaseEntries(ctx context.Context, issuerDID w3c.DID, nonce uint64) error
+	SetStatus(ctx context.Context, conn db.Querier, issuerDID w3c.DID, nonce uint64, purpose string, status bool) error
+	GetCredential(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (string, error)
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// VerificationRepository is the interface implemented by the verification queries and sessions repository
+type VerificationRepository interface {
+	SaveQuery(ctx context.Context, query *domain.VerificationQuery) error
+	GetQueryByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.VerificationQuery, error)
+	GetQueries(ctx context.Context, issuerDID w3c.DID) ([]domain.VerificationQuery, error)
+	DeleteQuery(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+	SaveSession(ctx context.Context, session *domain.VerificationSession) error
+	GetSessionByID(ctx context.Context, id uuid.UUID) (*domain.VerificationSession, error)
+	UpdatePendingSession(ctx context.Context, session *domain.VerificationSession) (int64, error)
+}
@@ -0,0 +1,47 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// VerificationRequestCallbackURL is the URL the holders send the proofs of a verification session to
+const VerificationRequestCallbackURL = "%s/v2/verification/callback?sessionID=%s"
+
+// AuthorizationResponseVerifier verifies the zero knowledge proofs of an authorization response against
+// the circuits verification keys and the states published on chain
+type AuthorizationResponseVerifier interface {
+	FullVerify(ctx context.Context, token string, request protocol.AuthorizationRequestMessage, opts ...pubsignals.VerifyOpt) (*protocol.AuthorizationResponseMessage, error)
+}
+
+// VerificationQueryRequest holds the values of a verification query to create
+type VerificationQueryRequest struct {
+	Name   string
+	Reason string
+	Scope  []protocol.ZeroKnowledgeProofRequest
+}
+
+// CreateVerificationSessionResponse holds a new verification session and the links to share it with a holder
+type CreateVerificationSessionResponse struct {
+	Session       *domain.VerificationSession
+	QrID          uuid.UUID
+	DeepLink      string
+	UniversalLink string
+}
+
+// VerificationService is the interface implemented by the verification service
+type VerificationService interface {
+	CreateQuery(ctx context.Context, issuerDID w3c.DID, req VerificationQueryRequest) (*domain.VerificationQuery, error)
+	GetQuery(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.VerificationQuery, error)
+	GetQueries(ctx context.Context, issuerDID w3c.DID) ([]domain.VerificationQuery, error)
+	DeleteQuery(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+	CreateSession(ctx context.Context, issuerDID w3c.DID, queryID uuid.UUID, serverURL string) (*CreateVerificationSessionResponse, error)
+	GetSession(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.VerificationSession, error)
+	Callback(ctx context.Context, sessionID uuid.UUID, token string) (*domain.VerificationSession, error)
+}
@@ -52,3 +52,13 @@ func (as *AccountService) GetBalanceByDID(ctx context.Context, did *w3c.DID) (*b
 	commonAddress := ethCommon.BytesToAddress(ethAddress[:])
 	return ethClient.BalanceAt(ctx, commonAddress)
 }
+
+// GetBalanceByAddress returns the balance of the address in the network of the resolver prefix
+func (as *AccountService) GetBalanceByAddress(ctx context.Context, resolverPrefix string, address ethCommon.Address) (*big.Int, error) {
+	ethClient, err := as.networkResolver.GetEthClient(resolverPrefix)
+	if err != nil {
+		log.Error(ctx, "cannot get eth client", "err", err)
+		return nil, err
+	}
+	return ethClient.BalanceAt(ctx, address)
+}
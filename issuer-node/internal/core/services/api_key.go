@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	apiKeyPrefix       = "isk_"
+	apiKeySecretLength = 32
+)
+
+var (
+	// ErrAPIKeyEmptyName means that the API key has no name
+	ErrAPIKeyEmptyName = errors.New("the api key name cannot be empty")
+	// ErrAPIKeyNoIdentities means that the API key is not scoped to any identity
+	ErrAPIKeyNoIdentities = errors.New("the api key must be scoped to at least one identity")
+	// ErrAPIKeyNoPermissions means that the API key has no permission
+	ErrAPIKeyNoPermissions = errors.New("the api key must have at least one permission")
+	// ErrAPIKeyInvalidPermission means that a permission of the API key is not a valid one
+	ErrAPIKeyInvalidPermission = errors.New("invalid api key permission")
+	// ErrAPIKeyUnknownIdentity means that the API key is scoped to an identity that does not exist
+	ErrAPIKeyUnknownIdentity = errors.New("the api key is scoped to an identity that does not exist")
+	// ErrAPIKeyInvalid means that the key sent by the client does not belong to any API key
+	ErrAPIKeyInvalid = errors.New("invalid api key")
+)
+
+type apiKeyService struct {
+	storage            *db.Storage
+	repo               ports.APIKeyRepository
+	identityRepository ports.IdentityRepository
+}
+
+// NewAPIKey returns a new API keys service
+func NewAPIKey(storage *db.Storage, repo ports.APIKeyRepository, identityRepository ports.IdentityRepository) ports.APIKeyService {
+	return &apiKeyService{
+		storage:            storage,
+		repo:               repo,
+		identityRepository: identityRepository,
+	}
+}
+
+// Create generates a random key and stores its hash.
+// The key is only returned here, it cannot be recovered later.
+func (s *apiKeyService) Create(ctx context.Context, req ports.APIKeyRequest) (*domain.APIKey, string, error) {
+	if err := s.validate(ctx, req.Name, req.Identities, req.Permissions); err != nil {
+		return nil, "", err
+	}
+
+	secret := make([]byte, apiKeySecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	apiKey := domain.NewAPIKey(strings.TrimSpace(req.Name), hashAPIKey(key), didsToStrings(req.Identities), req.Permissions)
+	if err := s.repo.Save(ctx, apiKey); err != nil {
+		log.Error(ctx, "saving api key", "err", err)
+		return nil, "", err
+	}
+	return apiKey, key, nil
+}
+
+// Update changes the name, the identities or the permissions of the API key. The key itself does not change.
+func (s *apiKeyService) Update(ctx context.Context, id uuid.UUID, req ports.UpdateAPIKeyRequest) (*domain.APIKey, error) {
+	apiKey, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name := apiKey.Name
+	if req.Name != nil {
+		name = *req.Name
+	}
+	identities := req.Identities
+	if identities == nil {
+		if identities, err = stringsToDIDs(apiKey.Identities); err != nil {
+			return nil, err
+		}
+	}
+	permissions := req.Permissions
+	if permissions == nil {
+		permissions = apiKey.Permissions
+	}
+	if err := s.validate(ctx, name, identities, permissions); err != nil {
+		return nil, err
+	}
+
+	apiKey.Name = strings.TrimSpace(name)
+	apiKey.Identities = didsToStrings(identities)
+	apiKey.Permissions = permissions
+	if err := s.repo.Save(ctx, apiKey); err != nil {
+		log.Error(ctx, "updating api key", "err", err, "id", id)
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+// GetByID returns the API key
+func (s *apiKeyService) GetByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// GetAll returns all the API keys
+func (s *apiKeyService) GetAll(ctx context.Context) ([]*domain.APIKey, error) {
+	return s.repo.GetAll(ctx)
+}
+
+// Delete removes the API key, the requests sent with it are rejected from now on
+func (s *apiKeyService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// Authenticate looks for the API key by the hash of the key sent by the client
+func (s *apiKeyService) Authenticate(ctx context.Context, key string) (*domain.APIKey, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, ErrAPIKeyInvalid
+	}
+	apiKey, err := s.repo.GetByHash(ctx, hashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyNotFound) {
+			return nil, ErrAPIKeyInvalid
+		}
+		log.Error(ctx, "getting api key", "err", err)
+		return nil, err
+	}
+	if err := s.repo.UpdateLastUsed(ctx, apiKey.ID); err != nil {
+		log.Warn(ctx, "updating api key last use", "err", err, "id", apiKey.ID)
+	}
+	return apiKey, nil
+}
+
+func (s *apiKeyService) validate(ctx context.Context, name string, identities []w3c.DID, permissions []domain.APIKeyPermission) error {
+	if strings.TrimSpace(name) == "" {
+		return ErrAPIKeyEmptyName
+	}
+	if len(identities) == 0 {
+		return ErrAPIKeyNoIdentities
+	}
+	if len(permissions) == 0 {
+		return ErrAPIKeyNoPermissions
+	}
+	for _, permission := range permissions {
+		if !permission.IsValid() {
+			return fmt.Errorf("%w: %s", ErrAPIKeyInvalidPermission, permission)
+		}
+	}
+	for _, did := range identities {
+		if _, err := s.identityRepository.GetByID(ctx, s.storage.Pgx, did); err != nil {
+			if errors.Is(err, repositories.ErrIdentityNotFound) {
+				return fmt.Errorf("%w: %s", ErrAPIKeyUnknownIdentity, did.String())
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// hashAPIKey returns the hash stored for a key.
+// The keys are random, so a fast hash is enough to make the stored value useless to call the API.
+func hashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func didsToStrings(dids []w3c.DID) []string {
+	result := make([]string, len(dids))
+	for i, did := range dids {
+		result[i] = did.String()
+	}
+	return result
+}
+
+func stringsToDIDs(values []string) ([]w3c.DID, error) {
+	result := make([]w3c.DID, len(values))
+	for i, value := range values {
+		did, err := w3c.ParseDID(value)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *did
+	}
+	return result, nil
+}
@@ -0,0 +1,446 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/pubsub"
+)
+
+const (
+	// DefaultBulkIssuanceBatchSize is the number of rows issued in the same transaction when no batch size is configured
+	DefaultBulkIssuanceBatchSize = 100
+	// maxNDJSONLineSize is the maximum size of a credential subject in a NDJSON file
+	maxNDJSONLineSize = 1024 * 1024
+)
+
+var (
+	// ErrBulkIssuanceEmptyFile means that the uploaded file does not contain any row
+	ErrBulkIssuanceEmptyFile = errors.New("bulk issuance file does not contain any row")
+	// ErrBulkIssuanceMalformedFile means that the uploaded file cannot be parsed
+	ErrBulkIssuanceMalformedFile = errors.New("malformed bulk issuance file")
+	// ErrBulkIssuanceUnsupportedFormat means that the format of the uploaded file is not supported
+	ErrBulkIssuanceUnsupportedFormat = errors.New("unsupported bulk issuance file format")
+)
+
+// BulkIssuance issues credentials of the same schema from a file with one credential subject per row.
+// Jobs are stored when they are created and processed in batches by ProcessPendingJobs.
+type BulkIssuance struct {
+	storage         *db.Storage
+	repo            ports.BulkIssuanceRepository
+	schemaService   ports.SchemaService
+	claimService    ports.ClaimService
+	claimRepository ports.ClaimRepository
+	loader          loader.DocumentLoader
+	publisher       pubsub.Publisher
+	batchSize       int
+}
+
+// NewBulkIssuance returns a bulk issuance service. If batchSize is not positive DefaultBulkIssuanceBatchSize is used.
+func NewBulkIssuance(storage *db.Storage, repo ports.BulkIssuanceRepository, schemaService ports.SchemaService, claimService ports.ClaimService, claimRepository ports.ClaimRepository, loader loader.DocumentLoader, publisher pubsub.Publisher, batchSize int) *BulkIssuance {
+	if batchSize <= 0 {
+		batchSize = DefaultBulkIssuanceBatchSize
+	}
+	return &BulkIssuance{
+		storage:         storage,
+		repo:            repo,
+		schemaService:   schemaService,
+		claimService:    claimService,
+		claimRepository: claimRepository,
+		loader:          loader,
+		publisher:       publisher,
+		batchSize:       batchSize,
+	}
+}
+
+// Create parses the uploaded file and stores a new job with one pending row for every credential subject.
+// Rows that cannot be parsed are stored as failed so the caller can see them in the job results.
+func (bi *BulkIssuance) Create(ctx context.Context, req *ports.CreateBulkIssuanceJobRequest) (*domain.BulkIssuanceJob, error) {
+	schema, err := bi.schemaService.GetByID(ctx, req.DID, req.SchemaID)
+	if err != nil {
+		log.Error(ctx, "loading schema", "err", err, "id", req.SchemaID)
+		return nil, err
+	}
+
+	jsonSchema, err := jsonschema.Load(ctx, schema.URL, bi.loader)
+	if err != nil {
+		log.Error(ctx, "loading jsonschema", "err", err, "jsonschema", schema.URL)
+		return nil, ErrLoadingSchema
+	}
+
+	var entries []bulkIssuanceEntry
+	switch req.Format {
+	case domain.BulkIssuanceFormatCSV:
+		attrs, err := jsonSchema.Attributes()
+		if err != nil {
+			log.Error(ctx, "getting schema attributes", "err", err, "jsonschema", schema.URL)
+			return nil, ErrProcessSchema
+		}
+		entries, err = parseBulkIssuanceCSV(req.Data, attrs)
+		if err != nil {
+			return nil, err
+		}
+	case domain.BulkIssuanceFormatNDJSON:
+		entries, err = parseBulkIssuanceNDJSON(req.Data)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrBulkIssuanceUnsupportedFormat
+	}
+	if len(entries) == 0 {
+		return nil, ErrBulkIssuanceEmptyFile
+	}
+
+	job := domain.NewBulkIssuanceJob(req.DID, schema.ID, req.Format, req.Expiration, req.ClaimRequestProofs.BJJSignatureProof2021, req.ClaimRequestProofs.Iden3SparseMerkleTreeProof, req.CredentialStatusType, req.RefreshService, req.DisplayMethod)
+	rows := make([]domain.BulkIssuanceRow, len(entries))
+	for i, entry := range entries {
+		rows[i] = domain.BulkIssuanceRow{
+			JobID:             job.ID,
+			RowNumber:         i + 1,
+			CredentialSubject: entry.credentialSubject,
+			Status:            domain.BulkIssuanceRowStatusPending,
+		}
+		if entry.err != nil {
+			rows[i].Failed(entry.err)
+			continue
+		}
+		for key, value := range req.CredentialSubjectDefaults {
+			if _, ok := rows[i].CredentialSubject[key]; !ok {
+				rows[i].CredentialSubject[key] = value
+			}
+		}
+	}
+
+	err = bi.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := bi.repo.Save(ctx, tx, job); err != nil {
+			return err
+		}
+		return bi.repo.SaveRows(ctx, tx, rows)
+	})
+	if err != nil {
+		log.Error(ctx, "saving bulk issuance job", "err", err)
+		return nil, err
+	}
+
+	return bi.repo.GetByID(ctx, req.DID, job.ID)
+}
+
+// GetByID returns the job with its row counters
+func (bi *BulkIssuance) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.BulkIssuanceJob, error) {
+	return bi.repo.GetByID(ctx, issuerDID, id)
+}
+
+// GetRows returns the rows of the job and the total number of rows that match the filter
+func (bi *BulkIssuance) GetRows(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, filter ports.BulkIssuanceRowsFilter) ([]domain.BulkIssuanceRow, uint, error) {
+	if _, err := bi.repo.GetByID(ctx, issuerDID, id); err != nil {
+		return nil, 0, err
+	}
+	return bi.repo.GetRows(ctx, issuerDID, id, filter)
+}
+
+// ProcessPendingJobs issues the pending rows of all the unfinished jobs. It is meant to be called periodically by a worker.
+// An error processing a job is logged and the job is retried on the next call.
+func (bi *BulkIssuance) ProcessPendingJobs(ctx context.Context) error {
+	jobs, err := bi.repo.GetUnfinished(ctx)
+	if err != nil {
+		log.Error(ctx, "getting unfinished bulk issuance jobs", "err", err)
+		return err
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := bi.processJob(ctx, job); err != nil {
+			log.Error(ctx, "processing bulk issuance job", "err", err, "job", job.ID)
+		}
+	}
+	return nil
+}
+
+func (bi *BulkIssuance) processJob(ctx context.Context, job *domain.BulkIssuanceJob) error {
+	schema, err := bi.schemaService.GetByID(ctx, *job.IssuerCoreDID(), job.SchemaID)
+	if err != nil {
+		return err
+	}
+
+	jsonSchema, err := jsonschema.Load(ctx, schema.URL, bi.loader)
+	if err != nil {
+		log.Error(ctx, "loading jsonschema", "err", err, "jsonschema", schema.URL)
+		return ErrLoadingSchema
+	}
+
+	if job.Status == domain.BulkIssuanceJobStatusPending {
+		job.Status = domain.BulkIssuanceJobStatusProcessing
+		if err := bi.repo.Save(ctx, bi.storage.Pgx, job); err != nil {
+			return err
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		processed, err := bi.processBatch(ctx, job, schema, jsonSchema)
+		if err != nil {
+			return err
+		}
+		if processed == 0 {
+			break
+		}
+	}
+
+	// Another worker could still be issuing some locked rows, so the job is only completed when none of them is pending.
+	job, err = bi.repo.GetByID(ctx, *job.IssuerCoreDID(), job.ID)
+	if err != nil {
+		return err
+	}
+	if job.PendingRows > 0 {
+		return nil
+	}
+	job.Status = domain.BulkIssuanceJobStatusCompleted
+	if err := bi.repo.Save(ctx, bi.storage.Pgx, job); err != nil {
+		return err
+	}
+	log.Info(ctx, "bulk issuance job completed", "job", job.ID, "issued", job.IssuedRows, "failed", job.FailedRows)
+	return nil
+}
+
+// processBatch issues up to batchSize pending rows of the job in a single transaction and publishes a single
+// CreateCredentialEvent with all the credentials issued in the batch. It returns the number of rows processed.
+func (bi *BulkIssuance) processBatch(ctx context.Context, job *domain.BulkIssuanceJob, schema *domain.Schema, jsonSchema *jsonschema.JSONSchema) (int, error) {
+	var processed int
+	var credentialIDs []string
+	var credentials []*domain.Claim
+	err := bi.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		credentialIDs = make([]string, 0, bi.batchSize)
+		credentials = make([]*domain.Claim, 0, bi.batchSize)
+		rows, err := bi.repo.LockPendingRows(ctx, tx, job.ID, bi.batchSize)
+		if err != nil {
+			return err
+		}
+		processed = len(rows)
+
+		for i := range rows {
+			row := &rows[i]
+			credential, err := bi.createCredential(ctx, job, schema, jsonSchema, row.CredentialSubject)
+			if err != nil {
+				row.Failed(err)
+			} else {
+				// A savepoint keeps the batch transaction usable when a single credential cannot be stored
+				err = tx.BeginFunc(ctx, func(savepoint pgx.Tx) error {
+					_, err := bi.claimRepository.Save(ctx, savepoint, credential)
+					return err
+				})
+				if err != nil {
+					log.Warn(ctx, "saving bulk issuance credential", "err", err, "job", job.ID, "row", row.RowNumber)
+					bi.claimService.ReleaseCredentialStatus(ctx, credential)
+					row.Failed(err)
+				} else {
+					row.Issued(credential.ID)
+					credentialIDs = append(credentialIDs, credential.ID.String())
+					credentials = append(credentials, credential)
+				}
+			}
+			if err := bi.repo.UpdateRow(ctx, tx, row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx, "processing bulk issuance batch", "err", err, "job", job.ID)
+		// the rows of the batch are processed again, with new credentials
+		for _, credential := range credentials {
+			bi.claimService.ReleaseCredentialStatus(ctx, credential)
+		}
+		return 0, err
+	}
+
+	if job.CredentialSignatureProof && len(credentialIDs) > 0 {
+		err = bi.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: credentialIDs, IssuerID: job.IssuerCoreDID().String()})
+		if err != nil {
+			log.Error(ctx, "publish CreateCredentialEvent", "err", err.Error(), "job", job.ID)
+		}
+	}
+	return processed, nil
+}
+
+func (bi *BulkIssuance) createCredential(ctx context.Context, job *domain.BulkIssuanceJob, schema *domain.Schema, jsonSchema *jsonschema.JSONSchema, credentialSubject domain.CredentialSubject) (*domain.Claim, error) {
+	if err := jsonSchema.ValidateCredentialSubject(bi.loader, schema.Type, credentialSubject); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCredentialSubject, err)
+	}
+
+	claimRequestProofs := ports.ClaimRequestProofs{
+		BJJSignatureProof2021:      job.CredentialSignatureProof,
+		Iden3SparseMerkleTreeProof: job.CredentialMTPProof,
+	}
+	req := ports.NewCreateClaimRequest(job.IssuerCoreDID(),
+		nil,
+		schema.URL,
+		credentialSubject,
+		job.CredentialExpiration,
+		schema.Type,
+		nil, nil, nil,
+		claimRequestProofs,
+		nil,
+		false,
+		job.CredentialStatusType,
+		job.CredentialRefreshService,
+		nil,
+		job.CredentialDisplayMethod,
+		nil,
+	)
+	req.EncryptionPolicy = schema.CredentialEncryption
+	return bi.claimService.CreateCredential(ctx, req)
+}
+
+// bulkIssuanceEntry is a credential subject read from a bulk issuance file or the reason why it could not be read
+type bulkIssuanceEntry struct {
+	credentialSubject domain.CredentialSubject
+	err               error
+}
+
+// parseBulkIssuanceCSV reads a csv file whose first line contains the credential subject attribute names.
+// Nested attributes are separated by dots and values are converted to the type of the schema attribute.
+func parseBulkIssuanceCSV(r io.Reader, attrs jsonschema.Attributes) ([]bulkIssuanceEntry, error) {
+	types := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		types[attr.ID] = attr.Type
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrBulkIssuanceEmptyFile
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrBulkIssuanceMalformedFile, err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+		if header[i] == "" {
+			return nil, fmt.Errorf("%w: empty column name in position %d", ErrBulkIssuanceMalformedFile, i+1)
+		}
+	}
+
+	entries := make([]bulkIssuanceEntry, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+			return nil, fmt.Errorf("%w: %s", ErrBulkIssuanceMalformedFile, err)
+		}
+		if err != nil {
+			entries = append(entries, bulkIssuanceEntry{credentialSubject: domain.CredentialSubject{}, err: err})
+			continue
+		}
+		entries = append(entries, csvRecordToEntry(header, record, types))
+	}
+	return entries, nil
+}
+
+func csvRecordToEntry(header []string, record []string, types map[string]string) bulkIssuanceEntry {
+	credentialSubject := domain.CredentialSubject{}
+	var convErr error
+	for i, column := range header {
+		raw := strings.TrimSpace(record[i])
+		if raw == "" {
+			continue
+		}
+		path := strings.Split(column, ".")
+		value, err := csvValue(raw, types[path[len(path)-1]])
+		if err != nil {
+			convErr = errors.Join(convErr, fmt.Errorf("column %s: %w", column, err))
+			value = raw
+		}
+		setNestedValue(credentialSubject, path, value)
+	}
+	return bulkIssuanceEntry{credentialSubject: credentialSubject, err: convErr}
+}
+
+func csvValue(raw string, attrType string) (interface{}, error) {
+	switch attrType {
+	case "integer":
+		if _, err := strconv.ParseInt(raw, 10, 64); err != nil {
+			return nil, fmt.Errorf("%s is not an integer", raw)
+		}
+		return json.Number(raw), nil
+	case "number":
+		if _, err := strconv.ParseFloat(raw, 64); err != nil {
+			return nil, fmt.Errorf("%s is not a number", raw)
+		}
+		return json.Number(raw), nil
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a boolean", raw)
+		}
+		return b, nil
+	default:
+		return raw, nil
+	}
+}
+
+func setNestedValue(m map[string]interface{}, path []string, value interface{}) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := m[key].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			m[key] = child
+		}
+		m = child
+	}
+	m[path[len(path)-1]] = value
+}
+
+// parseBulkIssuanceNDJSON reads a file with a json object per line. Blank lines are ignored.
+func parseBulkIssuanceNDJSON(r io.Reader) ([]bulkIssuanceEntry, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxNDJSONLineSize)
+	entries := make([]bulkIssuanceEntry, 0)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var credentialSubject domain.CredentialSubject
+		d := json.NewDecoder(bytes.NewReader(line))
+		d.UseNumber()
+		if err := d.Decode(&credentialSubject); err != nil || credentialSubject == nil {
+			if err == nil {
+				err = errors.New("credential subject must be a json object")
+			}
+			entries = append(entries, bulkIssuanceEntry{credentialSubject: domain.CredentialSubject{}, err: fmt.Errorf("invalid json: %w", err)})
+			continue
+		}
+		entries = append(entries, bulkIssuanceEntry{credentialSubject: credentialSubject})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrBulkIssuanceMalformedFile, err)
+	}
+	return entries, nil
+}
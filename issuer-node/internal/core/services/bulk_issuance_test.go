@@ -0,0 +1,116 @@
+package services
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+)
+
+func TestParseBulkIssuanceCSV(t *testing.T) {
+	attrs := jsonschema.Attributes{
+		{ID: "id", Type: "string"},
+		{ID: "birthday", Type: "integer"},
+		{ID: "score", Type: "number"},
+		{ID: "verified", Type: "boolean"},
+		{ID: "city", Type: "string"},
+	}
+
+	type expected struct {
+		entries []bulkIssuanceEntry
+		errs    []string
+		err     error
+	}
+	for _, tc := range []struct {
+		name     string
+		file     string
+		expected expected
+	}{
+		{
+			name:     "empty file",
+			file:     "",
+			expected: expected{err: ErrBulkIssuanceEmptyFile},
+		},
+		{
+			name:     "empty column name",
+			file:     "id,,birthday\n",
+			expected: expected{err: ErrBulkIssuanceMalformedFile},
+		},
+		{
+			name: "typed values",
+			file: "id, birthday,score,verified\ndid:example:1,19960424,4.5,true\n",
+			expected: expected{
+				entries: []bulkIssuanceEntry{
+					{credentialSubject: domain.CredentialSubject{"id": "did:example:1", "birthday": json.Number("19960424"), "score": json.Number("4.5"), "verified": true}},
+				},
+				errs: []string{""},
+			},
+		},
+		{
+			name: "nested columns and empty cells",
+			file: "id,address.city,birthday\ndid:example:1,Madrid,\n",
+			expected: expected{
+				entries: []bulkIssuanceEntry{
+					{credentialSubject: domain.CredentialSubject{"id": "did:example:1", "address": map[string]interface{}{"city": "Madrid"}}},
+				},
+				errs: []string{""},
+			},
+		},
+		{
+			name: "rows with errors",
+			file: "id,birthday,verified\ndid:example:1,wrong,true\ndid:example:2\ndid:example:3,19960424,false\n",
+			expected: expected{
+				entries: []bulkIssuanceEntry{
+					{credentialSubject: domain.CredentialSubject{"id": "did:example:1", "birthday": "wrong", "verified": true}},
+					{credentialSubject: domain.CredentialSubject{}},
+					{credentialSubject: domain.CredentialSubject{"id": "did:example:3", "birthday": json.Number("19960424"), "verified": false}},
+				},
+				errs: []string{"column birthday: wrong is not an integer", "wrong number of fields", ""},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := parseBulkIssuanceCSV(strings.NewReader(tc.file), attrs)
+			if tc.expected.err != nil {
+				require.ErrorIs(t, err, tc.expected.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, entries, len(tc.expected.entries))
+			for i, entry := range entries {
+				assert.Equal(t, tc.expected.entries[i].credentialSubject, entry.credentialSubject)
+				if tc.expected.errs[i] == "" {
+					assert.NoError(t, entry.err)
+				} else {
+					assert.ErrorContains(t, entry.err, tc.expected.errs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseBulkIssuanceNDJSON(t *testing.T) {
+	file := `{"id": "did:example:1", "birthday": 19960424}
+
+{"id": "did:example:2",
+[1, 2]
+null
+{"id": "did:example:3", "address": {"city": "Madrid"}}
+`
+	entries, err := parseBulkIssuanceNDJSON(strings.NewReader(file))
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+
+	assert.NoError(t, entries[0].err)
+	assert.Equal(t, domain.CredentialSubject{"id": "did:example:1", "birthday": json.Number("19960424")}, entries[0].credentialSubject)
+	assert.ErrorContains(t, entries[1].err, "invalid json")
+	assert.ErrorContains(t, entries[2].err, "invalid json")
+	assert.ErrorContains(t, entries[3].err, "credential subject must be a json object")
+	assert.NoError(t, entries[4].err)
+	assert.Equal(t, domain.CredentialSubject{"id": "did:example:3", "address": map[string]interface{}{"city": "Madrid"}}, entries[4].credentialSubject)
+}
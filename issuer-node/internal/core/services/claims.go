@@ -1,6 +1,7 @@
 package services
 
 import (
+	"bytes"
 	"context"
 	b64 "encoding/base64"
 	"encoding/json"
@@ -17,12 +18,14 @@ import (
 	"github.com/iden3/go-merkletree-sql/v2"
 	"github.com/iden3/go-schema-processor/v2/merklize"
 	"github.com/iden3/go-schema-processor/v2/processor"
+	schemaUtils "github.com/iden3/go-schema-processor/v2/utils"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 	"github.com/iden3/iden3comm/v2"
 	"github.com/iden3/iden3comm/v2/packers"
 	"github.com/iden3/iden3comm/v2/packers/providers/jwe"
 	"github.com/iden3/iden3comm/v2/protocol"
 	shell "github.com/ipfs/go-ipfs-api"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/lestrrat-go/jwx/v3/jwa"
 	"github.com/lestrrat-go/jwx/v3/jwk"
@@ -44,14 +47,21 @@ import (
 	schemaPkg "github.com/polygonid/sh-id-platform/internal/schema"
 	"github.com/polygonid/sh-id-platform/internal/urn"
 	"github.com/polygonid/sh-id-platform/internal/utils"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/verifier"
 )
 
 var (
+	ErrAuthCredentialCannotBeSuspended   = errors.New("authentication credentials cannot be suspended")                // ErrAuthCredentialCannotBeSuspended means that the credential is an authentication credential
+	ErrCredentialAlreadyRevoked          = errors.New("credential is already revoked")                                 // ErrCredentialAlreadyRevoked means that a revoked credential cannot be reissued
+	ErrCredentialCannotBeReissued        = errors.New("authentication and encrypted credentials cannot be reissued")   // ErrCredentialCannotBeReissued means that the credential type does not support reissuing
 	ErrCredentialNotFound                = errors.New("credential not found")                                          // ErrCredentialNotFound Cannot retrieve the given claim
+	ErrCredentialWithoutSignatureProof   = errors.New("credential does not have a signature proof")                    // ErrCredentialWithoutSignatureProof means that only credentials with a BJJSignatureProof2021 can be signed again
+	ErrInvalidSigningAuthCredential      = errors.New("the signing credential is not a non revoked auth credential")   // ErrInvalidSigningAuthCredential means that the credential given to sign another one is not a valid auth credential of the issuer
 	ErrDisplayMethodLacksURL             = errors.New("credential request with display method lacks url")              // ErrDisplayMethodLacksURL means the credential request includes a display method, but the url is not set
 	ErrEmptyMTPProof                     = errors.New("mtp credentials must have a mtp proof to be fetched")           // ErrEmptyMTPProof means that a credential of MTP type can not be fetched if it does not contain the proof
 	ErrJSONLdContext                     = errors.New("jsonLdContext must be a string")                                // ErrJSONLdContext Field jsonLdContext must be a string
 	ErrInvalidCredentialSubject          = errors.New("credential subject does not match the provided schema")         // ErrInvalidCredentialSubject means the credentialSubject does not match the schema provided
+	ErrInvalidCredentialPatch            = errors.New("credential subject id and type cannot be changed")              // ErrInvalidCredentialPatch means that a reissue request tries to change the subject id or type
 	ErrLinkNotFound                      = errors.New("link not found")                                                // ErrLinkNotFound Cannot get the given link from the DB
 	ErrLoadingSchema                     = errors.New("cannot load schema")                                            // ErrLoadingSchema means the system cannot load the schema file
 	ErrMalformedURL                      = errors.New("malformed url")                                                 // ErrMalformedURL The schema url is wrong
@@ -62,12 +72,15 @@ var (
 	ErrSchemaNotFound                    = errors.New("schema not found")                                              // ErrSchemaNotFound Cannot retrieve the given schema from DB
 	ErrUnsupportedDisplayMethodType      = errors.New("unsupported display method type")                               // ErrUnsupportedDisplayMethodType means the display method type is not supported
 	ErrUnsupportedRefreshServiceType     = errors.New("unsupported refresh service type")                              // ErrUnsupportedRefreshServiceType means the refresh service type is not supported
+	ErrWebIssuerMTPProof                 = errors.New("did:web issuers do not support merkle tree proofs")             // ErrWebIssuerMTPProof means that the credentials of did:web issuers are only signed
 	ErrWrongCredentialSubjectID          = errors.New("wrong format for credential subject ID")                        // ErrWrongCredentialSubjectID means the credential subject ID is wrong
 	ErrAuthCredentialCannotBeRevoked     = errors.New("cannot delete the only remaining authentication credential. " +
 		"An identity must have at least one credential") // ErrAuthCredentialCannotBeRevoked means the credential cannot be revoked
 	ErrDisplayMethodNotFound = errors.New("display method not found") // ErrDisplayMethodNotFound Cannot retrieve the given display method
 )
 
+const jwsProofPurpose = "assertionMethod"
+
 type claim struct {
 	host string
 	cfg  config.UniversalLinks
@@ -82,11 +95,13 @@ type claim struct {
 	publisher                pubsub.Publisher
 	ipfsClient               *shell.Shell
 	revocationStatusResolver *revocationstatus.Resolver
+	statusListService        ports.StatusListService
 	mediatypeManager         ports.MediaTypeManager
+	encryptionKeyResolver    ports.EncryptionKeyResolver
 }
 
 // NewClaim creates a new claim service
-func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrService ports.QrStoreService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, host string, ps pubsub.Publisher, ipfsGatewayURL string, revocationStatusResolver *revocationstatus.Resolver, mediatypeManager ports.MediaTypeManager, cfg config.UniversalLinks) ports.ClaimService {
+func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrService ports.QrStoreService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, host string, ps pubsub.Publisher, ipfsGatewayURL string, revocationStatusResolver *revocationstatus.Resolver, statusListService ports.StatusListService, mediatypeManager ports.MediaTypeManager, cfg config.UniversalLinks, encryptionKeyResolver ports.EncryptionKeyResolver) ports.ClaimService {
 	s := &claim{
 		host:                     host,
 		icRepo:                   repo,
@@ -98,8 +113,10 @@ func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrServi
 		loader:                   ld,
 		publisher:                ps,
 		revocationStatusResolver: revocationStatusResolver,
+		statusListService:        statusListService,
 		mediatypeManager:         mediatypeManager,
 		cfg:                      cfg,
+		encryptionKeyResolver:    encryptionKeyResolver,
 	}
 	if ipfsGatewayURL != "" {
 		s.ipfsClient = shell.NewShell(ipfsGatewayURL)
@@ -118,6 +135,7 @@ func (c *claim) Save(ctx context.Context, req *ports.CreateClaimRequest) (*domai
 	}
 	claim.ID, err = c.icRepo.Save(ctx, c.storage.Pgx, claim)
 	if err != nil {
+		c.ReleaseCredentialStatus(ctx, claim)
 		return nil, err
 	}
 	if req.SignatureProof {
@@ -142,6 +160,16 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 		return nil, err
 	}
 
+	if err := c.resolveEncryptionKey(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// did:web issuers have no merkle trees, their credentials are revoked with a status list
+	webIssuer := domain.IsWebDID(*req.DID)
+	if webIssuer {
+		req.CredentialStatusType = revocationstatus.BitstringStatusListEntry
+	}
+
 	var nonce uint64
 	var err error
 	if req.RevNonce != nil {
@@ -202,6 +230,14 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 		opts.MerklizerOpts = []merklize.MerklizeOption{merklize.WithDocumentLoader(c.loader)}
 	}
 
+	// the status list entries allocated to the credential are released if it cannot be created
+	created := false
+	defer func() {
+		if !created {
+			c.releaseStatusListEntries(ctx, *req.DID, nonce)
+		}
+	}()
+
 	vc, err := c.createVC(ctx, req, vcID, jsonLdContext, nonce)
 	if err != nil {
 		log.Error(ctx, "creating verifiable credential", "err", err)
@@ -236,7 +272,11 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 	claim.Issuer = issuerDIDString
 	claim.ID = vcID
 
-	if req.SignatureProof {
+	if webIssuer {
+		if err := c.setJWSProof(ctx, req.DID, vc, claim); err != nil {
+			return nil, err
+		}
+	} else if req.SignatureProof {
 		if err := c.setSignature(ctx, req.DID, coreClaim, claim); err != nil {
 			return nil, err
 		}
@@ -256,9 +296,27 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 	claim.MtProof = req.MTProof
 	claim.LinkID = req.LinkID
 	claim.CreatedAt = *vc.IssuanceDate
+	created = true
 	return claim, nil
 }
 
+// ReleaseCredentialStatus gives back the status list entries allocated to a credential created with CreateCredential
+// that could not be stored, so they are allocated to other credentials
+func (c *claim) ReleaseCredentialStatus(ctx context.Context, credential *domain.Claim) {
+	did, err := w3c.ParseDID(credential.Issuer)
+	if err != nil {
+		log.Error(ctx, "parsing credential issuer", "err", err, "issuer", credential.Issuer)
+		return
+	}
+	c.releaseStatusListEntries(ctx, *did, uint64(credential.RevNonce))
+}
+
+func (c *claim) releaseStatusListEntries(ctx context.Context, did w3c.DID, nonce uint64) {
+	if err := c.statusListService.ReleaseEntries(ctx, did, nonce); err != nil {
+		log.Error(ctx, "releasing the status list entries of a credential that was not issued", "err", err, "nonce", nonce)
+	}
+}
+
 // setSignature sets the signature proof in the claim by signing the core claim with the auth claim of the DID.
 // The claim parameter is modified in place.
 func (c *claim) setSignature(ctx context.Context, DID *w3c.DID, coreClaim *core.Claim, claim *domain.Claim) error {
@@ -267,7 +325,12 @@ func (c *claim) setSignature(ctx context.Context, DID *w3c.DID, coreClaim *core.
 		log.Error(ctx, "cannot retrieve the auth claim", "err", err)
 		return err
 	}
+	return c.setSignatureWithAuthClaim(ctx, authClaim, coreClaim, claim)
+}
 
+// setSignatureWithAuthClaim sets the signature proof in the claim by signing the core claim with the given auth claim.
+// The claim parameter is modified in place.
+func (c *claim) setSignatureWithAuthClaim(ctx context.Context, authClaim *domain.Claim, coreClaim *core.Claim, claim *domain.Claim) error {
 	proof, err := c.identitySrv.SignClaimEntry(ctx, authClaim, coreClaim)
 	if err != nil {
 		log.Error(ctx, "cannot sign claim entry", "err", err)
@@ -294,6 +357,95 @@ func (c *claim) setSignature(ctx context.Context, DID *w3c.DID, coreClaim *core.
 	return nil
 }
 
+// setJWSProof sets a JsonWebSignature2020 proof signed with the key of the did:web issuer in the claim.
+// As in the Linked Data Proofs signature algorithm, the signed payload is the concatenation of the SHA-256 hashes
+// of the URDNA2015 canonical forms of the proof options and of the credential.
+// The claim parameter is modified in place.
+func (c *claim) setJWSProof(ctx context.Context, DID *w3c.DID, vc verifiable.W3CCredential, claim *domain.Claim) error {
+	verificationMethod, err := c.identitySrv.GetJWSVerificationMethod(ctx, *DID)
+	if err != nil {
+		log.Error(ctx, "cannot get the verification method of the issuer", "err", err)
+		return err
+	}
+
+	proof := domain.JSONWebSignature2020Proof{
+		Type:               domain.JSONWebSignature2020ProofType,
+		Created:            time.Now().UTC().Truncate(time.Second),
+		VerificationMethod: verificationMethod,
+		ProofPurpose:       jwsProofPurpose,
+	}
+	vc.Proof = nil
+	proofOptions := map[string]any{
+		"@context":           vc.Context,
+		"type":               proof.Type,
+		"created":            proof.Created.Format(time.RFC3339),
+		"verificationMethod": proof.VerificationMethod,
+		"proofPurpose":       proof.ProofPurpose,
+	}
+	proofOptionsHash, err := verifier.CanonicalHash(c.loader, proofOptions)
+	if err != nil {
+		log.Error(ctx, "cannot canonicalize the proof options", "err", err)
+		return err
+	}
+	credentialHash, err := verifier.CanonicalHash(c.loader, vc)
+	if err != nil {
+		log.Error(ctx, "cannot canonicalize the credential", "err", err)
+		return err
+	}
+
+	proof.JWS, err = c.identitySrv.SignDetachedJWS(ctx, *DID, verificationMethod, append(proofOptionsHash, credentialHash...))
+	if err != nil {
+		log.Error(ctx, "cannot sign the credential", "err", err)
+		return err
+	}
+
+	jsonProof, err := json.Marshal(proof)
+	if err != nil {
+		log.Error(ctx, "cannot encode the json web signature proof", "err", err)
+		return err
+	}
+	if err := claim.SignatureProof.Set(jsonProof); err != nil {
+		log.Error(ctx, "cannot set the json web signature proof", "err", err)
+		return err
+	}
+	return nil
+}
+
+// resolveEncryptionKey sets the encryption key of the request from the DID document of the holder when the encryption policy requires it.
+// With the preferred policy the credential is issued in plain text if the holder has no usable key.
+func (c *claim) resolveEncryptionKey(ctx context.Context, req *ports.CreateClaimRequest) error {
+	if req.EncryptionKey != nil || !req.EncryptionPolicy.Enabled() {
+		return nil
+	}
+
+	var err error
+	var key ports.EncryptionKey
+	subjectID, _ := req.CredentialSubject["id"].(string)
+	switch {
+	case c.encryptionKeyResolver == nil:
+		err = fmt.Errorf("%w: no encryption key resolver configured", ErrHolderEncryptionKeyNotFound)
+	case subjectID == "":
+		err = fmt.Errorf("%w: the credential subject has no id", ErrHolderEncryptionKeyNotFound)
+	default:
+		userDID, parseErr := w3c.ParseDID(subjectID)
+		if parseErr != nil {
+			return ErrWrongCredentialSubjectID
+		}
+		key, err = c.encryptionKeyResolver.Resolve(ctx, *req.DID, *userDID)
+	}
+
+	if err != nil {
+		if errors.Is(err, ErrHolderEncryptionKeyNotFound) && req.EncryptionPolicy == domain.CredentialEncryptionPreferred {
+			log.Warn(ctx, "issuing credential without encryption", "err", err, "subject", subjectID)
+			return nil
+		}
+		log.Error(ctx, "resolving holder encryption key", "err", err, "subject", subjectID)
+		return err
+	}
+	req.EncryptionKey = key
+	return nil
+}
+
 // setVC sets the verifiable credential or the encrypted data in the claim.
 // If the encryption key is provided, it encrypts the verifiable credential and sets the encrypted data in the claim.
 // Otherwise, it sets the verifiable credential in the claim.
@@ -335,6 +487,53 @@ func (c *claim) Revoke(ctx context.Context, id w3c.DID, nonce uint64, descriptio
 	return c.revoke(ctx, &id, nonce, description, c.storage.Pgx)
 }
 
+// Suspend suspends the credentials with the given revocation nonce. Unlike revocation, the nonce is not added to the revocation tree
+// and the suspension can be reverted with Resume.
+func (c *claim) Suspend(ctx context.Context, id w3c.DID, nonce uint64) error {
+	return c.setSuspended(ctx, &id, nonce, true)
+}
+
+// Resume resumes the suspended credentials with the given revocation nonce
+func (c *claim) Resume(ctx context.Context, id w3c.DID, nonce uint64) error {
+	return c.setSuspended(ctx, &id, nonce, false)
+}
+
+func (c *claim) setSuspended(ctx context.Context, did *w3c.DID, nonce uint64, suspended bool) error {
+	claims, err := c.icRepo.GetByRevocationNonce(ctx, c.storage.Pgx, did, domain.RevNonceUint64(nonce))
+	if err != nil {
+		return err
+	}
+
+	authHash, err := core.AuthSchemaHash.MarshalText()
+	if err != nil {
+		return err
+	}
+	for _, claim := range claims {
+		if claim.EqualToSchemaHash(string(authHash)) {
+			return ErrAuthCredentialCannotBeSuspended
+		}
+		if claim.Revoked {
+			return ErrCredentialAlreadyRevoked
+		}
+	}
+
+	err = c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		updated, err := c.icRepo.UpdateSuspended(ctx, tx, did, domain.RevNonceUint64(nonce), suspended)
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return repositories.ErrClaimDoesNotExist
+		}
+		return c.statusListService.SetStatus(ctx, tx, *did, nonce, domain.StatusPurposeSuspension, suspended)
+	})
+	if err != nil {
+		log.Error(ctx, "updating credential suspension", "err", err, "nonce", nonce, "suspended", suspended)
+		return err
+	}
+	return nil
+}
+
 func (c *claim) RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, issuerID w3c.DID) error {
 	credentials, err := c.icRepo.GetNonRevokedByConnectionAndIssuerID(ctx, c.storage.Pgx, connID, issuerID)
 	if err != nil {
@@ -353,6 +552,114 @@ func (c *claim) RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, i
 		})
 }
 
+// Reissue creates a new version of a credential with the given credential subject patches applied.
+// The new credential keeps the schema, subject, proofs and credential status type of the previous one, and its version is increased.
+// The previous credential is revoked, and the new one and the relation between both are stored, in a single transaction.
+// The holder receives an offer for the new credential.
+func (c *claim) Reissue(ctx context.Context, req *ports.ReissueCredentialRequest) (*domain.Claim, error) {
+	previous, err := c.icRepo.GetByIdAndIssuer(ctx, c.storage.Pgx, &req.DID, req.CredentialID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+			return nil, ErrCredentialNotFound
+		}
+		return nil, err
+	}
+	if previous.Revoked {
+		return nil, ErrCredentialAlreadyRevoked
+	}
+
+	authHash, err := core.AuthSchemaHash.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	if previous.EqualToSchemaHash(string(authHash)) || previous.HasEncryptedData() {
+		return nil, ErrCredentialCannotBeReissued
+	}
+
+	createClaimRequest, err := newReissueClaimRequest(previous, req)
+	if err != nil {
+		log.Error(ctx, "building reissue credential request", "err", err, "id", previous.ID)
+		return nil, err
+	}
+
+	credential, err := c.CreateCredential(ctx, createClaimRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := c.revoke(ctx, &req.DID, uint64(previous.RevNonce), fmt.Sprintf("reissued as %s", credential.ID), tx); err != nil {
+			return err
+		}
+		if _, err := c.icRepo.Save(ctx, tx, credential); err != nil {
+			return err
+		}
+		return c.icRepo.SaveReissue(ctx, tx, &domain.CredentialReissue{
+			Issuer:        req.DID.String(),
+			PredecessorID: previous.ID,
+			SuccessorID:   credential.ID,
+		})
+	})
+	if err != nil {
+		log.Error(ctx, "reissuing credential", "err", err, "id", previous.ID)
+		c.ReleaseCredentialStatus(ctx, credential)
+		return nil, err
+	}
+
+	if createClaimRequest.SignatureProof {
+		err = c.publisher.Publish(ctx, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: []string{credential.ID.String()}, IssuerID: req.DID.String()})
+		if err != nil {
+			log.Error(ctx, "publish CreateCredentialEvent", "err", err.Error(), "credential", credential.ID.String())
+		}
+	}
+	return credential, nil
+}
+
+// Resign replaces the BJJSignatureProof2021 of the credential with a new one created with the given auth credential of the issuer.
+// It is used after a key rotation, when the auth credential that signed the credential is revoked.
+// The credentials of did:web issuers get a new JsonWebSignature2020 proof and the auth credential is not used.
+func (c *claim) Resign(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID, authCredentialID uuid.UUID) (*domain.Claim, error) {
+	credential, err := c.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if credential.Revoked {
+		return nil, ErrCredentialAlreadyRevoked
+	}
+	if credential.SignatureProof.Status != pgtype.Present {
+		return nil, ErrCredentialWithoutSignatureProof
+	}
+
+	if domain.IsWebDID(*issuerDID) {
+		vc, err := credential.GetVerifiableCredential()
+		if err != nil {
+			return nil, err
+		}
+		if err := c.setJWSProof(ctx, issuerDID, vc, credential); err != nil {
+			return nil, err
+		}
+	} else {
+		authCredential, err := c.GetByID(ctx, issuerDID, authCredentialID)
+		if err != nil {
+			if errors.Is(err, ErrCredentialNotFound) {
+				return nil, ErrInvalidSigningAuthCredential
+			}
+			return nil, err
+		}
+		if authCredential.SchemaType != domain.AuthBJJCredentialSchemaType || authCredential.Revoked {
+			return nil, ErrInvalidSigningAuthCredential
+		}
+		if err := c.setSignatureWithAuthClaim(ctx, authCredential, credential.CoreClaim.Get(), credential); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := c.icRepo.Save(ctx, c.storage.Pgx, credential); err != nil {
+		log.Error(ctx, "saving the signed credential", "err", err, "id", id)
+		return nil, err
+	}
+	return credential, nil
+}
+
 func (c *claim) Delete(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID) error {
 	claim, err := c.icRepo.GetByIdAndIssuer(ctx, c.storage.Pgx, issuerDID, id)
 	if err != nil {
@@ -531,15 +838,20 @@ func (c *claim) GetAll(ctx context.Context, did w3c.DID, filter *ports.ClaimsFil
 	return claims, total, nil
 }
 
-func (c *claim) GetRevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*verifiable.RevocationStatus, error) {
+func (c *claim) GetRevocationStatus(ctx context.Context, issuerDID w3c.DID, nonce uint64) (*domain.RevocationStatus, error) {
 	rID := new(big.Int).SetUint64(nonce)
-	revocationStatus := &verifiable.RevocationStatus{}
+	revocationStatus := &domain.RevocationStatus{}
 
 	state, err := c.identityStateRepository.GetLatestStateByIdentifier(ctx, c.storage.Pgx, &issuerDID)
 	if err != nil {
 		return nil, err
 	}
 
+	revocationStatus.Suspended, err = c.icRepo.IsSuspended(ctx, c.storage.Pgx, &issuerDID, domain.RevNonceUint64(nonce))
+	if err != nil {
+		return nil, err
+	}
+
 	revocationStatus.Issuer.State = state.State
 	revocationStatus.Issuer.ClaimsTreeRoot = state.ClaimsTreeRoot
 	revocationStatus.Issuer.RevocationTreeRoot = state.RevocationTreeRoot
@@ -742,14 +1054,18 @@ func (c *claim) revoke(ctx context.Context, did *w3c.DID, nonce uint64, descript
 		Description: description,
 	}
 
-	identityTrees, err := c.mtService.GetIdentityMerkleTrees(ctx, querier, did)
-	if err != nil {
-		return fmt.Errorf("error getting merkle trees: %w", err)
-	}
+	// did:web identities have no revocation tree nor states to publish, only their status lists are updated
+	webIssuer := domain.IsWebDID(*did)
+	if !webIssuer {
+		identityTrees, err := c.mtService.GetIdentityMerkleTrees(ctx, querier, did)
+		if err != nil {
+			return fmt.Errorf("error getting merkle trees: %w", err)
+		}
 
-	err = identityTrees.RevokeClaim(ctx, rID)
-	if err != nil {
-		return fmt.Errorf("error revoking the claim: %w", err)
+		err = identityTrees.RevokeClaim(ctx, rID)
+		if err != nil {
+			return fmt.Errorf("error revoking the claim: %w", err)
+		}
 	}
 
 	var claims []*domain.Claim
@@ -761,9 +1077,11 @@ func (c *claim) revoke(ctx context.Context, did *w3c.DID, nonce uint64, descript
 		return fmt.Errorf("error getting the claim by revocation nonce: %w", err)
 	}
 
-	err = c.storage.Pgx.BeginFunc(ctx,
+	err = querier.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
+			suspended := false
 			for _, claim := range claims {
+				suspended = suspended || claim.Suspended
 				claim.Revoked = true
 				_, err = c.icRepo.Save(ctx, tx, claim)
 				if err != nil {
@@ -772,6 +1090,23 @@ func (c *claim) revoke(ctx context.Context, did *w3c.DID, nonce uint64, descript
 				}
 			}
 
+			if err := c.statusListService.SetStatus(ctx, tx, *did, nonce, domain.StatusPurposeRevocation, true); err != nil {
+				return fmt.Errorf("error updating the status lists: %w", err)
+			}
+
+			// a revoked credential cannot be resumed, so its suspension is cleared
+			if suspended {
+				if _, err := c.icRepo.UpdateSuspended(ctx, tx, did, domain.RevNonceUint64(nonce), false); err != nil {
+					return fmt.Errorf("error clearing the suspension: %w", err)
+				}
+				if err := c.statusListService.SetStatus(ctx, tx, *did, nonce, domain.StatusPurposeSuspension, false); err != nil {
+					return fmt.Errorf("error updating the status lists: %w", err)
+				}
+			}
+
+			if webIssuer {
+				return nil
+			}
 			return c.icRepo.RevokeNonce(ctx, tx, &revocation)
 		})
 	if err != nil {
@@ -811,6 +1146,13 @@ func (c *claim) canRevokeNonce(ctx context.Context, did *w3c.DID, querier db.Que
 	return canBeRevoked, nil
 }
 
+// revocationStatusResponseMessageBody extends the revocation status response with the suspension of the credential.
+// Holders and verifiers that do not know about suspensions ignore the extra field.
+type revocationStatusResponseMessageBody struct {
+	protocol.RevocationStatusResponseMessageBody
+	Suspended bool `json:"suspended,omitempty"`
+}
+
 func (c *claim) getRevocationStatus(ctx context.Context, basicMessage *ports.AgentRequest) (*iden3comm.BasicMessage, error) {
 	revData := &protocol.RevocationStatusRequestMessageBody{}
 	err := json.Unmarshal(basicMessage.Body, revData)
@@ -818,13 +1160,16 @@ func (c *claim) getRevocationStatus(ctx context.Context, basicMessage *ports.Age
 		return nil, fmt.Errorf("invalid revocation request body: %w", err)
 	}
 
-	var revStatus *verifiable.RevocationStatus
+	var revStatus *domain.RevocationStatus
 	revStatus, err = c.GetRevocationStatus(ctx, *basicMessage.IssuerDID, revData.RevocationNonce)
 	if err != nil {
 		return nil, fmt.Errorf("failed get revocation status: %w", err)
 	}
 
-	body, err := json.Marshal(protocol.RevocationStatusResponseMessageBody{RevocationStatus: *revStatus})
+	body, err := json.Marshal(revocationStatusResponseMessageBody{
+		RevocationStatusResponseMessageBody: protocol.RevocationStatusResponseMessageBody{RevocationStatus: revStatus.RevocationStatus},
+		Suspended:                           revStatus.Suspended,
+	})
 	if err != nil {
 		log.Error(ctx, "marshaling body", "err", err)
 		return nil, err
@@ -960,6 +1305,13 @@ func (c *claim) guardCreateClaimRequest(req *ports.CreateClaimRequest) error {
 	type guardFunc func() error
 
 	guards := []guardFunc{
+		// check that did:web issuers are not asked for merkle tree proofs
+		func() error {
+			if req.MTProof && domain.IsWebDID(*req.DID) {
+				return ErrWebIssuerMTPProof
+			}
+			return nil
+		},
 		// check if schema's URL is valid
 		func() error {
 			if _, err := url.ParseRequestURI(req.Schema); err != nil {
@@ -1059,17 +1411,16 @@ func (c *claim) newVerifiableCredential(ctx context.Context, claimReq *ports.Cre
 
 	credentialSubject["type"] = claimReq.Type
 
-	latestIssuerState, err := c.identitySrv.GetLatestStateByID(ctx, *claimReq.DID)
-	if err != nil {
-		log.Error(ctx, "getting latest issuer state", "err", err)
-		return verifiable.W3CCredential{}, err
-	}
-	cs, err := c.revocationStatusResolver.GetCredentialRevocationStatus(ctx, *claimReq.DID, nonce, *latestIssuerState.State, claimReq.CredentialStatusType)
+	cs, err := c.newCredentialStatus(ctx, claimReq, nonce)
 	if err != nil {
 		log.Error(ctx, "getting credential status", "err", err)
 		return verifiable.W3CCredential{}, err
 	}
 
+	if domain.IsWebDID(*claimReq.DID) {
+		credentialCtx = append(credentialCtx, domain.JSONWebKey2020Context)
+	}
+
 	if claimReq.DisplayMethod != nil {
 		credentialCtx = append(credentialCtx, verifiable.JSONLDSchemaIden3DisplayMethod)
 	}
@@ -1093,6 +1444,120 @@ func (c *claim) newVerifiableCredential(ctx context.Context, claimReq *ports.Cre
 	}, nil
 }
 
+// newCredentialStatus returns the credential status of the credential. Bitstring status list entries do not depend
+// on the issuer state, so they can be used by the identities that do not publish states.
+func (c *claim) newCredentialStatus(ctx context.Context, claimReq *ports.CreateClaimRequest, nonce uint64) (any, error) {
+	if claimReq.CredentialStatusType == revocationstatus.BitstringStatusListEntry {
+		return c.statusListService.CreateEntry(ctx, *claimReq.DID, nonce)
+	}
+
+	latestIssuerState, err := c.identitySrv.GetLatestStateByID(ctx, *claimReq.DID)
+	if err != nil {
+		log.Error(ctx, "getting latest issuer state", "err", err)
+		return nil, err
+	}
+	return c.revocationStatusResolver.GetCredentialRevocationStatus(ctx, *claimReq.DID, nonce, *latestIssuerState.State, claimReq.CredentialStatusType)
+}
+
+// newReissueClaimRequest builds the request to create the credential that replaces the previous one
+func newReissueClaimRequest(previous *domain.Claim, req *ports.ReissueCredentialRequest) (*ports.CreateClaimRequest, error) {
+	vc, err := previous.GetVerifiableCredential()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get the verifiable credential: %w", err)
+	}
+
+	// The credential subject is decoded again to keep the numbers as they were issued
+	var data struct {
+		CredentialSubject map[string]any `json:"credentialSubject"`
+	}
+	d := json.NewDecoder(bytes.NewReader(previous.Data.Bytes))
+	d.UseNumber()
+	if err := d.Decode(&data); err != nil {
+		return nil, fmt.Errorf("cannot decode the credential subject: %w", err)
+	}
+
+	credentialSubject := make(map[string]any, len(data.CredentialSubject)+len(req.CredentialSubject))
+	for k, v := range data.CredentialSubject {
+		if k != "type" {
+			credentialSubject[k] = v
+		}
+	}
+	for k, v := range req.CredentialSubject {
+		if k == "id" || k == "type" {
+			return nil, ErrInvalidCredentialPatch
+		}
+		if v == nil {
+			delete(credentialSubject, k)
+			continue
+		}
+		credentialSubject[k] = v
+	}
+
+	credentialStatus, err := previous.GetCredentialStatus()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get the credential status: %w", err)
+	}
+
+	subjectPosition, merklizedRootPosition, err := claimPositions(previous.CoreClaim.Get())
+	if err != nil {
+		return nil, err
+	}
+
+	expiration := vc.Expiration
+	if req.Expiration != nil {
+		expiration = req.Expiration
+	}
+
+	claimRequestProofs := ports.ClaimRequestProofs{
+		BJJSignatureProof2021:      previous.SignatureProof.Status == pgtype.Present,
+		Iden3SparseMerkleTreeProof: previous.MtProof,
+	}
+	return ports.NewCreateClaimRequest(&req.DID,
+		nil,
+		previous.SchemaURL,
+		credentialSubject,
+		expiration,
+		previous.SchemaType,
+		common.ToPointer(previous.Version+1),
+		&subjectPosition,
+		&merklizedRootPosition,
+		claimRequestProofs,
+		previous.LinkID,
+		false,
+		credentialStatus.Type,
+		vc.RefreshService,
+		nil,
+		vc.DisplayMethod,
+		nil,
+	), nil
+}
+
+// claimPositions returns the subject and merklized root positions of the core claim as they are used in the claim processor options
+func claimPositions(coreClaim *core.Claim) (subjectPosition string, merklizedRootPosition string, err error) {
+	idPosition, err := coreClaim.GetIDPosition()
+	if err != nil {
+		return "", "", fmt.Errorf("cannot get the subject position: %w", err)
+	}
+	switch idPosition {
+	case core.IDPositionIndex:
+		subjectPosition = schemaUtils.SubjectPositionIndex
+	case core.IDPositionValue:
+		subjectPosition = schemaUtils.SubjectPositionValue
+	}
+
+	merklizedPosition, err := coreClaim.GetMerklizedPosition()
+	if err != nil {
+		return "", "", fmt.Errorf("cannot get the merklized root position: %w", err)
+	}
+	switch merklizedPosition {
+	case core.MerklizedRootPositionIndex:
+		merklizedRootPosition = schemaUtils.MerklizedRootPositionIndex
+	case core.MerklizedRootPositionValue:
+		merklizedRootPosition = schemaUtils.MerklizedRootPositionValue
+	}
+	return subjectPosition, merklizedRootPosition, nil
+}
+
 func (c *claim) buildCredentialID(credID uuid.UUID) urn.URN {
 	return urn.FromUUID(credID)
 }
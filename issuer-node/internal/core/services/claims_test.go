@@ -9,9 +9,11 @@ import (
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
 	"github.com/iden3/iden3comm/v2/protocol"
 	"github.com/jackc/pgtype"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
@@ -261,6 +263,59 @@ func TestAgent(t *testing.T) {
 		assert.NotNil(t, vc.IssuanceDate)
 		assert.NotNil(t, vc.CredentialSubject)
 	})
+
+	t.Run("revocation status of a suspended credential", func(t *testing.T) {
+		userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+		assert.NoError(t, err)
+
+		claimId := uuid.New()
+		req := &ports.CreateClaimRequest{
+			ClaimID: &claimId,
+			DID:     did,
+			Schema:  "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+			Type:    "KYCAgeCredential",
+			CredentialSubject: map[string]any{
+				"id":           userDID.String(),
+				"birthday":     19960425,
+				"documentType": 2,
+			},
+			Expiration:     common.ToPointer(time.Now().Add(365 * 24 * time.Hour)),
+			SignatureProof: true,
+			Version:        0,
+			RevNonce:       common.ToPointer[uint64](101),
+		}
+		_, err = claimsService.Save(ctx, req)
+		require.NoError(t, err)
+		require.NoError(t, claimsService.Suspend(ctx, *did, 101))
+
+		revocationStatus := func(t *testing.T) map[string]any {
+			t.Helper()
+			revocationStatusBody, err := json.Marshal(protocol.RevocationStatusRequestMessageBody{RevocationNonce: 101})
+			require.NoError(t, err)
+			agentRequest := &ports.AgentRequest{
+				Body:      revocationStatusBody,
+				IssuerDID: did,
+				UserDID:   userDID,
+				Type:      protocol.RevocationStatusRequestMessageType,
+				ThreadID:  uuid.New().String(),
+			}
+			basicMessage, err := claimsService.Agent(ctx, agentRequest, packers.MediaTypePlainMessage)
+			require.NoError(t, err)
+			assert.Equal(t, protocol.RevocationStatusResponseMessageType, basicMessage.Type)
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(basicMessage.Body, &body))
+			return body
+		}
+
+		assert.Equal(t, true, revocationStatus(t)["suspended"])
+
+		require.NoError(t, claimsService.Revoke(ctx, *did, 101, ""))
+		credential, err := claimsService.GetByID(ctx, did, claimId)
+		require.NoError(t, err)
+		assert.True(t, credential.Revoked)
+		assert.False(t, credential.Suspended)
+		assert.NotContains(t, revocationStatus(t), "suspended")
+	})
 }
 
 func TestConvertDataToJWEJsonEncryption(t *testing.T) {
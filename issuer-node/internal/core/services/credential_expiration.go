@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// credentialExpirationBatchSize is the max number of credentials notified or revoked for each policy in a sweep
+const credentialExpirationBatchSize = 500
+
+var (
+	// ErrCredentialExpirationPolicyNoAction means that the policy does not notify nor revoke the credentials
+	ErrCredentialExpirationPolicyNoAction = errors.New("the policy should send renewal notices or revoke the expired credentials")
+	// ErrCredentialExpirationPolicyInvalidNotifyDays means that the renewal notice days are not positive
+	ErrCredentialExpirationPolicyInvalidNotifyDays = errors.New("notifyDaysBefore must be greater than 0")
+)
+
+// CredentialExpiration is the service that sends renewal notices for the credentials that are about to expire and
+// revokes the expired ones according to the expiration policies of the identities
+type CredentialExpiration struct {
+	repo                ports.CredentialExpirationPolicyRepository
+	schemaService       ports.SchemaService
+	claimRepository     ports.ClaimRepository
+	claimService        ports.ClaimService
+	notificationService ports.NotificationService
+	storage             *db.Storage
+}
+
+// NewCredentialExpiration returns a new credential expiration service
+func NewCredentialExpiration(repo ports.CredentialExpirationPolicyRepository, schemaService ports.SchemaService, claimRepository ports.ClaimRepository, claimService ports.ClaimService, notificationService ports.NotificationService, storage *db.Storage) ports.CredentialExpirationService {
+	return &CredentialExpiration{
+		repo:                repo,
+		schemaService:       schemaService,
+		claimRepository:     claimRepository,
+		claimService:        claimService,
+		notificationService: notificationService,
+		storage:             storage,
+	}
+}
+
+// SavePolicy validates and stores a new expiration policy
+func (ce *CredentialExpiration) SavePolicy(ctx context.Context, issuerDID w3c.DID, req ports.CredentialExpirationPolicyRequest) (*domain.CredentialExpirationPolicy, error) {
+	if err := ce.validate(ctx, issuerDID, req); err != nil {
+		return nil, err
+	}
+	policy := domain.NewCredentialExpirationPolicy(issuerDID, req.SchemaID, req.NotifyDaysBefore, req.AutoRevoke)
+	if err := ce.repo.Save(ctx, policy); err != nil {
+		log.Error(ctx, "saving credential expiration policy", "err", err)
+		return nil, err
+	}
+	return ce.repo.GetByID(ctx, issuerDID, policy.ID)
+}
+
+// UpdatePolicy replaces all the values of the expiration policy with the given id
+func (ce *CredentialExpiration) UpdatePolicy(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, req ports.CredentialExpirationPolicyRequest) (*domain.CredentialExpirationPolicy, error) {
+	policy, err := ce.repo.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := ce.validate(ctx, issuerDID, req); err != nil {
+		return nil, err
+	}
+	policy.SchemaID = req.SchemaID
+	policy.NotifyDaysBefore = req.NotifyDaysBefore
+	policy.AutoRevoke = req.AutoRevoke
+	if err := ce.repo.Save(ctx, policy); err != nil {
+		log.Error(ctx, "updating credential expiration policy", "err", err, "id", id)
+		return nil, err
+	}
+	return ce.repo.GetByID(ctx, issuerDID, id)
+}
+
+// GetPolicies returns the expiration policies of the identity
+func (ce *CredentialExpiration) GetPolicies(ctx context.Context, issuerDID w3c.DID) ([]domain.CredentialExpirationPolicy, error) {
+	return ce.repo.GetByIssuer(ctx, issuerDID)
+}
+
+// DeletePolicy removes the expiration policy with the given id
+func (ce *CredentialExpiration) DeletePolicy(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	return ce.repo.Delete(ctx, issuerDID, id)
+}
+
+// Sweep applies the expiration policies of all the identities. The holders of the credentials that expire in the
+// notice period get a renewal notice once, and the expired credentials are revoked if the policy says so.
+// The policy of a schema takes precedence over the policy of the whole identity.
+func (ce *CredentialExpiration) Sweep(ctx context.Context) error {
+	policies, err := ce.repo.GetAll(ctx)
+	if err != nil {
+		log.Error(ctx, "getting credential expiration policies", "err", err)
+		return err
+	}
+
+	schemaURLsByIssuer := make(map[string][]string)
+	for _, policy := range policies {
+		if policy.SchemaURL != nil {
+			issuer := policy.IssuerCoreDID().String()
+			schemaURLsByIssuer[issuer] = append(schemaURLsByIssuer[issuer], *policy.SchemaURL)
+		}
+	}
+
+	now := time.Now()
+	var sweepErr error
+	for i := range policies {
+		policy := &policies[i]
+		filter := ports.ExpiringClaimsFilter{SchemaURL: policy.SchemaURL, MaxResults: credentialExpirationBatchSize}
+		if policy.SchemaID == nil {
+			filter.ExcludedSchemaURLs = schemaURLsByIssuer[policy.IssuerCoreDID().String()]
+		}
+		if err := ce.notify(ctx, policy, filter, now); err != nil {
+			sweepErr = errors.Join(sweepErr, err)
+		}
+		if err := ce.revoke(ctx, policy, filter, now); err != nil {
+			sweepErr = errors.Join(sweepErr, err)
+		}
+	}
+	return sweepErr
+}
+
+// notify sends a renewal notice for the credentials that expire in the notice period of the policy. The notice is
+// not sent again even if it fails, e.g. because the holder has no connection or push service.
+func (ce *CredentialExpiration) notify(ctx context.Context, policy *domain.CredentialExpirationPolicy, filter ports.ExpiringClaimsFilter, now time.Time) error {
+	notifyUntil := policy.NotifyUntil(now)
+	if notifyUntil == nil {
+		return nil
+	}
+	issuerDID := policy.IssuerCoreDID()
+	filter.ExpiresAfter = &now
+	filter.ExpiresBefore = *notifyUntil
+	filter.NotNotified = true
+	credentials, err := ce.claimRepository.GetExpiring(ctx, ce.storage.Pgx, *issuerDID, filter)
+	if err != nil {
+		log.Error(ctx, "getting credentials about to expire", "err", err, "issuer", issuerDID.String())
+		return err
+	}
+
+	for _, credential := range credentials {
+		if err := ce.notificationService.SendExpirationNotice(ctx, credential); err != nil {
+			log.Warn(ctx, "sending credential renewal notice", "err", err, "issuer", issuerDID.String(), "id", credential.ID)
+		}
+		if err := ce.claimRepository.UpdateExpirationNotified(ctx, ce.storage.Pgx, *issuerDID, credential.ID); err != nil {
+			log.Error(ctx, "updating credential renewal notice", "err", err, "issuer", issuerDID.String(), "id", credential.ID)
+			return err
+		}
+	}
+	return nil
+}
+
+// revoke revokes the expired credentials if the policy says so
+func (ce *CredentialExpiration) revoke(ctx context.Context, policy *domain.CredentialExpirationPolicy, filter ports.ExpiringClaimsFilter, now time.Time) error {
+	if !policy.AutoRevoke {
+		return nil
+	}
+	issuerDID := policy.IssuerCoreDID()
+	filter.ExpiresBefore = now
+	credentials, err := ce.claimRepository.GetExpiring(ctx, ce.storage.Pgx, *issuerDID, filter)
+	if err != nil {
+		log.Error(ctx, "getting expired credentials", "err", err, "issuer", issuerDID.String())
+		return err
+	}
+
+	// a credential that can not be revoked does not stop the others, it is retried on the next run
+	var errs []error
+	revoked := make(map[domain.RevNonceUint64]bool, len(credentials))
+	for _, credential := range credentials {
+		// the versions of a credential share the revocation nonce
+		if revoked[credential.RevNonce] {
+			continue
+		}
+		revoked[credential.RevNonce] = true
+		if err := ce.claimService.Revoke(ctx, *issuerDID, uint64(credential.RevNonce), "credential expired"); err != nil {
+			log.Error(ctx, "revoking expired credential", "err", err, "issuer", issuerDID.String(), "id", credential.ID)
+			errs = append(errs, fmt.Errorf("revoking credential %s: %w", credential.ID, err))
+			continue
+		}
+		log.Info(ctx, "expired credential revoked", "issuer", issuerDID.String(), "id", credential.ID)
+	}
+	return errors.Join(errs...)
+}
+
+func (ce *CredentialExpiration) validate(ctx context.Context, issuerDID w3c.DID, req ports.CredentialExpirationPolicyRequest) error {
+	if req.NotifyDaysBefore == nil && !req.AutoRevoke {
+		return ErrCredentialExpirationPolicyNoAction
+	}
+	if req.NotifyDaysBefore != nil && *req.NotifyDaysBefore <= 0 {
+		return ErrCredentialExpirationPolicyInvalidNotifyDays
+	}
+	if req.SchemaID != nil {
+		if _, err := ce.schemaService.GetByID(ctx, issuerDID, *req.SchemaID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/jws"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	schemaPkg "github.com/polygonid/sh-id-platform/internal/schema"
+)
+
+const (
+	jwtVCType       = "JWT"
+	sdJWTVCType     = "vc+sd-jwt"
+	sdJWTHashAlg    = "sha-256"
+	sdJWTSaltLength = 16
+)
+
+var (
+	// ErrCredentialExportInvalidFormat means that the export format is not supported
+	ErrCredentialExportInvalidFormat = errors.New("invalid export format, valid values are [jwt-vc, sd-jwt-vc]")
+	// ErrCredentialExportSigningKeyNotFound means that the identity has no key that can sign the exported credential
+	ErrCredentialExportSigningKeyNotFound = errors.New("the identity needs an ETH or Ed25519 key to export credentials as JWT")
+	// ErrCredentialExportInvalidKey means that the given key does not belong to the identity or cannot sign JWTs
+	ErrCredentialExportInvalidKey = errors.New("the key must be an ETH or Ed25519 key of the identity")
+	// ErrCredentialExportInvalidField means that a selectively disclosable field is not a top level attribute of the credential subject
+	ErrCredentialExportInvalidField = errors.New("selectively disclosable fields must be top level attributes of the credential subject other than id and type")
+	// ErrCredentialExportEncrypted means that the credential data is encrypted and cannot be exported
+	ErrCredentialExportEncrypted = errors.New("encrypted credentials cannot be exported")
+)
+
+type credentialExport struct {
+	keyService ports.KeyService
+	kms        kms.KMSType
+}
+
+// NewCredentialExport returns the service that exports credentials as JWT-VC and SD-JWT VC
+func NewCredentialExport(keyService ports.KeyService, kms kms.KMSType) ports.CredentialExportService {
+	return &credentialExport{
+		keyService: keyService,
+		kms:        kms,
+	}
+}
+
+// Validate checks that the credentials of the issuer can be exported with the given options
+func (ce *credentialExport) Validate(ctx context.Context, issuerDID w3c.DID, opts ports.CredentialExportOptions) error {
+	if err := validateExportOptions(opts); err != nil {
+		return err
+	}
+	_, err := ce.signingKey(ctx, issuerDID, opts.KeyID)
+	return err
+}
+
+// Export encodes the credential as a JWT-VC or an SD-JWT VC signed with a key of the issuer.
+// The stored iden3 credential is not modified, the JWT is built from a copy without its iden3 proofs.
+func (ce *credentialExport) Export(ctx context.Context, issuerDID w3c.DID, credential *domain.Claim, opts ports.CredentialExportOptions) (string, error) {
+	if err := validateExportOptions(opts); err != nil {
+		return "", err
+	}
+	if credential.HasEncryptedData() {
+		return "", ErrCredentialExportEncrypted
+	}
+
+	method, err := ce.signingKey(ctx, issuerDID, opts.KeyID)
+	if err != nil {
+		return "", err
+	}
+
+	vc, err := schemaPkg.FromClaimModelToW3CCredential(*credential)
+	if err != nil {
+		return "", err
+	}
+	vc.Proof = nil
+
+	var token string
+	switch opts.Format {
+	case ports.CredentialExportFormatJWTVC:
+		token, err = ce.jwtVC(ctx, method, vc)
+	case ports.CredentialExportFormatSDJWTVC:
+		token, err = ce.sdJWTVC(ctx, method, vc, opts.DisclosableFields)
+	}
+	if err != nil {
+		log.Error(ctx, "exporting credential", "err", err, "id", credential.ID, "format", opts.Format)
+		return "", err
+	}
+	return token, nil
+}
+
+// jwtVC encodes the credential following the JWT encoding of the VC data model v1.1
+func (ce *credentialExport) jwtVC(ctx context.Context, method *jwsVerificationMethod, vc *verifiable.W3CCredential) (string, error) {
+	claims := map[string]any{
+		"iss": vc.Issuer,
+		"jti": vc.ID,
+		"vc":  vc,
+	}
+	if subject, ok := vc.CredentialSubject["id"]; ok {
+		claims["sub"] = subject
+	}
+	setTimeClaims(claims, vc.IssuanceDate, vc.Expiration)
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	return jws.Sign(ctx, ce.kms, method.KeyID, jws.Header{"typ": jwtVCType, "kid": method.ID}, payload)
+}
+
+// sdJWTVC encodes the credential subject as an SD-JWT VC. Every disclosable attribute is replaced by the digest of
+// its disclosure and the disclosures are appended to the issuer signed JWT separated by '~'.
+func (ce *credentialExport) sdJWTVC(ctx context.Context, method *jwsVerificationMethod, vc *verifiable.W3CCredential, disclosableFields []string) (string, error) {
+	claims, disclosures, err := sdJWTClaims(vc.CredentialSubject, disclosableFields)
+	if err != nil {
+		return "", err
+	}
+	claims["iss"] = vc.Issuer
+	claims["jti"] = vc.ID
+	setTimeClaims(claims, vc.IssuanceDate, vc.Expiration)
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	token, err := jws.Sign(ctx, ce.kms, method.KeyID, jws.Header{"typ": sdJWTVCType, "kid": method.ID}, payload)
+	if err != nil {
+		return "", err
+	}
+
+	for _, disclosure := range disclosures {
+		token += "~" + disclosure
+	}
+	return token + "~", nil
+}
+
+func validateExportOptions(opts ports.CredentialExportOptions) error {
+	switch opts.Format {
+	case ports.CredentialExportFormatJWTVC, ports.CredentialExportFormatSDJWTVC:
+	default:
+		return ErrCredentialExportInvalidFormat
+	}
+	for _, field := range opts.DisclosableFields {
+		if field == "" || field == "id" || field == "type" {
+			return ErrCredentialExportInvalidField
+		}
+	}
+	return nil
+}
+
+// sdJWTClaims returns the SD-JWT VC claims of the credential subject and the disclosures of its selectively disclosable attributes.
+// The subject id is mapped to sub and its type to vct. When disclosableFields is nil every other attribute is disclosable.
+func sdJWTClaims(credentialSubject map[string]any, disclosableFields []string) (map[string]any, []string, error) {
+	disclosable := make(map[string]bool, len(credentialSubject))
+	if disclosableFields == nil {
+		for field := range credentialSubject {
+			disclosable[field] = true
+		}
+	}
+	for _, field := range disclosableFields {
+		if _, ok := credentialSubject[field]; !ok {
+			return nil, nil, ErrCredentialExportInvalidField
+		}
+		disclosable[field] = true
+	}
+
+	fields := make([]string, 0, len(credentialSubject))
+	for field := range credentialSubject {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	claims := map[string]any{"_sd_alg": sdJWTHashAlg}
+	digests := make([]string, 0, len(disclosable))
+	disclosures := make([]string, 0, len(disclosable))
+	for _, field := range fields {
+		value := credentialSubject[field]
+		switch {
+		case field == "id":
+			claims["sub"] = value
+		case field == "type":
+			claims["vct"] = value
+		case disclosable[field]:
+			disclosure, digest, err := newDisclosure(field, value)
+			if err != nil {
+				return nil, nil, err
+			}
+			disclosures = append(disclosures, disclosure)
+			digests = append(digests, digest)
+		default:
+			claims[field] = value
+		}
+	}
+	if len(digests) > 0 {
+		// sorted so the order of the digests does not leak the order of the attributes
+		sort.Strings(digests)
+		claims["_sd"] = digests
+	}
+	return claims, disclosures, nil
+}
+
+// newDisclosure returns the base64url encoded disclosure [salt, name, value] of an attribute and its sha-256 digest
+func newDisclosure(name string, value any) (string, string, error) {
+	salt := make([]byte, sdJWTSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", "", err
+	}
+	disclosureBytes, err := json.Marshal([]any{base64.RawURLEncoding.EncodeToString(salt), name, value})
+	if err != nil {
+		return "", "", err
+	}
+	disclosure := base64.RawURLEncoding.EncodeToString(disclosureBytes)
+	digest := sha256.Sum256([]byte(disclosure))
+	return disclosure, base64.RawURLEncoding.EncodeToString(digest[:]), nil
+}
+
+// signingKey returns the verification method of the given key if it is an ETH or Ed25519 key of the issuer or of the
+// default signing key of the issuer otherwise. The JWTs have its id as kid, so they are checked with the key listed in
+// the DID document of the issuer.
+func (ce *credentialExport) signingKey(ctx context.Context, issuerDID w3c.DID, keyID *string) (*jwsVerificationMethod, error) {
+	keyIDs, err := ce.kms.KeysByIdentity(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyID == nil {
+		signingKey, err := jws.SigningKey(keyIDs)
+		if err != nil {
+			return nil, ErrCredentialExportSigningKeyNotFound
+		}
+		return newJWSVerificationMethod(ce.kms, issuerDID, signingKey)
+	}
+
+	key, err := ce.keyService.Get(ctx, &issuerDID, *keyID)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrInvalidKeyType) {
+			return nil, ErrCredentialExportInvalidKey
+		}
+		return nil, err
+	}
+	if _, err := jws.Alg(key.KeyType); err != nil {
+		return nil, ErrCredentialExportInvalidKey
+	}
+	for _, id := range keyIDs {
+		if id.ID == *keyID && id.Type == key.KeyType {
+			return newJWSVerificationMethod(ce.kms, issuerDID, id)
+		}
+	}
+	return nil, ErrCredentialExportInvalidKey
+}
+
+func setTimeClaims(claims map[string]any, issuanceDate *time.Time, expiration *time.Time) {
+	if issuanceDate != nil {
+		claims["iat"] = issuanceDate.Unix()
+		claims["nbf"] = issuanceDate.Unix()
+	}
+	if expiration != nil {
+		claims["exp"] = expiration.Unix()
+	}
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDJWTClaims(t *testing.T) {
+	credentialSubject := map[string]any{
+		"id":           "did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi",
+		"type":         "KYCAgeCredential",
+		"birthday":     float64(19960424),
+		"documentType": float64(2),
+	}
+
+	type expected struct {
+		plain       map[string]any
+		disclosures map[string]any
+		err         error
+	}
+	for _, tc := range []struct {
+		name              string
+		disclosableFields []string
+		expected          expected
+	}{
+		{
+			name:              "all fields disclosable by default",
+			disclosableFields: nil,
+			expected: expected{
+				plain:       map[string]any{},
+				disclosures: map[string]any{"birthday": float64(19960424), "documentType": float64(2)},
+			},
+		},
+		{
+			name:              "some fields disclosable",
+			disclosableFields: []string{"birthday"},
+			expected: expected{
+				plain:       map[string]any{"documentType": float64(2)},
+				disclosures: map[string]any{"birthday": float64(19960424)},
+			},
+		},
+		{
+			name:              "no fields disclosable",
+			disclosableFields: []string{},
+			expected: expected{
+				plain:       map[string]any{"birthday": float64(19960424), "documentType": float64(2)},
+				disclosures: map[string]any{},
+			},
+		},
+		{
+			name:              "unknown field",
+			disclosableFields: []string{"name"},
+			expected: expected{
+				err: ErrCredentialExportInvalidField,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, disclosures, err := sdJWTClaims(credentialSubject, tc.disclosableFields)
+			if tc.expected.err != nil {
+				require.ErrorIs(t, err, tc.expected.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, credentialSubject["id"], claims["sub"])
+			assert.Equal(t, credentialSubject["type"], claims["vct"])
+			assert.Equal(t, sdJWTHashAlg, claims["_sd_alg"])
+			for field, value := range tc.expected.plain {
+				assert.Equal(t, value, claims[field])
+			}
+
+			require.Len(t, disclosures, len(tc.expected.disclosures))
+			digests, _ := claims["_sd"].([]string)
+			require.Len(t, digests, len(tc.expected.disclosures))
+			for _, disclosure := range disclosures {
+				digest := sha256.Sum256([]byte(disclosure))
+				assert.Contains(t, digests, base64.RawURLEncoding.EncodeToString(digest[:]))
+
+				decoded, err := base64.RawURLEncoding.DecodeString(disclosure)
+				require.NoError(t, err)
+				var content []any
+				require.NoError(t, json.Unmarshal(decoded, &content))
+				require.Len(t, content, 3)
+				name, ok := content[1].(string)
+				require.True(t, ok)
+				assert.Equal(t, tc.expected.disclosures[name], content[2])
+				_, isPlain := claims[name]
+				assert.False(t, isPlain)
+			}
+		})
+	}
+}
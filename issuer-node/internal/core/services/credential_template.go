@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+var (
+	// ErrCredentialTemplateEmptyName means that the credential template has no name
+	ErrCredentialTemplateEmptyName = errors.New("credential template name is required")
+	// ErrCredentialTemplateNoProofs means that the credential template does not enable any proof type
+	ErrCredentialTemplateNoProofs = errors.New("at least one proof type should be enabled")
+)
+
+// CredentialTemplate is the service that manages the credential templates of the identities
+type CredentialTemplate struct {
+	repo                 ports.CredentialTemplateRepository
+	schemaService        ports.SchemaService
+	displayMethodService ports.DisplayMethodService
+}
+
+// NewCredentialTemplate returns a new credential template service
+func NewCredentialTemplate(repo ports.CredentialTemplateRepository, schemaService ports.SchemaService, displayMethodService ports.DisplayMethodService) ports.CredentialTemplateService {
+	return &CredentialTemplate{
+		repo:                 repo,
+		schemaService:        schemaService,
+		displayMethodService: displayMethodService,
+	}
+}
+
+// Save validates and stores a new credential template
+func (ct *CredentialTemplate) Save(ctx context.Context, issuerDID w3c.DID, req ports.CredentialTemplateRequest) (*domain.CredentialTemplate, error) {
+	if err := ct.validate(ctx, issuerDID, req); err != nil {
+		return nil, err
+	}
+	template := domain.NewCredentialTemplate(issuerDID, req.Name, req.SchemaID, req.CredentialSubject, req.CredentialExpiration,
+		req.CredentialSignatureProof, req.CredentialMTPProof, req.DisplayMethodID, req.RefreshService)
+	if err := ct.repo.Save(ctx, template); err != nil {
+		log.Error(ctx, "saving credential template", "err", err)
+		return nil, err
+	}
+	return ct.repo.GetByID(ctx, issuerDID, template.ID)
+}
+
+// Update replaces all the values of the credential template with the given id
+func (ct *CredentialTemplate) Update(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, req ports.CredentialTemplateRequest) (*domain.CredentialTemplate, error) {
+	template, err := ct.repo.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := ct.validate(ctx, issuerDID, req); err != nil {
+		return nil, err
+	}
+
+	template.Name = req.Name
+	template.SchemaID = req.SchemaID
+	template.CredentialSubject = req.CredentialSubject
+	if template.CredentialSubject == nil {
+		template.CredentialSubject = domain.CredentialSubject{}
+	}
+	template.CredentialExpiration = req.CredentialExpiration
+	template.CredentialSignatureProof = req.CredentialSignatureProof
+	template.CredentialMTPProof = req.CredentialMTPProof
+	template.DisplayMethodID = req.DisplayMethodID
+	template.RefreshService = req.RefreshService
+	if err := ct.repo.Save(ctx, template); err != nil {
+		log.Error(ctx, "updating credential template", "err", err, "id", id)
+		return nil, err
+	}
+	return ct.repo.GetByID(ctx, issuerDID, id)
+}
+
+// GetByID returns the credential template with the given id
+func (ct *CredentialTemplate) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.CredentialTemplate, error) {
+	return ct.repo.GetByID(ctx, issuerDID, id)
+}
+
+// GetAll returns the credential templates of the identity
+func (ct *CredentialTemplate) GetAll(ctx context.Context, issuerDID w3c.DID, filter ports.CredentialTemplateFilter) ([]domain.CredentialTemplate, uint, error) {
+	return ct.repo.GetAll(ctx, issuerDID, filter)
+}
+
+// Delete removes the credential template with the given id. Credentials, links and jobs created from it are not affected.
+func (ct *CredentialTemplate) Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	return ct.repo.Delete(ctx, issuerDID, id)
+}
+
+// Issuance resolves the template with the given id for a credential issued at issuedAt to a subject with the given attributes.
+// The attributes override the default values of the template.
+func (ct *CredentialTemplate) Issuance(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, attributes domain.CredentialSubject, issuedAt time.Time) (*ports.CredentialTemplateIssuance, error) {
+	template, err := ct.repo.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := ct.schemaService.GetByID(ctx, issuerDID, template.SchemaID)
+	if err != nil {
+		log.Error(ctx, "loading credential template schema", "err", err, "id", id)
+		return nil, err
+	}
+
+	expiration, err := template.CredentialExpirationFrom(issuedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var displayMethod *verifiable.DisplayMethod
+	if template.DisplayMethodID != nil {
+		dm, err := ct.displayMethodService.GetByID(ctx, issuerDID, *template.DisplayMethodID)
+		if err != nil {
+			log.Error(ctx, "loading credential template display method", "err", err, "id", id)
+			return nil, err
+		}
+		displayMethod = &verifiable.DisplayMethod{ID: dm.URL, Type: verifiable.DisplayMethodType(dm.Type)}
+	}
+
+	return &ports.CredentialTemplateIssuance{
+		Template:             template,
+		Schema:               schema,
+		CredentialSubject:    template.CredentialSubjectWith(attributes),
+		CredentialExpiration: expiration,
+		ClaimRequestProofs: ports.ClaimRequestProofs{
+			BJJSignatureProof2021:      template.CredentialSignatureProof,
+			Iden3SparseMerkleTreeProof: template.CredentialMTPProof,
+		},
+		DisplayMethod:  displayMethod,
+		RefreshService: template.RefreshService,
+	}, nil
+}
+
+func (ct *CredentialTemplate) validate(ctx context.Context, issuerDID w3c.DID, req ports.CredentialTemplateRequest) error {
+	if req.Name == "" {
+		return ErrCredentialTemplateEmptyName
+	}
+	if !req.CredentialSignatureProof && !req.CredentialMTPProof {
+		return ErrCredentialTemplateNoProofs
+	}
+	if req.CredentialExpiration != nil {
+		if _, err := domain.RelativeExpiration(*req.CredentialExpiration, time.Now()); err != nil {
+			return err
+		}
+	}
+	if req.RefreshService != nil {
+		if req.CredentialExpiration == nil {
+			return ErrRefreshServiceLacksExpirationTime
+		}
+		if _, err := url.ParseRequestURI(req.RefreshService.ID); err != nil {
+			return ErrRefreshServiceLacksURL
+		}
+		if req.RefreshService.Type != verifiable.Iden3RefreshService2023 {
+			return ErrUnsupportedRefreshServiceType
+		}
+	}
+	if _, err := ct.schemaService.GetByID(ctx, issuerDID, req.SchemaID); err != nil {
+		return err
+	}
+	if req.DisplayMethodID != nil {
+		if _, err := ct.displayMethodService.GetByID(ctx, issuerDID, *req.DisplayMethodID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/verifier"
+)
+
+type credentialVerification struct {
+	verifier *verifier.Verifier
+}
+
+// NewCredentialVerification returns the service that verifies the credentials issued by the identities of the node.
+// States, credential statuses and DID documents are resolved from the node database, so credentials of other issuers fail the verification.
+func NewCredentialVerification(identityStateRepository ports.IdentityStateRepository, claimService ports.ClaimService, statusListRepository ports.StatusListRepository, didDocumentService ports.DIDDocumentService, storage *db.Storage, documentLoader loader.DocumentLoader) ports.CredentialVerificationService {
+	revocationNonceResolver := &revocationNonceStatusResolver{claimService: claimService}
+	return &credentialVerification{
+		verifier: verifier.New(
+			&localStateResolver{identityStateRepository: identityStateRepository, storage: storage},
+			verifier.WithDocumentLoader(documentLoader),
+			verifier.WithDIDDocumentResolver(&localDIDDocumentResolver{didDocumentService: didDocumentService}),
+			verifier.WithCredentialStatusResolver(verifiable.Iden3commRevocationStatusV1, revocationNonceResolver),
+			verifier.WithCredentialStatusResolver(verifiable.Iden3ReverseSparseMerkleTreeProof, revocationNonceResolver),
+			verifier.WithCredentialStatusResolver(verifiable.Iden3OnchainSparseMerkleTreeProof2023, revocationNonceResolver),
+			verifier.WithCredentialStatusResolver(revocationstatus.BitstringStatusListEntry, &statusListStatusResolver{statusListRepository: statusListRepository, storage: storage}),
+		),
+	}
+}
+
+// Verify checks the proofs, the status and the expiration of the credential
+func (cv *credentialVerification) Verify(ctx context.Context, credential verifiable.W3CCredential) *verifier.Report {
+	return cv.verifier.Verify(ctx, credential)
+}
+
+// localDIDDocumentResolver returns the DID documents of the identities of the node, as they are published
+type localDIDDocumentResolver struct {
+	didDocumentService ports.DIDDocumentService
+}
+
+func (r *localDIDDocumentResolver) Resolve(ctx context.Context, did w3c.DID) (json.RawMessage, error) {
+	doc, err := r.didDocumentService.Get(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+// localStateResolver accepts the states of the issuer confirmed on chain by the node publisher
+type localStateResolver struct {
+	identityStateRepository ports.IdentityStateRepository
+	storage                 *db.Storage
+}
+
+func (r *localStateResolver) IsPublishedState(ctx context.Context, issuerDID w3c.DID, state *merkletree.Hash) (bool, error) {
+	states, err := r.identityStateRepository.GetStatesByStatusAndIssuerID(ctx, r.storage.Pgx, domain.StatusConfirmed, issuerDID)
+	if err != nil {
+		return false, err
+	}
+	for _, s := range states {
+		if s.State != nil && *s.State == state.Hex() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// revocationNonceStatusResolver resolves the iden3 credential statuses with the revocation tree of the issuer
+type revocationNonceStatusResolver struct {
+	claimService ports.ClaimService
+}
+
+func (r *revocationNonceStatusResolver) Resolve(ctx context.Context, issuerDID w3c.DID, credentialStatus json.RawMessage) (*verifier.CredentialStatus, error) {
+	var status verifiable.CredentialStatus
+	if err := json.Unmarshal(credentialStatus, &status); err != nil {
+		return nil, fmt.Errorf("invalid credential status: %w", err)
+	}
+	revocationStatus, err := r.claimService.GetRevocationStatus(ctx, issuerDID, status.RevocationNonce)
+	if err != nil {
+		return nil, err
+	}
+	return &verifier.CredentialStatus{
+		Revoked:   revocationStatus.MTP.Existence,
+		Suspended: revocationStatus.Suspended,
+	}, nil
+}
+
+// statusListStatusResolver resolves the BitstringStatusListEntry credential statuses with the status lists of the issuer
+type statusListStatusResolver struct {
+	statusListRepository ports.StatusListRepository
+	storage              *db.Storage
+}
+
+func (r *statusListStatusResolver) Resolve(ctx context.Context, issuerDID w3c.DID, credentialStatus json.RawMessage) (*verifier.CredentialStatus, error) {
+	var status revocationstatus.BitstringStatusListEntryStatus
+	if err := json.Unmarshal(credentialStatus, &status); err != nil {
+		return nil, fmt.Errorf("invalid credential status: %w", err)
+	}
+	listURL, err := url.Parse(status.StatusListCredential)
+	if err != nil {
+		return nil, fmt.Errorf("invalid status list credential: %w", err)
+	}
+	listID, err := uuid.Parse(path.Base(listURL.Path))
+	if err != nil {
+		return nil, fmt.Errorf("invalid status list credential: %w", err)
+	}
+	index, err := strconv.Atoi(status.StatusListIndex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid status list index: %w", err)
+	}
+
+	list, err := r.statusListRepository.GetByID(ctx, r.storage.Pgx, issuerDID, listID)
+	if err != nil {
+		return nil, err
+	}
+	set, err := list.Status(index)
+	if err != nil {
+		return nil, err
+	}
+	if list.Purpose == domain.StatusPurposeSuspension {
+		return &verifier.CredentialStatus{Suspended: set}, nil
+	}
+	return &verifier.CredentialStatus{Revoked: set}, nil
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const bjjCoordinateLength = 32
+
+type didDocument struct {
+	storage            *db.Storage
+	identityRepository ports.IdentityRepository
+	claimsRepository   ports.ClaimRepository
+	kms                kms.KMSType
+	serverURL          string
+	cfg                config.DIDDocument
+}
+
+// NewDIDDocument returns the service that builds the DID documents of the issuer identities
+func NewDIDDocument(storage *db.Storage, identityRepository ports.IdentityRepository, claimsRepository ports.ClaimRepository, kms kms.KMSType, serverURL string, cfg config.DIDDocument) ports.DIDDocumentService {
+	return &didDocument{
+		storage:            storage,
+		identityRepository: identityRepository,
+		claimsRepository:   claimsRepository,
+		kms:                kms,
+		serverURL:          serverURL,
+		cfg:                cfg,
+	}
+}
+
+// Get returns the DID document of the identity with the Baby JubJub keys of its non revoked auth credentials,
+// the ETH and Ed25519 keys that sign its JWT credentials, the iden3comm agent service and the push and refresh
+// services, if configured.
+// The documents of did:web identities only have their ETH and Ed25519 keys.
+// It returns repositories.ErrIdentityNotFound if the identity is not managed by the issuer node.
+func (d *didDocument) Get(ctx context.Context, did w3c.DID) (*domain.DIDDocument, error) {
+	if _, err := d.identityRepository.GetByID(ctx, d.storage.Pgx, did); err != nil {
+		return nil, err
+	}
+
+	if domain.IsWebDID(did) {
+		return d.getWebDocument(ctx, did)
+	}
+
+	authHash, err := core.AuthSchemaHash.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	authClaims, err := d.claimsRepository.GetAuthCoreClaims(ctx, d.storage.Pgx, &did, string(authHash))
+	if err != nil {
+		log.Error(ctx, "getting auth credentials", "err", err, "did", did.String())
+		return nil, err
+	}
+
+	doc := domain.NewDIDDocument(did.String())
+	for _, authClaim := range authClaims {
+		if authClaim.Revoked || authClaim.SchemaURL != verifiable.JSONSchemaIden3AuthBJJCredential {
+			continue
+		}
+		slots := authClaim.CoreClaim.Get().RawSlotsAsInts()
+		doc.AddBJJKey(fmt.Sprintf("%s#%s", did.String(), authClaim.ID), encodeBJJCoordinate(slots[2]), encodeBJJCoordinate(slots[3]))
+	}
+
+	methods, err := jwsVerificationMethods(ctx, d.kms, did)
+	if err != nil {
+		log.Error(ctx, "getting jws keys", "err", err, "did", did.String())
+		return nil, err
+	}
+	for _, method := range methods {
+		doc.AddAssertionJWK(method.ID, method.JWK)
+	}
+
+	doc.AddService(domain.Iden3CommServiceType, domain.Iden3CommServiceType, fmt.Sprintf(ports.AgentUrl, d.serverURL))
+	if d.cfg.PushServiceURL != "" {
+		doc.AddService("push", domain.PushNotificationServiceType, d.cfg.PushServiceURL)
+	}
+	if d.cfg.RefreshServiceURL != "" {
+		doc.AddService("refresh", domain.Iden3RefreshServiceType, d.cfg.RefreshServiceURL)
+	}
+	return doc, nil
+}
+
+func (d *didDocument) getWebDocument(ctx context.Context, did w3c.DID) (*domain.DIDDocument, error) {
+	methods, err := jwsVerificationMethods(ctx, d.kms, did)
+	if err != nil {
+		log.Error(ctx, "getting did:web keys", "err", err, "did", did.String())
+		return nil, err
+	}
+	doc := domain.NewDIDDocument(did.String())
+	for _, method := range methods {
+		doc.AddJWK(method.ID, method.JWK)
+	}
+	return doc, nil
+}
+
+// encodeBJJCoordinate returns the coordinate as a 32 bytes big endian base64url value, like the coordinates of EC JWKs
+func encodeBJJCoordinate(coordinate *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(coordinate.FillBytes(make([]byte, bjjCoordinateLength)))
+}
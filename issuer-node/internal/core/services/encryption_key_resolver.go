@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/packers"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// ErrHolderEncryptionKeyNotFound means that the DID document of the holder has no key that can be used to encrypt credentials
+var ErrHolderEncryptionKeyNotFound = errors.New("the DID document of the holder has no usable encryption key")
+
+const (
+	defaultECEncryptionAlg  = "ECDH-ES+A256KW"
+	defaultRSAEncryptionAlg = "RSA-OAEP-256"
+)
+
+type encryptionKeyResolver struct {
+	connRepo    ports.ConnectionRepository
+	storage     *db.Storage
+	didResolver packers.DIDResolverHandlerFunc
+}
+
+// NewEncryptionKeyResolver returns a resolver that looks for the encryption key of a holder.
+// The DID document stored in the connection with the issuer is used first. If there is no connection,
+// the document is fetched with the DID resolver, if any.
+func NewEncryptionKeyResolver(connRepo ports.ConnectionRepository, storage *db.Storage, didResolver packers.DIDResolverHandlerFunc) ports.EncryptionKeyResolver {
+	return &encryptionKeyResolver{
+		connRepo:    connRepo,
+		storage:     storage,
+		didResolver: didResolver,
+	}
+}
+
+// Resolve returns the first key agreement key of the DID document of the holder that can be used to encrypt credentials
+func (r *encryptionKeyResolver) Resolve(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) (ports.EncryptionKey, error) {
+	doc, err := r.didDocument(ctx, issuerDID, userDID)
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("%w: DID document of %s not found", ErrHolderEncryptionKeyNotFound, userDID.String())
+	}
+	return encryptionKeyFromDIDDocument(doc, userDID)
+}
+
+func (r *encryptionKeyResolver) didDocument(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) (json.RawMessage, error) {
+	conn, err := r.connRepo.GetByUserID(ctx, r.storage.Pgx, issuerDID, userDID)
+	if err != nil && !errors.Is(err, repositories.ErrConnectionDoesNotExist) {
+		return nil, err
+	}
+	if conn != nil && len(conn.UserDoc) > 0 {
+		return conn.UserDoc, nil
+	}
+
+	if r.didResolver == nil {
+		return nil, nil
+	}
+	doc, err := r.didResolver(userDID.String())
+	if err != nil {
+		log.Warn(ctx, "resolving holder DID document", "err", err, "did", userDID.String())
+		return nil, nil
+	}
+	return json.Marshal(doc)
+}
+
+type encryptionVerificationMethod struct {
+	ID           string         `json:"id"`
+	PublicKeyJwk map[string]any `json:"publicKeyJwk"`
+}
+
+type encryptionDIDDocument struct {
+	VerificationMethod []encryptionVerificationMethod `json:"verificationMethod"`
+	KeyAgreement       []json.RawMessage              `json:"keyAgreement"`
+}
+
+// encryptionKeyFromDIDDocument looks for an EC or RSA public key in the key agreement section of the document.
+// The entries of the section can be references to verification methods or embedded verification methods.
+func encryptionKeyFromDIDDocument(raw json.RawMessage, userDID w3c.DID) (ports.EncryptionKey, error) {
+	var doc encryptionDIDDocument
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parsing holder DID document: %w", err)
+	}
+
+	for _, entry := range doc.KeyAgreement {
+		var ref string
+		if err := json.Unmarshal(entry, &ref); err == nil {
+			for _, vm := range doc.VerificationMethod {
+				if vm.ID == ref || (strings.HasPrefix(ref, "#") && strings.HasSuffix(vm.ID, ref)) {
+					if key := toEncryptionKey(vm); key != nil {
+						return key, nil
+					}
+				}
+			}
+			continue
+		}
+		var vm encryptionVerificationMethod
+		if err := json.Unmarshal(entry, &vm); err != nil {
+			continue
+		}
+		if key := toEncryptionKey(vm); key != nil {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrHolderEncryptionKeyNotFound, userDID.String())
+}
+
+// toEncryptionKey returns the JWK of the verification method with the algorithm used to wrap the content key, or nil if it cannot be used
+func toEncryptionKey(vm encryptionVerificationMethod) ports.EncryptionKey {
+	if vm.PublicKeyJwk == nil || vm.PublicKeyJwk["use"] == "sig" {
+		return nil
+	}
+
+	key := make(ports.EncryptionKey, len(vm.PublicKeyJwk)+2)
+	for k, v := range vm.PublicKeyJwk {
+		key[k] = v
+	}
+
+	var alg string
+	switch key["kty"] {
+	case "EC":
+		switch key["crv"] {
+		case "P-256", "P-384", "P-521":
+		default:
+			return nil
+		}
+		alg = defaultECEncryptionAlg
+	case "RSA":
+		alg = defaultRSAEncryptionAlg
+	default:
+		return nil
+	}
+	if _, ok := key["alg"]; !ok {
+		key["alg"] = alg
+	}
+	if _, ok := key["kid"]; !ok && vm.ID != "" {
+		key["kid"] = vm.ID
+	}
+	return key
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// idempotencyKeyStaleAfter is the time after which a request that never finished no longer blocks its idempotency key
+const idempotencyKeyStaleAfter = 5 * time.Minute
+
+var (
+	// ErrIdempotencyKeyMismatch means that the idempotency key has already been used with a different request
+	ErrIdempotencyKeyMismatch = errors.New("the idempotency key has already been used with a different request")
+	// ErrIdempotencyKeyInProgress means that a request with the same idempotency key is still being processed
+	ErrIdempotencyKeyInProgress = errors.New("a request with the same idempotency key is being processed")
+)
+
+// Idempotency is the service that stores the responses of the requests sent with an idempotency key
+type Idempotency struct {
+	repo ports.IdempotencyKeyRepository
+	ttl  time.Duration
+}
+
+// NewIdempotency returns a new idempotency service. The responses are replayed during ttl.
+func NewIdempotency(repo ports.IdempotencyKeyRepository, ttl time.Duration) ports.IdempotencyService {
+	return &Idempotency{
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+// Start reserves the key of the principal for the request with the given hash. It returns nil if the request must be processed
+// or the stored idempotency key if the response of the same request has to be replayed.
+func (i *Idempotency) Start(ctx context.Context, principal string, key string, requestHash string) (*domain.IdempotencyKey, error) {
+	now := time.Now()
+	reserved, err := i.repo.Reserve(ctx, domain.NewIdempotencyKey(principal, key, requestHash, now.Add(i.ttl)), now.Add(-idempotencyKeyStaleAfter))
+	if err != nil {
+		log.Error(ctx, "reserving idempotency key", "err", err)
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	stored, err := i.repo.GetByKey(ctx, principal, key)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdempotencyKeyNotFound) {
+			// the key expired or was released after the reservation attempt
+			return nil, ErrIdempotencyKeyInProgress
+		}
+		log.Error(ctx, "getting idempotency key", "err", err)
+		return nil, err
+	}
+	if stored.RequestHash != requestHash {
+		return nil, ErrIdempotencyKeyMismatch
+	}
+	if !stored.Completed() {
+		return nil, ErrIdempotencyKeyInProgress
+	}
+	return stored, nil
+}
+
+// Complete stores the response of the request of a reserved key
+func (i *Idempotency) Complete(ctx context.Context, principal string, key string, statusCode int, contentType string, body []byte) error {
+	return i.repo.SaveResponse(ctx, &domain.IdempotencyKey{
+		Principal:    principal,
+		Key:          key,
+		StatusCode:   common.ToPointer(statusCode),
+		ContentType:  common.ToPointer(contentType),
+		ResponseBody: body,
+	})
+}
+
+// Release removes a reserved key whose response must not be replayed, so that the request can be retried
+func (i *Idempotency) Release(ctx context.Context, principal string, key string) error {
+	return i.repo.Delete(ctx, principal, key)
+}
+
+// DeleteExpired removes the expired keys
+func (i *Idempotency) DeleteExpired(ctx context.Context) error {
+	deleted, err := i.repo.DeleteExpired(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Info(ctx, "expired idempotency keys deleted", "count", deleted)
+	}
+	return nil
+}
@@ -29,8 +29,10 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/event"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/jws"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/network"
@@ -74,6 +76,18 @@ var (
 
 	// ErrKeyNotFound - represents an error when the key is not found
 	ErrKeyNotFound = errors.New("key not found")
+
+	// ErrWebIdentityKeyType - did:web identities are backed by ETH or Ed25519 keys
+	ErrWebIdentityKeyType = fmt.Errorf("%w: did:web identities need an ETH or Ed25519 key", ErrWrongDIDMetada)
+
+	// ErrNotWebIdentity - the operation is only supported by did:web identities
+	ErrNotWebIdentity = errors.New("the identity is not a did:web identity")
+
+	// ErrVerificationMethodNotFound - the identity has no key with the given verification method
+	ErrVerificationMethodNotFound = errors.New("verification method not found")
+
+	// ErrInvalidStateDiffRange - the from state of a diff must be older than the to state
+	ErrInvalidStateDiffRange = errors.New("the from state must be older than the to state")
 )
 
 type identity struct {
@@ -150,6 +164,11 @@ func (i *identity) Create(ctx context.Context, hostURL string, didOptions *ports
 	var err error
 	err = i.storage.Pgx.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
+			if didOptions != nil && didOptions.Method == domain.DIDMethodWeb {
+				identifier, err = i.createWebIdentity(ctx, tx, hostURL, didOptions)
+				return err
+			}
+
 			var keyType kms.KeyType
 			if didOptions == nil || didOptions.KeyType == "" {
 				keyType = kms.KeyTypeBabyJubJub
@@ -322,7 +341,7 @@ func (i *identity) UpdateState(ctx context.Context, did w3c.DID) (*domain.Identi
 			}
 
 			// Get all mtp claims with state == nil
-			claimsAddedToTree, err := i.processClaims(ctx, tx, did, iTrees)
+			addedClaims, err := i.processClaims(ctx, tx, did, iTrees)
 			if err != nil {
 				return err
 			}
@@ -336,7 +355,7 @@ func (i *identity) UpdateState(ctx context.Context, did w3c.DID) (*domain.Identi
 
 			log.Info(ctx, "updating revocation status", "revocations", len(updatedRevocations))
 
-			if len(updatedRevocations) == 0 && !claimsAddedToTree {
+			if len(updatedRevocations) == 0 && len(addedClaims) == 0 {
 				log.Info(ctx, "no claims or revocations found to process")
 				return ErrNoClaimsFoundToProcess
 			}
@@ -358,6 +377,11 @@ func (i *identity) UpdateState(ctx context.Context, did w3c.DID) (*domain.Identi
 				return fmt.Errorf("error saving new identity state: %w", err)
 			}
 
+			err = i.identityStateRepository.SaveChanges(ctx, tx, identityStateChanges(*newState, addedClaims, updatedRevocations))
+			if err != nil {
+				return fmt.Errorf("error saving identity state changes: %w", err)
+			}
+
 			rhsSettings, err := i.networkResolver.GetRhsSettings(ctx, resolverPrefix)
 			if err != nil {
 				log.Error(ctx, "getting RHS settings", "err", err)
@@ -417,28 +441,67 @@ func (i *identity) UpdateIdentityDisplayName(ctx context.Context, did w3c.DID, d
 		})
 }
 
-func (i *identity) processClaims(ctx context.Context, tx pgx.Tx, did w3c.DID, iTrees *domain.IdentityMerkleTrees) (bool, error) {
-	lc, err := i.claimsRepository.GetAllByState(ctx, tx, &did, nil)
+// PendingState returns the state the unpublished claims and revocations of the identity would lead to, without saving it.
+// The claims are added to the merkle trees of the identity with conn, so the caller rolls back its transaction to discard them.
+func (i *identity) PendingState(ctx context.Context, conn db.Querier, did w3c.DID) (*domain.IdentityState, error) {
+	iTrees, err := i.mtService.GetIdentityMerkleTrees(ctx, conn, &did)
+	if err != nil {
+		return nil, err
+	}
+
+	previousState, err := i.identityStateRepository.GetLatestStateByIdentifier(ctx, conn, &did)
 	if err != nil {
-		return false, fmt.Errorf("error getting the states: %w", err)
+		return nil, fmt.Errorf("error getting the identifier last state: %w", err)
+	}
+
+	if _, err := i.processClaims(ctx, conn, did, iTrees); err != nil {
+		return nil, err
+	}
+
+	newState := &domain.IdentityState{
+		Identifier: did.String(),
+		Status:     domain.StatusCreated,
+	}
+	if err := populateIdentityState(ctx, iTrees, newState, previousState); err != nil {
+		log.Error(ctx, "populating identity state", "err", err)
+		return nil, err
+	}
+	return newState, nil
+}
+
+// processClaims adds the claims that are not in a state yet to the claims tree and returns them
+func (i *identity) processClaims(ctx context.Context, conn db.Querier, did w3c.DID, iTrees *domain.IdentityMerkleTrees) ([]domain.Claim, error) {
+	lc, err := i.claimsRepository.GetAllByState(ctx, conn, &did, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the states: %w", err)
 	}
 
-	claimsAddedToTree := false
 	if len(lc) > 0 {
 		log.Info(ctx, "adding claims to tree", "claims", len(lc))
-		claimsAddedToTree = true
 	}
 
 	for i := range lc {
 		err = iTrees.AddClaim(ctx, &lc[i])
 		if err != nil {
 			log.Error(ctx, "adding claim to tree", "err", err)
-			return false, err
+			return nil, err
 		}
 
 	}
 
-	return claimsAddedToTree, nil
+	return lc, nil
+}
+
+// identityStateChanges returns the log of the claims and revocations anchored by the new state
+func identityStateChanges(state domain.IdentityState, claims []domain.Claim, revocations []*domain.Revocation) []domain.IdentityStateChange {
+	changes := make([]domain.IdentityStateChange, 0, len(claims)+len(revocations))
+	for _, claim := range claims {
+		changes = append(changes, domain.NewCredentialStateChange(state.Identifier, *state.State, claim.ID))
+	}
+	for _, revocation := range revocations {
+		changes = append(changes, domain.NewRevocationStateChange(state.Identifier, *state.State, revocation.Nonce))
+	}
+	return changes
 }
 
 func (i *identity) UpdateIdentityState(ctx context.Context, state *domain.IdentityState) error {
@@ -457,6 +520,19 @@ func (i *identity) UpdateIdentityState(ctx context.Context, state *domain.Identi
 	return err
 }
 
+// AddStateReplacementTxID records the hash of a transaction sent with higher fees to replace the one publishing the state
+func (i *identity) AddStateReplacementTxID(ctx context.Context, state *domain.IdentityState, txID string) error {
+	affected, err := i.identityStateRepository.AddReplacementTxID(ctx, i.storage.Pgx, *state.State, txID)
+	if err != nil {
+		return fmt.Errorf("can't save identity state replacement transaction; %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("identity state hasn't been updated")
+	}
+	state.ReplacementTxIDs = append(state.ReplacementTxIDs, txID)
+	return nil
+}
+
 func (i *identity) AuthenticateWithRequest(ctx context.Context, sessionID *uuid.UUID, authReq protocol.AuthorizationRequestMessage, message string, serverURL string) (*protocol.AuthorizationResponseMessage, error) {
 	arm, err := i.verifier.FullVerify(ctx, message, authReq, pubsignals.WithAcceptedStateTransitionDelay(transitionDelay))
 	if err != nil {
@@ -730,6 +806,138 @@ func (i *identity) createEthIdentity(ctx context.Context, tx db.Querier, hostURL
 	return did, identity.State.TreeState().State.BigInt(), nil
 }
 
+// createWebIdentity - creates a did:web identity under the host of the server url backed by a new ETH or Ed25519 key.
+// These identities have no merkle trees nor states.
+func (i *identity) createWebIdentity(ctx context.Context, tx db.Querier, hostURL string, didOptions *ports.DIDCreationOptions) (*w3c.DID, error) {
+	if didOptions.KeyType != kms.KeyTypeEthereum && didOptions.KeyType != kms.KeyTypeEd25519 {
+		return nil, ErrWebIdentityKeyType
+	}
+
+	did, err := domain.NewWebDID(hostURL, uuid.New().String())
+	if err != nil {
+		log.Error(ctx, "building did:web", "err", err, "host", hostURL)
+		return nil, errors.Join(ErrWrongDIDMetada, err)
+	}
+
+	key, err := i.kms.CreateKey(didOptions.KeyType, did)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := i.kms.PublicKey(key)
+	if err != nil {
+		log.Error(ctx, "getting public key", "err", err)
+		return nil, err
+	}
+
+	identity := &domain.Identity{
+		Identifier:  did.String(),
+		KeyType:     string(didOptions.KeyType),
+		DisplayName: didOptions.DisplayName,
+	}
+	if key.Type == kms.KeyTypeEthereum {
+		ecdsaPubKey, err := ethPubKey(ctx, i.kms, key)
+		if err != nil {
+			return nil, err
+		}
+		identity.Address = common.ToPointer(crypto.PubkeyToAddress(*ecdsaPubKey).Hex())
+	}
+
+	if err = i.identityRepository.Save(ctx, tx, identity); err != nil {
+		if errors.Is(err, repositories.ErrDisplayNameDuplicated) {
+			return nil, ErrIdentityDisplayNameDuplicated
+		}
+		log.Error(ctx, "saving identity", "err", err)
+		return nil, errors.Join(err, errors.New("can't save identity"))
+	}
+
+	defaultKey := domain.NewKey(*did, hexutil.Encode(publicKey), hexutil.Encode(publicKey))
+	if _, err = i.keyRepository.Save(ctx, tx, defaultKey); err != nil {
+		log.Error(ctx, "saving default key", "err", err)
+		return nil, fmt.Errorf("can't save default key: %w", err)
+	}
+	return did, nil
+}
+
+// GetJWSVerificationMethod returns the verification method of the key that signs the credentials of a did:web identity.
+// Ed25519 keys are preferred to ETH keys.
+func (i *identity) GetJWSVerificationMethod(ctx context.Context, did w3c.DID) (string, error) {
+	if !domain.IsWebDID(did) {
+		return "", ErrNotWebIdentity
+	}
+	keyIDs, err := i.kms.KeysByIdentity(ctx, did)
+	if err != nil {
+		return "", err
+	}
+	keyID, err := jws.SigningKey(keyIDs)
+	if err != nil {
+		return "", err
+	}
+	method, err := newJWSVerificationMethod(i.kms, did, keyID)
+	if err != nil {
+		return "", err
+	}
+	return method.ID, nil
+}
+
+// SignDetachedJWS signs the payload with the key of the verification method of a did:web identity
+// and returns a JWS with a detached and unencoded payload
+func (i *identity) SignDetachedJWS(ctx context.Context, did w3c.DID, verificationMethod string, payload []byte) (string, error) {
+	if !domain.IsWebDID(did) {
+		return "", ErrNotWebIdentity
+	}
+	methods, err := jwsVerificationMethods(ctx, i.kms, did)
+	if err != nil {
+		return "", err
+	}
+	for _, method := range methods {
+		if method.ID == verificationMethod {
+			return jws.SignDetached(ctx, i.kms, method.KeyID, jws.Header{"kid": method.ID}, payload)
+		}
+	}
+	return "", ErrVerificationMethodNotFound
+}
+
+// jwsVerificationMethod is an ETH or Ed25519 key of an identity as it is listed in its DID document
+type jwsVerificationMethod struct {
+	ID    string
+	KeyID kms.KeyID
+	JWK   map[string]any
+}
+
+// newJWSVerificationMethod returns the verification method of the key. Its fragment is the JWK thumbprint of the key,
+// so the ids do not change when keys are added to the identity.
+func newJWSVerificationMethod(keyStore kms.KMSType, did w3c.DID, keyID kms.KeyID) (*jwsVerificationMethod, error) {
+	jwk, err := jws.PublicJWK(keyStore, keyID)
+	if err != nil {
+		return nil, err
+	}
+	thumbprint, err := jws.Thumbprint(jwk)
+	if err != nil {
+		return nil, err
+	}
+	return &jwsVerificationMethod{ID: did.String() + "#" + thumbprint, KeyID: keyID, JWK: jwk}, nil
+}
+
+// jwsVerificationMethods returns the ETH and Ed25519 keys of the identity
+func jwsVerificationMethods(ctx context.Context, keyStore kms.KMSType, did w3c.DID) ([]jwsVerificationMethod, error) {
+	keyIDs, err := keyStore.KeysByIdentity(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	methods := make([]jwsVerificationMethod, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		if keyID.Type != kms.KeyTypeEthereum && keyID.Type != kms.KeyTypeEd25519 {
+			continue
+		}
+		method, err := newJWSVerificationMethod(keyStore, did, keyID)
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, *method)
+	}
+	return methods, nil
+}
+
 // createIdentity - creates a new identity
 func (i *identity) createIdentity(ctx context.Context, tx db.Querier, hostURL string, didOptions *ports.DIDCreationOptions) (*w3c.DID, *big.Int, error) {
 	if didOptions == nil {
@@ -1059,6 +1267,39 @@ func (i *identity) GetStates(ctx context.Context, issuerDID w3c.DID, filter *por
 	return i.identityStateRepository.GetStates(ctx, i.storage.Pgx, issuerDID, filter)
 }
 
+// GetStateChanges returns the state and a page of the credentials and revocations it anchored
+func (i *identity) GetStateChanges(ctx context.Context, issuerDID w3c.DID, state string, filter pagination.Filter) (*domain.IdentityState, []domain.IdentityStateChange, uint, error) {
+	idState, err := i.identityStateRepository.GetByState(ctx, i.storage.Pgx, issuerDID, state)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	changes, total, err := i.identityStateRepository.GetChanges(ctx, i.storage.Pgx, issuerDID, state, filter)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return idState, changes, total, nil
+}
+
+// GetStateDiff returns the credentials and revocations anchored by the states created after fromState, up to and including toState
+func (i *identity) GetStateDiff(ctx context.Context, issuerDID w3c.DID, fromState string, toState string) (*domain.IdentityStateDiff, error) {
+	from, err := i.identityStateRepository.GetByState(ctx, i.storage.Pgx, issuerDID, fromState)
+	if err != nil {
+		return nil, err
+	}
+	to, err := i.identityStateRepository.GetByState(ctx, i.storage.Pgx, issuerDID, toState)
+	if err != nil {
+		return nil, err
+	}
+	if from.StateID > to.StateID {
+		return nil, ErrInvalidStateDiffRange
+	}
+	states, changes, err := i.identityStateRepository.GetChangesBetween(ctx, i.storage.Pgx, issuerDID, from.StateID, to.StateID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.IdentityStateDiff{From: *from, To: *to, States: states, Changes: changes}, nil
+}
+
 func (i *identity) GetUnprocessedIssuersIDs(ctx context.Context) ([]*w3c.DID, error) {
 	return i.identityRepository.GetUnprocessedIssuersIDs(ctx, i.storage.Pgx)
 }
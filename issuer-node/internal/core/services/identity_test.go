@@ -47,7 +47,7 @@ func Test_identity_CreateIdentity(t *testing.T) {
 	connectionsRepository := repositories.NewConnection()
 	keyRepository := repositories.NewKey(*storage)
 
-	claimService := NewClaim(claimsRepo, nil, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, nil, nil, cfg.UniversalLinks)
+	claimService := NewClaim(claimsRepo, nil, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, nil, nil, nil, cfg.UniversalLinks, nil)
 	keyService := NewKey(keyStore, claimService, keyRepository)
 
 	reader := common.CreateFile(t)
@@ -427,7 +427,7 @@ func Test_identity_UpdateState(t *testing.T) {
 		true,
 	)
 
-	claimsService := NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	claimsService := NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, revocationStatusResolver, NewStatusList(storage, repositories.NewStatusList(*storage), keyStore, revocationStatusResolver), mediaTypeManager, cfg.UniversalLinks, nil)
 
 	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: net, KeyType: BJJ})
 	require.NoError(t, err)
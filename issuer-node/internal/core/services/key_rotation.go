@@ -0,0 +1,450 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgtype"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrKeyRotationEmptyKeyName means that the name of the new key is missing
+	ErrKeyRotationEmptyKeyName = errors.New("the name of the new key cannot be empty")
+	// ErrKeyRotationInProgress means that the identity already has a key rotation that is not completed
+	ErrKeyRotationInProgress = errors.New("the identity has a key rotation in progress")
+	// ErrKeyRotationLocked means that the key rotation is being processed by another request or worker
+	ErrKeyRotationLocked = errors.New("the key rotation is being processed")
+	// ErrKeyRotationInvalidAuthCredential means that the credential to replace is not a non revoked auth credential of the identity
+	ErrKeyRotationInvalidAuthCredential = errors.New("the credential to replace is not a non revoked auth credential")
+	// ErrKeyRotationStateFailed means that the state with the new auth credential failed to be published
+	ErrKeyRotationStateFailed = errors.New("the state with the new auth credential failed to be published, resume the rotation to publish it again")
+)
+
+// keyRotationLockDuration is the time a step can run before the rotation can be taken by another request or worker.
+// It only matters when the process running the step dies, as the lock is released when the step finishes.
+const keyRotationLockDuration = 10 * time.Minute
+
+// KeyRotation replaces the auth credential of an issuer with a new one of a new BJJ key.
+// Each step of the rotation is stored, so a failed rotation is retried from the step that failed by Resume or ProcessPending.
+// The old auth credential is only revoked when the state that includes the new one is confirmed on chain.
+type KeyRotation struct {
+	storage         *db.Storage
+	repo            ports.KeyRotationRepository
+	keyService      ports.KeyService
+	identityService ports.IdentityService
+	claimService    ports.ClaimService
+	claimRepository ports.ClaimRepository
+	publisher       ports.Publisher
+}
+
+// NewKeyRotation returns a new key rotation service
+func NewKeyRotation(storage *db.Storage, repo ports.KeyRotationRepository, keyService ports.KeyService, identityService ports.IdentityService, claimService ports.ClaimService, claimRepository ports.ClaimRepository, publisher ports.Publisher) ports.KeyRotationService {
+	return &KeyRotation{
+		storage:         storage,
+		repo:            repo,
+		keyService:      keyService,
+		identityService: identityService,
+		claimService:    claimService,
+		claimRepository: claimRepository,
+		publisher:       publisher,
+	}
+}
+
+// Start stores a new key rotation and runs its steps until the published state has to be confirmed
+func (kr *KeyRotation) Start(ctx context.Context, req *ports.StartKeyRotationRequest) (*domain.KeyRotation, error) {
+	if strings.TrimSpace(req.KeyName) == "" {
+		return nil, ErrKeyRotationEmptyKeyName
+	}
+
+	rotations, err := kr.repo.GetAll(ctx, req.DID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rotation := range rotations {
+		if !rotation.Completed() {
+			return nil, ErrKeyRotationInProgress
+		}
+	}
+
+	key, err := kr.findKeyByName(ctx, &req.DID, req.KeyName)
+	if err != nil {
+		return nil, err
+	}
+	if key != nil {
+		return nil, ErrDuplicateKeyName
+	}
+
+	oldAuthCredential, err := kr.getAuthCredentialToReplace(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rotation := domain.NewKeyRotation(req.DID, req.KeyName, oldAuthCredential.ID, req.ResignCredentials)
+	if err := kr.repo.Save(ctx, nil, rotation); err != nil {
+		if errors.Is(err, repositories.ErrKeyRotationUnfinished) {
+			return nil, ErrKeyRotationInProgress
+		}
+		log.Error(ctx, "saving key rotation", "err", err)
+		return nil, err
+	}
+	return kr.advance(ctx, rotation.ID)
+}
+
+// GetByID returns the key rotation of the issuer
+func (kr *KeyRotation) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyRotation, error) {
+	return kr.repo.GetByID(ctx, issuerDID, id)
+}
+
+// GetAll returns the key rotations of the issuer, newest first
+func (kr *KeyRotation) GetAll(ctx context.Context, issuerDID w3c.DID) ([]*domain.KeyRotation, error) {
+	return kr.repo.GetAll(ctx, issuerDID)
+}
+
+// Resume runs the pending steps of the key rotation, starting with the one that failed or is waiting
+func (kr *KeyRotation) Resume(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyRotation, error) {
+	rotation, err := kr.repo.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if rotation.Completed() {
+		return rotation, nil
+	}
+	return kr.advance(ctx, rotation.ID)
+}
+
+// GetCredentialsToResign returns the non revoked credentials whose BJJSignatureProof2021 was created with the auth credential replaced by the rotation
+func (kr *KeyRotation) GetCredentialsToResign(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) ([]*domain.Claim, error) {
+	rotation, err := kr.repo.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	return kr.credentialsSignedWithOldKey(ctx, rotation)
+}
+
+// ProcessPending resumes all the key rotations that are not completed. It is meant to be called periodically by a worker.
+// An error processing a rotation is stored in the rotation and retried on the next call.
+// The rotations whose state failed are left for the operator to resume, as publishing it again spends gas.
+func (kr *KeyRotation) ProcessPending(ctx context.Context) error {
+	rotations, err := kr.repo.GetUnfinished(ctx)
+	if err != nil {
+		log.Error(ctx, "getting unfinished key rotations", "err", err)
+		return err
+	}
+
+	for _, rotation := range rotations {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if rotation.Step == domain.KeyRotationStepRepublishState {
+			continue
+		}
+		if _, err := kr.advance(ctx, rotation.ID); err != nil && !errors.Is(err, ErrKeyRotationLocked) {
+			log.Error(ctx, "processing key rotation", "err", err, "rotation", rotation.ID)
+		}
+	}
+	return nil
+}
+
+// advance runs the steps of the rotation until it is completed, it has to wait for the state confirmation or a step fails.
+// The rotation is locked while each step runs and saved after it, which releases the lock, so no database transaction is
+// kept open while the state is published or the credentials are signed. If another request or worker takes the rotation
+// between two steps, the progress made so far is returned.
+// The error of a failed step is stored in the rotation and the step is retried on the next call.
+func (kr *KeyRotation) advance(ctx context.Context, id uuid.UUID) (*domain.KeyRotation, error) {
+	var rotation *domain.KeyRotation
+	for rotation == nil || !rotation.Completed() {
+		locked, err := kr.repo.Lock(ctx, id, keyRotationLockDuration)
+		if err != nil {
+			if !errors.Is(err, repositories.ErrKeyRotationNotFound) {
+				return nil, err
+			}
+			if rotation != nil {
+				return rotation, nil
+			}
+			return nil, ErrKeyRotationLocked
+		}
+		rotation = locked
+
+		var waiting bool
+		var stepErr error
+		if !rotation.Completed() {
+			step := rotation.Step
+			waiting, stepErr = kr.runStep(ctx, rotation)
+			if stepErr != nil {
+				log.Warn(ctx, "key rotation step failed", "err", stepErr, "rotation", rotation.ID, "step", step)
+				rotation.Failed(stepErr)
+			}
+		}
+		if err := kr.repo.Save(ctx, nil, rotation); err != nil {
+			log.Error(ctx, "saving key rotation", "err", err, "rotation", rotation.ID)
+			return nil, err
+		}
+		if stepErr != nil || waiting {
+			break
+		}
+	}
+	if rotation.Completed() {
+		log.Info(ctx, "key rotation completed", "rotation", rotation.ID, "resignedCredentials", rotation.ResignedCredentials)
+	}
+	return rotation, nil
+}
+
+// runStep runs the current step of the rotation and moves it to the next one. It returns true if the step has to wait for the state confirmation.
+// Every step can be run again after a failure, even if the failure happened after the step did its job.
+func (kr *KeyRotation) runStep(ctx context.Context, rotation *domain.KeyRotation) (bool, error) {
+	did := rotation.IssuerCoreDID()
+	switch rotation.Step {
+	case domain.KeyRotationStepCreateKey:
+		keyID, err := kr.createKey(ctx, did, rotation.KeyName)
+		if err != nil {
+			return false, err
+		}
+		rotation.NewKeyID = common.ToPointer(keyID)
+		rotation.Next(domain.KeyRotationStepCreateAuthCredential)
+	case domain.KeyRotationStepCreateAuthCredential:
+		authCredentialID, err := kr.createAuthCredential(ctx, rotation)
+		if err != nil {
+			return false, err
+		}
+		rotation.NewAuthCredentialID = common.ToPointer(authCredentialID)
+		rotation.Next(domain.KeyRotationStepPublishState)
+	case domain.KeyRotationStepPublishState:
+		state, err := kr.publishState(ctx, rotation)
+		if err != nil {
+			return false, err
+		}
+		rotation.PublishedState = common.ToPointer(state)
+		rotation.Next(domain.KeyRotationStepWaitConfirmation)
+	case domain.KeyRotationStepWaitConfirmation:
+		authCredential, err := kr.claimService.GetByID(ctx, did, *rotation.NewAuthCredentialID)
+		if err != nil {
+			return false, err
+		}
+		// The MTP proof of the auth credential is set when the state that includes it is confirmed
+		if authCredential.MTPProof.Status == pgtype.Present {
+			rotation.Next(domain.KeyRotationStepRevokeAuthCredential)
+			break
+		}
+		failed, err := kr.publishedStateFailed(ctx, rotation)
+		if err != nil {
+			return false, err
+		}
+		if failed == nil {
+			return true, nil
+		}
+		rotation.Next(domain.KeyRotationStepRepublishState)
+		if failed.FailureReason != nil {
+			return false, fmt.Errorf("%w: %s", ErrKeyRotationStateFailed, *failed.FailureReason)
+		}
+		return false, ErrKeyRotationStateFailed
+	case domain.KeyRotationStepRepublishState:
+		// the failed state may have been published again with the retry endpoint in the meantime
+		failed, err := kr.publishedStateFailed(ctx, rotation)
+		if err != nil {
+			return false, err
+		}
+		if failed != nil {
+			published, err := kr.publisher.RetryPublishState(ctx, did)
+			if err != nil {
+				return false, err
+			}
+			if published.State != nil {
+				rotation.PublishedState = published.State
+			}
+		}
+		rotation.Next(domain.KeyRotationStepWaitConfirmation)
+	case domain.KeyRotationStepRevokeAuthCredential:
+		oldAuthCredential, err := kr.claimService.GetByID(ctx, did, rotation.OldAuthCredentialID)
+		if err != nil {
+			return false, err
+		}
+		if !oldAuthCredential.Revoked {
+			description := fmt.Sprintf("replaced by auth credential %s", *rotation.NewAuthCredentialID)
+			if err := kr.claimService.Revoke(ctx, *did, uint64(oldAuthCredential.RevNonce), description); err != nil {
+				return false, err
+			}
+		}
+		rotation.Next(domain.KeyRotationStepResignCredentials)
+	case domain.KeyRotationStepResignCredentials:
+		if rotation.ResignCredentials {
+			if err := kr.resignCredentials(ctx, rotation); err != nil {
+				return false, err
+			}
+		}
+		rotation.Next(domain.KeyRotationStepCompleted)
+	default:
+		return false, fmt.Errorf("unknown key rotation step %s", rotation.Step)
+	}
+	return false, nil
+}
+
+// createKey creates the new BJJ key. If a key with the same name exists it was created by a previous run of the step.
+func (kr *KeyRotation) createKey(ctx context.Context, did *w3c.DID, name string) (string, error) {
+	key, err := kr.findKeyByName(ctx, did, name)
+	if err != nil {
+		return "", err
+	}
+	if key != nil {
+		return key.KeyID, nil
+	}
+	if _, err := kr.keyService.Create(ctx, did, kms.KeyTypeBabyJubJub, name); err != nil {
+		return "", err
+	}
+	key, err = kr.findKeyByName(ctx, did, name)
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		return "", ErrKeyNotFound
+	}
+	return key.KeyID, nil
+}
+
+// createAuthCredential creates the auth credential of the new key, with the credential status type of the replaced one.
+// If the key already has an auth credential it was created by a previous run of the step.
+func (kr *KeyRotation) createAuthCredential(ctx context.Context, rotation *domain.KeyRotation) (uuid.UUID, error) {
+	did := rotation.IssuerCoreDID()
+	key, err := kr.keyService.Get(ctx, did, *rotation.NewKeyID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if key.HasAssociatedAuthCredential {
+		publicKey, err := hexutil.Decode(key.PublicKey)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		authCredential, err := kr.claimService.GetAuthCredentialByPublicKey(ctx, did, publicKey)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if authCredential != nil {
+			return authCredential.ID, nil
+		}
+	}
+
+	oldAuthCredential, err := kr.claimService.GetByID(ctx, did, rotation.OldAuthCredentialID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	credentialStatus, err := oldAuthCredential.GetCredentialStatus()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return kr.identityService.CreateAuthCredential(ctx, did, *rotation.NewKeyID, nil, nil, nil, credentialStatus.Type)
+}
+
+// publishState publishes the state that includes the new auth credential and returns it.
+// When the publisher has nothing to publish or is busy, the auth credential may already be in a state published by someone else.
+func (kr *KeyRotation) publishState(ctx context.Context, rotation *domain.KeyRotation) (string, error) {
+	did := rotation.IssuerCoreDID()
+	published, publishErr := kr.publisher.PublishState(ctx, did)
+	if publishErr == nil && published.State != nil {
+		return *published.State, nil
+	}
+
+	authCredential, err := kr.claimService.GetByID(ctx, did, *rotation.NewAuthCredentialID)
+	if err != nil {
+		return "", err
+	}
+	if authCredential.IdentityState != nil {
+		return *authCredential.IdentityState, nil
+	}
+	if publishErr != nil {
+		return "", publishErr
+	}
+	return "", errors.New("the published state does not include the new auth credential")
+}
+
+// publishedStateFailed returns the state published by the rotation if it failed, or nil if it is still pending or was published again
+func (kr *KeyRotation) publishedStateFailed(ctx context.Context, rotation *domain.KeyRotation) (*domain.IdentityState, error) {
+	failed, err := kr.identityService.GetFailedState(ctx, *rotation.IssuerCoreDID())
+	if err != nil {
+		return nil, err
+	}
+	if failed == nil || failed.State == nil || rotation.PublishedState == nil || *failed.State != *rotation.PublishedState {
+		return nil, nil
+	}
+	return failed, nil
+}
+
+// resignCredentials signs again the credentials signed with the replaced auth credential with the new one.
+// The counter of the rotation is updated even if a credential fails, so the ones already signed are not lost.
+func (kr *KeyRotation) resignCredentials(ctx context.Context, rotation *domain.KeyRotation) error {
+	credentials, err := kr.credentialsSignedWithOldKey(ctx, rotation)
+	if err != nil {
+		return err
+	}
+	for _, credential := range credentials {
+		if _, err := kr.claimService.Resign(ctx, rotation.IssuerCoreDID(), credential.ID, *rotation.NewAuthCredentialID); err != nil {
+			return fmt.Errorf("signing credential %s: %w", credential.ID, err)
+		}
+		rotation.ResignedCredentials++
+	}
+	return nil
+}
+
+func (kr *KeyRotation) credentialsSignedWithOldKey(ctx context.Context, rotation *domain.KeyRotation) ([]*domain.Claim, error) {
+	oldAuthCredential, err := kr.claimService.GetByID(ctx, rotation.IssuerCoreDID(), rotation.OldAuthCredentialID)
+	if err != nil {
+		return nil, err
+	}
+	authCoreClaim, err := oldAuthCredential.CoreClaim.Get().Hex()
+	if err != nil {
+		return nil, err
+	}
+	return kr.claimRepository.GetSignedWithAuthClaim(ctx, kr.storage.Pgx, *rotation.IssuerCoreDID(), authCoreClaim)
+}
+
+// getAuthCredentialToReplace returns the auth credential of the request or, if none is given, the current auth credential of the identity
+func (kr *KeyRotation) getAuthCredentialToReplace(ctx context.Context, req *ports.StartKeyRotationRequest) (*domain.Claim, error) {
+	if req.AuthCredentialID == nil {
+		authCredential, err := kr.claimService.GetAuthClaim(ctx, &req.DID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrClaimDoesNotExist) {
+				return nil, ErrKeyRotationInvalidAuthCredential
+			}
+			return nil, err
+		}
+		return authCredential, nil
+	}
+
+	authCredential, err := kr.claimService.GetByID(ctx, &req.DID, *req.AuthCredentialID)
+	if err != nil {
+		if errors.Is(err, ErrCredentialNotFound) {
+			return nil, ErrKeyRotationInvalidAuthCredential
+		}
+		return nil, err
+	}
+	if authCredential.SchemaType != domain.AuthBJJCredentialSchemaType || authCredential.Revoked {
+		return nil, ErrKeyRotationInvalidAuthCredential
+	}
+	return authCredential, nil
+}
+
+func (kr *KeyRotation) findKeyByName(ctx context.Context, did *w3c.DID, name string) (*ports.KMSKey, error) {
+	keys, _, err := kr.keyService.GetAll(ctx, did, ports.KeyFilter{MaxResults: math.MaxInt32, Page: 1, KeyType: common.ToPointer(kms.KeyTypeBabyJubJub)})
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.Name == name {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
@@ -90,6 +90,7 @@ func (ls *Link) Save(
 	credentialSubject domain.CredentialSubject,
 	refreshService *verifiable.RefreshService,
 	displayMethod *verifiable.DisplayMethod,
+	credentialEncryption *domain.CredentialEncryptionPolicy,
 ) (*domain.Link, error) {
 	schemaDB, err := ls.schemaRepository.GetByID(ctx, did, schemaID)
 	if err != nil {
@@ -110,6 +111,14 @@ func (ls *Link) Save(
 	}
 
 	link := domain.NewLink(did, maxIssuance, validUntil, schemaID, credentialExpiration, credentialSignatureProof, credentialMTPProof, credentialSubject, refreshService, displayMethod)
+	// links without an explicit encryption policy inherit the policy of the schema
+	link.CredentialEncryption = schemaDB.CredentialEncryption.OrDefault()
+	if credentialEncryption != nil {
+		if err := credentialEncryption.Validate(); err != nil {
+			return nil, err
+		}
+		link.CredentialEncryption = credentialEncryption.OrDefault()
+	}
 	_, err = ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
 	if err != nil {
 		return nil, err
@@ -291,6 +300,7 @@ func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDI
 			link.DisplayMethod,
 			nil,
 		)
+		claimReq.EncryptionPolicy = link.CredentialEncryption
 
 		credentialIssued, err = ls.claimsService.CreateCredential(ctx, claimReq)
 		if err != nil {
@@ -314,6 +324,7 @@ func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDI
 				return nil
 			})
 		if err != nil {
+			ls.claimsService.ReleaseCredentialStatus(ctx, credentialIssued)
 			return nil, err
 		}
 	} else {
@@ -148,7 +148,7 @@ func TestMain(m *testing.M) {
 	)
 	schemaLoader := loader.NewDocumentLoader(ipfsGatewayURL, false)
 	identityService = NewIdentity(keyStore, identityRepository, idenMerkleTreeRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionRepository, s, nil, sessionsRepository, pubSub, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService = NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	claimsService = NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, NewStatusList(storage, repositories.NewStatusList(*storage), keyStore, revocationStatusResolver), mediaTypeManager, cfg.UniversalLinks, nil)
 
 	m.Run()
 }
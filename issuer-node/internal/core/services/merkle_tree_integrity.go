@@ -0,0 +1,498 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-merkletree-sql/v2/db/memory"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrMerkleTreeIntegrityWebIdentity means that the identity is a did:web identity, that has no merkle trees
+	ErrMerkleTreeIntegrityWebIdentity = errors.New("did:web identities have no merkle trees")
+	// ErrMerkleTreeRepairDiverged means that the proofs cannot be regenerated because the trees do not match the confirmed state
+	ErrMerkleTreeRepairDiverged = errors.New("the claims tree recomputed for the last confirmed state does not match it, the proofs cannot be regenerated")
+)
+
+// MerkleTreeIntegrity rebuilds the claims, revocations and roots trees of an identity from the claims and revocation tables,
+// and compares them with the trees stored in the database, the stored states and the state published on chain.
+type MerkleTreeIntegrity struct {
+	storage                 *db.Storage
+	mtService               ports.MtService
+	identityStateRepository ports.IdentityStateRepository
+	claimRepository         ports.ClaimRepository
+	revocationRepository    ports.RevocationRepository
+	networkResolver         network.Resolver
+}
+
+// NewMerkleTreeIntegrity returns a new merkle tree integrity service
+func NewMerkleTreeIntegrity(storage *db.Storage, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, claimRepository ports.ClaimRepository, revocationRepository ports.RevocationRepository, networkResolver network.Resolver) ports.MerkleTreeIntegrityService {
+	return &MerkleTreeIntegrity{
+		storage:                 storage,
+		mtService:               mtService,
+		identityStateRepository: identityStateRepository,
+		claimRepository:         claimRepository,
+		revocationRepository:    revocationRepository,
+		networkResolver:         networkResolver,
+	}
+}
+
+// rebuiltTrees are the trees of an identity rebuilt in memory
+type rebuiltTrees struct {
+	claims *merkletree.MerkleTree
+	roots  *merkletree.MerkleTree
+	// claimsRoots is the claims tree root recomputed for each state
+	claimsRoots map[string]*merkletree.Hash
+	// publishedRevocationsRoot is the revocations tree root with the revocations already included in a state
+	publishedRevocationsRoot *merkletree.Hash
+	// revocationsRoot is the revocations tree root with all the revocations, as the tree stored in the database
+	revocationsRoot *merkletree.Hash
+}
+
+// Check rebuilds the trees of the identity and reports the roots that diverge and the credentials with stale merkle tree proofs.
+// With repair, the stale proofs of the credentials included in the last confirmed state are regenerated for that state.
+func (m *MerkleTreeIntegrity) Check(ctx context.Context, did w3c.DID, repair bool) (*domain.MerkleTreeIntegrityReport, error) {
+	if domain.IsWebDID(did) {
+		return nil, ErrMerkleTreeIntegrityWebIdentity
+	}
+
+	states, err := m.identityStateRepository.GetAllByIdentifier(ctx, m.storage.Pgx, did)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, repositories.ErrIdentityNotFound
+	}
+	claims, err := m.claimRepository.GetAllInMerkleTree(ctx, m.storage.Pgx, did)
+	if err != nil {
+		return nil, err
+	}
+	revocations, err := m.revocationRepository.GetAll(ctx, m.storage.Pgx, &did)
+	if err != nil {
+		return nil, err
+	}
+
+	rebuilt, err := rebuildIdentityTrees(ctx, states, claims, revocations)
+	if err != nil {
+		return nil, fmt.Errorf("rebuilding merkle trees: %w", err)
+	}
+
+	latest := states[len(states)-1]
+	report := &domain.MerkleTreeIntegrityReport{
+		Identifier:     did.String(),
+		CheckedAt:      time.Now(),
+		LatestState:    latest.State,
+		Divergences:    make([]domain.MerkleTreeDivergence, 0),
+		StaleProofs:    make([]domain.StaleMTPProof, 0),
+		RepairedProofs: make([]uuid.UUID, 0),
+	}
+
+	if err := m.checkDatabaseTrees(ctx, did, rebuilt, report); err != nil {
+		return nil, err
+	}
+	if err := checkStates(states, rebuilt, report); err != nil {
+		return nil, err
+	}
+
+	var confirmed *domain.IdentityState
+	for i := range states {
+		if states[i].Status == domain.StatusConfirmed {
+			confirmed = &states[i]
+		}
+	}
+	if confirmed != nil {
+		report.ConfirmedState = confirmed.State
+		m.checkOnChainState(ctx, did, states, *confirmed, report)
+	}
+
+	stale, err := staleMTPProofs(states, claims)
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range stale {
+		report.StaleProofs = append(report.StaleProofs, domain.StaleMTPProof{ClaimID: claim.claim.ID, State: claim.claim.IdentityState, Reason: claim.reason})
+	}
+
+	log.Info(ctx, "merkle trees checked", "did", did, "divergences", len(report.Divergences), "staleProofs", len(report.StaleProofs))
+	if !repair || len(stale) == 0 {
+		return report, nil
+	}
+	if confirmed == nil || confirmed.ClaimsTreeRoot == nil || confirmed.State == nil {
+		return nil, ErrMerkleTreeRepairDiverged
+	}
+	if recomputed, ok := rebuilt.claimsRoots[*confirmed.State]; !ok || recomputed.Hex() != *confirmed.ClaimsTreeRoot {
+		return nil, ErrMerkleTreeRepairDiverged
+	}
+	for _, divergence := range report.Divergences {
+		if divergence.Source == domain.MerkleTreeIntegritySourceOnChain {
+			return nil, ErrMerkleTreeRepairDiverged
+		}
+	}
+
+	repaired, err := m.repairMTPProofs(ctx, did, states, *confirmed, rebuilt, stale)
+	if err != nil {
+		return nil, err
+	}
+	report.RepairedProofs = repaired
+	log.Info(ctx, "merkle tree proofs regenerated", "did", did, "proofs", len(repaired))
+	return report, nil
+}
+
+// rebuildIdentityTrees replays the states of the identity in order, adding to the claims tree the credentials of each state,
+// and to the roots tree the claims tree root of each state after the genesis one, as UpdateState does.
+func rebuildIdentityTrees(ctx context.Context, states []domain.IdentityState, claims []*domain.Claim, revocations []*domain.Revocation) (*rebuiltTrees, error) {
+	newTree := func() (*merkletree.MerkleTree, error) {
+		return merkletree.NewMerkleTree(ctx, memory.NewMemoryStorage(), mtDepth)
+	}
+	claimsTree, err := newTree()
+	if err != nil {
+		return nil, err
+	}
+	revocationsTree, err := newTree()
+	if err != nil {
+		return nil, err
+	}
+	rootsTree, err := newTree()
+	if err != nil {
+		return nil, err
+	}
+
+	claimsByState := make(map[string][]*domain.Claim)
+	for _, claim := range claims {
+		claimsByState[*claim.IdentityState] = append(claimsByState[*claim.IdentityState], claim)
+	}
+	addClaims := func(claims []*domain.Claim) error {
+		for _, claim := range claims {
+			hi, hv, err := claim.CoreClaim.Get().HiHv()
+			if err != nil {
+				return err
+			}
+			if err := claimsTree.Add(ctx, hi, hv); err != nil {
+				return fmt.Errorf("adding credential %s: %w", claim.ID, err)
+			}
+		}
+		return nil
+	}
+
+	rebuilt := &rebuiltTrees{claims: claimsTree, roots: rootsTree, claimsRoots: make(map[string]*merkletree.Hash, len(states))}
+	for _, state := range states {
+		if state.State == nil {
+			continue
+		}
+		if err := addClaims(claimsByState[*state.State]); err != nil {
+			return nil, err
+		}
+		delete(claimsByState, *state.State)
+		if state.PreviousState != nil {
+			if _, _, _, err := rootsTree.Get(ctx, claimsTree.Root().BigInt()); errors.Is(err, merkletree.ErrKeyNotFound) {
+				if err := rootsTree.Add(ctx, claimsTree.Root().BigInt(), big.NewInt(0)); err != nil {
+					return nil, err
+				}
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		rebuilt.claimsRoots[*state.State] = claimsTree.Root()
+	}
+	// credentials of states that no longer exist are still in the tree stored in the database
+	for _, claims := range claimsByState {
+		if err := addClaims(claims); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, status := range []domain.RevStatus{domain.RevPublished, domain.RevPending} {
+		for _, revocation := range revocations {
+			if revocation.Status != status {
+				continue
+			}
+			if err := revocationsTree.Add(ctx, new(big.Int).SetUint64(uint64(revocation.Nonce)), big.NewInt(0)); err != nil {
+				return nil, fmt.Errorf("adding revocation nonce %d: %w", revocation.Nonce, err)
+			}
+		}
+		if status == domain.RevPublished {
+			rebuilt.publishedRevocationsRoot = revocationsTree.Root()
+		}
+	}
+	rebuilt.revocationsRoot = revocationsTree.Root()
+	return rebuilt, nil
+}
+
+// checkDatabaseTrees compares the roots of the trees stored in the database with the rebuilt ones
+func (m *MerkleTreeIntegrity) checkDatabaseTrees(ctx context.Context, did w3c.DID, rebuilt *rebuiltTrees, report *domain.MerkleTreeIntegrityReport) error {
+	trees, err := m.mtService.GetIdentityMerkleTrees(ctx, m.storage.Pgx, &did)
+	if err != nil {
+		return err
+	}
+	for _, tree := range []struct {
+		tree       domain.MerkleTreeIntegrityTree
+		mtType     int
+		recomputed *merkletree.Hash
+	}{
+		{domain.MerkleTreeIntegrityTreeClaims, domain.MerkleTreeTypeClaims, rebuilt.claims.Root()},
+		{domain.MerkleTreeIntegrityTreeRevocations, domain.MerkleTreeTypeRevocations, rebuilt.revocationsRoot},
+		{domain.MerkleTreeIntegrityTreeRoots, domain.MerkleTreeTypeRoots, rebuilt.roots.Root()},
+	} {
+		stored := trees.Trees[tree.mtType].Root()
+		if !stored.Equals(tree.recomputed) {
+			report.Divergences = append(report.Divergences, domain.MerkleTreeDivergence{
+				Source:   domain.MerkleTreeIntegritySourceDatabase,
+				Tree:     tree.tree,
+				Expected: stored.Hex(),
+				Actual:   tree.recomputed.Hex(),
+			})
+		}
+	}
+	return nil
+}
+
+// checkStates compares the claims tree root of every state, and the three roots and the hash of the latest state, with the rebuilt ones
+func checkStates(states []domain.IdentityState, rebuilt *rebuiltTrees, report *domain.MerkleTreeIntegrityReport) error {
+	addDivergence := func(state domain.IdentityState, tree domain.MerkleTreeIntegrityTree, stored *string, recomputed *merkletree.Hash) {
+		if stored != nil && *stored != recomputed.Hex() {
+			report.Divergences = append(report.Divergences, domain.MerkleTreeDivergence{
+				Source:   domain.MerkleTreeIntegritySourceState,
+				Tree:     tree,
+				State:    state.State,
+				Expected: *stored,
+				Actual:   recomputed.Hex(),
+			})
+		}
+	}
+
+	for _, state := range states {
+		if state.State != nil {
+			addDivergence(state, domain.MerkleTreeIntegrityTreeClaims, state.ClaimsTreeRoot, rebuilt.claimsRoots[*state.State])
+		}
+	}
+
+	latest := states[len(states)-1]
+	claimsRoot := rebuilt.claims.Root()
+	if latest.State != nil {
+		claimsRoot = rebuilt.claimsRoots[*latest.State]
+	}
+	recomputedState, err := merkletree.HashElems(claimsRoot.BigInt(), rebuilt.publishedRevocationsRoot.BigInt(), rebuilt.roots.Root().BigInt())
+	if err != nil {
+		return err
+	}
+	report.RecomputedState = recomputedState.Hex()
+	addDivergence(latest, domain.MerkleTreeIntegrityTreeRevocations, latest.RevocationTreeRoot, rebuilt.publishedRevocationsRoot)
+	addDivergence(latest, domain.MerkleTreeIntegrityTreeRoots, latest.RootOfRoots, rebuilt.roots.Root())
+	// the genesis state of the identities created from an ethereum address is not the hash of its trees
+	if latest.ClaimsTreeRoot != nil {
+		addDivergence(latest, domain.MerkleTreeIntegrityTreeState, latest.State, recomputedState)
+	}
+	return nil
+}
+
+// checkOnChainState compares the state published in the state contract with the last confirmed state.
+// The genesis state is not published, and a state published that is still waiting for confirmation is not a divergence.
+func (m *MerkleTreeIntegrity) checkOnChainState(ctx context.Context, did w3c.DID, states []domain.IdentityState, confirmed domain.IdentityState, report *domain.MerkleTreeIntegrityReport) {
+	if confirmed.PreviousState == nil || confirmed.State == nil {
+		return
+	}
+	onChain, err := m.onChainState(ctx, did)
+	if err != nil {
+		log.Warn(ctx, "getting the on chain state", "err", err, "did", did)
+		report.OnChainError = common.ToPointer(err.Error())
+		return
+	}
+	report.OnChainState = &onChain
+	if onChain == *confirmed.State {
+		return
+	}
+	for _, state := range states {
+		if state.State != nil && *state.State == onChain && state.Status == domain.StatusTransacted {
+			return
+		}
+	}
+	report.Divergences = append(report.Divergences, domain.MerkleTreeDivergence{
+		Source:   domain.MerkleTreeIntegritySourceOnChain,
+		Tree:     domain.MerkleTreeIntegrityTreeState,
+		State:    confirmed.State,
+		Expected: onChain,
+		Actual:   *confirmed.State,
+	})
+}
+
+func (m *MerkleTreeIntegrity) onChainState(ctx context.Context, did w3c.DID) (string, error) {
+	resolverPrefix, err := common.ResolverPrefix(&did)
+	if err != nil {
+		return "", err
+	}
+	client, err := m.networkResolver.GetEthClient(resolverPrefix)
+	if err != nil {
+		return "", err
+	}
+	addr, err := m.networkResolver.GetContractAddress(resolverPrefix)
+	if err != nil {
+		return "", err
+	}
+	id, err := core.IDFromDID(did)
+	if err != nil {
+		return "", err
+	}
+	info, err := client.GetLatestStateByID(ctx, *addr, id.BigInt())
+	if err != nil {
+		return "", err
+	}
+	state, err := merkletree.NewHashFromBigInt(info.State)
+	if err != nil {
+		return "", err
+	}
+	return state.Hex(), nil
+}
+
+type staleMTPProof struct {
+	claim  *domain.Claim
+	reason domain.StaleMTPProofReason
+}
+
+// staleMTPProofs returns the credentials of confirmed states without a proof, or with a proof that is not for a confirmed state
+// or that does not verify against the claims tree root of its state. The credentials of states not confirmed yet get their proofs
+// when the state is confirmed, so they are not checked.
+func staleMTPProofs(states []domain.IdentityState, claims []*domain.Claim) ([]staleMTPProof, error) {
+	statesByHash := make(map[string]domain.IdentityState, len(states))
+	for _, state := range states {
+		if state.State != nil {
+			statesByHash[*state.State] = state
+		}
+	}
+
+	stale := make([]staleMTPProof, 0)
+	for _, claim := range claims {
+		if state, ok := statesByHash[*claim.IdentityState]; !ok || state.Status != domain.StatusConfirmed {
+			continue
+		}
+		if claim.MTPProof.Status != pgtype.Present {
+			stale = append(stale, staleMTPProof{claim: claim, reason: domain.StaleMTPProofMissing})
+			continue
+		}
+		var proof verifiable.Iden3SparseMerkleTreeProof
+		if err := json.Unmarshal(claim.MTPProof.Bytes, &proof); err != nil || proof.MTP == nil || proof.IssuerData.State.Value == nil {
+			stale = append(stale, staleMTPProof{claim: claim, reason: domain.StaleMTPProofInvalid})
+			continue
+		}
+		state, ok := statesByHash[*proof.IssuerData.State.Value]
+		if !ok || state.Status != domain.StatusConfirmed {
+			stale = append(stale, staleMTPProof{claim: claim, reason: domain.StaleMTPProofUnconfirmedState})
+			continue
+		}
+		valid, err := verifyMTPProof(claim, proof, state)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			stale = append(stale, staleMTPProof{claim: claim, reason: domain.StaleMTPProofInvalid})
+		}
+	}
+	return stale, nil
+}
+
+// verifyMTPProof checks that the proof is for the claims tree root of the state and that it proves the credential is in the tree
+func verifyMTPProof(claim *domain.Claim, proof verifiable.Iden3SparseMerkleTreeProof, state domain.IdentityState) (bool, error) {
+	if state.ClaimsTreeRoot == nil || proof.IssuerData.State.ClaimsTreeRoot == nil || *proof.IssuerData.State.ClaimsTreeRoot != *state.ClaimsTreeRoot {
+		return false, nil
+	}
+	root, err := merkletree.NewHashFromHex(*state.ClaimsTreeRoot)
+	if err != nil {
+		return false, err
+	}
+	hi, hv, err := claim.CoreClaim.Get().HiHv()
+	if err != nil {
+		return false, err
+	}
+	return proof.MTP.Existence && merkletree.VerifyProof(root, proof.MTP, hi, hv), nil
+}
+
+// repairMTPProofs regenerates, for the last confirmed state, the proofs of the stale credentials included in it
+func (m *MerkleTreeIntegrity) repairMTPProofs(ctx context.Context, did w3c.DID, states []domain.IdentityState, confirmed domain.IdentityState, rebuilt *rebuiltTrees, stale []staleMTPProof) ([]uuid.UUID, error) {
+	included := make(map[string]bool, len(states))
+	for _, state := range states {
+		if state.State != nil && state.StateID <= confirmed.StateID {
+			included[*state.State] = true
+		}
+	}
+	root, err := merkletree.NewHashFromHex(*confirmed.ClaimsTreeRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	repaired := make([]uuid.UUID, 0, len(stale))
+	err = m.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, item := range stale {
+			claim := item.claim
+			if !included[*claim.IdentityState] {
+				continue
+			}
+			coreClaim := claim.CoreClaim.Get()
+			hi, err := coreClaim.HIndex()
+			if err != nil {
+				return err
+			}
+			proof, _, err := rebuilt.claims.GenerateProof(ctx, hi, root)
+			if err != nil {
+				return fmt.Errorf("generating proof of credential %s: %w", claim.ID, err)
+			}
+			coreClaimHex, err := coreClaim.Hex()
+			if err != nil {
+				return err
+			}
+			jsonProof, err := json.Marshal(verifiable.Iden3SparseMerkleTreeProof{
+				Type: verifiable.Iden3SparseMerkleTreeProofType,
+				IssuerData: verifiable.IssuerData{
+					ID: did.String(),
+					State: verifiable.State{
+						RootOfRoots:        confirmed.RootOfRoots,
+						ClaimsTreeRoot:     confirmed.ClaimsTreeRoot,
+						RevocationTreeRoot: confirmed.RevocationTreeRoot,
+						Value:              confirmed.State,
+						BlockTimestamp:     confirmed.BlockTimestamp,
+						TxID:               confirmed.TxID,
+						BlockNumber:        confirmed.BlockNumber,
+					},
+				},
+				CoreClaim: coreClaimHex,
+				MTP:       proof,
+			})
+			if err != nil {
+				return fmt.Errorf("can't marshal proof: %w", err)
+			}
+			if err := claim.MTPProof.Set(jsonProof); err != nil {
+				return fmt.Errorf("failed set mtp proof: %w", err)
+			}
+			affected, err := m.claimRepository.UpdateClaimMTP(ctx, tx, claim)
+			if err != nil {
+				return fmt.Errorf("can't update claim mtp: %w", err)
+			}
+			if affected == 0 {
+				return fmt.Errorf("claim has not been updated %s", claim.ID)
+			}
+			repaired = append(repaired, claim.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repaired, nil
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// staleNonceTimeout is the time after which an allocated nonce that is not in the mempool of the node is given to another transaction
+const staleNonceTimeout = 10 * time.Minute
+
+// NonceAllocator allocates the nonces of the publishing accounts in the database, so the issuer node and the
+// pending publisher do not send two transactions with the same nonce.
+type NonceAllocator struct {
+	storage    *db.Storage
+	repository ports.PublishingNonceRepository
+}
+
+// NewNonceAllocator returns a new nonce allocator
+func NewNonceAllocator(storage *db.Storage, repository ports.PublishingNonceRepository) ports.NonceAllocator {
+	return &NonceAllocator{
+		storage:    storage,
+		repository: repository,
+	}
+}
+
+// Allocate returns the nonce of the next transaction of the account. confirmedNonce and pendingNonce are the nonces reported
+// by the node, without and with the transactions of the mempool. The nonces of transactions that failed to be sent, or that
+// were dropped from the mempool, are allocated again first, so they do not leave a gap that blocks the next transactions.
+func (n *NonceAllocator) Allocate(ctx context.Context, chainID int64, address ethCommon.Address, confirmedNonce uint64, pendingNonce uint64) (uint64, error) {
+	var nonce uint64
+	err := n.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		nextNonce, err := n.repository.LockAccount(ctx, tx, chainID, address.Hex())
+		if err != nil {
+			return err
+		}
+		if err := n.repository.DeleteMined(ctx, tx, chainID, address.Hex(), confirmedNonce); err != nil {
+			return err
+		}
+
+		reusable, err := n.repository.GetReusable(ctx, tx, chainID, address.Hex(), pendingNonce, time.Now().Add(-staleNonceTimeout))
+		switch {
+		case err == nil:
+			log.Info(ctx, "reusing nonce", "address", address.Hex(), "nonce", reusable.Nonce, "status", reusable.Status)
+			nonce = reusable.Nonce
+		case errors.Is(err, repositories.ErrPublishingNonceNotFound):
+			// the node may know transactions sent with the account by other means
+			nonce = max(nextNonce, pendingNonce)
+			if err := n.repository.SetNextNonce(ctx, tx, chainID, address.Hex(), nonce+1); err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+
+		return n.repository.Save(ctx, tx, domain.PublishingNonce{
+			ChainID: chainID,
+			Address: address.Hex(),
+			Nonce:   nonce,
+			Status:  domain.PublishingNonceAllocated,
+		})
+	})
+	if err != nil {
+		log.Error(ctx, "allocating nonce", "err", err, "address", address.Hex())
+		return 0, err
+	}
+	return nonce, nil
+}
+
+// MarkSent records the transaction sent with the nonce
+func (n *NonceAllocator) MarkSent(ctx context.Context, chainID int64, address ethCommon.Address, nonce uint64, txID string) error {
+	return n.repository.Save(ctx, n.storage.Pgx, domain.PublishingNonce{
+		ChainID: chainID,
+		Address: address.Hex(),
+		Nonce:   nonce,
+		Status:  domain.PublishingNonceSent,
+		TxID:    &txID,
+	})
+}
+
+// Release frees the nonce of a transaction that could not be sent, so it is allocated to the next one
+func (n *NonceAllocator) Release(ctx context.Context, chainID int64, address ethCommon.Address, nonce uint64) error {
+	return n.repository.Save(ctx, n.storage.Pgx, domain.PublishingNonce{
+		ChainID: chainID,
+		Address: address.Hex(),
+		Nonce:   nonce,
+		Status:  domain.PublishingNonceReleased,
+	})
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+func TestNonceAllocator(t *testing.T) {
+	ctx := context.Background()
+	allocator := NewNonceAllocator(storage, repositories.NewPublishingNonce())
+	const chainID = 80002
+	newAddress := func() ethCommon.Address {
+		id := uuid.New()
+		return ethCommon.BytesToAddress(id[:])
+	}
+
+	t.Run("consecutive nonces", func(t *testing.T) {
+		address := newAddress()
+		for expected := uint64(0); expected < 3; expected++ {
+			nonce, err := allocator.Allocate(ctx, chainID, address, 0, 0)
+			require.NoError(t, err)
+			assert.Equal(t, expected, nonce)
+		}
+	})
+
+	t.Run("starts at the pending nonce of the node", func(t *testing.T) {
+		address := newAddress()
+		nonce, err := allocator.Allocate(ctx, chainID, address, 5, 7)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), nonce)
+		nonce, err = allocator.Allocate(ctx, chainID, address, 5, 7)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), nonce)
+	})
+
+	t.Run("released nonce is allocated again", func(t *testing.T) {
+		address := newAddress()
+		first, err := allocator.Allocate(ctx, chainID, address, 0, 0)
+		require.NoError(t, err)
+		second, err := allocator.Allocate(ctx, chainID, address, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, allocator.MarkSent(ctx, chainID, address, second, "0x01"))
+		require.NoError(t, allocator.Release(ctx, chainID, address, first))
+
+		nonce, err := allocator.Allocate(ctx, chainID, address, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, first, nonce)
+		nonce, err = allocator.Allocate(ctx, chainID, address, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, second+1, nonce)
+	})
+
+	t.Run("released nonce already used is not allocated again", func(t *testing.T) {
+		address := newAddress()
+		first, err := allocator.Allocate(ctx, chainID, address, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, allocator.Release(ctx, chainID, address, first))
+
+		nonce, err := allocator.Allocate(ctx, chainID, address, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), nonce)
+	})
+
+	t.Run("stale nonce not in the mempool is allocated again", func(t *testing.T) {
+		address := newAddress()
+		first, err := allocator.Allocate(ctx, chainID, address, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, allocator.MarkSent(ctx, chainID, address, first, "0x02"))
+		_, err = storage.Pgx.Exec(ctx, `UPDATE publishing_nonces SET modified_at = $3 WHERE chain_id = $1 AND address = $2`,
+			chainID, address.Hex(), time.Now().Add(-2*staleNonceTimeout))
+		require.NoError(t, err)
+
+		nonce, err := allocator.Allocate(ctx, chainID, address, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, first, nonce)
+	})
+
+	t.Run("concurrent allocations get different nonces", func(t *testing.T) {
+		address := newAddress()
+		const n = 10
+		nonces := make(chan uint64, n)
+		errs := make(chan error, n)
+		for range n {
+			go func() {
+				nonce, err := allocator.Allocate(ctx, chainID, address, 0, 0)
+				errs <- err
+				nonces <- nonce
+			}()
+		}
+		seen := make(map[uint64]bool)
+		for range n {
+			require.NoError(t, <-errs)
+			nonce := <-nonces
+			assert.False(t, seen[nonce])
+			seen[nonce] = true
+		}
+		assert.Len(t, seen, n)
+	})
+}
@@ -60,6 +60,42 @@ func (n *notification) SendCreateConnectionNotification(ctx context.Context, e p
 	return n.sendCreateConnectionNotification(ctx, cEvent.IssuerID, cEvent.ConnectionID)
 }
 
+// SendExpirationNotice notifies the holder of the credential that it is about to expire
+func (n *notification) SendExpirationNotice(ctx context.Context, credential *domain.Claim) error {
+	issuerDID, err := w3c.ParseDID(credential.Issuer)
+	if err != nil {
+		log.Error(ctx, "sendExpirationNotice: failed to parse issuerID", "err", err.Error(), "issuerID", credential.Issuer, "credID", credential.ID)
+		return err
+	}
+
+	userDID, err := w3c.ParseDID(credential.OtherIdentifier)
+	if err != nil {
+		log.Error(ctx, "sendExpirationNotice: failed to parse credential userID", "err", err.Error(), "issuerID", credential.Issuer, "credID", credential.ID)
+		return err
+	}
+
+	connection, err := n.connService.GetByUserID(ctx, *issuerDID, *userDID)
+	if err != nil {
+		log.Warn(ctx, "sendExpirationNotice: get connection", "err", err.Error(), "issuerID", credential.Issuer, "credID", credential.ID)
+		return err
+	}
+
+	msgBytes, err := notifications2.NewExpirationMsg(credential)
+	if err != nil {
+		log.Error(ctx, "sendExpirationNotice: NewExpirationMsg", "err", err.Error(), "issuerID", credential.Issuer, "credID", credential.ID)
+		return err
+	}
+
+	var subjectDIDDoc verifiable.DIDDocument
+	if err := json.Unmarshal(connection.UserDoc, &subjectDIDDoc); err != nil {
+		log.Error(ctx, "sendExpirationNotice: unmarshal subjectDIDDoc", "err", err.Error(), "issuerID", credential.Issuer, "credID", credential.ID)
+		return err
+	}
+
+	log.Info(ctx, "sendExpirationNotice: sending notification", "issuerID", credential.Issuer, "subjectDIDDoc", subjectDIDDoc.ID)
+	return n.send(ctx, msgBytes, subjectDIDDoc)
+}
+
 func (n *notification) sendRevokeCredentialNotification(ctx context.Context, state string) error {
 	rCreds, err := n.credService.GetRevoked(ctx, state)
 	if err != nil {
@@ -105,6 +141,8 @@ func (n *notification) sendRevokeCredentialNotification(ctx context.Context, sta
 	return nil
 }
 
+// sendCreateCredentialNotification sends a credential offer for the given credentials. Credentials can belong
+// to different subjects (e.g. a bulk issuance batch), so they are grouped by subject and one offer is sent per connection.
 func (n *notification) sendCreateCredentialNotification(ctx context.Context, issuerID string, credIDs []string) error {
 	issuerDID, err := w3c.ParseDID(issuerID)
 	if err != nil {
@@ -112,10 +150,9 @@ func (n *notification) sendCreateCredentialNotification(ctx context.Context, iss
 		return err
 	}
 
-	credentialsUserID := ""
-	var connection *domain.Connection
-	credentials := make([]*domain.Claim, len(credIDs))
-	for i, credID := range credIDs {
+	subjects := make([]string, 0)
+	credentialsBySubject := make(map[string][]*domain.Claim)
+	for _, credID := range credIDs {
 		credUUID, err := uuid.Parse(credID)
 		if err != nil {
 			log.Error(ctx, "sendCreateCredentialNotification: failed to parse credID", "err", err.Error(), "issuerID", issuerID, "credID", credID)
@@ -128,34 +165,46 @@ func (n *notification) sendCreateCredentialNotification(ctx context.Context, iss
 			return err
 		}
 
-		if credentialsUserID == "" {
-			userDID, err := w3c.ParseDID(credential.OtherIdentifier)
-			if err != nil {
-				log.Error(ctx, "sendCreateCredentialNotification: failed to parse credential userID", "err", err.Error(), "issuerID", issuerID, "credID", credID)
-				return err
-			}
-
-			credentialsUserID = credential.OtherIdentifier
-			connection, err = n.connService.GetByUserID(ctx, *issuerDID, *userDID)
-			if err != nil {
-				log.Warn(ctx, "sendCreateCredentialNotification: get connection", "err", err.Error(), "issuerID", issuerID, "credID", credID)
-				return err
-			}
+		if _, ok := credentialsBySubject[credential.OtherIdentifier]; !ok {
+			subjects = append(subjects, credential.OtherIdentifier)
 		}
-		credentials[i] = credential
+		credentialsBySubject[credential.OtherIdentifier] = append(credentialsBySubject[credential.OtherIdentifier], credential)
+	}
+
+	var sendErr error
+	for _, subject := range subjects {
+		if err := n.sendCredentialOffer(ctx, *issuerDID, subject, credentialsBySubject[subject]); err != nil {
+			sendErr = errors.Join(sendErr, err)
+		}
+	}
+
+	return sendErr
+}
+
+func (n *notification) sendCredentialOffer(ctx context.Context, issuerDID w3c.DID, subject string, credentials []*domain.Claim) error {
+	userDID, err := w3c.ParseDID(subject)
+	if err != nil {
+		log.Error(ctx, "sendCreateCredentialNotification: failed to parse credential userID", "err", err.Error(), "issuerID", issuerDID.String(), "userID", subject)
+		return err
+	}
+
+	connection, err := n.connService.GetByUserID(ctx, issuerDID, *userDID)
+	if err != nil {
+		log.Warn(ctx, "sendCreateCredentialNotification: get connection", "err", err.Error(), "issuerID", issuerDID.String(), "userID", subject)
+		return err
 	}
 
 	credOfferBytes, subjectDIDDoc, err := getCredentialOfferData(connection, credentials...)
 	if err != nil {
-		log.Error(ctx, "sendCreateCredentialNotification: getCredentialOfferData", "err", err.Error(), "issuerID", issuerID)
+		log.Error(ctx, "sendCreateCredentialNotification: getCredentialOfferData", "err", err.Error(), "issuerID", issuerDID.String())
 		return err
 	}
 
 	// send notification
-	log.Info(ctx, "sendCreateCredentialNotification: sending notification", "issuerID", issuerID, "subjectDIDDoc", subjectDIDDoc.ID)
+	log.Info(ctx, "sendCreateCredentialNotification: sending notification", "issuerID", issuerDID.String(), "subjectDIDDoc", subjectDIDDoc.ID)
 	err = n.send(ctx, credOfferBytes, subjectDIDDoc)
 	if err != nil {
-		log.Error(ctx, "sendCreateCredentialNotification: send notification", "err", err.Error(), "issuerID", issuerID)
+		log.Error(ctx, "sendCreateCredentialNotification: send notification", "err", err.Error(), "issuerID", issuerDID.String())
 		return err
 	}
 
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+var (
+	// ErrPublishPolicyInvalidMinPendingChanges means that the number of pending changes to publish is not positive
+	ErrPublishPolicyInvalidMinPendingChanges = errors.New("minPendingChanges must be greater than 0")
+	// ErrPublishPolicyInvalidMinutes means that the interval or the max delay are not positive
+	ErrPublishPolicyInvalidMinutes = errors.New("minIntervalMinutes and maxDelayMinutes must be greater than 0")
+	// ErrPublishPolicyInvalidWindow means that the publish window is incomplete or empty
+	ErrPublishPolicyInvalidWindow = errors.New("the publish window needs a different windowStart and windowEnd")
+	// ErrPublishPolicyWebIdentity means that the identity is a did:web identity, whose state is not published
+	ErrPublishPolicyWebIdentity = errors.New("did:web identities do not publish states")
+)
+
+// PublishPolicy is the service that manages the publish policies of the identities and publishes the states of the
+// identities whose pending changes meet their policies
+type PublishPolicy struct {
+	repo               ports.PublishPolicyRepository
+	identityRepository ports.IdentityRepository
+	publisher          ports.Publisher
+	storage            *db.Storage
+}
+
+// NewPublishPolicy returns a new publish policy service
+func NewPublishPolicy(repo ports.PublishPolicyRepository, identityRepository ports.IdentityRepository, publisher ports.Publisher, storage *db.Storage) ports.PublishPolicyService {
+	return &PublishPolicy{
+		repo:               repo,
+		identityRepository: identityRepository,
+		publisher:          publisher,
+		storage:            storage,
+	}
+}
+
+// SavePolicy validates and stores the publish policy of the identity, replacing the previous one
+func (pp *PublishPolicy) SavePolicy(ctx context.Context, identifier w3c.DID, req ports.PublishPolicyRequest) (*domain.PublishPolicy, error) {
+	if err := pp.validate(req); err != nil {
+		return nil, err
+	}
+	if domain.IsWebDID(identifier) {
+		return nil, ErrPublishPolicyWebIdentity
+	}
+	if _, err := pp.identityRepository.GetByID(ctx, pp.storage.Pgx, identifier); err != nil {
+		return nil, err
+	}
+
+	policy := &domain.PublishPolicy{
+		Identifier:          identifier.String(),
+		MinPendingChanges:   req.MinPendingChanges,
+		MinIntervalMinutes:  req.MinIntervalMinutes,
+		MaxDelayMinutes:     req.MaxDelayMinutes,
+		WindowStart:         req.WindowStart,
+		WindowEnd:           req.WindowEnd,
+		PublishOnRevocation: req.PublishOnRevocation,
+	}
+	if err := pp.repo.Save(ctx, policy); err != nil {
+		log.Error(ctx, "saving publish policy", "err", err, "did", identifier.String())
+		return nil, err
+	}
+	return pp.repo.Get(ctx, identifier)
+}
+
+// GetPolicy returns the publish policy of the identity
+func (pp *PublishPolicy) GetPolicy(ctx context.Context, identifier w3c.DID) (*domain.PublishPolicy, error) {
+	return pp.repo.Get(ctx, identifier)
+}
+
+// DeletePolicy removes the publish policy of the identity, whose changes are only published on demand again
+func (pp *PublishPolicy) DeletePolicy(ctx context.Context, identifier w3c.DID) error {
+	return pp.repo.Delete(ctx, identifier)
+}
+
+// PublishDue publishes the states of the identities whose pending credentials and revocations meet their policies.
+// The identities without policy are not published, they publish their states on demand.
+func (pp *PublishPolicy) PublishDue(ctx context.Context) error {
+	publications, err := pp.repo.GetPendingPublications(ctx)
+	if err != nil {
+		log.Error(ctx, "getting pending publications", "err", err)
+		return err
+	}
+
+	now := time.Now()
+	for _, publication := range publications {
+		publish, reason := publication.Policy.ShouldPublish(now, publication)
+		if !publish {
+			log.Debug(ctx, "state publication postponed", "did", publication.Identifier, "reason", reason,
+				"credentials", publication.Credentials, "revocations", publication.Revocations)
+			continue
+		}
+
+		did, err := w3c.ParseDID(publication.Identifier)
+		if err != nil {
+			log.Error(ctx, "parsing identity did", "err", err, "did", publication.Identifier)
+			continue
+		}
+		published, err := pp.publisher.PublishState(ctx, did)
+		if err != nil {
+			log.Warn(ctx, "publishing state", "err", err, "did", publication.Identifier, "reason", reason)
+			continue
+		}
+		var txID string
+		if published.TxID != nil {
+			txID = *published.TxID
+		}
+		log.Info(ctx, "state published", "did", publication.Identifier, "reason", reason, "tx", txID,
+			"credentials", publication.Credentials, "revocations", publication.Revocations)
+	}
+	return nil
+}
+
+func (pp *PublishPolicy) validate(req ports.PublishPolicyRequest) error {
+	if req.MinPendingChanges <= 0 {
+		return ErrPublishPolicyInvalidMinPendingChanges
+	}
+	if (req.MinIntervalMinutes != nil && *req.MinIntervalMinutes <= 0) || (req.MaxDelayMinutes != nil && *req.MaxDelayMinutes <= 0) {
+		return ErrPublishPolicyInvalidMinutes
+	}
+	if (req.WindowStart == nil) != (req.WindowEnd == nil) {
+		return ErrPublishPolicyInvalidWindow
+	}
+	if req.WindowStart != nil {
+		start, err := domain.PublishWindowMinute(*req.WindowStart)
+		if err != nil {
+			return err
+		}
+		end, err := domain.PublishWindowMinute(*req.WindowEnd)
+		if err != nil {
+			return err
+		}
+		if start == end {
+			return ErrPublishPolicyInvalidWindow
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	schemaPkg "github.com/polygonid/sh-id-platform/internal/schema"
+	"github.com/polygonid/sh-id-platform/internal/urn"
+)
+
+var (
+	// ErrCredentialNotRefreshable means that the credential was not issued with a refresh service
+	ErrCredentialNotRefreshable = errors.New("the credential does not support refreshing")
+	// ErrCredentialNotOwnedBySender means that the credential to refresh was not issued to the sender of the message
+	ErrCredentialNotOwnedBySender = errors.New("credential doesn't relate to sender")
+	// ErrRefreshNotAuthenticated means that the refresh message was not sent as a zero knowledge proof message, so its sender is not authenticated
+	ErrRefreshNotAuthenticated = errors.New("credential refresh messages must be authenticated with a zkp")
+)
+
+type refresh struct {
+	claimService     ports.ClaimService
+	dataSource       ports.RefreshDataSource
+	mediatypeManager ports.MediaTypeManager
+}
+
+// NewRefresh returns the service that refreshes the credentials issued with an Iden3RefreshService2023.
+// If dataSource is nil the refreshed credentials keep their attributes and only get a new expiration.
+func NewRefresh(claimService ports.ClaimService, dataSource ports.RefreshDataSource, mediatypeManager ports.MediaTypeManager) ports.RefreshService {
+	return &refresh{
+		claimService:     claimService,
+		dataSource:       dataSource,
+		mediatypeManager: mediatypeManager,
+	}
+}
+
+// Agent handles the credential refresh messages. The refreshed credential is sent to the sender of the message, so
+// only zero knowledge proof messages, that authenticate the sender, are accepted whatever the media type manager allows.
+func (r *refresh) Agent(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*iden3comm.BasicMessage, error) {
+	if req.UserDID == nil {
+		return nil, fmt.Errorf("'from' field cannot be empty")
+	}
+
+	if req.IssuerDID == nil {
+		return nil, fmt.Errorf("'to' field cannot be empty")
+	}
+
+	if mediatype != packers.MediaTypeZKPMessage {
+		log.Warn(ctx, "agent: unauthenticated refresh message", "mediatype", mediatype, "sender", req.UserDID.String())
+		return nil, ErrRefreshNotAuthenticated
+	}
+
+	if !r.mediatypeManager.AllowMediaType(req.Type, mediatype) {
+		err := fmt.Errorf("unsupported media type '%s' for message type '%s'", mediatype, req.Type)
+		log.Error(ctx, "agent: unsupported media type", "err", err)
+		return nil, err
+	}
+
+	refreshBody := &protocol.CredentialRefreshMessageBody{}
+	if err := json.Unmarshal(req.Body, refreshBody); err != nil {
+		log.Error(ctx, "unmarshalling agent body", "err", err)
+		return nil, fmt.Errorf("invalid credential refresh request body: %w", err)
+	}
+
+	credentialID, err := urn.UUIDFromURNString(refreshBody.ID)
+	if err != nil {
+		credentialID, err = uuid.Parse(refreshBody.ID)
+		if err != nil {
+			log.Error(ctx, "wrong credential id in agent request body", "err", err)
+			return nil, fmt.Errorf("invalid claim ID")
+		}
+	}
+
+	credential, err := r.Refresh(ctx, *req.IssuerDID, *req.UserDID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	vc, err := schemaPkg.FromClaimModelToW3CCredential(*credential)
+	if err != nil {
+		log.Error(ctx, "creating W3 credential", "err", err)
+		return nil, fmt.Errorf("failed to convert claim to  w3cCredential: %w", err)
+	}
+
+	body, err := json.Marshal(protocol.IssuanceMessageBody{Credential: *vc})
+	if err != nil {
+		log.Error(ctx, "marshaling body", "err", err)
+		return nil, err
+	}
+
+	return &iden3comm.BasicMessage{
+		ID:       uuid.NewString(),
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.CredentialIssuanceResponseMessageType,
+		ThreadID: req.ThreadID,
+		Body:     body,
+		From:     req.IssuerDID.String(),
+		To:       req.UserDID.String(),
+	}, nil
+}
+
+// Refresh reissues the credential with the attributes provided by the data source and a new expiration
+// that keeps the validity period of the original credential. The refreshed credential is revoked.
+func (r *refresh) Refresh(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, credentialID uuid.UUID) (*domain.Claim, error) {
+	credential, err := r.claimService.GetByID(ctx, &issuerDID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	if credential.OtherIdentifier != userDID.String() {
+		log.Warn(ctx, "refresh: credential doesn't relate to sender", "id", credentialID, "sender", userDID.String())
+		return nil, ErrCredentialNotOwnedBySender
+	}
+
+	vc, err := credential.GetVerifiableCredential()
+	if err != nil {
+		log.Error(ctx, "refresh: getting verifiable credential", "err", err, "id", credentialID)
+		return nil, err
+	}
+	if vc.RefreshService == nil {
+		return nil, ErrCredentialNotRefreshable
+	}
+	if vc.Expiration == nil || vc.IssuanceDate == nil {
+		return nil, ErrRefreshServiceLacksExpirationTime
+	}
+
+	var attributes map[string]any
+	if r.dataSource != nil {
+		attributes, err = r.dataSource.Fetch(ctx, ports.RefreshDataSourceRequest{
+			IssuerDID:         issuerDID,
+			UserDID:           userDID,
+			CredentialID:      credential.ID,
+			CredentialType:    credential.SchemaType,
+			CredentialSchema:  credential.SchemaURL,
+			CredentialSubject: vc.CredentialSubject,
+		})
+		if err != nil {
+			log.Error(ctx, "refresh: fetching credential attributes", "err", err, "id", credentialID)
+			return nil, err
+		}
+		// The subject of the credential cannot change
+		delete(attributes, "id")
+		delete(attributes, "type")
+	}
+
+	expiration := time.Now().Add(vc.Expiration.Sub(*vc.IssuanceDate))
+	refreshed, err := r.claimService.Reissue(ctx, &ports.ReissueCredentialRequest{
+		DID:               issuerDID,
+		CredentialID:      credential.ID,
+		CredentialSubject: attributes,
+		Expiration:        &expiration,
+	})
+	if err != nil {
+		log.Error(ctx, "refresh: reissuing credential", "err", err, "id", credentialID)
+		return nil, err
+	}
+	return refreshed, nil
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/gateways"
+	httpPkg "github.com/polygonid/sh-id-platform/internal/http"
+)
+
+func TestRefresh(t *testing.T) {
+	ctx := t.Context()
+	identity, err := identityService.Create(ctx, "http://localhost", &ports.DIDCreationOptions{
+		Blockchain: blockchain,
+		Network:    net,
+		Method:     method,
+	})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(identity.Identifier)
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+
+	var dataSourceRequests []map[string]any
+	dataSource := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		dataSourceRequests = append(dataSourceRequests, req)
+		_, err := w.Write([]byte(`{"credentialSubject": {"id": "did:example:other", "birthday": 19960426}}`))
+		assert.NoError(t, err)
+	}))
+	defer dataSource.Close()
+
+	mediaTypeManager := NewMediaTypeManager(
+		map[iden3comm.ProtocolMessage][]string{
+			protocol.CredentialRefreshMessageType: {string(packers.MediaTypeZKPMessage)},
+		},
+		true,
+	)
+	refreshService := NewRefresh(claimsService, gateways.NewHTTPRefreshDataSource(httpPkg.NewClient(http.Client{}), dataSource.URL), mediaTypeManager)
+
+	validity := 30 * 24 * time.Hour
+	createCredential := func(t *testing.T, refreshService *verifiable.RefreshService) uuid.UUID {
+		t.Helper()
+		credential, err := claimsService.Save(ctx, &ports.CreateClaimRequest{
+			DID:    did,
+			Schema: "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+			Type:   "KYCAgeCredential",
+			CredentialSubject: map[string]any{
+				"id":           userDID.String(),
+				"birthday":     19960425,
+				"documentType": 2,
+			},
+			Expiration:     common.ToPointer(time.Now().Add(validity)),
+			SignatureProof: true,
+			RefreshService: refreshService,
+		})
+		require.NoError(t, err)
+		return credential.ID
+	}
+	refreshable := &verifiable.RefreshService{ID: "http://localhost/v2/agent", Type: verifiable.Iden3RefreshService2023}
+
+	t.Run("refresh credential", func(t *testing.T) {
+		credentialID := createCredential(t, refreshable)
+
+		refreshed, err := refreshService.Refresh(ctx, *did, *userDID, credentialID)
+		require.NoError(t, err)
+		assert.NotEqual(t, credentialID, refreshed.ID)
+
+		require.Len(t, dataSourceRequests, 1)
+		assert.Equal(t, credentialID.String(), dataSourceRequests[0]["credentialId"])
+		assert.Equal(t, userDID.String(), dataSourceRequests[0]["subject"])
+		assert.Equal(t, "KYCAgeCredential", dataSourceRequests[0]["credentialType"])
+
+		vc, err := refreshed.GetVerifiableCredential()
+		require.NoError(t, err)
+		assert.Equal(t, userDID.String(), vc.CredentialSubject["id"])
+		assert.Equal(t, float64(19960426), vc.CredentialSubject["birthday"])
+		assert.Equal(t, float64(2), vc.CredentialSubject["documentType"])
+		require.NotNil(t, vc.Expiration)
+		assert.WithinDuration(t, time.Now().Add(validity), *vc.Expiration, time.Minute)
+		assert.Equal(t, refreshable, vc.RefreshService)
+
+		previous, err := claimsService.GetByID(ctx, did, credentialID)
+		require.NoError(t, err)
+		assert.True(t, previous.Revoked)
+
+		_, err = refreshService.Refresh(ctx, *did, *userDID, credentialID)
+		assert.ErrorIs(t, err, ErrCredentialAlreadyRevoked)
+	})
+
+	t.Run("credential of another holder", func(t *testing.T) {
+		credentialID := createCredential(t, refreshable)
+		otherDID, err := w3c.ParseDID("did:polygonid:polygon:mumbai:2qE1BZ7gcmEoP2KppvFPCZqyzyb5tK9T6Gec5HFANQ")
+		require.NoError(t, err)
+		_, err = refreshService.Refresh(ctx, *did, *otherDID, credentialID)
+		assert.ErrorIs(t, err, ErrCredentialNotOwnedBySender)
+	})
+
+	t.Run("credential without refresh service", func(t *testing.T) {
+		credentialID := createCredential(t, nil)
+		_, err := refreshService.Refresh(ctx, *did, *userDID, credentialID)
+		assert.ErrorIs(t, err, ErrCredentialNotRefreshable)
+	})
+
+	t.Run("unauthenticated refresh message", func(t *testing.T) {
+		credentialID := createCredential(t, refreshable)
+		body, err := json.Marshal(protocol.CredentialRefreshMessageBody{ID: credentialID.String(), Reason: "expired"})
+		require.NoError(t, err)
+		_, err = refreshService.Agent(ctx, &ports.AgentRequest{
+			Body:      body,
+			IssuerDID: did,
+			UserDID:   userDID,
+			Type:      protocol.CredentialRefreshMessageType,
+			ThreadID:  uuid.NewString(),
+		}, packers.MediaTypePlainMessage)
+		assert.ErrorIs(t, err, ErrRefreshNotAuthenticated)
+	})
+
+	t.Run("unauthenticated refresh message without media type checks", func(t *testing.T) {
+		refreshService := NewRefresh(claimsService, nil, NewMediaTypeManager(nil, false))
+		credentialID := createCredential(t, refreshable)
+		body, err := json.Marshal(protocol.CredentialRefreshMessageBody{ID: credentialID.String(), Reason: "expired"})
+		require.NoError(t, err)
+		_, err = refreshService.Agent(ctx, &ports.AgentRequest{
+			Body:      body,
+			IssuerDID: did,
+			UserDID:   userDID,
+			Type:      protocol.CredentialRefreshMessageType,
+			ThreadID:  uuid.NewString(),
+		}, packers.MediaTypePlainMessage)
+		assert.ErrorIs(t, err, ErrRefreshNotAuthenticated)
+	})
+}
@@ -69,6 +69,15 @@ func (s *schema) fixSchemaContext(ctx context.Context, schema *domain.Schema) (*
 	return schema, nil
 }
 
+// GetByURL returns the last imported schema with the given url
+func (s *schema) GetByURL(ctx context.Context, issuerDID w3c.DID, url string) (*domain.Schema, error) {
+	schema, err := s.repo.GetByURL(ctx, issuerDID, url)
+	if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+		return nil, ErrSchemaNotFound
+	}
+	return schema, err
+}
+
 // GetAll return all schemas in the database that matches the query string
 func (s *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error) {
 	return s.repo.GetAll(ctx, issuerDID, query)
@@ -106,19 +115,24 @@ func (s *schema) ImportSchema(ctx context.Context, did w3c.DID, req *ports.Impor
 		}
 	}
 
+	if err := req.CredentialEncryption.Validate(); err != nil {
+		return nil, err
+	}
+
 	schema := &domain.Schema{
-		ID:              uuid.New(),
-		IssuerDID:       did,
-		URL:             req.URL,
-		Type:            req.SType,
-		ContextURL:      contextUrl,
-		Version:         req.Version,
-		Title:           req.Title,
-		Description:     req.Description,
-		Hash:            hash,
-		Words:           attributeNames.SchemaAttrs(),
-		DisplayMethodID: req.DisplayMethodID,
-		CreatedAt:       time.Now(),
+		ID:                   uuid.New(),
+		IssuerDID:            did,
+		URL:                  req.URL,
+		Type:                 req.SType,
+		ContextURL:           contextUrl,
+		Version:              req.Version,
+		Title:                req.Title,
+		Description:          req.Description,
+		Hash:                 hash,
+		Words:                attributeNames.SchemaAttrs(),
+		DisplayMethodID:      req.DisplayMethodID,
+		CredentialEncryption: req.CredentialEncryption.OrDefault(),
+		CreatedAt:            time.Now(),
 	}
 
 	if err := s.repo.Save(ctx, schema); err != nil {
@@ -130,6 +144,9 @@ func (s *schema) ImportSchema(ctx context.Context, did w3c.DID, req *ports.Impor
 
 // Update updates a schema
 func (s *schema) Update(ctx context.Context, schema *domain.Schema) error {
+	if err := schema.CredentialEncryption.Validate(); err != nil {
+		return err
+	}
 	if schema.DisplayMethodID != nil {
 		_, err := s.displayMethodService.GetByID(ctx, schema.IssuerDID, *schema.DisplayMethodID)
 		if err != nil {
@@ -142,5 +159,8 @@ func (s *schema) Update(ctx context.Context, schema *domain.Schema) error {
 		return err
 	}
 	schemaInDatabase.DisplayMethodID = schema.DisplayMethodID
+	if schema.CredentialEncryption != "" {
+		schemaInDatabase.CredentialEncryption = schema.CredentialEncryption
+	}
 	return s.repo.Save(ctx, schemaInDatabase)
 }
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/eth"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+)
+
+// StateReplication is the service that replicates the confirmed states of the identities to the bridge contracts of
+// the chains configured in the replicateTo setting of their network
+type StateReplication struct {
+	repo                    ports.StateReplicationRepository
+	identityStateRepository ports.IdentityStateRepository
+	gateway                 ports.StateBridgeGateway
+	networkResolver         network.Resolver
+	storage                 *db.Storage
+}
+
+// NewStateReplication returns a new state replication service
+func NewStateReplication(repo ports.StateReplicationRepository, identityStateRepository ports.IdentityStateRepository, gateway ports.StateBridgeGateway, networkResolver network.Resolver, storage *db.Storage) ports.StateReplicationService {
+	return &StateReplication{
+		repo:                    repo,
+		identityStateRepository: identityStateRepository,
+		gateway:                 gateway,
+		networkResolver:         networkResolver,
+		storage:                 storage,
+	}
+}
+
+// Replicate schedules the replication of the latest confirmed states, sends the pending ones to the bridge contracts
+// and updates the status of the ones already sent
+func (sr *StateReplication) Replicate(ctx context.Context) error {
+	for source, chains := range sr.networkResolver.GetReplicationTargets() {
+		blockchain, net, found := strings.Cut(source, ":")
+		if !found {
+			log.Error(ctx, "invalid resolver prefix", "prefix", source)
+			continue
+		}
+		for _, chain := range chains {
+			scheduled, err := sr.repo.Schedule(ctx, sr.storage.Pgx, blockchain, net, chain)
+			if err != nil {
+				log.Error(ctx, "scheduling state replications", "err", err, "source", source, "chain", chain)
+				return err
+			}
+			if scheduled > 0 {
+				log.Info(ctx, "state replications scheduled", "source", source, "chain", chain, "count", scheduled)
+			}
+		}
+	}
+
+	pending, err := sr.repo.GetByStatus(ctx, sr.storage.Pgx, domain.StateReplicationPending)
+	if err != nil {
+		log.Error(ctx, "getting pending state replications", "err", err)
+		return err
+	}
+	for i := range pending {
+		sr.send(ctx, &pending[i])
+	}
+
+	transacted, err := sr.repo.GetByStatus(ctx, sr.storage.Pgx, domain.StateReplicationTransacted)
+	if err != nil {
+		log.Error(ctx, "getting transacted state replications", "err", err)
+		return err
+	}
+	for i := range transacted {
+		sr.checkReceipt(ctx, &transacted[i])
+	}
+	return nil
+}
+
+// GetLatestReplications returns the latest replication of the states of the identity to each chain
+func (sr *StateReplication) GetLatestReplications(ctx context.Context, identifier w3c.DID) ([]domain.StateReplication, error) {
+	return sr.repo.GetLatestByIdentifier(ctx, sr.storage.Pgx, identifier)
+}
+
+// send sends the state of the replication to the bridge contract of its chain. The replication fails when the bridge
+// rejects the state; on any other error it stays pending and is sent again on the next run.
+func (sr *StateReplication) send(ctx context.Context, replication *domain.StateReplication) {
+	did, err := w3c.ParseDID(replication.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing identity did", "err", err, "did", replication.Identifier)
+		return
+	}
+	state, err := sr.identityStateRepository.GetByState(ctx, sr.storage.Pgx, *did, replication.State)
+	if err != nil {
+		log.Error(ctx, "getting replicated state", "err", err, "did", replication.Identifier, "state", replication.State)
+		return
+	}
+
+	txID, err := sr.gateway.ReplicateState(ctx, replication.Chain, *state)
+	var revertErr *eth.RevertError
+	switch {
+	case errors.As(err, &revertErr):
+		log.Warn(ctx, "state replication rejected by the bridge", "did", replication.Identifier, "chain", replication.Chain, "reason", revertErr.Reason)
+		reason := revertErr.Error()
+		replication.Status = domain.StateReplicationFailed
+		replication.FailureReason = &reason
+	case err != nil:
+		log.Error(ctx, "replicating state", "err", err, "did", replication.Identifier, "chain", replication.Chain)
+		return
+	default:
+		replication.Status = domain.StateReplicationTransacted
+		replication.TxID = txID
+		replication.FailureReason = nil
+	}
+
+	if err := sr.repo.Update(ctx, sr.storage.Pgx, replication); err != nil {
+		log.Error(ctx, "updating state replication", "err", err, "id", replication.ID)
+	}
+}
+
+// checkReceipt updates the replication with the status of its transactions. When none of them has been mined, the
+// replication is retried, so a transaction stuck in the mempool or dropped from it does not keep its nonce forever.
+func (sr *StateReplication) checkReceipt(ctx context.Context, replication *domain.StateReplication) {
+	txIDs := replication.TxIDs()
+	if len(txIDs) == 0 {
+		return
+	}
+	var receipt *domain.StateReplicationReceipt
+	var minedTxID string
+	for _, txID := range txIDs {
+		var err error
+		receipt, err = sr.gateway.ReplicationReceipt(ctx, replication.Chain, txID)
+		if err != nil {
+			log.Error(ctx, "getting state replication receipt", "err", err, "tx", txID, "chain", replication.Chain)
+			return
+		}
+		if receipt.BlockNumber != nil {
+			minedTxID = txID
+			break
+		}
+	}
+	if minedTxID == "" {
+		// receipt is the one of the last transaction sent
+		sr.retry(ctx, replication, receipt.Status == domain.StateReplicationPending)
+		return
+	}
+
+	if minedTxID != *replication.TxID {
+		log.Info(ctx, "replacement replication transaction mined", "tx", *replication.TxID, "replacement", minedTxID)
+		replication.TxID = &minedTxID
+	}
+	replication.Status = receipt.Status
+	replication.BlockNumber = receipt.BlockNumber
+	replication.BlockTimestamp = receipt.BlockTimestamp
+	if receipt.Status == domain.StateReplicationFailed {
+		reason := "the replication transaction was reverted"
+		replication.FailureReason = &reason
+	}
+	if err := sr.repo.Update(ctx, sr.storage.Pgx, replication); err != nil {
+		log.Error(ctx, "updating state replication", "err", err, "id", replication.ID)
+		return
+	}
+	if receipt.Status == domain.StateReplicationConfirmed {
+		log.Info(ctx, "state replication confirmed", "did", replication.Identifier, "state", replication.State, "chain", replication.Chain)
+	}
+}
+
+// retry handles a replication whose transactions have not been mined. When the last transaction was dropped from the
+// mempool, and the confirmation timeout of the chain has passed, the replication is sent again. Otherwise, the last
+// transaction is replaced with higher fees once it has been pending for longer than the gas bump interval of the chain.
+// The replication is modified every time a replacement is sent, so ModifiedAt is the time of the last transaction.
+func (sr *StateReplication) retry(ctx context.Context, replication *domain.StateReplication, dropped bool) {
+	if dropped {
+		confirmationTimeout, err := sr.networkResolver.GetConfirmationTimeout(replication.Chain)
+		if err != nil {
+			log.Error(ctx, "failed to get confirmation timeout", "err", err, "chain", replication.Chain)
+			return
+		}
+		if time.Now().After(replication.ModifiedAt.Add(confirmationTimeout)) {
+			sr.requeue(ctx, replication)
+		}
+		return
+	}
+
+	gasBumpInterval, err := sr.networkResolver.GetGasBumpInterval(replication.Chain)
+	if err != nil {
+		log.Error(ctx, "failed to get gas bump interval", "err", err, "chain", replication.Chain)
+		return
+	}
+	if !domain.IsTxReplacementDue(replication.ModifiedAt, gasBumpInterval) {
+		return
+	}
+
+	txIDs := replication.TxIDs()
+	pendingTxID := txIDs[len(txIDs)-1]
+	replacementTxID, err := sr.gateway.ReplaceReplication(ctx, replication.Chain, pendingTxID)
+	switch {
+	case errors.Is(err, eth.ErrTransactionNotPending):
+		log.Debug(ctx, "replication transaction mined while it was being replaced", "tx", pendingTxID, "chain", replication.Chain)
+		return
+	case errors.Is(err, eth.ErrTransactionNotFound):
+		sr.requeue(ctx, replication)
+		return
+	case err != nil:
+		log.Error(ctx, "cannot replace stuck replication transaction", "err", err, "tx", pendingTxID, "chain", replication.Chain)
+		return
+	}
+
+	replication.ReplacementTxIDs = append(replication.ReplacementTxIDs, *replacementTxID)
+	if err := sr.repo.Update(ctx, sr.storage.Pgx, replication); err != nil {
+		log.Error(ctx, "cannot save replacement replication transaction", "err", err, "tx", pendingTxID, "replacement", *replacementTxID)
+		return
+	}
+	log.Info(ctx, "stuck replication transaction replaced", "tx", pendingTxID, "replacement", *replacementTxID, "chain", replication.Chain)
+}
+
+// requeue makes the replication, whose transaction was dropped from the mempool, pending again, so it is sent on the next run
+func (sr *StateReplication) requeue(ctx context.Context, replication *domain.StateReplication) {
+	log.Warn(ctx, "state replication transaction dropped, sending it again", "tx", *replication.TxID, "chain", replication.Chain)
+	replication.Status = domain.StateReplicationPending
+	replication.TxID = nil
+	replication.ReplacementTxIDs = nil
+	if err := sr.repo.Update(ctx, sr.storage.Pgx, replication); err != nil {
+		log.Error(ctx, "updating state replication", "err", err, "id", replication.ID)
+	}
+}
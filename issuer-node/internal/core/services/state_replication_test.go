@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/eth"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const replicationChain = "privado:main"
+
+const replicationResolverSettings = `polygon:
+  amoy:
+    contractAddress: 0x1a4cC30f2aA0377b0c3bc9848766D90cb4404124
+    networkURL: https://polygon-amoy.g.alchemy.com/v2/123
+    defaultGasLimit: 600000
+    confirmationTimeout: 10s
+    confirmationBlockCount: 5
+    receiptTimeout: 600s
+    rpcResponseTimeout: 5s
+    rhsSettings:
+      mode: None
+    replicateTo:
+      - privado:main
+privado:
+  main:
+    contractAddress: 0x3C9acB2205Aa72A05F6D77d708b5Cf85FCa3a896
+    networkURL: https://rpc-mainnet.privado.id
+    defaultGasLimit: 600000
+    confirmationTimeout: 10s
+    confirmationBlockCount: 5
+    receiptTimeout: 600s
+    rpcResponseTimeout: 5s
+    gasBumpInterval: 1m
+    rhsSettings:
+      mode: None
+    bridgeContractAddress: 0x7dF78ED37d0B39Ffb6d4D527Bb1865Bf85B60f81
+`
+
+// stateBridgeGatewayMock mines the transactions that have a receipt. The ones without it are pending in the mempool.
+type stateBridgeGatewayMock struct {
+	sent         int
+	replicateErr error
+	replaceErr   error
+	receipts     map[string]domain.StateReplicationReceipt
+}
+
+func (g *stateBridgeGatewayMock) ReplicateState(_ context.Context, _ string, _ domain.IdentityState) (*string, error) {
+	if g.replicateErr != nil {
+		return nil, g.replicateErr
+	}
+	return g.nextTxID(), nil
+}
+
+func (g *stateBridgeGatewayMock) ReplicationReceipt(_ context.Context, _ string, txID string) (*domain.StateReplicationReceipt, error) {
+	if receipt, ok := g.receipts[txID]; ok {
+		return &receipt, nil
+	}
+	return &domain.StateReplicationReceipt{Status: domain.StateReplicationTransacted}, nil
+}
+
+func (g *stateBridgeGatewayMock) ReplaceReplication(_ context.Context, _ string, _ string) (*string, error) {
+	if g.replaceErr != nil {
+		return nil, g.replaceErr
+	}
+	return g.nextTxID(), nil
+}
+
+func (g *stateBridgeGatewayMock) nextTxID() *string {
+	g.sent++
+	return common.ToPointer(fmt.Sprintf("0x%064x", g.sent))
+}
+
+func TestStateReplication(t *testing.T) {
+	ctx := t.Context()
+	networkResolver, err := network.NewResolver(ctx, cfg, keyStore, common.NewMyYAMLReader([]byte(replicationResolverSettings)))
+	require.NoError(t, err)
+	repo := repositories.NewStateReplication()
+	identityStateRepository := repositories.NewIdentityState()
+
+	newService := func(gateway ports.StateBridgeGateway) *StateReplication {
+		return &StateReplication{
+			repo:                    repo,
+			identityStateRepository: identityStateRepository,
+			gateway:                 gateway,
+			networkResolver:         *networkResolver,
+			storage:                 storage,
+		}
+	}
+
+	// confirmState saves a new confirmed state of the identity, schedules its replication and returns it
+	confirmState := func(t *testing.T, did *w3c.DID, previousState *string) domain.StateReplication {
+		t.Helper()
+		hash := make([]byte, 32)
+		_, err := rand.Read(hash)
+		require.NoError(t, err)
+		require.NoError(t, identityStateRepository.Save(ctx, storage.Pgx, domain.IdentityState{
+			Identifier:    did.String(),
+			State:         common.ToPointer(hex.EncodeToString(hash)),
+			PreviousState: previousState,
+			Status:        domain.StatusConfirmed,
+		}))
+		_, err = repo.Schedule(ctx, storage.Pgx, blockchain, net, replicationChain)
+		require.NoError(t, err)
+		return latestReplication(t, did)
+	}
+
+	// newReplication creates an identity and returns the pending replication of its first confirmed state
+	newReplication := func(t *testing.T) (*w3c.DID, domain.StateReplication) {
+		t.Helper()
+		identity, err := identityService.Create(ctx, "http://localhost", &ports.DIDCreationOptions{Blockchain: blockchain, Network: net, Method: method})
+		require.NoError(t, err)
+		did, err := w3c.ParseDID(identity.Identifier)
+		require.NoError(t, err)
+		genesis, err := identityStateRepository.GetLatestStateByIdentifier(ctx, storage.Pgx, did)
+		require.NoError(t, err)
+		replication := confirmState(t, did, genesis.State)
+		require.Equal(t, domain.StateReplicationPending, replication.Status)
+		return did, replication
+	}
+
+	// pendingSince makes the last transaction of the replication look sent the given time ago
+	pendingSince := func(replication domain.StateReplication, ago time.Duration) *domain.StateReplication {
+		replication.ModifiedAt = time.Now().Add(-ago)
+		return &replication
+	}
+
+	mined := func(status domain.StateReplicationStatus) domain.StateReplicationReceipt {
+		return domain.StateReplicationReceipt{Status: status, BlockNumber: common.ToPointer(100), BlockTimestamp: common.ToPointer(1700000000)}
+	}
+
+	t.Run("Mined", func(t *testing.T) {
+		gateway := &stateBridgeGatewayMock{receipts: map[string]domain.StateReplicationReceipt{}}
+		sr := newService(gateway)
+		did, replication := newReplication(t)
+
+		sr.send(ctx, &replication)
+		replication = latestReplication(t, did)
+		require.Equal(t, domain.StateReplicationTransacted, replication.Status)
+		require.NotNil(t, replication.TxID)
+
+		sr.checkReceipt(ctx, &replication)
+		assert.Equal(t, domain.StateReplicationTransacted, latestReplication(t, did).Status)
+
+		gateway.receipts[*replication.TxID] = mined(domain.StateReplicationConfirmed)
+		sr.checkReceipt(ctx, &replication)
+		replication = latestReplication(t, did)
+		assert.Equal(t, domain.StateReplicationConfirmed, replication.Status)
+		assert.Equal(t, common.ToPointer(100), replication.BlockNumber)
+		assert.Equal(t, common.ToPointer(1700000000), replication.BlockTimestamp)
+		assert.Nil(t, replication.FailureReason)
+	})
+
+	t.Run("Replaced then mined", func(t *testing.T) {
+		gateway := &stateBridgeGatewayMock{receipts: map[string]domain.StateReplicationReceipt{}}
+		sr := newService(gateway)
+		did, replication := newReplication(t)
+
+		sr.send(ctx, &replication)
+		replication = latestReplication(t, did)
+		txID := *replication.TxID
+
+		sr.checkReceipt(ctx, pendingSince(replication, 30*time.Second))
+		assert.Empty(t, latestReplication(t, did).ReplacementTxIDs)
+
+		sr.checkReceipt(ctx, pendingSince(replication, 2*time.Minute))
+		replication = latestReplication(t, did)
+		require.Len(t, replication.ReplacementTxIDs, 1)
+		replacementTxID := replication.ReplacementTxIDs[0]
+		assert.Equal(t, txID, *replication.TxID)
+		assert.Equal(t, []string{txID, replacementTxID}, replication.TxIDs())
+
+		gateway.receipts[replacementTxID] = mined(domain.StateReplicationConfirmed)
+		sr.checkReceipt(ctx, &replication)
+		replication = latestReplication(t, did)
+		assert.Equal(t, domain.StateReplicationConfirmed, replication.Status)
+		assert.Equal(t, replacementTxID, *replication.TxID)
+	})
+
+	t.Run("Dropped and requeued", func(t *testing.T) {
+		gateway := &stateBridgeGatewayMock{receipts: map[string]domain.StateReplicationReceipt{}}
+		sr := newService(gateway)
+		did, replication := newReplication(t)
+
+		sr.send(ctx, &replication)
+		replication = latestReplication(t, did)
+		gateway.receipts[*replication.TxID] = domain.StateReplicationReceipt{Status: domain.StateReplicationPending}
+
+		sr.checkReceipt(ctx, pendingSince(replication, time.Second))
+		assert.Equal(t, domain.StateReplicationTransacted, latestReplication(t, did).Status)
+
+		sr.checkReceipt(ctx, pendingSince(replication, time.Minute))
+		replication = latestReplication(t, did)
+		assert.Equal(t, domain.StateReplicationPending, replication.Status)
+		assert.Nil(t, replication.TxID)
+		assert.Empty(t, replication.ReplacementTxIDs)
+
+		sr.send(ctx, &replication)
+		replication = latestReplication(t, did)
+		assert.Equal(t, domain.StateReplicationTransacted, replication.Status)
+		assert.Equal(t, common.ToPointer(fmt.Sprintf("0x%064x", 2)), replication.TxID)
+	})
+
+	t.Run("Dropped while being replaced", func(t *testing.T) {
+		gateway := &stateBridgeGatewayMock{receipts: map[string]domain.StateReplicationReceipt{}, replaceErr: eth.ErrTransactionNotFound}
+		sr := newService(gateway)
+		did, replication := newReplication(t)
+
+		sr.send(ctx, &replication)
+		replication = latestReplication(t, did)
+
+		sr.checkReceipt(ctx, pendingSince(replication, 2*time.Minute))
+		replication = latestReplication(t, did)
+		assert.Equal(t, domain.StateReplicationPending, replication.Status)
+		assert.Nil(t, replication.TxID)
+	})
+
+	t.Run("Reverted", func(t *testing.T) {
+		gateway := &stateBridgeGatewayMock{receipts: map[string]domain.StateReplicationReceipt{}}
+		sr := newService(gateway)
+		did, replication := newReplication(t)
+
+		sr.send(ctx, &replication)
+		replication = latestReplication(t, did)
+		gateway.receipts[*replication.TxID] = mined(domain.StateReplicationFailed)
+
+		sr.checkReceipt(ctx, &replication)
+		replication = latestReplication(t, did)
+		assert.Equal(t, domain.StateReplicationFailed, replication.Status)
+		assert.Equal(t, common.ToPointer(100), replication.BlockNumber)
+		require.NotNil(t, replication.FailureReason)
+		assert.Equal(t, "the replication transaction was reverted", *replication.FailureReason)
+	})
+
+	t.Run("Rejected by the bridge", func(t *testing.T) {
+		sr := newService(&stateBridgeGatewayMock{replicateErr: &eth.RevertError{Reason: "Invalid source chain"}})
+		did, replication := newReplication(t)
+
+		sr.send(ctx, &replication)
+		replication = latestReplication(t, did)
+		assert.Equal(t, domain.StateReplicationFailed, replication.Status)
+		assert.Nil(t, replication.TxID)
+		require.NotNil(t, replication.FailureReason)
+		assert.Equal(t, "execution reverted: Invalid source chain", *replication.FailureReason)
+	})
+
+	t.Run("Superseded", func(t *testing.T) {
+		gateway := &stateBridgeGatewayMock{replicateErr: errors.New("connection refused")}
+		sr := newService(gateway)
+		did, replication := newReplication(t)
+
+		sr.send(ctx, &replication)
+		assert.Equal(t, domain.StateReplicationPending, latestReplication(t, did).Status)
+
+		newer := confirmState(t, did, &replication.State)
+		assert.NotEqual(t, replication.ID, newer.ID)
+		assert.Equal(t, domain.StateReplicationPending, newer.Status)
+
+		superseded, err := repo.GetByStatus(ctx, storage.Pgx, domain.StateReplicationSuperseded)
+		require.NoError(t, err)
+		assert.Contains(t, replicationIDs(superseded), replication.ID)
+
+		gateway.replicateErr = nil
+		sr.send(ctx, &newer)
+		newer = latestReplication(t, did)
+		assert.Equal(t, domain.StateReplicationTransacted, newer.Status)
+		assert.Equal(t, common.ToPointer(fmt.Sprintf("0x%064x", 1)), newer.TxID)
+	})
+}
+
+// latestReplication returns the latest replication of the states of the identity to replicationChain
+func latestReplication(t *testing.T, did *w3c.DID) domain.StateReplication {
+	t.Helper()
+	replications, err := repositories.NewStateReplication().GetLatestByIdentifier(t.Context(), storage.Pgx, *did)
+	require.NoError(t, err)
+	for _, replication := range replications {
+		if replication.Chain == replicationChain {
+			return replication
+		}
+	}
+	require.FailNow(t, "no replication", "did %s chain %s", did, replicationChain)
+	return domain.StateReplication{}
+}
+
+func replicationIDs(replications []domain.StateReplication) []int64 {
+	ids := make([]int64, 0, len(replications))
+	for _, replication := range replications {
+		ids = append(ids, replication.ID)
+	}
+	return ids
+}
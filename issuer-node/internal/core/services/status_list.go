@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/jws"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+)
+
+const (
+	statusListCredentialContext = "https://www.w3.org/ns/credentials/v2"
+	statusListCredentialType    = "BitstringStatusListCredential"
+	statusListType              = "BitstringStatusList"
+	statusListCredentialJWTType = "vc+jwt"
+)
+
+var (
+	// ErrStatusListNotFound means that the status list does not exist
+	ErrStatusListNotFound = errors.New("status list not found")
+	// ErrStatusListSigningKeyNotFound means that the identity has no key that can sign its status list credentials
+	ErrStatusListSigningKeyNotFound = errors.New("the identity needs an ETH or Ed25519 key to sign its status lists")
+)
+
+type statusList struct {
+	storage                  *db.Storage
+	repo                     ports.StatusListRepository
+	kms                      kms.KMSType
+	revocationStatusResolver *revocationstatus.Resolver
+}
+
+// NewStatusList returns the service that manages the W3C Bitstring Status Lists of the identities
+func NewStatusList(storage *db.Storage, repo ports.StatusListRepository, kms kms.KMSType, revocationStatusResolver *revocationstatus.Resolver) ports.StatusListService {
+	return &statusList{
+		storage:                  storage,
+		repo:                     repo,
+		kms:                      kms,
+		revocationStatusResolver: revocationStatusResolver,
+	}
+}
+
+// CreateEntry allocates an index in a revocation status list and another one in a suspension status list of the issuer
+// for the given revocation nonce and returns the credential statuses that point to them.
+// Entries released by credentials that could not be issued are allocated first. A new status list is created when
+// the current one is full.
+func (sl *statusList) CreateEntry(ctx context.Context, issuerDID w3c.DID, nonce uint64) ([]*revocationstatus.BitstringStatusListEntryStatus, error) {
+	if _, err := sl.signingKey(ctx, issuerDID); err != nil {
+		return nil, err
+	}
+
+	purposes := []string{domain.StatusPurposeRevocation, domain.StatusPurposeSuspension}
+	entries := make([]*domain.StatusListEntry, len(purposes))
+	err := sl.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for i, purpose := range purposes {
+			entry, err := sl.repo.ReuseEntry(ctx, tx, issuerDID, purpose, domain.RevNonceUint64(nonce))
+			if err == nil {
+				entries[i] = entry
+				continue
+			}
+			if !errors.Is(err, repositories.ErrStatusListEntryNotFound) {
+				return err
+			}
+
+			list, err := sl.repo.GetAvailableForUpdate(ctx, tx, issuerDID, purpose)
+			if errors.Is(err, repositories.ErrStatusListNotFound) {
+				list = domain.NewStatusList(issuerDID.String(), purpose)
+				err = sl.repo.Save(ctx, tx, list)
+			}
+			if err != nil {
+				return err
+			}
+			entries[i] = &domain.StatusListEntry{
+				StatusListID: list.ID,
+				Index:        list.NextIndex,
+				IssuerID:     issuerDID.String(),
+				RevNonce:     domain.RevNonceUint64(nonce),
+			}
+			if err := sl.repo.SaveEntry(ctx, tx, entries[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx, "allocating status list entry", "err", err, "did", issuerDID.String())
+		return nil, err
+	}
+
+	statuses := make([]*revocationstatus.BitstringStatusListEntryStatus, len(entries))
+	for i, entry := range entries {
+		statuses[i], err = sl.revocationStatusResolver.GetBitstringStatusListEntry(ctx, issuerDID, entry.StatusListID, entry.Index, purposes[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return statuses, nil
+}
+
+// ReleaseEntries gives back the entries allocated to the revocation nonce of a credential that could not be issued,
+// so they are allocated to the next credentials. The entries are kept while the issuer has a credential with that nonce.
+func (sl *statusList) ReleaseEntries(ctx context.Context, issuerDID w3c.DID, nonce uint64) error {
+	released, err := sl.repo.ReleaseEntries(ctx, sl.storage.Pgx, issuerDID, domain.RevNonceUint64(nonce))
+	if err != nil {
+		log.Error(ctx, "releasing status list entries", "err", err, "did", issuerDID.String(), "nonce", nonce)
+		return err
+	}
+	if released > 0 {
+		log.Info(ctx, "status list entries released", "did", issuerDID.String(), "nonce", nonce, "count", released)
+	}
+	return nil
+}
+
+// SetStatus sets or clears the bits allocated to the revocation nonce in the status lists with the given purpose.
+// Nonces without entries are ignored.
+func (sl *statusList) SetStatus(ctx context.Context, conn db.Querier, issuerDID w3c.DID, nonce uint64, purpose string, status bool) error {
+	if _, err := sl.repo.SetStatus(ctx, conn, issuerDID, domain.RevNonceUint64(nonce), purpose, status); err != nil {
+		log.Error(ctx, "updating status list entry", "err", err, "did", issuerDID.String(), "nonce", nonce, "purpose", purpose)
+		return err
+	}
+	return nil
+}
+
+// GetCredential returns the BitstringStatusListCredential of the status list secured as a vc+jwt
+// and signed with an ETH or Ed25519 key of the issuer.
+func (sl *statusList) GetCredential(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (string, error) {
+	list, err := sl.repo.GetByID(ctx, nil, issuerDID, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrStatusListNotFound) {
+			return "", ErrStatusListNotFound
+		}
+		return "", err
+	}
+
+	method, err := sl.signingKey(ctx, issuerDID)
+	if err != nil {
+		return "", err
+	}
+
+	credentialURL, err := sl.revocationStatusResolver.GetBitstringStatusListCredentialURL(ctx, issuerDID, list.ID)
+	if err != nil {
+		return "", err
+	}
+
+	encodedList, err := list.EncodedList()
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"@context":  []string{statusListCredentialContext},
+		"id":        credentialURL,
+		"type":      []string{"VerifiableCredential", statusListCredentialType},
+		"issuer":    issuerDID.String(),
+		"validFrom": list.UpdatedAt.UTC().Format(time.RFC3339),
+		"credentialSubject": map[string]any{
+			"id":            credentialURL + "#list",
+			"type":          statusListType,
+			"statusPurpose": list.Purpose,
+			"encodedList":   encodedList,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	token, err := jws.Sign(ctx, sl.kms, method.KeyID, jws.Header{"typ": statusListCredentialJWTType, "kid": method.ID}, payload)
+	if err != nil {
+		log.Error(ctx, "signing status list credential", "err", err, "id", id)
+		return "", err
+	}
+	return token, nil
+}
+
+// signingKey returns the verification method of the key that signs the status list credentials of the issuer
+func (sl *statusList) signingKey(ctx context.Context, issuerDID w3c.DID) (*jwsVerificationMethod, error) {
+	keyIDs, err := sl.kms.KeysByIdentity(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	keyID, err := jws.SigningKey(keyIDs)
+	if err != nil {
+		return nil, ErrStatusListSigningKeyNotFound
+	}
+	return newJWSVerificationMethod(sl.kms, issuerDID, keyID)
+}
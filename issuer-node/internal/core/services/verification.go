@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-circuits/v2"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/qrlink"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrVerificationQueryEmptyName means that the verification query has no name
+	ErrVerificationQueryEmptyName = errors.New("verification query name is required")
+	// ErrVerificationQueryEmptyScope means that the verification query has no proof requests
+	ErrVerificationQueryEmptyScope = errors.New("at least one proof request is required")
+	// ErrVerificationQueryDuplicateRequestID means that two proof requests of the verification query have the same id
+	ErrVerificationQueryDuplicateRequestID = errors.New("the ids of the proof requests should be unique")
+	// ErrVerificationQueryUnsupportedCircuit means that a proof request uses a circuit that the verifier does not support
+	ErrVerificationQueryUnsupportedCircuit = errors.New("unsupported circuit")
+	// ErrVerificationSessionCompleted means that the holder already sent the proofs of the verification session
+	ErrVerificationSessionCompleted = errors.New("verification session already completed")
+	// ErrVerificationFailed means that the proofs sent by the holder are not valid
+	ErrVerificationFailed = errors.New("verification failed")
+)
+
+// verificationSupportedCircuits are the query circuits whose verification keys are embedded in the verifier
+var verificationSupportedCircuits = map[circuits.CircuitID]struct{}{
+	circuits.AtomicQuerySigV2CircuitID: {},
+	circuits.AtomicQueryMTPV2CircuitID: {},
+	circuits.AtomicQueryV3CircuitID:    {},
+}
+
+// Verification is the service that lets the identities of the node act as verifiers.
+// It creates authorization requests from stored queries and verifies the zero knowledge proofs sent back by the wallets.
+type Verification struct {
+	repo      ports.VerificationRepository
+	verifier  ports.AuthorizationResponseVerifier
+	qrService ports.QrStoreService
+	cfg       config.UniversalLinks
+}
+
+// NewVerification returns a new verification service
+func NewVerification(repo ports.VerificationRepository, verifier ports.AuthorizationResponseVerifier, qrService ports.QrStoreService, cfg config.UniversalLinks) ports.VerificationService {
+	return &Verification{
+		repo:      repo,
+		verifier:  verifier,
+		qrService: qrService,
+		cfg:       cfg,
+	}
+}
+
+// CreateQuery validates and stores a new verification query
+func (v *Verification) CreateQuery(ctx context.Context, issuerDID w3c.DID, req ports.VerificationQueryRequest) (*domain.VerificationQuery, error) {
+	if req.Name == "" {
+		return nil, ErrVerificationQueryEmptyName
+	}
+	if len(req.Scope) == 0 {
+		return nil, ErrVerificationQueryEmptyScope
+	}
+	ids := make(map[uint32]struct{}, len(req.Scope))
+	for _, proofRequest := range req.Scope {
+		if _, ok := verificationSupportedCircuits[circuits.CircuitID(proofRequest.CircuitID)]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrVerificationQueryUnsupportedCircuit, proofRequest.CircuitID)
+		}
+		if _, ok := ids[proofRequest.ID]; ok {
+			return nil, ErrVerificationQueryDuplicateRequestID
+		}
+		ids[proofRequest.ID] = struct{}{}
+	}
+
+	query := domain.NewVerificationQuery(issuerDID, req.Name, req.Reason, req.Scope)
+	if err := v.repo.SaveQuery(ctx, query); err != nil {
+		log.Error(ctx, "saving verification query", "err", err)
+		return nil, err
+	}
+	return v.repo.GetQueryByID(ctx, issuerDID, query.ID)
+}
+
+// GetQuery returns the verification query with the given id
+func (v *Verification) GetQuery(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.VerificationQuery, error) {
+	return v.repo.GetQueryByID(ctx, issuerDID, id)
+}
+
+// GetQueries returns the verification queries of the identity
+func (v *Verification) GetQueries(ctx context.Context, issuerDID w3c.DID) ([]domain.VerificationQuery, error) {
+	return v.repo.GetQueries(ctx, issuerDID)
+}
+
+// DeleteQuery removes the verification query with the given id and its sessions
+func (v *Verification) DeleteQuery(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	return v.repo.DeleteQuery(ctx, issuerDID, id)
+}
+
+// CreateSession creates the authorization request of the verification query for a holder.
+// The request is stored in the QR store so that wallets can fetch it through the deep or universal link.
+func (v *Verification) CreateSession(ctx context.Context, issuerDID w3c.DID, queryID uuid.UUID, serverURL string) (*ports.CreateVerificationSessionResponse, error) {
+	query, err := v.repo.GetQueryByID(ctx, issuerDID, queryID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID := uuid.New()
+	reqID := uuid.New().String()
+	request := protocol.AuthorizationRequestMessage{
+		From:     issuerDID.String(),
+		ID:       reqID,
+		ThreadID: reqID,
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.AuthorizationRequestMessageType,
+		Body: protocol.AuthorizationRequestMessageBody{
+			CallbackURL: fmt.Sprintf(ports.VerificationRequestCallbackURL, serverURL, sessionID),
+			Reason:      query.Reason,
+			Scope:       query.Scope,
+		},
+	}
+
+	session := domain.NewVerificationSession(sessionID, query, request)
+	if err := v.repo.SaveSession(ctx, session); err != nil {
+		log.Error(ctx, "saving verification session", "err", err, "query", queryID)
+		return nil, err
+	}
+
+	raw, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	qrID, err := v.qrService.Store(ctx, raw, DefaultQRBodyTTL)
+	if err != nil {
+		log.Error(ctx, "storing verification request", "err", err, "session", sessionID)
+		return nil, err
+	}
+
+	session, err = v.repo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &ports.CreateVerificationSessionResponse{
+		Session:       session,
+		QrID:          qrID,
+		DeepLink:      qrlink.NewDeepLink(serverURL, qrID, nil),
+		UniversalLink: qrlink.NewUniversal(v.cfg.BaseUrl, serverURL, qrID, nil),
+	}, nil
+}
+
+// GetSession returns the verification session of the identity with the given id
+func (v *Verification) GetSession(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.VerificationSession, error) {
+	session, err := v.repo.GetSessionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.IssuerCoreDID().String() != issuerDID.String() {
+		return nil, repositories.ErrVerificationSessionNotFound
+	}
+	return session, nil
+}
+
+// Callback verifies the authorization response sent by the wallet for the given session and stores the result.
+// The proofs are checked against the verification keys of the circuits and the states of the holders and issuers published on chain.
+// The callback URL is not authenticated, so a response that does not pass the verification leaves the session pending
+// and the holder can send the proofs again.
+func (v *Verification) Callback(ctx context.Context, sessionID uuid.UUID, token string) (*domain.VerificationSession, error) {
+	session, err := v.repo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != domain.VerificationSessionStatusPending {
+		return nil, ErrVerificationSessionCompleted
+	}
+
+	arm, err := v.verifier.FullVerify(ctx, token, session.Request, pubsignals.WithAcceptedStateTransitionDelay(transitionDelay))
+	if err != nil {
+		log.Warn(ctx, "verification session response not valid", "err", err, "session", sessionID)
+		return nil, fmt.Errorf("%w: %v", ErrVerificationFailed, err)
+	}
+	session.Verified(arm.From, arm.Body.Scope)
+
+	affected, err := v.repo.UpdatePendingSession(ctx, session)
+	if err != nil {
+		log.Error(ctx, "updating verification session", "err", err, "session", sessionID)
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, ErrVerificationSessionCompleted
+	}
+	return session, nil
+}
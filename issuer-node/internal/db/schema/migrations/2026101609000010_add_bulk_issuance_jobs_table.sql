@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE bulk_issuance_jobs(
+    id                              UUID PRIMARY KEY NOT NULL,
+    issuer_did                      text NOT NULL,
+    schema_id                       UUID NOT NULL,
+    format                          text NOT NULL,
+    status                          text NOT NULL,
+    credential_expiration           timestamptz NULL,
+    credential_signature_proof      boolean NOT NULL,
+    credential_mtp_proof            boolean NOT NULL,
+    credential_status_type          text NOT NULL,
+    created_at                      timestamptz NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                      timestamptz NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT bulk_issuance_jobs_identities_id_key foreign key (issuer_did) references identities (identifier),
+    CONSTRAINT bulk_issuance_jobs_schemas_id_key foreign key (schema_id) references schemas (id)
+);
+
+CREATE INDEX bulk_issuance_jobs_status_idx ON bulk_issuance_jobs (status);
+
+CREATE TABLE bulk_issuance_job_rows(
+    job_id                          UUID NOT NULL,
+    row_number                      integer NOT NULL,
+    credential_subject              jsonb NOT NULL,
+    status                          text NOT NULL,
+    credential_id                   UUID NULL,
+    error                           text NULL,
+    updated_at                      timestamptz NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (job_id, row_number),
+    CONSTRAINT bulk_issuance_job_rows_jobs_id_key foreign key (job_id) references bulk_issuance_jobs (id) ON DELETE CASCADE
+);
+
+CREATE INDEX bulk_issuance_job_rows_status_idx ON bulk_issuance_job_rows (job_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS bulk_issuance_job_rows;
+DROP TABLE IF EXISTS bulk_issuance_jobs;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_reissues (
+    issuer_id text NOT NULL,
+    predecessor_id uuid NOT NULL,
+    successor_id uuid NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_reissues_pkey PRIMARY KEY (issuer_id, predecessor_id),
+    CONSTRAINT credential_reissues_successor_key UNIQUE (issuer_id, successor_id),
+    CONSTRAINT credential_reissues_predecessor_claims_fkey FOREIGN KEY (predecessor_id, issuer_id) REFERENCES claims (id, identifier) ON DELETE CASCADE,
+    CONSTRAINT credential_reissues_successor_claims_fkey FOREIGN KEY (successor_id, issuer_id) REFERENCES claims (id, identifier) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS credential_reissues;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE claims
+    ADD COLUMN suspended boolean NOT NULL DEFAULT false;
+CREATE INDEX claims_identifier_suspended_idx ON claims (identifier) WHERE suspended;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS claims_identifier_suspended_idx;
+ALTER TABLE claims
+    DROP COLUMN suspended;
+-- +goose StatementEnd
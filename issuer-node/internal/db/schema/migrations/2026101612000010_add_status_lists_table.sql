@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE status_lists(
+    id                              UUID PRIMARY KEY NOT NULL,
+    issuer_id                       text NOT NULL,
+    purpose                         text NOT NULL,
+    size                            integer NOT NULL,
+    next_index                      integer NOT NULL DEFAULT 0,
+    bits                            bytea NOT NULL,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT status_lists_identities_id_key foreign key (issuer_id) references identities (identifier)
+);
+
+CREATE INDEX status_lists_issuer_purpose_idx ON status_lists (issuer_id, purpose, created_at);
+
+CREATE TABLE status_list_entries(
+    status_list_id                  UUID NOT NULL,
+    list_index                      integer NOT NULL,
+    issuer_id                       text NOT NULL,
+    rev_nonce                       numeric NOT NULL,
+    PRIMARY KEY (status_list_id, list_index),
+    CONSTRAINT status_list_entries_status_lists_id_key foreign key (status_list_id) references status_lists (id) ON DELETE CASCADE
+);
+
+CREATE INDEX status_list_entries_issuer_nonce_idx ON status_list_entries (issuer_id, rev_nonce);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS status_list_entries;
+DROP TABLE IF EXISTS status_lists;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_templates(
+    id                              UUID PRIMARY KEY NOT NULL,
+    issuer_did                      text NOT NULL,
+    name                            text NOT NULL,
+    schema_id                       UUID NOT NULL,
+    credential_subject              jsonb NOT NULL,
+    credential_expiration           text NULL,
+    credential_signature_proof      boolean NOT NULL,
+    credential_mtp_proof            boolean NOT NULL,
+    display_method_id               UUID NULL,
+    refresh_service                 jsonb NULL,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_templates_unique_name UNIQUE (issuer_did, name),
+    CONSTRAINT credential_templates_identities_id_key foreign key (issuer_did) references identities (identifier),
+    CONSTRAINT credential_templates_schemas_id_key foreign key (schema_id) references schemas (id),
+    CONSTRAINT credential_templates_display_methods_id_key foreign key (display_method_id) references display_methods (id)
+);
+
+ALTER TABLE bulk_issuance_jobs
+    ADD COLUMN credential_refresh_service jsonb NULL,
+    ADD COLUMN credential_display_method jsonb NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bulk_issuance_jobs
+    DROP COLUMN IF EXISTS credential_refresh_service,
+    DROP COLUMN IF EXISTS credential_display_method;
+DROP TABLE IF EXISTS credential_templates;
+-- +goose StatementEnd
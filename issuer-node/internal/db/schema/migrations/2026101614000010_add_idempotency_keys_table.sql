@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE idempotency_keys(
+    key                             text PRIMARY KEY NOT NULL,
+    request_hash                    text NOT NULL,
+    status_code                     integer,
+    content_type                    text,
+    response_body                   bytea,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at                      timestamptz NOT NULL
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE credential_expiration_policies(
+    id                              UUID PRIMARY KEY NOT NULL,
+    issuer_did                      text NOT NULL,
+    schema_id                       UUID,
+    notify_days_before              integer,
+    auto_revoke                     boolean NOT NULL DEFAULT false,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT credential_expiration_policies_identities_id_key foreign key (issuer_did) references identities (identifier),
+    CONSTRAINT credential_expiration_policies_schemas_id_key foreign key (schema_id) references schemas (id) ON DELETE CASCADE
+);
+
+-- one policy for the whole identity (schema_id NULL) and one per schema
+CREATE UNIQUE INDEX credential_expiration_policies_issuer_schema_idx
+    ON credential_expiration_policies (issuer_did, COALESCE(schema_id, '00000000-0000-0000-0000-000000000000'::uuid));
+
+ALTER TABLE claims ADD COLUMN expiration_notified_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE claims DROP COLUMN IF EXISTS expiration_notified_at;
+DROP TABLE IF EXISTS credential_expiration_policies;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE verification_queries(
+    id                              UUID PRIMARY KEY NOT NULL,
+    issuer_did                      text NOT NULL,
+    name                            text NOT NULL,
+    reason                          text NOT NULL,
+    scope                           jsonb NOT NULL,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT verification_queries_unique_name UNIQUE (issuer_did, name),
+    CONSTRAINT verification_queries_identities_id_key foreign key (issuer_did) references identities (identifier)
+);
+
+CREATE TABLE verification_sessions(
+    id                              UUID PRIMARY KEY NOT NULL,
+    verification_query_id           UUID NOT NULL,
+    issuer_did                      text NOT NULL,
+    request                         jsonb NOT NULL,
+    status                          text NOT NULL,
+    user_did                        text NULL,
+    response                        jsonb NULL,
+    error                           text NULL,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT verification_sessions_verification_queries_id_key foreign key (verification_query_id) references verification_queries (id) ON DELETE CASCADE
+);
+
+CREATE INDEX verification_sessions_verification_query_id_idx ON verification_sessions (verification_query_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS verification_sessions;
+DROP TABLE IF EXISTS verification_queries;
+-- +goose StatementEnd
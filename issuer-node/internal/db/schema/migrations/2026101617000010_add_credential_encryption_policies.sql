@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE schemas
+    ADD COLUMN credential_encryption text NOT NULL DEFAULT 'none';
+
+ALTER TABLE links
+    ADD COLUMN credential_encryption text NOT NULL DEFAULT 'none';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE links
+    DROP COLUMN IF EXISTS credential_encryption;
+
+ALTER TABLE schemas
+    DROP COLUMN IF EXISTS credential_encryption;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE key_rotations(
+    id                              UUID PRIMARY KEY NOT NULL,
+    issuer_did                      text NOT NULL,
+    key_name                        text NOT NULL,
+    resign_credentials              boolean NOT NULL DEFAULT false,
+    old_auth_credential_id          UUID NOT NULL,
+    new_key_id                      text NULL,
+    new_auth_credential_id          UUID NULL,
+    published_state                 text NULL,
+    step                            text NOT NULL,
+    error                           text NULL,
+    resigned_credentials            integer NOT NULL DEFAULT 0,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT key_rotations_identities_id_key foreign key (issuer_did) references identities (identifier)
+);
+
+CREATE INDEX key_rotations_unfinished_idx ON key_rotations (created_at) WHERE step <> 'completed';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS key_rotations;
+-- +goose StatementEnd
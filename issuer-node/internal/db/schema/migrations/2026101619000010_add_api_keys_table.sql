@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE api_keys(
+    id                              uuid PRIMARY KEY NOT NULL,
+    name                            text NOT NULL,
+    key_hash                        text NOT NULL,
+    identities                      text[] NOT NULL,
+    permissions                     text[] NOT NULL,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at                    timestamptz,
+    CONSTRAINT api_keys_key_hash_unique UNIQUE (key_hash)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS api_keys;
+-- +goose StatementEnd
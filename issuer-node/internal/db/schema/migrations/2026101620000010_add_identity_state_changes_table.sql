@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE identity_state_changes(
+    id                              bigserial PRIMARY KEY NOT NULL,
+    identifier                      text NOT NULL,
+    state                           text NOT NULL,
+    change_type                     text NOT NULL,
+    claim_id                        uuid,
+    revocation_nonce                numeric,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT identity_state_changes_state_fkey FOREIGN KEY (identifier, state) REFERENCES identity_states(identifier, state) ON DELETE CASCADE
+);
+CREATE INDEX identity_state_changes_identifier_state_idx ON identity_state_changes(identifier, state);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS identity_state_changes;
+-- +goose StatementEnd
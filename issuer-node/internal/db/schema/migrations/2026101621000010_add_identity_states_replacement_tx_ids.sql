@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE identity_states ADD COLUMN replacement_tx_ids text[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE identity_states DROP COLUMN IF EXISTS replacement_tx_ids;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE publishing_accounts(
+    chain_id                        bigint NOT NULL,
+    address                         text NOT NULL,
+    next_nonce                      bigint NOT NULL DEFAULT 0,
+    modified_at                     timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT publishing_accounts_pkey PRIMARY KEY (chain_id, address)
+);
+
+CREATE TABLE publishing_nonces(
+    chain_id                        bigint NOT NULL,
+    address                         text NOT NULL,
+    nonce                           bigint NOT NULL,
+    status                          text NOT NULL,
+    tx_id                           text,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at                     timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT publishing_nonces_pkey PRIMARY KEY (chain_id, address, nonce),
+    CONSTRAINT publishing_nonces_account_fkey FOREIGN KEY (chain_id, address) REFERENCES publishing_accounts(chain_id, address) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS publishing_nonces;
+DROP TABLE IF EXISTS publishing_accounts;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE publish_policies(
+    identifier                      text PRIMARY KEY NOT NULL,
+    min_pending_changes             integer NOT NULL DEFAULT 1,
+    min_interval_minutes            integer,
+    max_delay_minutes               integer,
+    window_start                    text,
+    window_end                      text,
+    publish_on_revocation           boolean NOT NULL DEFAULT false,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT publish_policies_identities_id_key foreign key (identifier) references identities (identifier) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS publish_policies;
+-- +goose StatementEnd
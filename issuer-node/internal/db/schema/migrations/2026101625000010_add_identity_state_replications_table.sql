@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE identity_state_replications(
+    id                              bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    identifier                      text NOT NULL,
+    state                           varchar(64) NOT NULL,
+    chain                           text NOT NULL,
+    status                          text NOT NULL DEFAULT 'pending',
+    tx_id                           varchar(66),
+    block_number                    integer,
+    block_timestamp                 integer,
+    failure_reason                  text,
+    created_at                      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at                     timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT identity_state_replications_state_chain_key UNIQUE (identifier, state, chain),
+    CONSTRAINT identity_state_replications_identity_states_fkey FOREIGN KEY (identifier, state) REFERENCES identity_states (identifier, state) ON DELETE CASCADE
+);
+
+CREATE INDEX identity_state_replications_status_idx ON identity_state_replications (status);
+
+CREATE TRIGGER update_state_replication_modifiedtime BEFORE UPDATE ON identity_state_replications FOR EACH ROW EXECUTE PROCEDURE update_modified_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS identity_state_replications;
+-- +goose StatementEnd
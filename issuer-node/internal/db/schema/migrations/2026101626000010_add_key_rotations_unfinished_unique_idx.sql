@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- an identity can only have one key rotation that is not completed
+CREATE UNIQUE INDEX key_rotations_issuer_unfinished_key ON key_rotations (issuer_did) WHERE step <> 'completed';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS key_rotations_issuer_unfinished_key;
+-- +goose StatementEnd
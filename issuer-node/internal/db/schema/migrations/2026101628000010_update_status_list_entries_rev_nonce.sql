@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE status_list_entries ALTER COLUMN rev_nonce DROP NOT NULL;
+-- entries without nonce were released by credentials that could not be issued and are allocated again
+CREATE INDEX status_list_entries_released_idx ON status_list_entries (issuer_id) WHERE rev_nonce IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS status_list_entries_released_idx;
+DELETE FROM status_list_entries WHERE rev_nonce IS NULL;
+ALTER TABLE status_list_entries ALTER COLUMN rev_nonce SET NOT NULL;
+-- +goose StatementEnd
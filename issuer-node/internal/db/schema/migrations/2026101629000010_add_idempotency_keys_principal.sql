@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the stored keys are not scoped by a principal, they are dropped and the requests are not replayed anymore
+DELETE FROM idempotency_keys;
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD COLUMN principal text NOT NULL;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (principal, key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM idempotency_keys;
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS principal;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (key);
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE key_rotations ADD COLUMN locked_until timestamptz NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE key_rotations DROP COLUMN IF EXISTS locked_until;
+-- +goose StatementEnd
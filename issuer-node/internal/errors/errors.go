@@ -1,6 +1,9 @@
 package errors
 
-import "net/http"
+import (
+	"encoding/json"
+	"net/http"
+)
 
 // AuthError is a special error type used to signal an authorization error
 type AuthError struct {
@@ -12,6 +15,26 @@ func (a AuthError) Error() string {
 	return a.Err.Error()
 }
 
+// ConflictError is a special error type used to signal that the request conflicts with a previous one
+type ConflictError struct {
+	Err error
+}
+
+// Error satisfies error interface for ConflictError
+func (c ConflictError) Error() string {
+	return c.Err.Error()
+}
+
+// ForbiddenError is a special error type used to signal that the credentials are valid but not allowed to perform the request
+type ForbiddenError struct {
+	Err error
+}
+
+// Error satisfies error interface for ForbiddenError
+func (f ForbiddenError) Error() string {
+	return f.Err.Error()
+}
+
 // RequestErrorHandlerFunc is a Request Error Handler that can be injected in oapi-codegen to handler errors in requests
 func RequestErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error) {
 	http.Error(w, err.Error(), http.StatusBadRequest)
@@ -26,6 +49,14 @@ func ResponseErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error)
 		w.WriteHeader(http.StatusUnauthorized)
 		w.Header().Add("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
 		_, _ = w.Write([]byte("\"Unauthorized\""))
+	case ForbiddenError:
+		w.WriteHeader(http.StatusForbidden)
+		body, _ := json.Marshal(map[string]string{"message": err.Error()})
+		_, _ = w.Write(body)
+	case ConflictError:
+		w.WriteHeader(http.StatusConflict)
+		body, _ := json.Marshal(map[string]string{"message": err.Error()})
+		_, _ = w.Write(body)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 		_, _ = w.Write([]byte(err.Error()))
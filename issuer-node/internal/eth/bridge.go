@@ -0,0 +1,83 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// StateBridgeABI is the ABI of the bridge contracts that receive the identity states replicated from other chains
+const StateBridgeABI = `[{"inputs":[{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"uint256","name":"state","type":"uint256"},{"internalType":"uint256","name":"sourceChainId","type":"uint256"},{"internalType":"uint256","name":"sourceBlockNumber","type":"uint256"},{"internalType":"uint256","name":"sourceBlockTimestamp","type":"uint256"}],"name":"replicateState","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+const replicateStateMethod = "replicateState"
+
+// BridgeBackend is the client of the chain of a bridge contract. It is implemented by the ethereum client
+// and by the simulated backend.
+type BridgeBackend interface {
+	bind.ContractBackend
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// ReplicatedState is a state of an identity replicated from the chain it is published on
+type ReplicatedState struct {
+	ID                   *big.Int
+	State                *big.Int
+	SourceChainID        *big.Int
+	SourceBlockNumber    *big.Int
+	SourceBlockTimestamp *big.Int
+}
+
+// StateBridge is a binding of a bridge contract
+type StateBridge struct {
+	backend  BridgeBackend
+	contract *bind.BoundContract
+}
+
+// NewStateBridge returns a binding of the bridge contract deployed at address
+func NewStateBridge(address common.Address, backend BridgeBackend) (*StateBridge, error) {
+	parsed, err := ethabi.JSON(strings.NewReader(StateBridgeABI))
+	if err != nil {
+		return nil, err
+	}
+	return &StateBridge{
+		backend:  backend,
+		contract: bind.NewBoundContract(address, parsed, backend, backend, backend),
+	}, nil
+}
+
+// ReplicateState sends the transaction that replicates the state to the bridge.
+// It returns a *RevertError with the decoded reason when the bridge rejects it.
+func (b *StateBridge) ReplicateState(opts *bind.TransactOpts, state ReplicatedState) (*types.Transaction, error) {
+	tx, err := b.contract.Transact(opts, replicateStateMethod, state.ID, state.State, state.SourceChainID, state.SourceBlockNumber, state.SourceBlockTimestamp)
+	if err != nil {
+		return nil, DecodeRevert(err)
+	}
+	return tx, nil
+}
+
+// Receipt returns the receipt of the transaction txID, and whether confirmationBlockCount blocks were mined on top of it.
+// It returns ErrReceiptNotReceived while the transaction is pending.
+func (b *StateBridge) Receipt(ctx context.Context, txID string, confirmationBlockCount int64) (*types.Receipt, bool, error) {
+	receipt, err := b.backend.TransactionReceipt(ctx, common.HexToHash(txID))
+	if errors.Is(err, ethereum.NotFound) || (err == nil && receipt == nil) {
+		return nil, false, ErrReceiptNotReceived
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	latest, err := b.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	confirmed := new(big.Int).Add(receipt.BlockNumber, big.NewInt(confirmationBlockCount)).Cmp(new(big.Int).SetUint64(latest)) <= 0
+	return receipt, confirmed, nil
+}
@@ -0,0 +1,101 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// acceptingBridgeCode deploys a contract that accepts any call
+	acceptingBridgeCode = "0x6001600c60003960016000f300"
+	// rejectingBridgeCode deploys a contract that reverts any call with Error("Invalid source chain")
+	rejectingBridgeCode = "0x6070600c60003960706000f3" +
+		"6064600c60003960646000fd" +
+		"08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000014" +
+		"496e76616c696420736f7572636520636861696e000000000000000000000000"
+)
+
+func deployBridge(t *testing.T, backend *simulated.Backend, opts *bind.TransactOpts, code string) common.Address {
+	t.Helper()
+	address, _, _, err := bind.DeployContract(opts, ethabi.ABI{}, hexutil.MustDecode(code), backend.Client())
+	require.NoError(t, err)
+	backend.Commit()
+	return address
+}
+
+func TestStateBridge(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	alloc := types.GenesisAlloc{}
+	alloc[crypto.PubkeyToAddress(key.PublicKey)] = types.Account{Balance: big.NewInt(1e18)}
+	backend := simulated.NewBackend(alloc)
+	t.Cleanup(func() { _ = backend.Close() })
+	client := backend.Client()
+
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	require.NoError(t, err)
+
+	state := ReplicatedState{
+		ID:                   big.NewInt(1234),
+		State:                big.NewInt(5678),
+		SourceChainID:        big.NewInt(80002),
+		SourceBlockNumber:    big.NewInt(100),
+		SourceBlockTimestamp: big.NewInt(1700000000),
+	}
+
+	t.Run("replicated and confirmed", func(t *testing.T) {
+		bridge, err := NewStateBridge(deployBridge(t, backend, opts, acceptingBridgeCode), client)
+		require.NoError(t, err)
+
+		tx, err := bridge.ReplicateState(opts, state)
+		require.NoError(t, err)
+
+		parsed, err := ethabi.JSON(strings.NewReader(StateBridgeABI))
+		require.NoError(t, err)
+		args, err := parsed.Methods[replicateStateMethod].Inputs.Unpack(tx.Data()[4:])
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{state.ID, state.State, state.SourceChainID, state.SourceBlockNumber, state.SourceBlockTimestamp}, args)
+
+		_, _, err = bridge.Receipt(ctx, tx.Hash().Hex(), 1)
+		assert.ErrorIs(t, err, ErrReceiptNotReceived)
+
+		backend.Commit()
+		receipt, confirmed, err := bridge.Receipt(ctx, tx.Hash().Hex(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+		assert.False(t, confirmed)
+
+		backend.Commit()
+		_, confirmed, err = bridge.Receipt(ctx, tx.Hash().Hex(), 1)
+		require.NoError(t, err)
+		assert.True(t, confirmed)
+	})
+
+	t.Run("rejected by the bridge", func(t *testing.T) {
+		bridge, err := NewStateBridge(deployBridge(t, backend, opts, rejectingBridgeCode), client)
+		require.NoError(t, err)
+
+		_, err = bridge.ReplicateState(opts, state)
+		var revertErr *RevertError
+		require.True(t, errors.As(err, &revertErr), err)
+		assert.Equal(t, "Invalid source chain", revertErr.Reason)
+	})
+}
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -35,6 +37,8 @@ const (
 	gasPriceIncrement               = 10
 	transactionUnderpricedIncrement = 30
 	feeIncrement                    = 1.25
+	// minGasBumpPercent is the minimum fee increase accepted by the nodes to replace a pending transaction
+	minGasBumpPercent = 10
 )
 
 var (
@@ -46,17 +50,51 @@ var (
 	ErrReceiptNotReceived = errors.New("receipt not available")
 	// ErrTransactionNotFound transaction doesn't exist on blockchain
 	ErrTransactionNotFound = errors.New("transaction not found")
+	// ErrTransactionNotPending when the transaction to replace is already mined
+	ErrTransactionNotPending = errors.New("transaction is not pending")
+	// ErrGasBumpCeilingReached when the fees of the transaction to replace cannot be increased without going over the configured maximum
+	ErrGasBumpCeilingReached = errors.New("transaction fees cannot be increased over the configured maximum")
+	// ErrNoAccountAvailable when none of the accounts of the pool can send a transaction
+	ErrNoAccountAvailable = errors.New("no account available to send the transaction")
 	// CompressedPublicKeyLength is the length of a compressed public key
 	CompressedPublicKeyLength = 33
 	// AwsKmsPublicKeyLength is the length of a public key from AWS KMS
 	AwsKmsPublicKeyLength = 88
 )
 
+// AccountSelection is how the account that sends a transaction is chosen from a pool
+type AccountSelection string
+
+const (
+	// AccountSelectionRoundRobin takes the accounts of the pool in turns
+	AccountSelectionRoundRobin AccountSelection = "round-robin"
+	// AccountSelectionLeastPending takes the account with the fewest transactions in the mempool
+	AccountSelectionLeastPending AccountSelection = "least-pending"
+)
+
+// Account is an account of a pool with its nonces. ConfirmedNonce is the nonce of the next transaction
+// in the latest block, and PendingNonce counts the transactions of the account in the mempool too.
+type Account struct {
+	KeyID          kms.KeyID
+	Address        common.Address
+	ConfirmedNonce uint64
+	PendingNonce   uint64
+}
+
+// Pending returns the number of transactions of the account waiting to be mined
+func (a Account) Pending() uint64 {
+	if a.PendingNonce < a.ConfirmedNonce {
+		return 0
+	}
+	return a.PendingNonce - a.ConfirmedNonce
+}
+
 // Client is an ethereum client to call Smart Contract methods.
 type Client struct {
 	client *ethclient.Client
 	Config *ClientConfig
 	kms    *kms.KMS
+	turn   atomic.Uint64
 }
 
 // ClientConfig eth client config
@@ -71,6 +109,10 @@ type ClientConfig struct {
 	RPCResponseTimeout     time.Duration `json:"rpc_response_time_out"`
 	WaitReceiptCycleTime   time.Duration `json:"wait_receipt*eth.Client_cycle_time_out"`
 	WaitBlockCycleTime     time.Duration `json:"wait_block_cycle_time_out"`
+	GasBumpInterval        time.Duration `json:"gas_bump_interval"`
+	GasBumpPercent         int           `json:"gas_bump_percent"`
+	MaxGasFeeCap           *big.Int      `json:"max_gas_fee_cap"`
+	MinAccountBalance      *big.Int      `json:"min_account_balance"`
 }
 
 // NewClient creates a Client instance.
@@ -97,6 +139,16 @@ func (c *Client) GetConfirmationConfirmationTimeout() time.Duration {
 	return c.Config.ConfirmationTimeout
 }
 
+// GetGasBumpInterval returns the time a transaction can be pending before it is replaced with higher fees
+func (c *Client) GetGasBumpInterval() time.Duration {
+	return c.Config.GasBumpInterval
+}
+
+// GetMinAccountBalance returns the balance an account must exceed to send transactions. It is nil when not configured.
+func (c *Client) GetMinAccountBalance() *big.Int {
+	return c.Config.MinAccountBalance
+}
+
 // BalanceAt retrieves information about the default account
 func (c *Client) BalanceAt(ctx context.Context, addr common.Address) (*big.Int, error) {
 	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
@@ -366,6 +418,236 @@ func (c *Client) CreateTxOpts(ctx context.Context, kmsKey kms.KeyID) (*bind.Tran
 	return opts, nil
 }
 
+// SelectAccount returns the first account of the keys, in the order given by the selection, that is accepted by the accept function.
+// Round-robin starts each call at the account after the one the previous call started at, and least-pending orders the accounts
+// by the number of their transactions in the mempool, taking them in turns when they have the same number.
+// Accounts whose nonces cannot be retrieved are skipped.
+func (c *Client) SelectAccount(ctx context.Context, keys []kms.KeyID, selection AccountSelection, accept func(ctx context.Context, account Account) (bool, error)) (*Account, error) {
+	return c.selectAccount(ctx, keys, c.turn.Add(1)-1, selection, accept)
+}
+
+// PeekAccount returns the account the next call to SelectAccount would return, without taking the turn
+func (c *Client) PeekAccount(ctx context.Context, keys []kms.KeyID, selection AccountSelection, accept func(ctx context.Context, account Account) (bool, error)) (*Account, error) {
+	return c.selectAccount(ctx, keys, c.turn.Load(), selection, accept)
+}
+
+func (c *Client) selectAccount(ctx context.Context, keys []kms.KeyID, start uint64, selection AccountSelection, accept func(ctx context.Context, account Account) (bool, error)) (*Account, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoAccountAvailable
+	}
+	rotated := make([]kms.KeyID, 0, len(keys))
+	for i := range keys {
+		rotated = append(rotated, keys[(start+uint64(i))%uint64(len(keys))])
+	}
+
+	accounts := make([]Account, 0, len(keys))
+	for _, key := range rotated {
+		account, err := c.account(ctx, key)
+		if err != nil {
+			log.Warn(ctx, "skipping publishing account", "err", err, "key", key.ID)
+			continue
+		}
+		accounts = append(accounts, *account)
+	}
+	if selection == AccountSelectionLeastPending {
+		sort.SliceStable(accounts, func(i, j int) bool {
+			return accounts[i].Pending() < accounts[j].Pending()
+		})
+	}
+
+	for _, account := range accounts {
+		ok, err := accept(ctx, account)
+		if err != nil {
+			log.Warn(ctx, "skipping publishing account", "err", err, "address", account.Address.Hex())
+			continue
+		}
+		if ok {
+			return &account, nil
+		}
+	}
+	return nil, ErrNoAccountAvailable
+}
+
+// account returns the address and nonces of the key
+func (c *Client) account(ctx context.Context, key kms.KeyID) (*Account, error) {
+	address, err := c.getAddress(key)
+	if err != nil {
+		return nil, err
+	}
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	confirmedNonce, err := c.client.NonceAt(_ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	}
+	pendingNonce, err := c.client.PendingNonceAt(_ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending nonce: %w", err)
+	}
+	return &Account{
+		KeyID:          key,
+		Address:        address,
+		ConfirmedNonce: confirmedNonce,
+		PendingNonce:   pendingNonce,
+	}, nil
+}
+
+// ReplaceTransaction sends a transaction with the same nonce, destination and payload as the pending one, with its fees
+// increased by GasBumpPercent, so it replaces it in the mempool. It is signed with the key of keys that sent the pending one.
+// The fee cap of EIP-1559 transactions is limited by MaxGasFeeCap, and the gas price of legacy ones by MaxGasPrice.
+func (c *Client) ReplaceTransaction(ctx context.Context, txID string, keys []kms.KeyID) (*types.Transaction, error) {
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	tx, isPending, err := c.client.TransactionByHash(_ctx, common.HexToHash(txID))
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, ErrTransactionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !isPending {
+		return nil, ErrTransactionNotPending
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, err
+	}
+	var kmsKey kms.KeyID
+	var found bool
+	for _, key := range keys {
+		address, err := c.getAddress(key)
+		if err != nil {
+			return nil, err
+		}
+		if address == sender {
+			kmsKey, found = key, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("transaction %s was sent by %s, which is not a publishing account", txID, sender.Hex())
+	}
+
+	var replacement types.TxData
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		tip, feeCap, err := c.bumpedFees(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		replacement = &types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tip,
+			GasFeeCap:  feeCap,
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}
+	case types.LegacyTxType:
+		gasPrice, err := c.bumpedGasPrice(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		replacement = &types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: gasPrice,
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}
+	default:
+		return nil, fmt.Errorf("transactions of type %d cannot be replaced", tx.Type())
+	}
+
+	signed, err := c.signerFnFactory(ctx, kmsKey)(sender, types.NewTx(replacement))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.SendRawTx(ctx, signed); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "transaction replaced", "tx", txID, "replacement", signed.Hash().Hex(), "nonce", signed.Nonce(),
+		"gasTipCap", signed.GasTipCap(), "gasFeeCap", signed.GasFeeCap())
+	return signed, nil
+}
+
+// bumpedFees returns the tip and fee cap of the replacement of an EIP-1559 transaction. Both are increased at least by
+// GasBumpPercent, and up to the current suggested tip and twice the base fee of the latest block, when they are higher.
+func (c *Client) bumpedFees(ctx context.Context, tx *types.Transaction) (tip *big.Int, feeCap *big.Int, err error) {
+	minTip := c.bump(tx.GasTipCap())
+	minFeeCap := c.bump(tx.GasFeeCap())
+
+	tip, err = c.suggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tip.Cmp(minTip) == Lt {
+		tip = minTip
+	}
+
+	header, err := c.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	feeCap = minFeeCap
+	if header.BaseFee != nil {
+		if current := new(big.Int).Add(tip, new(big.Int).Mul(header.BaseFee, big.NewInt(2))); current.Cmp(feeCap) == Gt {
+			feeCap = current
+		}
+	}
+
+	if c.Config.MaxGasFeeCap != nil && c.Config.MaxGasFeeCap.Sign() > 0 && feeCap.Cmp(c.Config.MaxGasFeeCap) == Gt {
+		feeCap = new(big.Int).Set(c.Config.MaxGasFeeCap)
+	}
+	if tip.Cmp(feeCap) == Gt {
+		tip = new(big.Int).Set(feeCap)
+	}
+	if feeCap.Cmp(minFeeCap) == Lt || tip.Cmp(minTip) == Lt {
+		return nil, nil, ErrGasBumpCeilingReached
+	}
+	return tip, feeCap, nil
+}
+
+// bumpedGasPrice returns the gas price of the replacement of a legacy transaction
+func (c *Client) bumpedGasPrice(ctx context.Context, tx *types.Transaction) (*big.Int, error) {
+	gasPrice, err := c.getGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.replacementGasPrice(gasPrice, c.bump(tx.GasPrice()))
+}
+
+// replacementGasPrice returns the current gas price, increased up to minGasPrice when it is lower, and capped at
+// MaxGasPrice. It fails only when MaxGasPrice is below minGasPrice, as the nodes reject smaller increases.
+func (c *Client) replacementGasPrice(gasPrice *big.Int, minGasPrice *big.Int) (*big.Int, error) {
+	if gasPrice.Cmp(minGasPrice) == Lt {
+		gasPrice = minGasPrice
+	}
+	if c.Config.MaxGasPrice != nil && c.Config.MaxGasPrice.Sign() > 0 && gasPrice.Cmp(c.Config.MaxGasPrice) == Gt {
+		gasPrice = new(big.Int).Set(c.Config.MaxGasPrice)
+	}
+	if gasPrice.Cmp(minGasPrice) == Lt {
+		return nil, ErrGasBumpCeilingReached
+	}
+	return gasPrice, nil
+}
+
+// bump increases the value by GasBumpPercent, rounding up
+func (c *Client) bump(value *big.Int) *big.Int {
+	percent := c.Config.GasBumpPercent
+	if percent < minGasBumpPercent {
+		percent = minGasBumpPercent
+	}
+	bumped := new(big.Int).Mul(value, big.NewInt(int64(100+percent)))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
 // TransactionParams settings for transaction.
 type TransactionParams struct {
 	BaseFee     *big.Int
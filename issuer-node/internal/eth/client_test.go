@@ -0,0 +1,62 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_bump(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		percent  int
+		value    int64
+		expected int64
+	}{
+		{name: "default", percent: 0, value: 1000, expected: 1100},
+		{name: "below the minimum accepted by the nodes", percent: 5, value: 1000, expected: 1100},
+		{name: "configured", percent: 25, value: 1000, expected: 1250},
+		{name: "rounded up", percent: 10, value: 15, expected: 17},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{Config: &ClientConfig{GasBumpPercent: tc.percent}}
+			assert.Equal(t, big.NewInt(tc.expected), c.bump(big.NewInt(tc.value)))
+		})
+	}
+}
+
+func TestClient_replacementGasPrice(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		maxGasPrice *big.Int
+		gasPrice    int64
+		minGasPrice int64
+		expected    int64
+		err         error
+	}{
+		{name: "current gas price above the minimum bump", gasPrice: 1500, minGasPrice: 1100, expected: 1500},
+		{name: "current gas price below the minimum bump", gasPrice: 1000, minGasPrice: 1100, expected: 1100},
+		{name: "current gas price above the ceiling", maxGasPrice: big.NewInt(1200), gasPrice: 1500, minGasPrice: 1100, expected: 1200},
+		{name: "minimum bump at the ceiling", maxGasPrice: big.NewInt(1100), gasPrice: 1500, minGasPrice: 1100, expected: 1100},
+		{name: "minimum bump above the ceiling", maxGasPrice: big.NewInt(1050), gasPrice: 1500, minGasPrice: 1100, err: ErrGasBumpCeilingReached},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{Config: &ClientConfig{MaxGasPrice: tc.maxGasPrice}}
+			gasPrice, err := c.replacementGasPrice(big.NewInt(tc.gasPrice), big.NewInt(tc.minGasPrice))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(tc.expected), gasPrice)
+		})
+	}
+}
+
+func TestAccount_Pending(t *testing.T) {
+	assert.Equal(t, uint64(3), Account{ConfirmedNonce: 4, PendingNonce: 7}.Pending())
+	assert.Equal(t, uint64(0), Account{ConfirmedNonce: 4, PendingNonce: 4}.Pending())
+	assert.Equal(t, uint64(0), Account{ConfirmedNonce: 5, PendingNonce: 4}.Pending())
+}
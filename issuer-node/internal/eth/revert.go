@@ -0,0 +1,123 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+const executionReverted = "execution reverted"
+
+// RevertError is returned when the execution of a contract call reverts. Reason is the decoded revert reason,
+// and Data the raw revert data returned by the node, if any.
+type RevertError struct {
+	Reason string
+	Data   []byte
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return executionReverted
+	}
+	return executionReverted + ": " + e.Reason
+}
+
+// DecodeRevert returns a *RevertError with the decoded reason when err is the revert of a contract call.
+// Reasons of Error(string) and Panic(uint256) reverts are decoded, and custom errors are reported by their selector.
+// Any other error is returned as it is.
+func DecodeRevert(err error) error {
+	if err == nil {
+		return nil
+	}
+	var revertErr *RevertError
+	if errors.As(err, &revertErr) {
+		return err
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if hexData, ok := dataErr.ErrorData().(string); ok {
+			if data, decodeErr := hexutil.Decode(hexData); decodeErr == nil && len(data) > 0 {
+				return &RevertError{Reason: revertReason(data), Data: data}
+			}
+		}
+	}
+
+	msg := err.Error()
+	if idx := strings.Index(msg, executionReverted); idx >= 0 {
+		reason := strings.TrimPrefix(msg[idx+len(executionReverted):], ":")
+		return &RevertError{Reason: strings.TrimSpace(reason)}
+	}
+	return err
+}
+
+// revertReason decodes the revert data returned by a contract
+func revertReason(data []byte) string {
+	if reason, err := ethabi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if len(data) >= 4 {
+		return fmt.Sprintf("custom error %s", hexutil.Encode(data[:4]))
+	}
+	return hexutil.Encode(data)
+}
+
+// SimulateCall performs an eth_call of data to the contract at the latest block, sent from the given address.
+// It returns a *RevertError when the call reverts.
+func (c *Client) SimulateCall(ctx context.Context, from common.Address, to common.Address, data []byte) error {
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	_, err := c.client.CallContract(_ctx, ethereum.CallMsg{From: from, To: &to, Data: data}, nil)
+	return DecodeRevert(err)
+}
+
+// RevertReason replays the mined transaction txID at the state of the block before the one it was included in,
+// and returns the reason of its revert. It returns nil when the replay doesn't revert, because the state the
+// transaction was executed with can differ from the one at the end of the previous block.
+func (c *Client) RevertReason(ctx context.Context, txID string) (*string, error) {
+	tx, _, err := c.GetTransactionByID(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := c.GetTransactionReceiptByID(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusFailed {
+		return nil, nil
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockNumber := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	msg := ethereum.CallMsg{From: from, To: tx.To(), Gas: tx.Gas(), Value: tx.Value(), Data: tx.Data()}
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	_, err = c.client.CallContract(_ctx, msg, blockNumber)
+	if err == nil {
+		return nil, nil
+	}
+	var revertErr *RevertError
+	if errors.As(DecodeRevert(err), &revertErr) {
+		return &revertErr.Reason, nil
+	}
+	return nil, err
+}
+
+// Address returns the address of the ethereum key
+func (c *Client) Address(k kms.KeyID) (common.Address, error) {
+	return c.getAddress(k)
+}
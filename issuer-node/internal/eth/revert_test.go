@@ -0,0 +1,61 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type dataError struct {
+	msg  string
+	data interface{}
+}
+
+func (e dataError) Error() string          { return e.msg }
+func (e dataError) ErrorData() interface{} { return e.data }
+
+func TestDecodeRevert(t *testing.T) {
+	// Error(string) with "Identity does not exist"
+	errorString := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000017" +
+		"4964656e7469747920646f6573206e6f74206578697374000000000000000000"
+	// Panic(uint256) with code 0x11
+	panicCode := "0x4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011"
+
+	for _, tc := range []struct {
+		name     string
+		err      error
+		isRevert bool
+		reason   string
+	}{
+		{name: "nil", err: nil},
+		{name: "not a revert", err: errors.New("connection refused")},
+		{name: "error string", err: dataError{msg: "execution reverted: Identity does not exist", data: errorString}, isRevert: true, reason: "Identity does not exist"},
+		{name: "wrapped error string", err: fmt.Errorf("call failed: %w", dataError{msg: "execution reverted", data: errorString}), isRevert: true, reason: "Identity does not exist"},
+		{name: "panic", err: dataError{msg: "execution reverted", data: panicCode}, isRevert: true, reason: "arithmetic underflow or overflow"},
+		{name: "custom error", err: dataError{msg: "execution reverted", data: "0xdeadbeef"}, isRevert: true, reason: "custom error 0xdeadbeef"},
+		{name: "message only", err: errors.New("execution reverted: Old state does not match"), isRevert: true, reason: "Old state does not match"},
+		{name: "message without reason", err: errors.New("execution reverted"), isRevert: true, reason: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := DecodeRevert(tc.err)
+			var revertErr *RevertError
+			if !tc.isRevert {
+				assert.Equal(t, tc.err, err)
+				assert.False(t, errors.As(err, &revertErr))
+				return
+			}
+			require.True(t, errors.As(err, &revertErr))
+			assert.Equal(t, tc.reason, revertErr.Reason)
+			if data, ok := tc.err.(dataError); ok {
+				assert.Equal(t, hexutil.MustDecode(data.data.(string)), revertErr.Data)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/google/uuid"
 	"github.com/iden3/go-circuits/v2"
@@ -22,6 +23,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/event"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/eth"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/network"
@@ -38,6 +40,8 @@ var (
 	ErrStateIsBeingProcessed = errors.New("the state is being processed")
 	// ErrNoFailedStatesToProcess - No fialed states to process
 	ErrNoFailedStatesToProcess = errors.New("no failed states to process")
+	// ErrNoStateToSimulate - No failed or unpublished state to simulate the transition of
+	ErrNoStateToSimulate = errors.New("no failed or unpublished state to simulate")
 )
 
 const (
@@ -49,6 +53,9 @@ const (
 // PublisherGateway - Define the interface for publishers.
 type PublisherGateway interface {
 	PublishState(ctx context.Context, identifier *w3c.DID, latestState *merkletree.Hash, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*string, error)
+	ReplaceStateTransaction(ctx context.Context, identity *domain.Identity, txID string) (*string, error)
+	SimulateStateTransition(ctx context.Context, identifier *w3c.DID, latestState *merkletree.Hash, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) error
+	TransactionRevertReason(ctx context.Context, identity *domain.Identity, txID string) (*string, error)
 }
 
 type publisher struct {
@@ -117,6 +124,71 @@ func (p *publisher) RetryPublishState(ctx context.Context, identifier *w3c.DID)
 	return newState, err
 }
 
+// DryRunPublishState simulates the transition the identity would publish next against the latest block, without sending it
+// or changing the state. That is the transition to the failed state, the one a retry would publish, if there is one, and
+// otherwise the transition to the state of the unpublished claims and revocations.
+func (p *publisher) DryRunPublishState(ctx context.Context, identifier *w3c.DID) (*domain.StateTransitionSimulation, error) {
+	failedState, err := p.identityService.GetFailedState(ctx, *identifier)
+	if err != nil {
+		log.Error(ctx, "error fetching failed state", "err", err)
+		return nil, err
+	}
+	if failedState != nil {
+		return p.simulateStateTransition(ctx, p.storage.Pgx, identifier, *failedState)
+	}
+
+	exists, err := p.identityService.HasUnprocessedStatesByID(ctx, *identifier)
+	if err != nil {
+		log.Error(ctx, "error fetching unprocessed issuers did", "err", err)
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNoStateToSimulate
+	}
+
+	// the pending claims are added to the trees within the transaction, that is always rolled back
+	tx, err := p.storage.Pgx.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Error(ctx, "error rolling back the dry run", "err", err)
+		}
+	}()
+
+	pendingState, err := p.identityService.PendingState(ctx, tx, *identifier)
+	if err != nil {
+		log.Error(ctx, "error calculating the pending state", "err", err, "did", identifier.String())
+		return nil, err
+	}
+	return p.simulateStateTransition(ctx, tx, identifier, *pendingState)
+}
+
+// simulateStateTransition simulates the transition to newState, reading the trees of the identity with conn
+func (p *publisher) simulateStateTransition(ctx context.Context, conn db.Querier, identifier *w3c.DID, newState domain.IdentityState) (*domain.StateTransitionSimulation, error) {
+	args, err := p.buildStateTransition(ctx, conn, newState)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation := &domain.StateTransitionSimulation{
+		Identifier: identifier.String(),
+		State:      *newState.State,
+		Succeeded:  true,
+	}
+	err = p.publisherGateway.SimulateStateTransition(ctx, args.did, args.latestStateHash, args.newStateHash, args.isLatestStateGenesis, args.proof, args.identity)
+	if err != nil {
+		reason := revertReason(err)
+		if reason == nil {
+			return nil, err
+		}
+		simulation.Succeeded = false
+		simulation.RevertReason = reason
+	}
+	return simulation, nil
+}
+
 func (p *publisher) publishState(ctx context.Context, identifier *w3c.DID) (*domain.PublishedState, error) {
 	exists, err := p.identityService.HasUnprocessedStatesByID(ctx, *identifier)
 	if err != nil {
@@ -141,6 +213,7 @@ func (p *publisher) publishState(ctx context.Context, identifier *w3c.DID) (*dom
 		// TODO: Handle RHS status already published
 		log.Error(ctx, "Error during publishing proof:", "err", err, "did", identifier.String())
 		updatedState.Status = domain.StatusFailed
+		updatedState.FailureReason = revertReason(err)
 		errUpdating := p.identityService.UpdateIdentityState(ctx, updatedState)
 		if errUpdating != nil {
 			log.Error(ctx, "Error saving the state as failed:", "err", err, "did", identifier.String())
@@ -173,6 +246,12 @@ func (p *publisher) retryPublishFailedState(ctx context.Context, identifier *w3c
 	txID, err := p.publishProof(ctx, identifier, *failedState)
 	if err != nil {
 		log.Error(ctx, "Error during publishing proof:", "err", err, "did", identifier.String())
+		if reason := revertReason(err); reason != nil {
+			failedState.FailureReason = reason
+			if errUpdating := p.identityService.UpdateIdentityState(ctx, failedState); errUpdating != nil {
+				log.Error(ctx, "Error saving the failure reason:", "err", errUpdating, "did", identifier.String())
+			}
+		}
 		return nil, err
 	}
 
@@ -185,8 +264,19 @@ func (p *publisher) retryPublishFailedState(ctx context.Context, identifier *w3c
 	}, nil
 }
 
-// PublishProof publishes new proof using the latest state
-func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newState domain.IdentityState) (*string, error) {
+// stateTransitionArgs are the arguments of the transition from the latest published state of an identity to a new one
+type stateTransitionArgs struct {
+	did                  *w3c.DID
+	identity             *domain.Identity
+	latestStateHash      *merkletree.Hash
+	newStateHash         *merkletree.Hash
+	isLatestStateGenesis bool
+	proof                *rstypes.ProofData
+}
+
+// buildStateTransition returns the arguments of the transition to newState, generating its proof for baby jubjub identities
+// from the trees of the identity read with conn
+func (p *publisher) buildStateTransition(ctx context.Context, conn db.Querier, newState domain.IdentityState) (*stateTransitionArgs, error) {
 	did, err := w3c.ParseDID(newState.Identifier)
 	if err != nil {
 		return nil, err
@@ -244,7 +334,7 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 			return nil, err
 		}
 
-		circuitAuthClaim, circuitAuthClaimNewStateIncProof, err := p.fillAuthClaimData(ctx, did, authClaim, newState)
+		circuitAuthClaim, circuitAuthClaimNewStateIncProof, err := p.fillAuthClaimData(ctx, conn, did, authClaim, newState)
 		if err != nil {
 			return nil, err
 		}
@@ -292,9 +382,33 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 		zkProofData = zkProof.Proof
 	}
 
+	return &stateTransitionArgs{
+		did:                  did,
+		identity:             identity,
+		latestStateHash:      latestStateHash,
+		newStateHash:         newStateHash,
+		isLatestStateGenesis: isLatestStateGenesis,
+		proof:                zkProofData,
+	}, nil
+}
+
+// PublishProof publishes new proof using the latest state.
+// The transition is simulated against the latest block first, and it is only sent when the simulation succeeds.
+func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newState domain.IdentityState) (*string, error) {
+	args, err := p.buildStateTransition(ctx, p.storage.Pgx, newState)
+	if err != nil {
+		return nil, err
+	}
+	identity := args.identity
+
+	if err := p.publisherGateway.SimulateStateTransition(ctx, args.did, args.latestStateHash, args.newStateHash, args.isLatestStateGenesis, args.proof, identity); err != nil {
+		log.Error(ctx, "state transition simulation failed", "err", err, "did", args.did.String())
+		return nil, err
+	}
+
 	// 7. Publish state and receive txID
 
-	txID, err := p.publisherGateway.PublishState(ctx, did, latestStateHash, newStateHash, isLatestStateGenesis, zkProofData, identity)
+	txID, err := p.publisherGateway.PublishState(ctx, args.did, args.latestStateHash, args.newStateHash, args.isLatestStateGenesis, args.proof, identity)
 	if err != nil {
 		return nil, err
 	}
@@ -305,6 +419,7 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 
 	newState.Status = domain.StatusTransacted
 	newState.TxID = txID
+	newState.FailureReason = nil
 
 	err = p.identityService.UpdateIdentityState(ctx, &newState)
 	if err != nil {
@@ -323,10 +438,10 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 	return txID, nil
 }
 
-func (p *publisher) fillAuthClaimData(ctx context.Context, identifier *w3c.DID, authClaim *domain.Claim, newState domain.IdentityState) (
+func (p *publisher) fillAuthClaimData(ctx context.Context, conn db.Querier, identifier *w3c.DID, authClaim *domain.Claim, newState domain.IdentityState) (
 	authClaimData *circuits.ClaimWithMTPProof, authClaimNewStateIncProof *merkletree.Proof, err error,
 ) {
-	err = p.storage.Pgx.BeginFunc(
+	err = conn.BeginFunc(
 		ctx, func(tx pgx.Tx) error {
 			var errIn error
 
@@ -469,6 +584,11 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, identity *d
 
 	} else {
 		state.Status = domain.StatusFailed
+		reason, rErr := p.publisherGateway.TransactionRevertReason(ctx, identity, receipt.TxHash.Hex())
+		if rErr != nil {
+			log.Warn(ctx, "couldn't get the revert reason of the transaction", "err", rErr, "tx", receipt.TxHash.Hex())
+		}
+		state.FailureReason = reason
 		err = p.identityService.UpdateIdentityState(ctx, state)
 	}
 
@@ -480,6 +600,15 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, identity *d
 	return nil
 }
 
+// revertReason returns the decoded reason when err is the revert of a state transition, and nil otherwise
+func revertReason(err error) *string {
+	var revertErr *eth.RevertError
+	if errors.As(err, &revertErr) {
+		return &revertErr.Reason
+	}
+	return nil
+}
+
 // groupByUserId - groups claims by user id
 func groupByUserId(claims []*domain.Claim) map[string][]string {
 	grouped := make(map[string][]string)
@@ -528,7 +657,13 @@ func (p *publisher) CheckTransactionStatus(ctx context.Context, identity *domain
 			continue
 		}
 
-		if time.Now().Unix() > states[i].ModifiedAt.Add(confirmationTimeout).Unix() {
+		gasBumpInterval, err := p.networkResolver.GetGasBumpInterval(resolverPrefix)
+		if err != nil {
+			log.Error(ctx, "failed to get gas bump interval", "err", err)
+			continue
+		}
+
+		if time.Now().Unix() > states[i].ModifiedAt.Add(confirmationTimeout).Unix() || domain.IsTxReplacementDue(states[i].ModifiedAt, gasBumpInterval) {
 			toCheck = append(toCheck, states[i])
 			log.Debug(ctx, "considering state", "id", state.StateID, "identifier", state.Identifier, "prev", state.PreviousState, "created_at", state.CreatedAt, "updated_at", state.ModifiedAt)
 		}
@@ -559,17 +694,24 @@ func (p *publisher) checkStatus(ctx context.Context, identity *domain.Identity,
 		}
 	}
 
+	resolverPrefix, err := identity.GetResolverPrefix()
+	if err != nil {
+		log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+		return err
+	}
+
 	// GetEthClient receipt and check status
-	receipt, err := p.transactionService.GetTransactionReceiptByID(ctx, identity, *state.TxID)
+	receipt, err := p.minedTransactionReceipt(ctx, identity, state)
+	if errors.Is(err, ethereum.NotFound) {
+		return p.replaceStuckTransaction(ctx, resolverPrefix, state)
+	}
 	if err != nil {
 		log.Error(ctx, "error during receipt receiving:", "err", err, "state-id", *state.TxID)
 		return fmt.Errorf("error during receipt receiving::%s: %w", *state.TxID, err)
 	}
-
-	resolverPrefix, err := identity.GetResolverPrefix()
-	if err != nil {
-		log.Error(ctx, "failed to get networkResolver prefix", "err", err)
-		return err
+	if minedTxID := receipt.TxHash.Hex(); minedTxID != *state.TxID {
+		log.Info(ctx, "replacement transaction mined", "tx", *state.TxID, "replacement", minedTxID)
+		state.TxID = &minedTxID
 	}
 
 	confirmationBlockCount, err := p.networkResolver.GetConfirmationBlockCount(resolverPrefix)
@@ -599,3 +741,63 @@ func (p *publisher) checkStatus(ctx context.Context, identity *domain.Identity,
 	log.Info(ctx, "transaction status updated", "tx", *state.TxID)
 	return nil
 }
+
+// minedTransactionReceipt returns the receipt of the transaction that published the state, or the one of the transaction
+// that replaced it, whichever was mined. It returns ethereum.NotFound if none of them has been mined yet.
+func (p *publisher) minedTransactionReceipt(ctx context.Context, identity *domain.Identity, state *domain.IdentityState) (*types.Receipt, error) {
+	for _, txID := range state.TxIDs() {
+		receipt, err := p.transactionService.GetTransactionReceiptByID(ctx, identity, txID)
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+// replaceStuckTransaction resends the state transition with higher fees, replacing the last transaction sent,
+// if it has been pending for longer than the gas bump interval of the network
+func (p *publisher) replaceStuckTransaction(ctx context.Context, resolverPrefix string, state *domain.IdentityState) error {
+	gasBumpInterval, err := p.networkResolver.GetGasBumpInterval(resolverPrefix)
+	if err != nil {
+		log.Error(ctx, "failed to get gas bump interval", "err", err)
+		return err
+	}
+	txIDs := state.TxIDs()
+	// the state is modified every time a replacement is sent, so ModifiedAt is the time of the last one
+	if !domain.IsTxReplacementDue(state.ModifiedAt, gasBumpInterval) || len(txIDs) == 0 {
+		log.Debug(ctx, "transaction is still pending", "TxID", *state.TxID)
+		return ErrStateIsBeingProcessed
+	}
+
+	did, err := w3c.ParseDID(state.Identifier)
+	if err != nil {
+		return err
+	}
+	// the identity passed to checkStatus has no key type, that is needed to choose the signing key
+	identity, err := p.identityService.GetByDID(ctx, *did)
+	if err != nil {
+		return err
+	}
+
+	pendingTxID := txIDs[len(txIDs)-1]
+	replacementTxID, err := p.publisherGateway.ReplaceStateTransaction(ctx, identity, pendingTxID)
+	if errors.Is(err, eth.ErrTransactionNotPending) {
+		log.Debug(ctx, "transaction mined while it was being replaced", "TxID", pendingTxID)
+		return ErrStateIsBeingProcessed
+	}
+	if err != nil {
+		log.Error(ctx, "cannot replace stuck transaction", "err", err, "TxID", pendingTxID)
+		return fmt.Errorf("cannot replace stuck transaction %s: %w", pendingTxID, err)
+	}
+
+	if err := p.identityService.AddStateReplacementTxID(ctx, state, *replacementTxID); err != nil {
+		log.Error(ctx, "cannot save replacement transaction", "err", err, "TxID", pendingTxID, "replacement", *replacementTxID)
+		return err
+	}
+	log.Info(ctx, "stuck transaction replaced", "TxID", pendingTxID, "replacement", *replacementTxID, "replacements", len(state.ReplacementTxIDs))
+	return ErrStateIsBeingProcessed
+}
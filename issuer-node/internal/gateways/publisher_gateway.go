@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"math/big"
-	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
@@ -16,6 +15,7 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/eth"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
@@ -24,143 +24,300 @@ import (
 
 // PublisherEthGateway interact with blockchain
 type PublisherEthGateway struct {
-	rw                    *sync.RWMutex
 	kms                   *kms.KMS
-	publishingKeyID       kms.KeyID
+	publishingKeyIDs      []kms.KeyID
+	accountSelection      eth.AccountSelection
+	accountService        ports.AccountService
+	nonceAllocator        ports.NonceAllocator
 	ethRPCResponseTimeout time.Duration
 	networkResolver       network.Resolver
 }
 
 const rpcTimeout = 10 * time.Second
 
-// NewPublisherEthGateway creates new instance of publishing service
-func NewPublisherEthGateway(resolver network.Resolver, keyStore *kms.KMS, publishingKeyPath string) (*PublisherEthGateway, error) {
+// NewPublisherEthGateway creates new instance of publishing service.
+// The states of the baby jubjub identities are published by the accounts of publishingKeyPaths, chosen with accountSelection.
+func NewPublisherEthGateway(resolver network.Resolver, keyStore *kms.KMS, publishingKeyPaths []string, accountSelection eth.AccountSelection, accountService ports.AccountService, nonceAllocator ports.NonceAllocator) (*PublisherEthGateway, error) {
 	// TODO: make timeout configurable
+	if len(publishingKeyPaths) == 0 {
+		return nil, errors.New("at least one publishing key is required")
+	}
+	keyIDs := make([]kms.KeyID, 0, len(publishingKeyPaths))
+	for _, path := range publishingKeyPaths {
+		keyIDs = append(keyIDs, kms.KeyID{
+			Type: kms.KeyTypeEthereum,
+			ID:   path,
+		})
+	}
 
-	return newStateService(resolver, rpcTimeout, keyStore, kms.KeyID{
-		Type: kms.KeyTypeEthereum,
-		ID:   publishingKeyPath,
-	})
-}
-
-func newStateService(resolver network.Resolver, to time.Duration, kServ *kms.KMS, kPath kms.KeyID) (*PublisherEthGateway, error) {
 	return &PublisherEthGateway{
 		networkResolver:       resolver,
-		rw:                    &sync.RWMutex{},
-		kms:                   kServ,
-		publishingKeyID:       kPath,
-		ethRPCResponseTimeout: to,
+		kms:                   keyStore,
+		publishingKeyIDs:      keyIDs,
+		accountSelection:      accountSelection,
+		accountService:        accountService,
+		nonceAllocator:        nonceAllocator,
+		ethRPCResponseTimeout: rpcTimeout,
 	}, nil
 }
 
 // PublishState creates or updates state in the blockchain
 func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*string, error) {
-	pb.rw.Lock()
-	defer pb.rw.Unlock()
-
 	if common.CompareMerkleTreeHash(newState, latestState) {
 		return nil, errors.New("state hasn't been changed")
 	}
 
-	var tx *types.Transaction
+	transition, err := pb.stateTransition(identifier, latestState, newState, isOldStateGenesis, proof, identity)
+	if err != nil {
+		return nil, err
+	}
 
-	id, err := core.IDFromDID(*identifier)
+	keyIDs, err := pb.signingKeyIDs(ctx, identity)
 	if err != nil {
 		return nil, err
 	}
 
-	switch identity.KeyType {
-	case string(kms.KeyTypeEthereum):
-		keyIDs, err := pb.kms.KeysByIdentity(ctx, *identifier)
-		if err != nil {
-			return nil, err
-		}
+	resolverPrefix, err := identity.GetResolverPrefix()
+	if err != nil {
+		log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+		return nil, err
+	}
 
-		var sigKeyID kms.KeyID
-		for _, v := range keyIDs {
-			if v.Type == kms.KeyTypeEthereum {
-				sigKeyID = v
-				break
-			}
-		}
+	client, err := getEthClient(ctx, identity, pb.networkResolver)
+	if err != nil {
+		log.Error(ctx, "failed to get client", "err", err)
+		return nil, err
+	}
 
-		ctxWT, cancel := context.WithTimeout(ctx, pb.ethRPCResponseTimeout)
-		defer cancel()
+	contractBinding, err := getContractBinding(client, resolverPrefix, pb.networkResolver)
+	if err != nil {
+		log.Error(ctx, "failed to get contract binding", "err", err)
+		return nil, err
+	}
 
-		client, err := getEthClient(ctx, identity, pb.networkResolver)
-		if err != nil {
-			log.Error(ctx, "failed to get client", "err", err)
-			return nil, err
-		}
+	ctxWT, cancel := context.WithTimeout(ctx, pb.ethRPCResponseTimeout)
+	defer cancel()
 
-		opts, err := client.CreateTxOpts(ctxWT, sigKeyID)
-		if err != nil {
-			log.Error(ctx, "failed to create tx opts", "err", err)
-			return nil, err
-		}
-		log.Info(ctx, "Transaction metadata", "opts.GasPrice:", opts.GasPrice, "opts.GasLimit:", opts.GasLimit, "opts.GasTipCap:", opts.GasTipCap)
+	account, err := client.SelectAccount(ctxWT, keyIDs, pb.accountSelection, pb.hasBalance(client, resolverPrefix))
+	if err != nil {
+		log.Error(ctx, "failed to select publishing account", "err", err)
+		return nil, err
+	}
 
-		resolverPrefix, err := identity.GetResolverPrefix()
-		if err != nil {
-			log.Error(ctx, "failed to get networkResolver prefix", "err", err)
-			return nil, err
-		}
+	chainID, err := client.ChainID(ctxWT)
+	if err != nil {
+		return nil, err
+	}
 
-		contractBinding, err := getContractBinding(client, resolverPrefix, pb.networkResolver)
-		if err != nil {
-			log.Error(ctx, "failed to get contract binding", "err", err)
-			return nil, err
-		}
+	nonce, err := pb.nonceAllocator.Allocate(ctx, chainID.Int64(), account.Address, account.ConfirmedNonce, account.PendingNonce)
+	if err != nil {
+		return nil, err
+	}
 
-		tx, err = contractBinding.TransitStateGeneric(opts, id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, big.NewInt(1), []byte{})
-		if err != nil {
-			return nil, err
+	tx, err := pb.sendWithNonce(ctxWT, client, contractBinding, account.KeyID, nonce, transition)
+	if err != nil {
+		if err := pb.nonceAllocator.Release(ctx, chainID.Int64(), account.Address, nonce); err != nil {
+			log.Error(ctx, "failed to release nonce", "err", err, "address", account.Address.Hex(), "nonce", nonce)
 		}
+		return nil, err
+	}
 
-	case string(kms.KeyTypeBabyJubJub):
-		ctxWT, cancel := context.WithTimeout(ctx, pb.ethRPCResponseTimeout)
-		defer cancel()
+	txID := tx.Hash().Hex()
+	if err := pb.nonceAllocator.MarkSent(ctx, chainID.Int64(), account.Address, nonce, txID); err != nil {
+		log.Error(ctx, "failed to mark nonce as sent", "err", err, "address", account.Address.Hex(), "nonce", nonce, "tx", txID)
+	}
+	log.Info(ctx, "state transition sent", "tx", txID, "address", account.Address.Hex(), "nonce", nonce)
 
-		client, err := getEthClient(ctx, identity, pb.networkResolver)
-		if err != nil {
-			log.Error(ctx, "failed to get client", "err", err)
-			return nil, err
-		}
+	return &txID, nil
+}
 
-		opts, err := client.CreateTxOpts(ctxWT, pb.publishingKeyID)
-		if err != nil {
-			log.Error(ctx, "failed to create tx opts", "err", err)
-			return nil, err
-		}
-		log.Info(ctx, "Transaction metadata", "opts.GasPrice:", opts.GasPrice, "opts.GasLimit:", opts.GasLimit, "opts.GasTipCap:", opts.GasTipCap)
+// sendWithNonce sends the state transition signed with the key and the allocated nonce
+func (pb *PublisherEthGateway) sendWithNonce(ctx context.Context, client *eth.Client, contractBinding *abi.State, keyID kms.KeyID, nonce uint64, transition *stateTransition) (*types.Transaction, error) {
+	opts, err := client.CreateTxOpts(ctx, keyID)
+	if err != nil {
+		log.Error(ctx, "failed to create tx opts", "err", err)
+		return nil, err
+	}
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+	log.Info(ctx, "Transaction metadata", "opts.GasPrice:", opts.GasPrice, "opts.GasLimit:", opts.GasLimit, "opts.GasTipCap:", opts.GasTipCap, "opts.Nonce:", opts.Nonce)
+
+	return (&abi.StateRaw{Contract: contractBinding}).Transact(opts, transition.method, transition.params...)
+}
+
+// SimulateStateTransition performs an eth_call of the state transition against the latest block, without sending it.
+// It is called from the account that PublishState would select to send it, and returns an *eth.RevertError with the decoded reason when it reverts.
+func (pb *PublisherEthGateway) SimulateStateTransition(ctx context.Context, identifier *w3c.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) error {
+	if common.CompareMerkleTreeHash(newState, latestState) {
+		return errors.New("state hasn't been changed")
+	}
+
+	transition, err := pb.stateTransition(identifier, latestState, newState, isOldStateGenesis, proof, identity)
+	if err != nil {
+		return err
+	}
+	stateABI, err := abi.StateMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	data, err := stateABI.Pack(transition.method, transition.params...)
+	if err != nil {
+		return err
+	}
+
+	keyIDs, err := pb.signingKeyIDs(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	resolverPrefix, err := identity.GetResolverPrefix()
+	if err != nil {
+		log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+		return err
+	}
+
+	client, err := getEthClient(ctx, identity, pb.networkResolver)
+	if err != nil {
+		log.Error(ctx, "failed to get client", "err", err)
+		return err
+	}
+
+	contractAddress, err := pb.networkResolver.GetContractAddress(resolverPrefix)
+	if err != nil {
+		return err
+	}
+
+	ctxWT, cancel := context.WithTimeout(ctx, pb.ethRPCResponseTimeout)
+	defer cancel()
+
+	account, err := client.PeekAccount(ctxWT, keyIDs, pb.accountSelection, pb.hasBalance(client, resolverPrefix))
+	if err != nil {
+		log.Error(ctx, "failed to select publishing account", "err", err)
+		return err
+	}
+
+	return client.SimulateCall(ctx, account.Address, *contractAddress, data)
+}
+
+// TransactionRevertReason returns the decoded reason of the revert of the mined state transition txID,
+// or nil when it cannot be reproduced
+func (pb *PublisherEthGateway) TransactionRevertReason(ctx context.Context, identity *domain.Identity, txID string) (*string, error) {
+	client, err := getEthClient(ctx, identity, pb.networkResolver)
+	if err != nil {
+		log.Error(ctx, "failed to get client", "err", err)
+		return nil, err
+	}
+	return client.RevertReason(ctx, txID)
+}
+
+// stateTransition is the method of the state contract, and its params, that publishes a state of an identity
+type stateTransition struct {
+	method string
+	params []interface{}
+}
 
+// stateTransition returns the state transition of the identity. Identities created from an ethereum key are
+// transited with transitStateGeneric, and baby jubjub ones with transitState and the proof of the transition.
+func (pb *PublisherEthGateway) stateTransition(identifier *w3c.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*stateTransition, error) {
+	id, err := core.IDFromDID(*identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	switch identity.KeyType {
+	case string(kms.KeyTypeEthereum):
+		return &stateTransition{
+			method: "transitStateGeneric",
+			params: []interface{}{id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, big.NewInt(1), []byte{}},
+		}, nil
+	case string(kms.KeyTypeBabyJubJub):
+		if proof == nil {
+			return nil, errors.New("state transition proof is required")
+		}
 		a, b, c, err := pb.adaptProofToAbi(proof)
 		if err != nil {
 			return nil, err
 		}
+		return &stateTransition{
+			method: "transitState",
+			params: []interface{}{id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, a, b, c},
+		}, nil
+	default:
+		return nil, errors.New("unsupported key type for publishing")
+	}
+}
 
-		resolverPrefix, err := identity.GetResolverPrefix()
+// hasBalance returns a function accepting the accounts whose balance is over the minimum balance of the network
+func (pb *PublisherEthGateway) hasBalance(client *eth.Client, resolverPrefix string) func(ctx context.Context, account eth.Account) (bool, error) {
+	return func(ctx context.Context, account eth.Account) (bool, error) {
+		balance, err := pb.accountService.GetBalanceByAddress(ctx, resolverPrefix, account.Address)
 		if err != nil {
-			log.Error(ctx, "failed to get networkResolver prefix", "err", err)
-			return nil, err
+			return false, err
 		}
+		minBalance := client.GetMinAccountBalance()
+		if balance.Sign() <= 0 || (minBalance != nil && balance.Cmp(minBalance) <= 0) {
+			log.Warn(ctx, "publishing account balance is too low", "address", account.Address.Hex(), "balance", balance, "minBalance", minBalance)
+			return false, nil
+		}
+		return true, nil
+	}
+}
 
-		contractBinding, err := getContractBinding(client, resolverPrefix, pb.networkResolver)
+// ReplaceStateTransaction sends a transaction that replaces the pending state transition txID with higher fees,
+// signed with the same key, and returns the hash of the new transaction
+func (pb *PublisherEthGateway) ReplaceStateTransaction(ctx context.Context, identity *domain.Identity, txID string) (*string, error) {
+	keyIDs, err := pb.signingKeyIDs(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := getEthClient(ctx, identity, pb.networkResolver)
+	if err != nil {
+		log.Error(ctx, "failed to get client", "err", err)
+		return nil, err
+	}
+
+	tx, err := client.ReplaceTransaction(ctx, txID, keyIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	replacementTxID := tx.Hash().Hex()
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		log.Error(ctx, "failed to get the sender of the replacement", "err", err, "tx", replacementTxID)
+		return &replacementTxID, nil
+	}
+	if err := pb.nonceAllocator.MarkSent(ctx, tx.ChainId().Int64(), sender, tx.Nonce(), replacementTxID); err != nil {
+		log.Error(ctx, "failed to mark nonce as sent", "err", err, "address", sender.Hex(), "nonce", tx.Nonce(), "tx", replacementTxID)
+	}
+	return &replacementTxID, nil
+}
+
+// signingKeyIDs returns the keys that can sign the state transitions of the identity. Identities created from an
+// ethereum key sign their own transitions, and the ones of baby jubjub identities are sent with the publishing keys.
+func (pb *PublisherEthGateway) signingKeyIDs(ctx context.Context, identity *domain.Identity) ([]kms.KeyID, error) {
+	switch identity.KeyType {
+	case string(kms.KeyTypeEthereum):
+		did, err := w3c.ParseDID(identity.Identifier)
 		if err != nil {
-			log.Error(ctx, "failed to get contract binding", "err", err)
 			return nil, err
 		}
-		tx, err = contractBinding.TransitState(opts, id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, a, b, c)
+		keyIDs, err := pb.kms.KeysByIdentity(ctx, *did)
 		if err != nil {
 			return nil, err
 		}
+
+		for _, v := range keyIDs {
+			if v.Type == kms.KeyTypeEthereum {
+				return []kms.KeyID{v}, nil
+			}
+		}
+		return nil, errors.New("identity has no ethereum key")
+	case string(kms.KeyTypeBabyJubJub):
+		return pb.publishingKeyIDs, nil
 	default:
 		return nil, errors.New("unsupported key type for publishing")
 	}
-
-	txID := tx.Hash().Hex()
-
-	return &txID, nil
 }
 
 func (pb *PublisherEthGateway) adaptProofToAbi(proof *rstypes.ProofData) (proofA [2]*big.Int, proofB [2][2]*big.Int, proofC [2]*big.Int, err error) {
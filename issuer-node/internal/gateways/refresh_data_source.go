@@ -0,0 +1,68 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/http"
+)
+
+type refreshDataSourceRequest struct {
+	Issuer            string         `json:"issuer"`
+	Subject           string         `json:"subject"`
+	CredentialID      string         `json:"credentialId"`
+	CredentialType    string         `json:"credentialType"`
+	CredentialSchema  string         `json:"credentialSchema"`
+	CredentialSubject map[string]any `json:"credentialSubject"`
+}
+
+type refreshDataSourceResponse struct {
+	CredentialSubject map[string]any `json:"credentialSubject"`
+}
+
+// HTTPRefreshDataSource asks an external service for the up-to-date attributes of the credentials to refresh.
+// The service receives the issuer, the holder and the current credential and answers with the new credential subject.
+type HTTPRefreshDataSource struct {
+	conn *http.Client
+	url  string
+}
+
+// NewHTTPRefreshDataSource returns a refresh data source that posts the refresh requests to the given url
+func NewHTTPRefreshDataSource(conn *http.Client, url string) ports.RefreshDataSource {
+	return &HTTPRefreshDataSource{
+		conn: conn,
+		url:  url,
+	}
+}
+
+// Fetch returns the attributes of the credential provided by the external service
+func (ds *HTTPRefreshDataSource) Fetch(ctx context.Context, req ports.RefreshDataSourceRequest) (map[string]any, error) {
+	reqBody, err := json.Marshal(refreshDataSourceRequest{
+		Issuer:            req.IssuerDID.String(),
+		Subject:           req.UserDID.String(),
+		CredentialID:      req.CredentialID.String(),
+		CredentialType:    req.CredentialType,
+		CredentialSchema:  req.CredentialSchema,
+		CredentialSubject: req.CredentialSubject,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resp, err := ds.conn.Post(ctx, ds.url, reqBody)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var result refreshDataSourceResponse
+	d := json.NewDecoder(bytes.NewReader(resp))
+	d.UseNumber()
+	if err := d.Decode(&result); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return result.CredentialSubject, nil
+}
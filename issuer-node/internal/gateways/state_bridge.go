@@ -0,0 +1,239 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/eth"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+)
+
+// StateBridgeEthGateway sends the confirmed identity states to the bridge contracts of other chains.
+// The transactions are sent by the publishing accounts, with the nonces given by the nonce allocator.
+type StateBridgeEthGateway struct {
+	networkResolver  network.Resolver
+	publishingKeyIDs []kms.KeyID
+	accountSelection eth.AccountSelection
+	nonceAllocator   ports.NonceAllocator
+}
+
+// NewStateBridgeEthGateway creates a new instance of the state bridge gateway
+func NewStateBridgeEthGateway(resolver network.Resolver, publishingKeyPaths []string, accountSelection eth.AccountSelection, nonceAllocator ports.NonceAllocator) (*StateBridgeEthGateway, error) {
+	if len(publishingKeyPaths) == 0 {
+		return nil, errors.New("at least one publishing key is required")
+	}
+	keyIDs := make([]kms.KeyID, 0, len(publishingKeyPaths))
+	for _, path := range publishingKeyPaths {
+		keyIDs = append(keyIDs, kms.KeyID{
+			Type: kms.KeyTypeEthereum,
+			ID:   path,
+		})
+	}
+
+	return &StateBridgeEthGateway{
+		networkResolver:  resolver,
+		publishingKeyIDs: keyIDs,
+		accountSelection: accountSelection,
+		nonceAllocator:   nonceAllocator,
+	}, nil
+}
+
+// ReplicateState sends the confirmed state to the bridge contract of chain and returns the hash of the transaction.
+// It returns an *eth.RevertError when the bridge rejects the state.
+func (g *StateBridgeEthGateway) ReplicateState(ctx context.Context, chain string, state domain.IdentityState) (*string, error) {
+	replicated, err := toReplicatedState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	client, bridge, err := g.bridge(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxWT, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	account, err := client.SelectAccount(ctxWT, g.publishingKeyIDs, g.accountSelection, func(ctx context.Context, account eth.Account) (bool, error) {
+		balance, err := client.BalanceAt(ctx, account.Address)
+		if err != nil {
+			return false, err
+		}
+		return balance.Sign() > 0, nil
+	})
+	if err != nil {
+		log.Error(ctx, "failed to select replication account", "err", err, "chain", chain)
+		return nil, err
+	}
+
+	chainID, err := client.ChainID(ctxWT)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := g.nonceAllocator.Allocate(ctx, chainID.Int64(), account.Address, account.ConfirmedNonce, account.PendingNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := client.CreateTxOpts(ctxWT, account.KeyID)
+	if err != nil {
+		g.releaseNonce(ctx, chainID.Int64(), account.Address, nonce)
+		return nil, err
+	}
+	opts.Context = ctxWT
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+
+	tx, err := bridge.ReplicateState(opts, *replicated)
+	if err != nil {
+		g.releaseNonce(ctx, chainID.Int64(), account.Address, nonce)
+		return nil, err
+	}
+
+	txID := tx.Hash().Hex()
+	if err := g.nonceAllocator.MarkSent(ctx, chainID.Int64(), account.Address, nonce, txID); err != nil {
+		log.Error(ctx, "failed to mark nonce as sent", "err", err, "address", account.Address.Hex(), "nonce", nonce, "tx", txID)
+	}
+	log.Info(ctx, "state replication sent", "tx", txID, "chain", chain, "state", *state.State)
+
+	return &txID, nil
+}
+
+// ReplicationReceipt returns the status of the transaction txID that replicated a state to chain. The replication is
+// confirmed once the confirmation block count of the chain has been mined on top of the transaction, and it is pending
+// again when the node doesn't know the transaction because it was dropped from the mempool.
+func (g *StateBridgeEthGateway) ReplicationReceipt(ctx context.Context, chain string, txID string) (*domain.StateReplicationReceipt, error) {
+	client, bridge, err := g.bridge(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxWT, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	receipt, confirmed, err := bridge.Receipt(ctxWT, txID, client.GetConfirmationBlockCount())
+	if errors.Is(err, eth.ErrReceiptNotReceived) {
+		_, _, err := client.GetTransactionByID(ctxWT, txID)
+		if errors.Is(err, ethereum.NotFound) {
+			return &domain.StateReplicationReceipt{Status: domain.StateReplicationPending}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &domain.StateReplicationReceipt{Status: domain.StateReplicationTransacted}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := client.HeaderByNumber(ctxWT, receipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	blockNumber := int(receipt.BlockNumber.Int64())
+	blockTimestamp := int(header.Time)
+	result := &domain.StateReplicationReceipt{
+		Status:         domain.StateReplicationTransacted,
+		BlockNumber:    &blockNumber,
+		BlockTimestamp: &blockTimestamp,
+	}
+	switch {
+	case receipt.Status != types.ReceiptStatusSuccessful:
+		result.Status = domain.StateReplicationFailed
+	case confirmed:
+		result.Status = domain.StateReplicationConfirmed
+	}
+	return result, nil
+}
+
+// ReplaceReplication sends the pending replication transaction txID again with higher fees and the same nonce, and
+// returns the hash of the replacement. It returns eth.ErrTransactionNotPending if txID was mined in the meantime, and
+// eth.ErrTransactionNotFound if it was dropped from the mempool.
+func (g *StateBridgeEthGateway) ReplaceReplication(ctx context.Context, chain string, txID string) (*string, error) {
+	client, err := g.networkResolver.GetEthClient(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := client.ReplaceTransaction(ctx, txID, g.publishingKeyIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	replacementTxID := tx.Hash().Hex()
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		log.Error(ctx, "failed to get the sender of the replacement", "err", err, "tx", replacementTxID)
+		return &replacementTxID, nil
+	}
+	if err := g.nonceAllocator.MarkSent(ctx, tx.ChainId().Int64(), sender, tx.Nonce(), replacementTxID); err != nil {
+		log.Error(ctx, "failed to mark nonce as sent", "err", err, "address", sender.Hex(), "nonce", tx.Nonce(), "tx", replacementTxID)
+	}
+	return &replacementTxID, nil
+}
+
+// releaseNonce gives back the nonce of a replication that was not sent
+func (g *StateBridgeEthGateway) releaseNonce(ctx context.Context, chainID int64, address common.Address, nonce uint64) {
+	if err := g.nonceAllocator.Release(ctx, chainID, address, nonce); err != nil {
+		log.Error(ctx, "failed to release nonce", "err", err, "address", address.Hex(), "nonce", nonce)
+	}
+}
+
+// bridge returns the client of chain and the binding of its bridge contract
+func (g *StateBridgeEthGateway) bridge(chain string) (*eth.Client, *eth.StateBridge, error) {
+	client, err := g.networkResolver.GetEthClient(chain)
+	if err != nil {
+		return nil, nil, err
+	}
+	address, err := g.networkResolver.GetBridgeContractAddress(chain)
+	if err != nil {
+		return nil, nil, err
+	}
+	bridge, err := eth.NewStateBridge(*address, client.GetEthereumClient())
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, bridge, nil
+}
+
+// toReplicatedState returns the arguments of the replication of the state, with the chain and block it was confirmed in
+func toReplicatedState(state domain.IdentityState) (*eth.ReplicatedState, error) {
+	did, err := w3c.ParseDID(state.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	id, err := core.IDFromDID(*did)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := core.ChainIDfromDID(*did)
+	if err != nil {
+		return nil, err
+	}
+	if state.State == nil || state.BlockNumber == nil || state.BlockTimestamp == nil {
+		return nil, errors.New("the state is not confirmed")
+	}
+	stateHash, err := merkletree.NewHashFromHex(*state.State)
+	if err != nil {
+		return nil, err
+	}
+	return &eth.ReplicatedState{
+		ID:                   id.BigInt(),
+		State:                stateHash.BigInt(),
+		SourceChainID:        big.NewInt(int64(chainID)),
+		SourceBlockNumber:    big.NewInt(int64(*state.BlockNumber)),
+		SourceBlockTimestamp: big.NewInt(int64(*state.BlockTimestamp)),
+	}, nil
+}
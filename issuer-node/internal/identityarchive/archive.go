@@ -0,0 +1,160 @@
+// Package identityarchive moves a whole identity between issuer node deployments.
+// An archive holds the database rows of one DID, the nodes of its merkle trees and
+// its private keys, and it is encrypted with a passphrase before it is written to disk.
+package identityarchive
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+const (
+	// Version is the version of the archive format
+	Version = 1
+
+	saltLength = 16
+	keyLength  = 32
+	scryptN    = 1 << 15
+	scryptR    = 8
+	scryptP    = 1
+)
+
+var (
+	// ErrEmptyPassphrase is returned when the archive is encrypted or decrypted without a passphrase
+	ErrEmptyPassphrase = errors.New("the passphrase cannot be empty")
+	// ErrWrongPassphrase is returned when the archive cannot be decrypted with the given passphrase
+	ErrWrongPassphrase = errors.New("cannot decrypt the archive, wrong passphrase or corrupted file")
+	// ErrUnsupportedVersion is returned when the archive was written with another version of the format
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+)
+
+// Archive is the content of an identity export
+type Archive struct {
+	Version       int          `json:"version"`
+	DID           string       `json:"did"`
+	SchemaVersion int64        `json:"schemaVersion"` // last database migration applied in the exporting deployment
+	ExportedAt    time.Time    `json:"exportedAt"`
+	Tables        []Table      `json:"tables"`
+	MerkleTrees   []MerkleTree `json:"merkleTrees"`
+	Keys          []Key        `json:"keys"`
+}
+
+// Table holds the rows of a table that belong to the identity, encoded with row_to_json
+type Table struct {
+	Name string            `json:"name"`
+	Rows []json.RawMessage `json:"rows"`
+}
+
+// MerkleTree holds the root and the nodes of one of the identity merkle trees
+type MerkleTree struct {
+	Type  uint16            `json:"type"`
+	Root  json.RawMessage   `json:"root,omitempty"`
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+// Key is a private key of the identity, hex encoded
+type Key struct {
+	Type       kms.KeyType `json:"type"`
+	PrivateKey string      `json:"privateKey"`
+}
+
+// encryptedArchive is the file written to disk.
+// The DID is kept in clear so that the operator knows which identity a file belongs to,
+// and it is authenticated as additional data of the cipher.
+type encryptedArchive struct {
+	Version    int    `json:"version"`
+	DID        string `json:"did"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Encrypt serializes the archive and encrypts it with AES-GCM using a key derived from the passphrase with scrypt
+func Encrypt(archive *Archive, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, ErrEmptyPassphrase
+	}
+
+	plaintext, err := json.Marshal(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := newCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(encryptedArchive{
+		Version:    Version,
+		DID:        archive.DID,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, []byte(archive.DID)),
+	})
+}
+
+// Decrypt decrypts a file written by Encrypt and returns the archive
+func Decrypt(data []byte, passphrase string) (*Archive, error) {
+	if passphrase == "" {
+		return nil, ErrEmptyPassphrase
+	}
+
+	var encrypted encryptedArchive
+	if err := json.Unmarshal(data, &encrypted); err != nil {
+		return nil, fmt.Errorf("invalid archive file: %w", err)
+	}
+	if encrypted.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, encrypted.Version)
+	}
+
+	aead, err := newCipher(passphrase, encrypted.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(encrypted.Nonce) != aead.NonceSize() {
+		return nil, ErrWrongPassphrase
+	}
+	plaintext, err := aead.Open(nil, encrypted.Nonce, encrypted.Ciphertext, []byte(encrypted.DID))
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+
+	var archive Archive
+	if err := json.Unmarshal(plaintext, &archive); err != nil {
+		return nil, fmt.Errorf("invalid archive content: %w", err)
+	}
+	if archive.DID != encrypted.DID {
+		return nil, errors.New("the archive content does not belong to the DID of the file")
+	}
+	return &archive, nil
+}
+
+func newCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, keyLength)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
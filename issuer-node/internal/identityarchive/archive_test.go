@@ -0,0 +1,104 @@
+package identityarchive
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-merkletree-sql/v2/db/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	archive := &Archive{
+		Version:       Version,
+		DID:           "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR",
+		SchemaVersion: 2026101618000010,
+		ExportedAt:    time.Now().UTC().Truncate(time.Second),
+		Tables:        []Table{{Name: "identities", Rows: []json.RawMessage{json.RawMessage(`{"identifier":"did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"}`)}}},
+		MerkleTrees:   []MerkleTree{{Type: 0, Nodes: []json.RawMessage{}}},
+		Keys:          []Key{{Type: kms.KeyTypeBabyJubJub, PrivateKey: "9d7abdd5a43573ab9b623c50b9fc8f4357329d3009fe0fc22c8931161d98a03d"}},
+	}
+
+	data, err := Encrypt(archive, "passphrase")
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), archive.Keys[0].PrivateKey)
+
+	t.Run("right passphrase", func(t *testing.T) {
+		decrypted, err := Decrypt(data, "passphrase")
+		require.NoError(t, err)
+		assert.Equal(t, archive, decrypted)
+	})
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		_, err := Decrypt(data, "other passphrase")
+		assert.ErrorIs(t, err, ErrWrongPassphrase)
+	})
+
+	t.Run("empty passphrase", func(t *testing.T) {
+		_, err := Encrypt(archive, "")
+		assert.ErrorIs(t, err, ErrEmptyPassphrase)
+		_, err = Decrypt(data, "")
+		assert.ErrorIs(t, err, ErrEmptyPassphrase)
+	})
+
+	t.Run("DID of the file changed", func(t *testing.T) {
+		var encrypted encryptedArchive
+		require.NoError(t, json.Unmarshal(data, &encrypted))
+		encrypted.DID = "did:polygonid:polygon:amoy:2qV9QXdhXXmN5sKjN1YueMjxgRbnJcEGK2kGpvk3cq"
+		tampered, err := json.Marshal(encrypted)
+		require.NoError(t, err)
+		_, err = Decrypt(tampered, "passphrase")
+		assert.ErrorIs(t, err, ErrWrongPassphrase)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := Decrypt([]byte(`{"version":2}`), "passphrase")
+		assert.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+}
+
+func TestRecomputeRoot(t *testing.T) {
+	ctx := context.Background()
+	tree, err := merkletree.NewMerkleTree(ctx, memory.NewMemoryStorage(), mtDepth)
+	require.NoError(t, err)
+
+	emptyRoot, err := recomputeRoot(ctx, tree, tree.Root())
+	require.NoError(t, err)
+	assert.True(t, emptyRoot.Equals(tree.Root()))
+
+	for i := int64(1); i <= 10; i++ {
+		require.NoError(t, tree.Add(ctx, big.NewInt(i), big.NewInt(i*100)))
+	}
+	intermediateRoot := tree.Root()
+	require.NoError(t, tree.Add(ctx, big.NewInt(11), big.NewInt(1100)))
+
+	recomputed, err := recomputeRoot(ctx, tree, tree.Root())
+	require.NoError(t, err)
+	assert.True(t, recomputed.Equals(tree.Root()))
+
+	// the nodes are never deleted, so the roots of previous states can be recomputed too
+	recomputed, err = recomputeRoot(ctx, tree, intermediateRoot)
+	require.NoError(t, err)
+	assert.True(t, recomputed.Equals(intermediateRoot))
+
+	unknownRoot, err := merkletree.NewHashFromBigInt(big.NewInt(12345))
+	require.NoError(t, err)
+	_, err = recomputeRoot(ctx, tree, unknownRoot)
+	assert.Error(t, err)
+}
+
+func TestInsertFromJSONQuery(t *testing.T) {
+	assert.Equal(t,
+		`INSERT INTO "identities" ("identifier", "keytype") SELECT "identifier", "keytype" FROM json_populate_record(NULL::"identities", $1::json)`,
+		insertFromJSONQuery("identities", []string{"identifier", "keytype"}, ""))
+	assert.Equal(t,
+		`INSERT INTO "mt_nodes" ("mt_id", "key", "type") SELECT $2, "key", "type" FROM json_populate_record(NULL::"mt_nodes", $1::json)`,
+		insertFromJSONQuery("mt_nodes", []string{"key", "type"}, "mt_id"))
+}
@@ -0,0 +1,192 @@
+package identityarchive
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// table describes how the rows of an identity are found in a table.
+// The tables are listed in the order they have to be imported to satisfy the foreign keys.
+type table struct {
+	name      string
+	didColumn string
+	// where selects the rows of the identity, with the DID as $1, in the tables that have no DID column
+	where   string
+	orderBy string
+}
+
+var tables = []table{
+	{name: "identities", didColumn: "identifier", orderBy: "identifier"},
+	{name: "identity_states", didColumn: "identifier", orderBy: "state_id"},
+	{name: "identity_state_changes", didColumn: "identifier", orderBy: "id"},
+	{name: "identity_state_replications", didColumn: "identifier", orderBy: "created_at"},
+	{name: "revocation", didColumn: "identifier", orderBy: "id"},
+	{name: "display_methods", didColumn: "issuer_did", orderBy: "created_at"},
+	{name: "schemas", didColumn: "issuer_id", orderBy: "created_at"},
+	{name: "links", didColumn: "issuer_id", orderBy: "created_at"},
+	{name: "claims", didColumn: "identifier", orderBy: "created_at"},
+	{name: "credential_reissues", didColumn: "issuer_id", orderBy: "created_at"},
+	{name: "status_lists", didColumn: "issuer_id", orderBy: "created_at"},
+	{name: "status_list_entries", didColumn: "issuer_id", orderBy: "list_index"},
+	{name: "credential_templates", didColumn: "issuer_did", orderBy: "created_at"},
+	{name: "credential_expiration_policies", didColumn: "issuer_did", orderBy: "created_at"},
+	{name: "bulk_issuance_jobs", didColumn: "issuer_did", orderBy: "created_at"},
+	{name: "bulk_issuance_job_rows", where: "job_id IN (SELECT id FROM bulk_issuance_jobs WHERE issuer_did = $1)", orderBy: "row_number"},
+	{name: "verification_queries", didColumn: "issuer_did", orderBy: "created_at"},
+	{name: "verification_sessions", didColumn: "issuer_did", orderBy: "created_at"},
+	{name: "key_rotations", didColumn: "issuer_did", orderBy: "created_at"},
+	{name: "publish_policies", didColumn: "identifier", orderBy: "created_at"},
+	{name: "payment_options", didColumn: "issuer_did", orderBy: "created_at"},
+	{name: "keys", didColumn: "issuer_did", orderBy: "created_at"},
+}
+
+// query returns the query that reads the rows of the identity encoded with row_to_json
+func (t table) query() string {
+	where := t.where
+	if where == "" {
+		where = pgx.Identifier{t.didColumn}.Sanitize() + " = $1"
+	}
+	return fmt.Sprintf("SELECT row_to_json(t)::text FROM %s t WHERE %s ORDER BY %s",
+		pgx.Identifier{t.name}.Sanitize(), where, pgx.Identifier{t.orderBy}.Sanitize())
+}
+
+// Export reads everything the identity needs to be served by another deployment.
+// It fails before reading anything if any of the keys of the identity is stored by a key provider that cannot
+// export it (vault plugin, AWS KMS), as an archive without them would not be able to sign anything.
+func Export(ctx context.Context, conn db.Querier, keyStore *kms.KMS, did w3c.DID) (*Archive, error) {
+	keyIDs, err := keyStore.KeysByIdentity(ctx, did)
+	if err != nil {
+		return nil, fmt.Errorf("listing the keys of the identity: %w", err)
+	}
+	for _, keyID := range keyIDs {
+		if !keyStore.CanExportPrivateKeys(keyID.Type) {
+			return nil, fmt.Errorf("%w: %s key %s", kms.ErrPrivateKeyNotExportable, keyID.Type, keyID.ID)
+		}
+	}
+
+	schemaVersion, err := getSchemaVersion(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &Archive{
+		Version:       Version,
+		DID:           did.String(),
+		SchemaVersion: schemaVersion,
+		ExportedAt:    time.Now().UTC(),
+	}
+
+	for _, t := range tables {
+		rows, err := queryRows(ctx, conn, t.query(), did.String())
+		if err != nil {
+			return nil, fmt.Errorf("exporting table %s: %w", t.name, err)
+		}
+		archive.Tables = append(archive.Tables, Table{Name: t.name, Rows: rows})
+	}
+	if len(archive.Tables[0].Rows) == 0 {
+		return nil, fmt.Errorf("identity %s not found", did)
+	}
+
+	if archive.MerkleTrees, err = exportMerkleTrees(ctx, conn, did); err != nil {
+		return nil, err
+	}
+
+	if archive.Keys, err = exportKeys(ctx, keyStore, keyIDs); err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "identity exported", "did", did, "keys", len(archive.Keys))
+	return archive, nil
+}
+
+func exportMerkleTrees(ctx context.Context, conn db.Querier, did w3c.DID) ([]MerkleTree, error) {
+	rows, err := conn.Query(ctx, `SELECT id, type FROM identity_mts WHERE identifier = $1 ORDER BY type`, did.String())
+	if err != nil {
+		return nil, err
+	}
+	type identityMT struct {
+		id int64
+		tp uint16
+	}
+	var mts []identityMT
+	for rows.Next() {
+		var mt identityMT
+		if err := rows.Scan(&mt.id, &mt.tp); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		mts = append(mts, mt)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	trees := make([]MerkleTree, 0, len(mts))
+	for _, mt := range mts {
+		tree := MerkleTree{Type: mt.tp}
+		roots, err := queryRows(ctx, conn, `SELECT row_to_json(t)::text FROM mt_roots t WHERE mt_id = $1`, mt.id)
+		if err != nil {
+			return nil, fmt.Errorf("exporting root of merkle tree %d: %w", mt.tp, err)
+		}
+		if len(roots) > 0 {
+			tree.Root = roots[0]
+		}
+		if tree.Nodes, err = queryRows(ctx, conn, `SELECT row_to_json(t)::text FROM mt_nodes t WHERE mt_id = $1 ORDER BY created_at, key`, mt.id); err != nil {
+			return nil, fmt.Errorf("exporting nodes of merkle tree %d: %w", mt.tp, err)
+		}
+		trees = append(trees, tree)
+	}
+	return trees, nil
+}
+
+func exportKeys(ctx context.Context, keyStore *kms.KMS, keyIDs []kms.KeyID) ([]Key, error) {
+	keys := make([]Key, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		privateKey, err := keyStore.ExportPrivateKey(ctx, keyID)
+		if err != nil {
+			return nil, fmt.Errorf("exporting %s key %s: %w", keyID.Type, keyID.ID, err)
+		}
+		keys = append(keys, Key{Type: keyID.Type, PrivateKey: hex.EncodeToString(privateKey)})
+	}
+	return keys, nil
+}
+
+func queryRows(ctx context.Context, conn db.Querier, query string, args ...interface{}) ([]json.RawMessage, error) {
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]json.RawMessage, 0)
+	for rows.Next() {
+		var row string
+		if err := rows.Scan(&row); err != nil {
+			return nil, err
+		}
+		result = append(result, json.RawMessage(row))
+	}
+	return result, rows.Err()
+}
+
+// getSchemaVersion returns the last migration applied to the database.
+// Rows are copied column by column, so both deployments have to be on the same schema.
+func getSchemaVersion(ctx context.Context, conn db.Querier) (int64, error) {
+	var version int64
+	err := conn.QueryRow(ctx, `SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version WHERE is_applied`).Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("getting the database schema version: %w", err)
+	}
+	return version, nil
+}
@@ -0,0 +1,280 @@
+package identityarchive
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-merkletree-sql/v2/db/memory"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const mtDepth = 40
+
+var (
+	// ErrIdentityAlreadyExists is returned when the identity of the archive is already in the database
+	ErrIdentityAlreadyExists = errors.New("the identity already exists in this deployment")
+	// ErrSchemaVersionMismatch is returned when the archive was exported from a database with other migrations applied
+	ErrSchemaVersionMismatch = errors.New("the archive was exported from a database with a different schema version")
+	// ErrStateMismatch is returned when the state recomputed from the imported merkle trees is not the last confirmed one
+	ErrStateMismatch = errors.New("the state recomputed from the imported merkle trees does not match the last confirmed state")
+)
+
+// Import writes the content of the archive in the database and the private keys in the configured key provider.
+// Everything is written in a single transaction that is only committed once the state recomputed
+// from the imported merkle trees matches the last confirmed state of the identity.
+func Import(ctx context.Context, storage *db.Storage, keyStore *kms.KMS, mtService ports.MtService, archive *Archive) error {
+	if archive.Version != Version {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, archive.Version)
+	}
+	did, err := w3c.ParseDID(archive.DID)
+	if err != nil {
+		return fmt.Errorf("invalid DID in archive: %w", err)
+	}
+
+	schemaVersion, err := getSchemaVersion(ctx, storage.Pgx)
+	if err != nil {
+		return err
+	}
+	if schemaVersion != archive.SchemaVersion {
+		return fmt.Errorf("%w: archive %d, database %d", ErrSchemaVersionMismatch, archive.SchemaVersion, schemaVersion)
+	}
+	for _, key := range archive.Keys {
+		if !keyStore.CanImportPrivateKeys(key.Type) {
+			return fmt.Errorf("%w: %s key", kms.ErrPrivateKeyNotImportable, key.Type)
+		}
+	}
+
+	return storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM identities WHERE identifier = $1)`, archive.DID).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return ErrIdentityAlreadyExists
+		}
+
+		for _, t := range archive.Tables {
+			if err := importRows(ctx, tx, t.Name, t.Rows); err != nil {
+				return fmt.Errorf("importing table %s: %w", t.Name, err)
+			}
+		}
+
+		for _, tree := range archive.MerkleTrees {
+			if err := importMerkleTree(ctx, tx, archive.DID, tree); err != nil {
+				return fmt.Errorf("importing merkle tree %d: %w", tree.Type, err)
+			}
+		}
+
+		if err := verifyState(ctx, tx, mtService, did); err != nil {
+			return err
+		}
+
+		// The keys are the last step, so a failure before them does not leave keys without an identity.
+		// A key provider is not transactional: if a key fails, the keys already imported have to be removed by hand.
+		for _, key := range archive.Keys {
+			privateKey, err := hex.DecodeString(key.PrivateKey)
+			if err != nil {
+				return fmt.Errorf("invalid %s private key: %w", key.Type, err)
+			}
+			keyID, err := keyStore.ImportPrivateKey(ctx, key.Type, *did, privateKey)
+			if err != nil {
+				return fmt.Errorf("importing %s key: %w", key.Type, err)
+			}
+			log.Info(ctx, "key imported", "did", did, "type", key.Type, "keyID", keyID.ID)
+		}
+
+		log.Info(ctx, "identity imported", "did", did, "keys", len(archive.Keys))
+		return nil
+	})
+}
+
+func importRows(ctx context.Context, tx pgx.Tx, tableName string, rows []json.RawMessage) error {
+	if !isArchivedTable(tableName) {
+		return fmt.Errorf("unexpected table %s", tableName)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// identity and serial columns (state_id, revocation id, state change id) get new values in this database
+	columns, err := insertableColumns(ctx, tx, tableName)
+	if err != nil {
+		return err
+	}
+	query := insertFromJSONQuery(tableName, columns, "")
+	for _, row := range rows {
+		if _, err := tx.Exec(ctx, query, string(row)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importMerkleTree creates the tree for the identity and copies its root and its nodes.
+// The tree gets a new id in this database, so mt_id is replaced in every row.
+func importMerkleTree(ctx context.Context, tx pgx.Tx, did string, tree MerkleTree) error {
+	var mtID int64
+	if err := tx.QueryRow(ctx, `INSERT INTO identity_mts (identifier, type) VALUES ($1, $2) RETURNING id`, did, tree.Type).Scan(&mtID); err != nil {
+		return err
+	}
+
+	if tree.Root != nil {
+		columns, err := insertableColumns(ctx, tx, "mt_roots", "mt_id")
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, insertFromJSONQuery("mt_roots", columns, "mt_id"), string(tree.Root), mtID); err != nil {
+			return err
+		}
+	}
+
+	columns, err := insertableColumns(ctx, tx, "mt_nodes", "mt_id")
+	if err != nil {
+		return err
+	}
+	query := insertFromJSONQuery("mt_nodes", columns, "mt_id")
+	for _, node := range tree.Nodes {
+		if _, err := tx.Exec(ctx, query, string(node), mtID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyState rebuilds the trees of the last confirmed state from their leaves and checks
+// that the roots and the state they produce are the ones stored for that state.
+func verifyState(ctx context.Context, tx pgx.Tx, mtService ports.MtService, did *w3c.DID) error {
+	var state domain.IdentityState
+	err := tx.QueryRow(ctx, `
+		SELECT state, claims_tree_root, revocation_tree_root, root_of_roots
+		FROM identity_states
+		WHERE identifier = $1 AND status = $2
+		ORDER BY state_id DESC
+		LIMIT 1`, did.String(), domain.StatusConfirmed).
+		Scan(&state.State, &state.ClaimsTreeRoot, &state.RevocationTreeRoot, &state.RootOfRoots)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New("the identity has no confirmed state")
+		}
+		return err
+	}
+
+	trees, err := mtService.GetIdentityMerkleTrees(ctx, tx, did)
+	if err != nil {
+		return err
+	}
+
+	roots := make([]*merkletree.Hash, 0, len(trees.Trees))
+	for mtType, stored := range []*string{state.ClaimsTreeRoot, state.RevocationTreeRoot, state.RootOfRoots} {
+		if stored == nil {
+			return fmt.Errorf("%w: merkle tree %d has no root in the confirmed state", ErrStateMismatch, mtType)
+		}
+		root, err := merkletree.NewHashFromHex(*stored)
+		if err != nil {
+			return err
+		}
+		recomputed, err := recomputeRoot(ctx, trees.Trees[mtType], root)
+		if err != nil {
+			return fmt.Errorf("recomputing merkle tree %d: %w", mtType, err)
+		}
+		if !recomputed.Equals(root) {
+			return fmt.Errorf("%w: merkle tree %d root is %s, expected %s", ErrStateMismatch, mtType, recomputed.Hex(), root.Hex())
+		}
+		roots = append(roots, recomputed)
+	}
+
+	recomputedState, err := merkletree.HashElems(roots[0].BigInt(), roots[1].BigInt(), roots[2].BigInt())
+	if err != nil {
+		return err
+	}
+	if state.State == nil || recomputedState.Hex() != *state.State {
+		return fmt.Errorf("%w: recomputed %s", ErrStateMismatch, recomputedState.Hex())
+	}
+	log.Info(ctx, "imported state verified", "did", did, "state", recomputedState.Hex())
+	return nil
+}
+
+// recomputeRoot walks the tree from root, adds its leaves to an empty tree and returns the new root.
+// A missing node makes the walk fail, and a node whose content was altered produces another root.
+func recomputeRoot(ctx context.Context, tree *merkletree.MerkleTree, root *merkletree.Hash) (*merkletree.Hash, error) {
+	var leaves []*merkletree.Node
+	err := tree.Walk(ctx, root, func(node *merkletree.Node) {
+		if node.Type == merkletree.NodeTypeLeaf {
+			leaves = append(leaves, node)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rebuilt, err := merkletree.NewMerkleTree(ctx, memory.NewMemoryStorage(), mtDepth)
+	if err != nil {
+		return nil, err
+	}
+	for _, leaf := range leaves {
+		if err := rebuilt.Add(ctx, leaf.Entry[0].BigInt(), leaf.Entry[1].BigInt()); err != nil {
+			return nil, err
+		}
+	}
+	return rebuilt.Root(), nil
+}
+
+// insertableColumns returns the columns of the table that can be copied, skipping identity and serial columns and the excluded ones
+func insertableColumns(ctx context.Context, tx pgx.Tx, tableName string, excluded ...string) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1 AND is_identity = 'NO' AND is_generated = 'NEVER'
+		  AND COALESCE(column_default, '') NOT LIKE 'nextval(%'
+		ORDER BY ordinal_position`, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make([]string, 0)
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(excluded, column) {
+			columns = append(columns, column)
+		}
+	}
+	return columns, rows.Err()
+}
+
+// insertFromJSONQuery builds an insert of a row encoded with row_to_json passed as $1.
+// If override is not empty, that column takes the value of $2 instead of the one in the row.
+func insertFromJSONQuery(tableName string, columns []string, override string) string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = pgx.Identifier{column}.Sanitize()
+	}
+	target := strings.Join(quoted, ", ")
+	values := target
+	if override != "" {
+		target = pgx.Identifier{override}.Sanitize() + ", " + target
+		values = "$2, " + values
+	}
+	table := pgx.Identifier{tableName}.Sanitize()
+	return fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM json_populate_record(NULL::%s, $1::json)", table, target, values, table)
+}
+
+func isArchivedTable(name string) bool {
+	return slices.ContainsFunc(tables, func(t table) bool { return t.name == name })
+}
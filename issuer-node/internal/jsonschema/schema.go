@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 
 	core "github.com/iden3/go-iden3-core/v2"
 	jsonSuite "github.com/iden3/go-schema-processor/v2/json"
@@ -157,7 +158,17 @@ func ValidateCredentialSubject(ctx context.Context, loader loader.DocumentLoader
 		return err
 	}
 
-	schemaContext, err := schema.JSONLdContext()
+	return schema.validateCredentialSubject(loader, schemaType, cSubject)
+}
+
+// ValidateCredentialSubject validates that the given credential subject matches the schema without loading it again.
+// Unlike the package function, the given credential subject is not modified.
+func (s *JSONSchema) ValidateCredentialSubject(loader loader.DocumentLoader, schemaType string, cSubject map[string]interface{}) error {
+	return s.validateCredentialSubject(loader, schemaType, maps.Clone(cSubject))
+}
+
+func (s *JSONSchema) validateCredentialSubject(loader loader.DocumentLoader, schemaType string, cSubject map[string]interface{}) error {
+	schemaContext, err := s.JSONLdContext()
 	if err != nil {
 		return err
 	}
@@ -167,7 +178,7 @@ func ValidateCredentialSubject(ctx context.Context, loader loader.DocumentLoader
 		return err
 	}
 
-	err = validateDummyVCAgainstSchema(dummyVC, schema)
+	err = validateDummyVCAgainstSchema(dummyVC, s)
 	if err != nil {
 		return err
 	}
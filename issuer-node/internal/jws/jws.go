@@ -0,0 +1,199 @@
+// Package jws builds compact JSON Web Signatures signed with the keys stored in the KMS.
+// Ethereum keys sign with ES256K and Ed25519 keys with EdDSA. BabyJubJub keys are not supported.
+package jws
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+const (
+	// AlgES256K is the algorithm used to sign with Ethereum keys
+	AlgES256K = "ES256K"
+	// AlgEdDSA is the algorithm used to sign with Ed25519 keys
+	AlgEdDSA = "EdDSA"
+
+	ethSignatureLength          = 64
+	ethCoordinateLength         = 32
+	ethCompressedPubKeyLength   = 33
+	ethUncompressedPubKeyLength = 65
+)
+
+// thumbprintMembers are the required members of the OKP and EC keys, the only ones hashed in their thumbprints
+var thumbprintMembers = []string{"crv", "kty", "x", "y"}
+
+// ErrUnsupportedKeyType is returned when the key cannot be used to sign a JWS
+var ErrUnsupportedKeyType = errors.New("unsupported key type for jws signatures")
+
+// Header is the protected header of a JWS. The alg member is set by the sign functions.
+type Header map[string]any
+
+// Alg returns the JWS algorithm used with the given key type
+func Alg(keyType kms.KeyType) (string, error) {
+	switch keyType {
+	case kms.KeyTypeEthereum:
+		return AlgES256K, nil
+	case kms.KeyTypeEd25519:
+		return AlgEdDSA, nil
+	default:
+		return "", ErrUnsupportedKeyType
+	}
+}
+
+// SigningKey returns the first key of the list that can be used to sign a JWS, preferring Ed25519 keys.
+// It returns kms.ErrKeyNotFound if there is no such key.
+func SigningKey(keyIDs []kms.KeyID) (kms.KeyID, error) {
+	var ethKey *kms.KeyID
+	for i := range keyIDs {
+		switch keyIDs[i].Type {
+		case kms.KeyTypeEd25519:
+			return keyIDs[i], nil
+		case kms.KeyTypeEthereum:
+			if ethKey == nil {
+				ethKey = &keyIDs[i]
+			}
+		}
+	}
+	if ethKey == nil {
+		return kms.KeyID{}, kms.ErrKeyNotFound
+	}
+	return *ethKey, nil
+}
+
+// PublicJWK returns the public key as a JSON Web Key
+func PublicJWK(keyStore kms.KMSType, keyID kms.KeyID) (map[string]any, error) {
+	pubKey, err := keyStore.PublicKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	switch keyID.Type {
+	case kms.KeyTypeEd25519:
+		return map[string]any{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"x":   base64.RawURLEncoding.EncodeToString(pubKey),
+		}, nil
+	case kms.KeyTypeEthereum:
+		ecdsaKey, err := decodeETHPublicKey(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{
+			"kty": "EC",
+			"crv": "secp256k1",
+			"x":   base64.RawURLEncoding.EncodeToString(ecdsaKey.X.FillBytes(make([]byte, ethCoordinateLength))),
+			"y":   base64.RawURLEncoding.EncodeToString(ecdsaKey.Y.FillBytes(make([]byte, ethCoordinateLength))),
+		}, nil
+	default:
+		return nil, ErrUnsupportedKeyType
+	}
+}
+
+// Sign returns the compact serialization of a JWS of the payload signed with the given key.
+// The public key is not embedded in the header, that should have the kid of the verification method of the key in the
+// DID document of the signer, so that verifiers check the signature with a key published by the signer.
+func Sign(ctx context.Context, keyStore kms.KMSType, keyID kms.KeyID, header Header, payload []byte) (string, error) {
+	protected := make(Header, len(header)+1)
+	for k, v := range header {
+		protected[k] = v
+	}
+
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	encodedHeader, signature, err := sign(ctx, keyStore, keyID, protected, []byte(encodedPayload))
+	if err != nil {
+		return "", err
+	}
+	return encodedHeader + "." + encodedPayload + "." + signature, nil
+}
+
+// SignDetached returns a JWS with a detached and unencoded payload (RFC 7797), as used by the JsonWebSignature2020 proofs.
+// The payload is signed as is and it is not included in the serialization, that has the form header..signature
+func SignDetached(ctx context.Context, keyStore kms.KMSType, keyID kms.KeyID, header Header, payload []byte) (string, error) {
+	protected := make(Header, len(header)+2)
+	for k, v := range header {
+		protected[k] = v
+	}
+	protected["b64"] = false
+	protected["crit"] = []string{"b64"}
+
+	encodedHeader, signature, err := sign(ctx, keyStore, keyID, protected, payload)
+	if err != nil {
+		return "", err
+	}
+	return encodedHeader + ".." + signature, nil
+}
+
+// Thumbprint returns the base64url encoded SHA-256 thumbprint (RFC 7638) of a JWK returned by PublicJWK
+func Thumbprint(jwk map[string]any) (string, error) {
+	members := make(map[string]any, len(thumbprintMembers))
+	for _, member := range thumbprintMembers {
+		if v, ok := jwk[member]; ok {
+			members[member] = v
+		}
+	}
+	// json.Marshal sorts the members of maps, as required by the thumbprint
+	b, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(digest[:]), nil
+}
+
+// sign sets the algorithm of the key in the header and signs header.payload. It returns the encoded header and signature.
+func sign(ctx context.Context, keyStore kms.KMSType, keyID kms.KeyID, header Header, payload []byte) (string, string, error) {
+	alg, err := Alg(keyID.Type)
+	if err != nil {
+		return "", "", err
+	}
+	header["alg"] = alg
+
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return "", "", err
+	}
+	encodedHeader := base64.RawURLEncoding.EncodeToString(headerBytes)
+	signingInput := append([]byte(encodedHeader+"."), payload...)
+
+	var signature []byte
+	switch keyID.Type {
+	case kms.KeyTypeEthereum:
+		digest := sha256.Sum256(signingInput)
+		signature, err = keyStore.Sign(ctx, keyID, digest[:])
+		if err != nil {
+			return "", "", err
+		}
+		if len(signature) < ethSignatureLength {
+			return "", "", fmt.Errorf("unexpected signature length %d", len(signature))
+		}
+		// drop the recovery id, ES256K signatures are R || S
+		signature = signature[:ethSignatureLength]
+	case kms.KeyTypeEd25519:
+		signature, err = keyStore.Sign(ctx, keyID, signingInput)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return encodedHeader, base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func decodeETHPublicKey(pubKey []byte) (*ecdsa.PublicKey, error) {
+	switch len(pubKey) {
+	case ethCompressedPubKeyLength:
+		return crypto.DecompressPubkey(pubKey)
+	case ethUncompressedPubKeyLength:
+		return crypto.UnmarshalPubkey(pubKey)
+	default:
+		return kms.DecodeAWSETHPubKey(context.Background(), pubKey)
+	}
+}
@@ -0,0 +1,197 @@
+package kms
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	stderr "errors"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gagliardetto/solana-go"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/pkg/errors"
+)
+
+// ErrPrivateKeyNotExportable raises when the key provider does not allow to read the private keys it stores
+var ErrPrivateKeyNotExportable = stderr.New("the key provider does not allow exporting private keys")
+
+// ErrPrivateKeyNotImportable raises when the key provider does not allow to store a given private key
+var ErrPrivateKeyNotImportable = stderr.New("the key provider does not allow importing private keys")
+
+// ErrKeyAlreadyExists raises when an imported key is already stored by the key provider
+var ErrKeyAlreadyExists = stderr.New("key already exists")
+
+// privateKeyExporter is implemented by the key providers that can return the private keys they store
+type privateKeyExporter interface {
+	exportPrivateKey(ctx context.Context, keyID KeyID) ([]byte, error)
+}
+
+// privateKeyImporter is implemented by the key providers that can store a private key generated elsewhere
+type privateKeyImporter interface {
+	importPrivateKey(ctx context.Context, identity w3c.DID, privateKey []byte) (KeyID, error)
+}
+
+// CanExportPrivateKeys reports whether the provider of the key type can return the private keys it stores
+func (k *KMS) CanExportPrivateKeys(kt KeyType) bool {
+	_, ok := k.registry[kt].(privateKeyExporter)
+	return ok
+}
+
+// CanImportPrivateKeys reports whether the provider of the key type can store a private key generated elsewhere
+func (k *KMS) CanImportPrivateKeys(kt KeyType) bool {
+	_, ok := k.registry[kt].(privateKeyImporter)
+	return ok
+}
+
+// ExportPrivateKey returns the raw private key of keyID.
+// It is used to move the keys of an identity between deployments and fails
+// with ErrPrivateKeyNotExportable when the provider never reveals the keys (vault plugin, AWS KMS).
+func (k *KMS) ExportPrivateKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	kp, ok := k.registry[keyID.Type]
+	if !ok {
+		return nil, errors.WithStack(ErrUnknownKeyType)
+	}
+	exporter, ok := kp.(privateKeyExporter)
+	if !ok {
+		return nil, ErrPrivateKeyNotExportable
+	}
+	return exporter.exportPrivateKey(ctx, keyID)
+}
+
+// ImportPrivateKey stores a raw private key of type kt bound to identity.
+// The key ID is built by the provider from the public key, so it can be different
+// from the key ID the key had in the provider it was exported from.
+func (k *KMS) ImportPrivateKey(ctx context.Context, kt KeyType, identity w3c.DID, privateKey []byte) (KeyID, error) {
+	kp, ok := k.registry[kt]
+	if !ok {
+		return KeyID{}, errors.WithStack(ErrUnknownKeyType)
+	}
+	importer, ok := kp.(privateKeyImporter)
+	if !ok {
+		return KeyID{}, ErrPrivateKeyNotImportable
+	}
+	return importer.importPrivateKey(ctx, identity, privateKey)
+}
+
+// publicKeyFromPrivate returns the compressed public key of a raw private key.
+// Ed25519 private keys are the 32 bytes seed, as stored by the local providers.
+func publicKeyFromPrivate(keyType KeyType, privateKey []byte) ([]byte, error) {
+	switch keyType {
+	case KeyTypeBabyJubJub:
+		if len(privateKey) != defaultLength {
+			return nil, errors.New("incorrect private key")
+		}
+		var bjjPrivateKey babyjub.PrivateKey
+		copy(bjjPrivateKey[:], privateKey)
+		compressed := bjjPrivateKey.Public().Compress()
+		return compressed[:], nil
+	case KeyTypeEthereum:
+		ethPrivateKey, err := crypto.ToECDSA(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.CompressPubkey(&ethPrivateKey.PublicKey), nil
+	case KeyTypeEd25519:
+		if len(privateKey) != ed25519.SeedSize {
+			return nil, errors.New("incorrect private key")
+		}
+		publicKey, ok := ed25519.NewKeyFromSeed(privateKey).Public().(ed25519.PublicKey)
+		if !ok {
+			return nil, errors.New("unexpected public key type")
+		}
+		return publicKey, nil
+	default:
+		return nil, ErrUnknownKeyType
+	}
+}
+
+// importLocalPrivateKey saves a private key in the storage of a local key provider under keyID
+func importLocalPrivateKey(ctx context.Context, storageManager StorageManager, keyID KeyID, privateKey []byte) (KeyID, error) {
+	_, err := storageManager.getKeyMaterial(ctx, keyID)
+	if err == nil {
+		return KeyID{}, ErrKeyAlreadyExists
+	}
+	if !stderr.Is(err, ErrKeyNotFound) {
+		return KeyID{}, err
+	}
+
+	keyMaterial := map[string]string{
+		jsonKeyType: string(keyID.Type),
+		jsonKeyData: hex.EncodeToString(privateKey),
+	}
+	if err := storageManager.SaveKeyMaterial(ctx, keyMaterial, keyID.ID); err != nil {
+		return KeyID{}, err
+	}
+	return keyID, nil
+}
+
+func (ls *localBJJKeyProvider) exportPrivateKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	return ls.privateKey(ctx, keyID)
+}
+
+func (ls *localBJJKeyProvider) importPrivateKey(ctx context.Context, identity w3c.DID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(ls.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	keyID := KeyID{Type: ls.keyType, ID: keyPathForLocalProvider(&identity, ls.keyType, hex.EncodeToString(publicKey))}
+	return importLocalPrivateKey(ctx, ls.storageManager, keyID, privateKey)
+}
+
+func (ls *localEthKeyProvider) exportPrivateKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	return ls.privateKey(ctx, keyID)
+}
+
+func (ls *localEthKeyProvider) importPrivateKey(ctx context.Context, identity w3c.DID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(ls.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	keyID := KeyID{Type: ls.keyType, ID: getKeyID(&identity, ls.keyType, hex.EncodeToString(publicKey))}
+	return importLocalPrivateKey(ctx, ls.storageManager, keyID, privateKey)
+}
+
+func (ls *localEd25519KeyProvider) exportPrivateKey(ctx context.Context, keyID KeyID) ([]byte, error) {
+	privateKey, err := ls.privateKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return privateKey.Seed(), nil
+}
+
+func (ls *localEd25519KeyProvider) importPrivateKey(ctx context.Context, identity w3c.DID, privateKey []byte) (KeyID, error) {
+	publicKey, err := publicKeyFromPrivate(ls.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	keyID := KeyID{Type: ls.keyType, ID: getKeyID(&identity, ls.keyType, solana.PublicKeyFromBytes(publicKey).String())}
+	return importLocalPrivateKey(ctx, ls.storageManager, keyID, privateKey)
+}
+
+// importPrivateKey uses the import endpoint of the iden3 vault plugin.
+// The plugin never returns the private keys, so the vault provider does not implement privateKeyExporter.
+func (v *vaultPluginIden3KeyProvider) importPrivateKey(_ context.Context, identity w3c.DID, privateKey []byte) (KeyID, error) {
+	pubKey, err := publicKeyFromPrivate(v.keyType, privateKey)
+	if err != nil {
+		return KeyID{}, err
+	}
+	pluginKeyType, err := toPluginKeyType(v.keyType)
+	if err != nil {
+		return KeyID{}, err
+	}
+
+	keyPath := v.keyPathFromPublic(&identity, hex.EncodeToString(pubKey))
+	if _, err := publicKey(v.vaultCli, keyPath); err == nil {
+		return KeyID{}, ErrKeyAlreadyExists
+	}
+
+	data := map[string]interface{}{
+		jsonKeyType:    pluginKeyType,
+		jsonPrivateKey: hex.EncodeToString(privateKey),
+	}
+	if _, err := v.vaultCli.Logical().Write(keyPath.importKey(), data); err != nil {
+		return KeyID{}, err
+	}
+	return KeyID{Type: v.keyType, ID: keyPath.keyID}, nil
+}
@@ -0,0 +1,81 @@
+package kms
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLocalTestKMS(t *testing.T) *KMS {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), LocalStorageFileName)
+	require.NoError(t, os.WriteFile(file, []byte("[]"), 0o600))
+	storageManager := NewFileStorageManager(file)
+
+	keyStore := NewKMS()
+	require.NoError(t, keyStore.RegisterKeyProvider(KeyTypeBabyJubJub, NewLocalBJJKeyProvider(KeyTypeBabyJubJub, storageManager)))
+	require.NoError(t, keyStore.RegisterKeyProvider(KeyTypeEthereum, NewLocalEthKeyProvider(KeyTypeEthereum, storageManager)))
+	require.NoError(t, keyStore.RegisterKeyProvider(KeyTypeEd25519, NewLocalEd25519KeyProvider(KeyTypeEd25519, storageManager)))
+	return keyStore
+}
+
+func TestKMS_ExportImportPrivateKey(t *testing.T) {
+	ctx := context.Background()
+	did := randomDID(t)
+	source := newLocalTestKMS(t)
+	target := newLocalTestKMS(t)
+
+	ethPrivateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	_, ed25519PrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	bjjKeyID, err := source.CreateKey(KeyTypeBabyJubJub, &did)
+	require.NoError(t, err)
+	ethKeyID, err := source.ImportPrivateKey(ctx, KeyTypeEthereum, did, crypto.FromECDSA(ethPrivateKey))
+	require.NoError(t, err)
+	ed25519KeyID, err := source.ImportPrivateKey(ctx, KeyTypeEd25519, did, ed25519PrivateKey.Seed())
+	require.NoError(t, err)
+
+	for _, keyID := range []KeyID{bjjKeyID, ethKeyID, ed25519KeyID} {
+		t.Run(string(keyID.Type), func(t *testing.T) {
+			privateKey, err := source.ExportPrivateKey(ctx, keyID)
+			require.NoError(t, err)
+
+			importedKeyID, err := target.ImportPrivateKey(ctx, keyID.Type, did, privateKey)
+			require.NoError(t, err)
+			assert.Equal(t, keyID, importedKeyID)
+
+			sourcePublicKey, err := source.PublicKey(keyID)
+			require.NoError(t, err)
+			targetPublicKey, err := target.PublicKey(importedKeyID)
+			require.NoError(t, err)
+			assert.Equal(t, sourcePublicKey, targetPublicKey)
+
+			_, err = target.ImportPrivateKey(ctx, keyID.Type, did, privateKey)
+			assert.ErrorIs(t, err, ErrKeyAlreadyExists)
+		})
+	}
+
+	t.Run("keys are listed by identity after the import", func(t *testing.T) {
+		keys, err := target.KeysByIdentity(ctx, did)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []KeyID{bjjKeyID, ethKeyID, ed25519KeyID}, keys)
+	})
+
+	t.Run("provider that does not export keys", func(t *testing.T) {
+		keyStore := NewKMS()
+		require.NoError(t, keyStore.RegisterKeyProvider(KeyTypeEthereum, &awsKmsEthKeyProvider{keyType: KeyTypeEthereum}))
+		_, err := keyStore.ExportPrivateKey(ctx, KeyID{Type: KeyTypeEthereum, ID: "key"})
+		assert.ErrorIs(t, err, ErrPrivateKeyNotExportable)
+		_, err = keyStore.ImportPrivateKey(ctx, KeyTypeEthereum, did, crypto.FromECDSA(ethPrivateKey))
+		assert.ErrorIs(t, err, ErrPrivateKeyNotImportable)
+	})
+}
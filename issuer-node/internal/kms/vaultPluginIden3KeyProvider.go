@@ -390,6 +390,10 @@ func (p keyPathT) new() string {
 	return p.join("new")
 }
 
+func (p keyPathT) importKey() string {
+	return p.join("import")
+}
+
 func toPluginKeyType(keyType KeyType) (pluginIden3KeyTp, error) {
 	switch keyType {
 	case KeyTypeBabyJubJub:
@@ -55,6 +55,8 @@ type Resolver struct {
 	supportedContracts       map[string]*abi.State
 	stateResolvers           map[string]pubsignals.StateResolver
 	supportedNetworks        []SupportedNetworks
+	replicationTargets       map[resolverPrefix][]string
+	bridgeContracts          map[resolverPrefix]common.Address
 }
 
 // SupportedNetworks holds the chain and networks supoprted
@@ -88,11 +90,17 @@ type ResolverSettings map[string]map[string]struct {
 	WaitReceiptCycleTime   time.Duration `yaml:"waitReceiptCycleTime"`
 	WaitBlockCycleTime     time.Duration `yaml:"waitBlockCycleTime"`
 	GasLess                bool          `yaml:"gasLess"`
+	GasBumpInterval        time.Duration `yaml:"gasBumpInterval"`
+	GasBumpPercent         int           `yaml:"gasBumpPercent"`
+	MaxGasFeeCap           int           `yaml:"maxGasFeeCap"`
+	MinAccountBalance      *big.Int      `yaml:"minAccountBalance"`
 	TransferAmountWei      *big.Int      `yaml:"transferAmountWei"`
 	RhsSettings            RhsSettings   `yaml:"rhsSettings"`
 	NetworkFlag            byte          `yaml:"networkFlag"`
 	ChainID                string        `yaml:"chainID"`
 	Method                 string        `yaml:"method"`
+	ReplicateTo            []string      `yaml:"replicateTo"`
+	BridgeContractAddress  string        `yaml:"bridgeContractAddress"`
 }
 
 // NewResolver returns a new Network Resolver
@@ -108,6 +116,8 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 	rhsSettings := make(map[resolverPrefix]RhsSettings)
 	supportedContracts := make(map[string]*abi.State)
 	stateResolvers := make(map[string]pubsignals.StateResolver)
+	replicationTargets := make(map[resolverPrefix][]string)
+	bridgeContracts := make(map[resolverPrefix]common.Address)
 
 	log.Info(ctx, "the issuer node will use the resolver settings file for configuring multi chain feature")
 	var printer strings.Builder
@@ -142,6 +152,10 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 				RPCResponseTimeout:     networkSettings.RPCResponseTimeout,
 				WaitReceiptCycleTime:   networkSettings.WaitReceiptCycleTime,
 				WaitBlockCycleTime:     networkSettings.WaitBlockCycleTime,
+				GasBumpInterval:        networkSettings.GasBumpInterval,
+				GasBumpPercent:         networkSettings.GasBumpPercent,
+				MaxGasFeeCap:           big.NewInt(int64(networkSettings.MaxGasFeeCap)),
+				MinAccountBalance:      networkSettings.MinAccountBalance,
 			}, kms)
 
 			resolverClientConfig := &ResolverClientConfig{
@@ -179,6 +193,16 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 			supportedContracts[resolverPrefixKey] = stateContract
 
 			stateResolvers[resolverPrefixKey] = state.NewETHResolver(networkSettings.NetworkURL, networkSettings.ContractAddress)
+
+			if len(networkSettings.ReplicateTo) > 0 {
+				replicationTargets[resolverPrefix(resolverPrefixKey)] = networkSettings.ReplicateTo
+			}
+			if networkSettings.BridgeContractAddress != "" {
+				if !common.IsHexAddress(networkSettings.BridgeContractAddress) {
+					return nil, fmt.Errorf("invalid bridge contract address for %s", resolverPrefixKey)
+				}
+				bridgeContracts[resolverPrefix(resolverPrefixKey)] = common.HexToAddress(networkSettings.BridgeContractAddress)
+			}
 		}
 		supportedNetworks = append(supportedNetworks, supportedNetwork)
 
@@ -186,6 +210,17 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 
 	log.Info(ctx, "resolver settings", "settings:", printer.String())
 
+	for source, targets := range replicationTargets {
+		for _, target := range targets {
+			if target == string(source) {
+				return nil, fmt.Errorf("%s cannot replicate its states to itself", source)
+			}
+			if _, ok := bridgeContracts[resolverPrefix(target)]; !ok {
+				return nil, fmt.Errorf("%s replicates its states to %s, which is not configured or has no bridge contract address", source, target)
+			}
+		}
+	}
+
 	return &Resolver{
 		ethereumClients:          ethereumClients,
 		ethereumClientsByChainID: ethereumClientsByChainID,
@@ -193,6 +228,8 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 		supportedContracts:       supportedContracts,
 		stateResolvers:           stateResolvers,
 		supportedNetworks:        supportedNetworks,
+		replicationTargets:       replicationTargets,
+		bridgeContracts:          bridgeContracts,
 	}, nil
 }
 
@@ -270,6 +307,35 @@ func (r *Resolver) GetConfirmationTimeout(resolverPrefixKey string) (time.Durati
 	return confirmationTimeout, nil
 }
 
+// GetGasBumpInterval returns the time a state transition can be pending before it is replaced with higher fees.
+// Zero means that the transactions are not replaced.
+func (r *Resolver) GetGasBumpInterval(resolverPrefixKey string) (time.Duration, error) {
+	resolverClientConfig, ok := r.ethereumClients[resolverPrefix(resolverPrefixKey)]
+	if !ok {
+		return 0, fmt.Errorf("contract address not found for %s", resolverPrefixKey)
+	}
+	return resolverClientConfig.client.GetGasBumpInterval(), nil
+}
+
+// GetReplicationTargets returns the networks, by their resolver prefix, that the confirmed states of the identities
+// of each network are replicated to
+func (r *Resolver) GetReplicationTargets() map[string][]string {
+	targets := make(map[string][]string, len(r.replicationTargets))
+	for source, chains := range r.replicationTargets {
+		targets[string(source)] = chains
+	}
+	return targets
+}
+
+// GetBridgeContractAddress returns the address of the bridge contract that receives the states replicated to the network
+func (r *Resolver) GetBridgeContractAddress(resolverPrefixKey string) (*common.Address, error) {
+	address, ok := r.bridgeContracts[resolverPrefix(resolverPrefixKey)]
+	if !ok {
+		return nil, fmt.Errorf("bridge contract address not found for %s", resolverPrefixKey)
+	}
+	return &address, nil
+}
+
 // GetSupportedContracts returns the supported contracts
 func (r *Resolver) GetSupportedContracts() map[string]*abi.State {
 	return r.supportedContracts
@@ -3,7 +3,9 @@ package notifications
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/iden3/iden3comm/v2/packers"
@@ -58,6 +60,24 @@ func NewRevokedMsg(claim *domain.Claim) ([]byte, error) {
 	return json.Marshal(statusUpdate)
 }
 
+// NewExpirationMsg returns a message that notifies the holder that the credential is about to expire
+func NewExpirationMsg(claim *domain.Claim) ([]byte, error) {
+	msgID := uuid.NewString()
+	statusUpdate := &protocol.CredentialStatusUpdateMessage{
+		ID:       msgID,
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.CredentialStatusUpdateMessageType,
+		ThreadID: msgID,
+		Body: protocol.CredentialStatusUpdateMessageBody{
+			ID:     claim.ID.String(),
+			Reason: fmt.Sprintf("claim expires at %s, request a new one to renew it", time.Unix(claim.Expiration, 0).UTC().Format(time.RFC3339)),
+		},
+		From: claim.Issuer,
+		To:   claim.OtherIdentifier,
+	}
+	return json.Marshal(statusUpdate)
+}
+
 func toProtocolCredentialOffer(credentials []*domain.Claim) []protocol.CredentialOffer {
 	offers := make([]protocol.CredentialOffer, len(credentials))
 	for i := range credentials {
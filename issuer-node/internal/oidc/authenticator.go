@@ -0,0 +1,191 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	jwksFetchTimeout = 10 * time.Second
+	acceptableSkew   = time.Minute
+)
+
+var (
+	// ErrInvalidToken means that the token is malformed, expired, not signed by the identity provider or issued for another audience
+	ErrInvalidToken = errors.New("invalid bearer token")
+	// ErrNoRoles means that the token is valid but none of the roles of the user is mapped to issuer node permissions
+	ErrNoRoles = errors.New("none of the roles of the token is allowed to use the api")
+)
+
+// Authenticator validates the bearer JWTs issued by an OpenID Connect provider and maps their roles claim to
+// issuer node permissions and identities
+type Authenticator struct {
+	cfg        config.OIDC
+	roles      Roles
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	keys      jwk.Set
+	fetchedAt time.Time
+}
+
+// NewAuthenticator returns an authenticator for the given configuration. The key set is loaded
+// here so that a wrong configuration is detected on startup.
+func NewAuthenticator(ctx context.Context, cfg config.OIDC) (*Authenticator, error) {
+	if !cfg.Enabled() {
+		return nil, errors.New("oidc authentication is not configured")
+	}
+	roles, err := LoadRoles(cfg.RolesPath)
+	if err != nil {
+		return nil, err
+	}
+	a := &Authenticator{
+		cfg:        cfg,
+		roles:      roles,
+		httpClient: &http.Client{Timeout: jwksFetchTimeout},
+	}
+	if _, err := a.keySet(ctx); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Authenticate validates the signature, issuer, audience and expiration of the token and returns the user with
+// the permissions and identities of its roles
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	keys, err := a.keySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := jwt.Parse([]byte(token),
+		jwt.WithKeySet(keys, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithIssuer(a.cfg.Issuer),
+		jwt.WithAudience(a.cfg.Audience),
+		jwt.WithAcceptableSkew(acceptableSkew),
+	)
+	if err != nil {
+		log.Debug(ctx, "invalid bearer token", "err", err)
+		return nil, ErrInvalidToken
+	}
+
+	var subject string
+	_ = parsed.Get("sub", &subject)
+	userRoles, err := rolesFromClaims(parsed, a.cfg.RolesClaim)
+	if err != nil {
+		log.Debug(ctx, "reading roles claim", "err", err, "claim", a.cfg.RolesClaim)
+		return nil, ErrNoRoles
+	}
+	principal := a.roles.principal(subject, userRoles)
+	if !principal.Admin && (len(principal.Permissions) == 0 || len(principal.Identities) == 0) {
+		return nil, ErrNoRoles
+	}
+	return principal, nil
+}
+
+// keySet returns the JSON Web Key Set of the provider. A key set downloaded from JWKSURL is fetched again when it is
+// older than JWKSRefresh so that the keys rotated by the provider are picked up. If the download fails the previous
+// key set is used.
+func (a *Authenticator) keySet(ctx context.Context) (jwk.Set, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.keys != nil && (a.cfg.JWKSURL == "" || time.Since(a.fetchedAt) < a.cfg.JWKSRefresh) {
+		return a.keys, nil
+	}
+
+	keys, err := a.loadKeySet(ctx)
+	if err != nil {
+		if a.keys != nil {
+			log.Warn(ctx, "refreshing oidc key set, using the previous one", "err", err)
+			return a.keys, nil
+		}
+		return nil, err
+	}
+	a.keys = keys
+	a.fetchedAt = time.Now()
+	return a.keys, nil
+}
+
+func (a *Authenticator) loadKeySet(ctx context.Context) (jwk.Set, error) {
+	var content []byte
+	var err error
+	if a.cfg.JWKSFile != "" {
+		content, err = os.ReadFile(a.cfg.JWKSFile)
+	} else {
+		content, err = a.fetchKeySet(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading oidc key set: %w", err)
+	}
+	keys, err := jwk.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing oidc key set: %w", err)
+	}
+	return keys, nil
+}
+
+func (a *Authenticator) fetchKeySet(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.cfg.JWKSURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// rolesFromClaims returns the value of the roles claim, which can be a nested claim like realm_access.roles and
+// can contain a list of roles or a single one
+func rolesFromClaims(token jwt.Token, claim string) ([]string, error) {
+	path := strings.Split(claim, ".")
+	var value any
+	if err := token.Get(path[0], &value); err != nil {
+		return nil, err
+	}
+	for _, name := range path[1:] {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("claim %s is not an object", name)
+		}
+		if value, ok = object[name]; !ok {
+			return nil, fmt.Errorf("claim %s not found", name)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}, nil
+	case []string:
+		return v, nil
+	case []any:
+		roles := make([]string, 0, len(v))
+		for _, role := range v {
+			if name, ok := role.(string); ok {
+				roles = append(roles, name)
+			}
+		}
+		return roles, nil
+	default:
+		return nil, fmt.Errorf("unexpected type %T of the roles claim", value)
+	}
+}
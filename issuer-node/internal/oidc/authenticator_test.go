@@ -0,0 +1,195 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const (
+	testIssuer   = "https://idp.example.com/realms/issuer"
+	testAudience = "issuer-node"
+	testIdentity = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+	testKeyID    = "test-key"
+)
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, jwks(t, &privateKey.PublicKey), 0o600))
+	authenticator, err := NewAuthenticator(ctx, config.OIDC{
+		JWKSFile:   jwksFile,
+		Issuer:     testIssuer,
+		Audience:   testAudience,
+		RolesClaim: "realm_access.roles",
+		RolesPath:  "testdata/oidc_roles.test.yaml",
+	})
+	require.NoError(t, err)
+
+	claims := func(roles ...string) map[string]any {
+		return map[string]any{
+			"iss":          testIssuer,
+			"aud":          testAudience,
+			"sub":          "alice",
+			"exp":          time.Now().Add(time.Hour).Unix(),
+			"realm_access": map[string]any{"roles": roles},
+		}
+	}
+
+	t.Run("Operator role", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(ctx, signedJWT(t, privateKey, claims("kyc-operators", "unknown")))
+		require.NoError(t, err)
+		assert.Equal(t, "alice", principal.Subject)
+		assert.False(t, principal.Admin)
+		assert.True(t, principal.HasIdentity(testIdentity))
+		assert.False(t, principal.HasIdentity("did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"))
+		assert.True(t, principal.HasPermission(domain.APIKeyPermissionCredentialsWrite))
+		assert.False(t, principal.HasPermission(domain.APIKeyPermissionKeysAdmin))
+	})
+
+	t.Run("Roles are merged", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(ctx, signedJWT(t, privateKey, claims("kyc-operators", "auditors")))
+		require.NoError(t, err)
+		assert.True(t, principal.HasIdentity("did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"))
+		assert.True(t, principal.HasPermission(domain.APIKeyPermissionCredentialsRead))
+		assert.True(t, principal.HasPermission(domain.APIKeyPermissionLinksRead))
+	})
+
+	t.Run("Admin role", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(ctx, signedJWT(t, privateKey, claims("issuer-admins")))
+		require.NoError(t, err)
+		assert.True(t, principal.Admin)
+		assert.True(t, principal.HasPermission(domain.APIKeyPermissionKeysAdmin))
+	})
+
+	t.Run("No mapped roles", func(t *testing.T) {
+		_, err := authenticator.Authenticate(ctx, signedJWT(t, privateKey, claims("unknown")))
+		assert.ErrorIs(t, err, ErrNoRoles)
+	})
+
+	t.Run("Invalid tokens", func(t *testing.T) {
+		for _, tc := range []struct {
+			name  string
+			token string
+		}{
+			{name: "Malformed", token: "not-a-jwt"},
+			{name: "Other signer", token: signedJWT(t, otherKey, claims("issuer-admins"))},
+			{name: "Other issuer", token: signedJWT(t, privateKey, with(claims("issuer-admins"), "iss", "https://evil.example.com"))},
+			{name: "Other audience", token: signedJWT(t, privateKey, with(claims("issuer-admins"), "aud", "another-api"))},
+			{name: "Expired", token: signedJWT(t, privateKey, with(claims("issuer-admins"), "exp", time.Now().Add(-time.Hour).Unix()))},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := authenticator.Authenticate(ctx, tc.token)
+				assert.ErrorIs(t, err, ErrInvalidToken)
+			})
+		}
+	})
+}
+
+func TestAuthenticator_JWKSURL(t *testing.T) {
+	ctx := context.Background()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var requests atomic.Int32
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write(jwks(t, &privateKey.PublicKey))
+	}))
+	defer jwksServer.Close()
+
+	authenticator, err := NewAuthenticator(ctx, config.OIDC{
+		JWKSURL:     jwksServer.URL,
+		JWKSRefresh: time.Hour,
+		Issuer:      testIssuer,
+		Audience:    testAudience,
+		RolesClaim:  "groups",
+		RolesPath:   "testdata/oidc_roles.test.yaml",
+	})
+	require.NoError(t, err)
+
+	token := signedJWT(t, privateKey, map[string]any{
+		"iss":    testIssuer,
+		"aud":    []string{testAudience, "account"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"groups": "auditors",
+	})
+	for range 3 {
+		principal, err := authenticator.Authenticate(ctx, token)
+		require.NoError(t, err)
+		assert.True(t, principal.HasPermission(domain.APIKeyPermissionCredentialsRead))
+	}
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestLoadRoles(t *testing.T) {
+	roles, err := LoadRoles("testdata/oidc_roles.test.yaml")
+	require.NoError(t, err)
+	assert.Len(t, roles, 3)
+	assert.True(t, roles["issuer-admins"].Admin)
+
+	invalid := filepath.Join(t.TempDir(), "roles.yaml")
+	require.NoError(t, os.WriteFile(invalid, []byte("roles:\n  operators:\n    identities: [\"*\"]\n    permissions: [\"credentials:delete\"]\n"), 0o600))
+	_, err = LoadRoles(invalid)
+	assert.Error(t, err)
+}
+
+func with(claims map[string]any, name string, value any) map[string]any {
+	claims[name] = value
+	return claims
+}
+
+// jwks returns a JSON Web Key Set with the RSA public key
+func jwks(t *testing.T, key *rsa.PublicKey) []byte {
+	t.Helper()
+	set, err := json.Marshal(map[string]any{
+		"keys": []map[string]any{{
+			"kty": "RSA",
+			"kid": testKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+	return set
+}
+
+// signedJWT returns a RS256 JWT with the claims
+func signedJWT(t *testing.T, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": testKeyID})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	hash := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+	return strings.Join([]string{signingInput, base64.RawURLEncoding.EncodeToString(signature)}, ".")
+}
@@ -0,0 +1,97 @@
+package oidc
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// AllIdentities is the value of the identities of a role that is allowed to use every identity
+const AllIdentities = "*"
+
+// Role is what the users with a role or group of the identity provider are allowed to do.
+// Admin roles can perform every operation, like the basic auth user.
+type Role struct {
+	Admin       bool                      `yaml:"admin"`
+	Identities  []string                  `yaml:"identities"`
+	Permissions []domain.APIKeyPermission `yaml:"permissions"`
+}
+
+// Roles maps the roles or groups of the identity provider to issuer node permissions and identities
+type Roles map[string]Role
+
+type rolesFile struct {
+	Roles Roles `yaml:"roles"`
+}
+
+// LoadRoles reads the roles mapping from a yaml file like:
+//
+//	roles:
+//	  issuer-admins:
+//	    admin: true
+//	  kyc-operators:
+//	    identities: [ "did:iden3:polygon:amoy:xCRp75DgAdS63W65fmXHz6p9DwdonuRU9e46DifhX" ]
+//	    permissions: [ "credentials:write", "links:read" ]
+func LoadRoles(path string) (Roles, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading oidc roles file: %w", err)
+	}
+	var file rolesFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("parsing oidc roles file: %w", err)
+	}
+	for name, role := range file.Roles {
+		for _, permission := range role.Permissions {
+			if !permission.IsValid() {
+				return nil, fmt.Errorf("invalid permission %s in oidc role %s", permission, name)
+			}
+		}
+	}
+	return file.Roles, nil
+}
+
+// Principal is the user of a valid token with the permissions and identities of all its roles
+type Principal struct {
+	Subject     string
+	Admin       bool
+	Identities  []string
+	Permissions []domain.APIKeyPermission
+}
+
+// HasPermission returns true if one of the roles of the user has the permission
+func (p *Principal) HasPermission(permission domain.APIKeyPermission) bool {
+	return p.Admin || slices.Contains(p.Permissions, permission)
+}
+
+// HasIdentity returns true if one of the roles of the user is allowed to use the identity
+func (p *Principal) HasIdentity(did string) bool {
+	return p.Admin || slices.Contains(p.Identities, AllIdentities) || slices.Contains(p.Identities, did)
+}
+
+// principal merges the roles of the user that are in the mapping. Roles that are not in the mapping are ignored.
+func (r Roles) principal(subject string, userRoles []string) *Principal {
+	principal := &Principal{Subject: subject}
+	for _, name := range userRoles {
+		role, ok := r[name]
+		if !ok {
+			continue
+		}
+		principal.Admin = principal.Admin || role.Admin
+		for _, identity := range role.Identities {
+			if !slices.Contains(principal.Identities, identity) {
+				principal.Identities = append(principal.Identities, identity)
+			}
+		}
+		for _, permission := range role.Permissions {
+			if !slices.Contains(principal.Permissions, permission) {
+				principal.Permissions = append(principal.Permissions, permission)
+			}
+		}
+	}
+	return principal
+}
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrAPIKeyNotFound API key not found
+var ErrAPIKeyNotFound = errors.New("api key not found")
+
+const apiKeyColumns = `id, name, key_hash, identities, permissions, created_at, last_used_at`
+
+type apiKey struct {
+	conn db.Storage
+}
+
+// NewAPIKey returns a new API keys repository
+func NewAPIKey(conn db.Storage) ports.APIKeyRepository {
+	return &apiKey{
+		conn,
+	}
+}
+
+// Save stores a new API key or updates the name, identities and permissions of an existing one
+func (a *apiKey) Save(ctx context.Context, key *domain.APIKey) error {
+	permissions := make([]string, len(key.Permissions))
+	for i, permission := range key.Permissions {
+		permissions[i] = string(permission)
+	}
+	sql := `INSERT INTO api_keys (id, name, key_hash, identities, permissions) VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (id) DO
+			UPDATE SET name=EXCLUDED.name, identities=EXCLUDED.identities, permissions=EXCLUDED.permissions
+			RETURNING created_at`
+	return a.conn.Pgx.QueryRow(ctx, sql, key.ID, key.Name, key.KeyHash, key.Identities, permissions).Scan(&key.CreatedAt)
+}
+
+// GetByID returns the API key with the given id
+func (a *apiKey) GetByID(ctx context.Context, id uuid.UUID) (*domain.APIKey, error) {
+	return a.getOne(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE id=$1`, id)
+}
+
+// GetByHash returns the API key whose key has the given hash
+func (a *apiKey) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	return a.getOne(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash=$1`, keyHash)
+}
+
+// GetAll returns all the API keys, the newest first
+func (a *apiKey) GetAll(ctx context.Context) ([]*domain.APIKey, error) {
+	rows, err := a.conn.Pgx.Query(ctx, `SELECT `+apiKeyColumns+` FROM api_keys ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]*domain.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// UpdateLastUsed sets the last time the API key was used to now
+func (a *apiKey) UpdateLastUsed(ctx context.Context, id uuid.UUID) error {
+	_, err := a.conn.Pgx.Exec(ctx, `UPDATE api_keys SET last_used_at=NOW() WHERE id=$1`, id)
+	return err
+}
+
+// Delete removes the API key
+func (a *apiKey) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := a.conn.Pgx.Exec(ctx, `DELETE FROM api_keys WHERE id=$1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (a *apiKey) getOne(ctx context.Context, sql string, args ...interface{}) (*domain.APIKey, error) {
+	key, err := scanAPIKey(a.conn.Pgx.QueryRow(ctx, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+func scanAPIKey(row pgx.Row) (*domain.APIKey, error) {
+	var key domain.APIKey
+	var permissions []string
+	if err := row.Scan(&key.ID, &key.Name, &key.KeyHash, &key.Identities, &permissions, &key.CreatedAt, &key.LastUsedAt); err != nil {
+		return nil, err
+	}
+	key.Permissions = make([]domain.APIKeyPermission, len(permissions))
+	for i, permission := range permissions {
+		key.Permissions[i] = domain.APIKeyPermission(permission)
+	}
+	return &key, nil
+}
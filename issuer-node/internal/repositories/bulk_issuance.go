@@ -0,0 +1,255 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// bulkIssuanceRowsPerInsert keeps the number of parameters of a multi row insert far below the postgres limit
+const bulkIssuanceRowsPerInsert = 1000
+
+var (
+	// ErrBulkIssuanceJobNotFound bulk issuance job not found
+	ErrBulkIssuanceJobNotFound = errors.New("bulk issuance job not found")
+	// ErrBulkIssuanceSchemaNotFound the schema of the bulk issuance job does not exist
+	ErrBulkIssuanceSchemaNotFound = errors.New("bulk issuance job schema not found")
+)
+
+type bulkIssuance struct {
+	conn db.Storage
+}
+
+// NewBulkIssuance returns a new bulk issuance jobs repository
+func NewBulkIssuance(conn db.Storage) ports.BulkIssuanceRepository {
+	return &bulkIssuance{
+		conn,
+	}
+}
+
+// Save stores the job or updates its status if it already exists
+func (b *bulkIssuance) Save(ctx context.Context, conn db.Querier, job *domain.BulkIssuanceJob) error {
+	if conn == nil {
+		conn = b.conn.Pgx
+	}
+	sql := `INSERT INTO bulk_issuance_jobs (id, issuer_did, schema_id, format, status, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_status_type, credential_refresh_service, credential_display_method)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (id) DO
+			UPDATE SET status=$5, updated_at=NOW()`
+	_, err := conn.Exec(ctx, sql, job.ID, job.IssuerCoreDID().String(), job.SchemaID, string(job.Format), string(job.Status), job.CredentialExpiration,
+		job.CredentialSignatureProof, job.CredentialMTPProof, string(job.CredentialStatusType), job.CredentialRefreshService, job.CredentialDisplayMethod)
+	if err != nil && strings.Contains(err.Error(), "bulk_issuance_jobs_schemas_id_key") {
+		return ErrBulkIssuanceSchemaNotFound
+	}
+	return err
+}
+
+// SaveRows stores the given rows using multi row inserts
+func (b *bulkIssuance) SaveRows(ctx context.Context, conn db.Querier, rows []domain.BulkIssuanceRow) error {
+	if conn == nil {
+		conn = b.conn.Pgx
+	}
+	for start := 0; start < len(rows); start += bulkIssuanceRowsPerInsert {
+		end := min(start+bulkIssuanceRowsPerInsert, len(rows))
+		values := make([]string, 0, end-start)
+		args := make([]interface{}, 0, (end-start)*5)
+		for i, row := range rows[start:end] {
+			credentialSubject := pgtype.JSONB{}
+			if err := credentialSubject.Set(row.CredentialSubject); err != nil {
+				return fmt.Errorf("cannot set credential subject values: %w", err)
+			}
+			values = append(values, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", i*5+1, i*5+2, i*5+3, i*5+4, i*5+5))
+			args = append(args, row.JobID, row.RowNumber, credentialSubject, string(row.Status), row.Error)
+		}
+		sql := `INSERT INTO bulk_issuance_job_rows (job_id, row_number, credential_subject, status, error) VALUES ` + strings.Join(values, ", ")
+		if _, err := conn.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateRow updates the status, credential and error of the row
+func (b *bulkIssuance) UpdateRow(ctx context.Context, conn db.Querier, row *domain.BulkIssuanceRow) error {
+	if conn == nil {
+		conn = b.conn.Pgx
+	}
+	sql := `UPDATE bulk_issuance_job_rows SET status=$3, credential_id=$4, error=$5, updated_at=NOW() WHERE job_id=$1 AND row_number=$2`
+	_, err := conn.Exec(ctx, sql, row.JobID, row.RowNumber, string(row.Status), row.CredentialID, row.Error)
+	return err
+}
+
+// GetByID returns the job with its row counters
+func (b *bulkIssuance) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.BulkIssuanceJob, error) {
+	sql := `SELECT ` + bulkIssuanceJobFields + `
+			FROM bulk_issuance_jobs
+			LEFT JOIN bulk_issuance_job_rows ON bulk_issuance_job_rows.job_id = bulk_issuance_jobs.id
+			WHERE bulk_issuance_jobs.issuer_did=$1 AND bulk_issuance_jobs.id=$2
+			GROUP BY bulk_issuance_jobs.id`
+	job, err := scanBulkIssuanceJob(b.conn.Pgx.QueryRow(ctx, sql, issuerDID.String(), id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBulkIssuanceJobNotFound
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+// GetRows returns a page of rows of the job, optionally filtered by status, and the total number of rows that match the filter
+func (b *bulkIssuance) GetRows(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, filter ports.BulkIssuanceRowsFilter) ([]domain.BulkIssuanceRow, uint, error) {
+	sql := `SELECT job_rows.job_id, job_rows.row_number, job_rows.credential_subject, job_rows.status, job_rows.credential_id, job_rows.error
+			FROM bulk_issuance_job_rows job_rows
+			JOIN bulk_issuance_jobs jobs ON jobs.id = job_rows.job_id
+			WHERE jobs.issuer_did=$1 AND jobs.id=$2`
+	sqlArgs := []interface{}{issuerDID.String(), id}
+	if filter.Status != nil {
+		sql += " AND job_rows.status=$3"
+		sqlArgs = append(sqlArgs, string(*filter.Status))
+	}
+
+	var count uint
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s) as count", sql)
+	if err := b.conn.Pgx.QueryRow(ctx, countQuery, sqlArgs...).Scan(&count); err != nil {
+		return nil, 0, err
+	}
+
+	sql += " ORDER BY job_rows.row_number"
+	if filter.MaxResults > 0 {
+		sql += fmt.Sprintf(" OFFSET %d LIMIT %d;", (filter.Page-1)*filter.MaxResults, filter.MaxResults)
+	}
+	rows, err := b.conn.Pgx.Query(ctx, sql, sqlArgs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	result, err := scanBulkIssuanceRows(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, count, nil
+}
+
+// GetUnfinished returns all the jobs, from any issuer, that are pending or being processed, oldest first
+func (b *bulkIssuance) GetUnfinished(ctx context.Context) ([]*domain.BulkIssuanceJob, error) {
+	sql := `SELECT ` + bulkIssuanceJobFields + `
+			FROM bulk_issuance_jobs
+			LEFT JOIN bulk_issuance_job_rows ON bulk_issuance_job_rows.job_id = bulk_issuance_jobs.id
+			WHERE bulk_issuance_jobs.status IN ($1, $2)
+			GROUP BY bulk_issuance_jobs.id
+			ORDER BY bulk_issuance_jobs.created_at`
+	rows, err := b.conn.Pgx.Query(ctx, sql, string(domain.BulkIssuanceJobStatusPending), string(domain.BulkIssuanceJobStatusProcessing))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := make([]*domain.BulkIssuanceJob, 0)
+	for rows.Next() {
+		job, err := scanBulkIssuanceJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
+
+// LockPendingRows returns up to limit pending rows of the job and locks them until the transaction finishes.
+// Rows already locked by another transaction are skipped, so several workers can process the same job.
+func (b *bulkIssuance) LockPendingRows(ctx context.Context, tx db.Querier, id uuid.UUID, limit int) ([]domain.BulkIssuanceRow, error) {
+	sql := `SELECT job_id, row_number, credential_subject, status, credential_id, error
+			FROM bulk_issuance_job_rows
+			WHERE job_id=$1 AND status=$2
+			ORDER BY row_number
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED`
+	rows, err := tx.Query(ctx, sql, id, string(domain.BulkIssuanceRowStatusPending), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanBulkIssuanceRows(rows)
+}
+
+const bulkIssuanceJobFields = `bulk_issuance_jobs.id,
+       bulk_issuance_jobs.issuer_did,
+       bulk_issuance_jobs.schema_id,
+       bulk_issuance_jobs.format,
+       bulk_issuance_jobs.status,
+       bulk_issuance_jobs.credential_expiration,
+       bulk_issuance_jobs.credential_signature_proof,
+       bulk_issuance_jobs.credential_mtp_proof,
+       bulk_issuance_jobs.credential_status_type,
+       bulk_issuance_jobs.credential_refresh_service,
+       bulk_issuance_jobs.credential_display_method,
+       bulk_issuance_jobs.created_at,
+       bulk_issuance_jobs.updated_at,
+       COUNT(bulk_issuance_job_rows.row_number),
+       COUNT(bulk_issuance_job_rows.row_number) FILTER (WHERE bulk_issuance_job_rows.status = 'pending'),
+       COUNT(bulk_issuance_job_rows.row_number) FILTER (WHERE bulk_issuance_job_rows.status = 'issued'),
+       COUNT(bulk_issuance_job_rows.row_number) FILTER (WHERE bulk_issuance_job_rows.status = 'failed')`
+
+func scanBulkIssuanceJob(row pgx.Row) (*domain.BulkIssuanceJob, error) {
+	var job domain.BulkIssuanceJob
+	var format, status, credentialStatusType string
+	err := row.Scan(
+		&job.ID,
+		&job.IssuerDID,
+		&job.SchemaID,
+		&format,
+		&status,
+		&job.CredentialExpiration,
+		&job.CredentialSignatureProof,
+		&job.CredentialMTPProof,
+		&credentialStatusType,
+		&job.CredentialRefreshService,
+		&job.CredentialDisplayMethod,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+		&job.TotalRows,
+		&job.PendingRows,
+		&job.IssuedRows,
+		&job.FailedRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	job.Format = domain.BulkIssuanceFormat(format)
+	job.Status = domain.BulkIssuanceJobStatus(status)
+	job.CredentialStatusType = verifiable.CredentialStatusType(credentialStatusType)
+	return &job, nil
+}
+
+func scanBulkIssuanceRows(rows pgx.Rows) ([]domain.BulkIssuanceRow, error) {
+	result := make([]domain.BulkIssuanceRow, 0)
+	for rows.Next() {
+		var row domain.BulkIssuanceRow
+		var status string
+		var credentialSubject pgtype.JSONB
+		if err := rows.Scan(&row.JobID, &row.RowNumber, &credentialSubject, &status, &row.CredentialID, &row.Error); err != nil {
+			return nil, err
+		}
+		d := json.NewDecoder(bytes.NewReader(credentialSubject.Bytes))
+		d.UseNumber()
+		if err := d.Decode(&row.CredentialSubject); err != nil {
+			return nil, fmt.Errorf("parsing credential subject: %w", err)
+		}
+		row.Status = domain.BulkIssuanceRowStatus(status)
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
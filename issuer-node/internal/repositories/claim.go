@@ -91,7 +91,8 @@ func (c *claim) GetRevoked(ctx context.Context, conn db.Querier, currentState st
 		mtp,
 		claims.created_at,
 		claims.encrypted_data,
-		claims.context_url
+		claims.context_url,
+		claims.suspended
 	FROM claims
 	INNER JOIN revocation ON claims.rev_nonce = revocation.nonce AND claims.issuer = revocation.identifier
 	WHERE claims.identity_state = $1`
@@ -307,7 +308,9 @@ func (c *claim) GetByRevocationNonce(ctx context.Context, conn db.Querier, ident
 				   core_claim,
 				   mtp,
 				   encrypted_data,
-				   context_url
+				   context_url,
+				   revoked,
+				   suspended
 			FROM claims
 			LEFT JOIN identity_states ON claims.identity_state = identity_states.state
 			WHERE claims.identifier = $1
@@ -349,7 +352,9 @@ func (c *claim) GetByRevocationNonce(ctx context.Context, conn db.Querier, ident
 			&claim.CoreClaim,
 			&claim.MtProof,
 			&claim.EncryptedData,
-			&claim.ContextUrl)
+			&claim.ContextUrl,
+			&claim.Revoked,
+			&claim.Suspended)
 		if err != nil {
 			return nil, err
 		}
@@ -524,7 +529,8 @@ func (c *claim) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifie
 					link_id, 
 					encrypted_data,
 					context_url, 
-					created_at
+					created_at,
+					suspended
         FROM claims
         WHERE claims.identifier = $1 AND claims.id = $2`, identifier.String(), claimID).Scan(
 		&claim.ID,
@@ -549,7 +555,8 @@ func (c *claim) GetByIdAndIssuer(ctx context.Context, conn db.Querier, identifie
 		&claim.LinkID,
 		&claim.EncryptedData,
 		&claim.ContextUrl,
-		&claim.CreatedAt)
+		&claim.CreatedAt,
+		&claim.Suspended)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil, ErrClaimDoesNotExist
@@ -611,7 +618,8 @@ func (c *claim) GetNonRevokedByConnectionAndIssuerID(ctx context.Context, conn d
 				   mtp,
 				   claims.created_at,
 				   claims.encrypted_data,
-				   claims.context_url
+				   claims.context_url,
+				   claims.suspended
 			FROM claims
 			JOIN connections ON connections.issuer_id = claims.issuer AND connections.user_id = claims.other_identifier
 			LEFT JOIN identity_states  ON claims.identity_state = identity_states.state
@@ -845,6 +853,7 @@ func processClaims(rows pgx.Rows) ([]*domain.Claim, error) {
 			&claim.CreatedAt,
 			&claim.EncryptedData,
 			&claim.ContextUrl,
+			&claim.Suspended,
 		)
 		if err != nil {
 			return nil, err
@@ -880,6 +889,7 @@ func buildGetAllQueryAndFilters(issuerID w3c.DID, filter *ports.ClaimsFilter) (q
 		"claims.created_at",
 		"claims.encrypted_data",
 		"claims.context_url",
+		"claims.suspended",
 	}
 	query = `SELECT ##QUERYFIELDS## FROM claims
 			LEFT JOIN identity_states ON claims.identity_state = identity_states.state 
@@ -916,6 +926,10 @@ func buildGetAllQueryAndFilters(issuerID w3c.DID, filter *ports.ClaimsFilter) (q
 		filters = append(filters, *filter.Revoked)
 		query = fmt.Sprintf("%s and claims.revoked = $%d", query, len(filters))
 	}
+	if filter.Suspended != nil {
+		filters = append(filters, *filter.Suspended)
+		query = fmt.Sprintf("%s and claims.suspended = $%d", query, len(filters))
+	}
 	if filter.QueryField != "" {
 		filters = append(filters, filter.QueryField, filter.QueryFieldValue)
 		query = fmt.Sprintf("%s and data -> 'credentialSubject'  ->>$%d = $%d ", query, len(filters)-1, len(filters))
@@ -1000,7 +1014,8 @@ func (c *claim) GetAuthClaimsForPublishing(ctx context.Context, conn db.Querier,
 		mtp,
 		claims.created_at,
 		claims.encrypted_data,
-    	claims.context_url
+    	claims.context_url,
+		claims.suspended
 	FROM claims
 	LEFT JOIN identity_states  ON claims.identity_state = identity_states.state
 	LEFT JOIN revocation  ON claims.rev_nonce = revocation.nonce AND claims.issuer = revocation.identifier
@@ -1266,3 +1281,175 @@ func (c *claim) GetAuthCoreClaims(ctx context.Context, conn db.Querier, identifi
 	}
 	return claims, nil
 }
+
+// SaveReissue stores the relation between a reissued credential and the credential that replaces it
+func (c *claim) SaveReissue(ctx context.Context, conn db.Querier, reissue *domain.CredentialReissue) error {
+	_, err := conn.Exec(ctx, `INSERT INTO credential_reissues (issuer_id, predecessor_id, successor_id) VALUES($1, $2, $3)`,
+		reissue.Issuer,
+		reissue.PredecessorID,
+		reissue.SuccessorID)
+	if err != nil {
+		return fmt.Errorf("error saving the credential reissue: %w", err)
+	}
+	return nil
+}
+
+// UpdateSuspended sets the suspension of the claims of the identifier with the given revocation nonce. It returns the number of claims updated.
+func (c *claim) UpdateSuspended(ctx context.Context, conn db.Querier, identifier *w3c.DID, nonce domain.RevNonceUint64, suspended bool) (int64, error) {
+	tag, err := conn.Exec(ctx, `UPDATE claims SET suspended = $3 WHERE identifier = $1 AND rev_nonce = $2`, identifier.String(), nonce, suspended)
+	if err != nil {
+		return 0, fmt.Errorf("error updating the claim suspension: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// IsSuspended returns true if any claim of the identifier with the given revocation nonce is suspended
+func (c *claim) IsSuspended(ctx context.Context, conn db.Querier, identifier *w3c.DID, nonce domain.RevNonceUint64) (bool, error) {
+	var suspended bool
+	err := conn.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM claims WHERE identifier = $1 AND rev_nonce = $2 AND suspended)`, identifier.String(), nonce).Scan(&suspended)
+	return suspended, err
+}
+
+// GetExpiring returns the non revoked credentials of the identifier that expire in the range of the filter, sorted by expiration
+func (c *claim) GetExpiring(ctx context.Context, conn db.Querier, identifier w3c.DID, filter ports.ExpiringClaimsFilter) ([]*domain.Claim, error) {
+	query := `SELECT claims.id,
+				   issuer,
+				   schema_hash,
+				   schema_type,
+				   schema_url,
+				   other_identifier,
+				   expiration,
+				   updatable,
+				   claims.version,
+				   rev_nonce,
+				   signature_proof,
+				   mtp_proof,
+				   data,
+				   claims.identifier,
+				   identity_state,
+				   identity_states.status,
+				   credential_status,
+				   core_claim,
+				   revoked,
+				   mtp,
+				   claims.created_at,
+				   claims.encrypted_data,
+				   claims.context_url,
+				   claims.suspended
+			FROM claims
+			LEFT JOIN identity_states ON claims.identity_state = identity_states.state
+			WHERE claims.identifier = $1 AND claims.revoked = false AND claims.schema_type <> $2
+			AND claims.expiration > 0 AND claims.expiration < $3`
+	args := []interface{}{identifier.String(), domain.AuthBJJCredentialSchemaType, filter.ExpiresBefore.Unix()}
+	if filter.ExpiresAfter != nil {
+		args = append(args, filter.ExpiresAfter.Unix())
+		query += fmt.Sprintf(" AND claims.expiration >= $%d", len(args))
+	}
+	if filter.SchemaURL != nil {
+		args = append(args, *filter.SchemaURL)
+		query += fmt.Sprintf(" AND claims.schema_url = $%d", len(args))
+	}
+	if len(filter.ExcludedSchemaURLs) > 0 {
+		args = append(args, filter.ExcludedSchemaURLs)
+		query += fmt.Sprintf(" AND NOT (claims.schema_url = ANY($%d))", len(args))
+	}
+	if filter.NotNotified {
+		query += " AND claims.other_identifier <> '' AND claims.expiration_notified_at IS NULL"
+	}
+	query += " ORDER BY claims.expiration"
+	if filter.MaxResults > 0 {
+		query += fmt.Sprintf(" LIMIT %d", filter.MaxResults)
+	}
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return processClaims(rows)
+}
+
+// UpdateExpirationNotified records that the renewal notice of the credential has been sent
+func (c *claim) UpdateExpirationNotified(ctx context.Context, conn db.Querier, identifier w3c.DID, id uuid.UUID) error {
+	_, err := conn.Exec(ctx, `UPDATE claims SET expiration_notified_at = NOW() WHERE identifier = $1 AND id = $2`, identifier.String(), id)
+	if err != nil {
+		return fmt.Errorf("error updating the claim expiration notice: %w", err)
+	}
+	return nil
+}
+
+// GetSignedWithAuthClaim returns the non revoked credentials of the identifier whose signature proof was created with the given auth core claim.
+// authCoreClaim is the hex representation of the core claim, as it is stored in the issuer data of the proof.
+func (c *claim) GetSignedWithAuthClaim(ctx context.Context, conn db.Querier, identifier w3c.DID, authCoreClaim string) ([]*domain.Claim, error) {
+	rows, err := conn.Query(ctx, `SELECT claims.id,
+				   issuer,
+				   schema_hash,
+				   schema_type,
+				   schema_url,
+				   other_identifier,
+				   expiration,
+				   updatable,
+				   claims.version,
+				   rev_nonce,
+				   signature_proof,
+				   mtp_proof,
+				   data,
+				   claims.identifier,
+				   identity_state,
+				   identity_states.status,
+				   credential_status,
+				   core_claim,
+				   revoked,
+				   mtp,
+				   claims.created_at,
+				   claims.encrypted_data,
+				   claims.context_url,
+				   claims.suspended
+			FROM claims
+			LEFT JOIN identity_states ON claims.identity_state = identity_states.state
+			WHERE claims.identifier = $1 AND claims.revoked = false AND claims.schema_type <> $2
+			AND claims.signature_proof->'issuerData'->>'authCoreClaim' = $3
+			ORDER BY claims.created_at`, identifier.String(), domain.AuthBJJCredentialSchemaType, authCoreClaim)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return processClaims(rows)
+}
+
+// GetAllInMerkleTree returns the credentials of the issuer that were added to its claims tree, in the order they were created
+func (c *claim) GetAllInMerkleTree(ctx context.Context, conn db.Querier, issuer w3c.DID) ([]*domain.Claim, error) {
+	rows, err := conn.Query(ctx, `SELECT claims.id,
+				   issuer,
+				   schema_hash,
+				   schema_type,
+				   schema_url,
+				   other_identifier,
+				   expiration,
+				   updatable,
+				   claims.version,
+				   rev_nonce,
+				   signature_proof,
+				   mtp_proof,
+				   data,
+				   claims.identifier,
+				   identity_state,
+				   identity_states.status,
+				   credential_status,
+				   core_claim,
+				   revoked,
+				   mtp,
+				   claims.created_at,
+				   claims.encrypted_data,
+				   claims.context_url,
+				   claims.suspended
+			FROM claims
+			LEFT JOIN identity_states ON claims.identity_state = identity_states.state
+			WHERE claims.issuer = $1 AND claims.identifier = claims.issuer AND claims.mtp = true AND claims.identity_state IS NOT NULL
+			ORDER BY claims.created_at, claims.id`, issuer.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return processClaims(rows)
+}
@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrCredentialExpirationPolicyNotFound credential expiration policy not found
+	ErrCredentialExpirationPolicyNotFound = errors.New("credential expiration policy not found")
+	// ErrCredentialExpirationPolicyDuplicated the issuer already has a credential expiration policy for the same schema
+	ErrCredentialExpirationPolicyDuplicated = errors.New("credential expiration policy for the same schema already exists")
+)
+
+type credentialExpirationPolicy struct {
+	conn db.Storage
+}
+
+// NewCredentialExpirationPolicy returns a new credential expiration policies repository
+func NewCredentialExpirationPolicy(conn db.Storage) ports.CredentialExpirationPolicyRepository {
+	return &credentialExpirationPolicy{
+		conn,
+	}
+}
+
+// Save stores the policy or replaces its values if it already exists
+func (c *credentialExpirationPolicy) Save(ctx context.Context, policy *domain.CredentialExpirationPolicy) error {
+	sql := `INSERT INTO credential_expiration_policies (id, issuer_did, schema_id, notify_days_before, auto_revoke)
+			VALUES($1, $2, $3, $4, $5) ON CONFLICT (id) DO
+			UPDATE SET schema_id=$3, notify_days_before=$4, auto_revoke=$5, updated_at=NOW()`
+	_, err := c.conn.Pgx.Exec(ctx, sql, policy.ID, policy.IssuerCoreDID().String(), policy.SchemaID, policy.NotifyDaysBefore, policy.AutoRevoke)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrCredentialExpirationPolicyDuplicated
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID returns the policy of the issuer with the given id
+func (c *credentialExpirationPolicy) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.CredentialExpirationPolicy, error) {
+	sql := `SELECT ` + credentialExpirationPolicyFields + ` FROM credential_expiration_policies
+			LEFT JOIN schemas ON schemas.id = credential_expiration_policies.schema_id
+			WHERE credential_expiration_policies.issuer_did=$1 AND credential_expiration_policies.id=$2`
+	policy, err := scanCredentialExpirationPolicy(c.conn.Pgx.QueryRow(ctx, sql, issuerDID.String(), id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCredentialExpirationPolicyNotFound
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+// GetByIssuer returns the policies of the issuer, the policy of the whole identity first
+func (c *credentialExpirationPolicy) GetByIssuer(ctx context.Context, issuerDID w3c.DID) ([]domain.CredentialExpirationPolicy, error) {
+	return c.getAll(ctx, `WHERE credential_expiration_policies.issuer_did=$1`, issuerDID.String())
+}
+
+// GetAll returns the policies of all the issuers
+func (c *credentialExpirationPolicy) GetAll(ctx context.Context) ([]domain.CredentialExpirationPolicy, error) {
+	return c.getAll(ctx, ``)
+}
+
+// Delete removes the policy of the issuer with the given id
+func (c *credentialExpirationPolicy) Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	tag, err := c.conn.Pgx.Exec(ctx, `DELETE FROM credential_expiration_policies WHERE issuer_did=$1 AND id=$2`, issuerDID.String(), id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCredentialExpirationPolicyNotFound
+	}
+	return nil
+}
+
+func (c *credentialExpirationPolicy) getAll(ctx context.Context, where string, args ...interface{}) ([]domain.CredentialExpirationPolicy, error) {
+	sql := `SELECT ` + credentialExpirationPolicyFields + ` FROM credential_expiration_policies
+			LEFT JOIN schemas ON schemas.id = credential_expiration_policies.schema_id ` + where + `
+			ORDER BY credential_expiration_policies.issuer_did, credential_expiration_policies.schema_id NULLS FIRST, credential_expiration_policies.created_at`
+	rows, err := c.conn.Pgx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := make([]domain.CredentialExpirationPolicy, 0)
+	for rows.Next() {
+		policy, err := scanCredentialExpirationPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, *policy)
+	}
+	return policies, rows.Err()
+}
+
+const credentialExpirationPolicyFields = `credential_expiration_policies.id, credential_expiration_policies.issuer_did, credential_expiration_policies.schema_id, schemas.url,
+	credential_expiration_policies.notify_days_before, credential_expiration_policies.auto_revoke, credential_expiration_policies.created_at, credential_expiration_policies.updated_at`
+
+func scanCredentialExpirationPolicy(row pgx.Row) (*domain.CredentialExpirationPolicy, error) {
+	var policy domain.CredentialExpirationPolicy
+	err := row.Scan(
+		&policy.ID,
+		&policy.IssuerDID,
+		&policy.SchemaID,
+		&policy.SchemaURL,
+		&policy.NotifyDaysBefore,
+		&policy.AutoRevoke,
+		&policy.CreatedAt,
+		&policy.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrCredentialTemplateNotFound credential template not found
+	ErrCredentialTemplateNotFound = errors.New("credential template not found")
+	// ErrCredentialTemplateDuplicateName the issuer already has a credential template with the same name
+	ErrCredentialTemplateDuplicateName = errors.New("credential template with the same name already exists")
+)
+
+type credentialTemplate struct {
+	conn db.Storage
+}
+
+// NewCredentialTemplate returns a new credential templates repository
+func NewCredentialTemplate(conn db.Storage) ports.CredentialTemplateRepository {
+	return &credentialTemplate{
+		conn,
+	}
+}
+
+// Save stores the template or replaces its values if it already exists
+func (c *credentialTemplate) Save(ctx context.Context, template *domain.CredentialTemplate) error {
+	credentialSubject := pgtype.JSONB{}
+	if err := credentialSubject.Set(template.CredentialSubject); err != nil {
+		return fmt.Errorf("cannot set credential subject values: %w", err)
+	}
+	sql := `INSERT INTO credential_templates (id, issuer_did, name, schema_id, credential_subject, credential_expiration, credential_signature_proof, credential_mtp_proof, display_method_id, refresh_service)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (id) DO
+			UPDATE SET name=$3, schema_id=$4, credential_subject=$5, credential_expiration=$6, credential_signature_proof=$7, credential_mtp_proof=$8, display_method_id=$9, refresh_service=$10, updated_at=NOW()`
+	_, err := c.conn.Pgx.Exec(ctx, sql, template.ID, template.IssuerCoreDID().String(), template.Name, template.SchemaID, credentialSubject, template.CredentialExpiration,
+		template.CredentialSignatureProof, template.CredentialMTPProof, template.DisplayMethodID, template.RefreshService)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrCredentialTemplateDuplicateName
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID returns the template of the issuer with the given id
+func (c *credentialTemplate) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.CredentialTemplate, error) {
+	sql := `SELECT ` + credentialTemplateFields + ` FROM credential_templates WHERE issuer_did=$1 AND id=$2`
+	template, err := scanCredentialTemplate(c.conn.Pgx.QueryRow(ctx, sql, issuerDID.String(), id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCredentialTemplateNotFound
+		}
+		return nil, err
+	}
+	return template, nil
+}
+
+// GetAll returns a page of the templates of the issuer sorted by name and the total number of templates
+func (c *credentialTemplate) GetAll(ctx context.Context, issuerDID w3c.DID, filter ports.CredentialTemplateFilter) ([]domain.CredentialTemplate, uint, error) {
+	var count uint
+	if err := c.conn.Pgx.QueryRow(ctx, `SELECT COUNT(*) FROM credential_templates WHERE issuer_did=$1`, issuerDID.String()).Scan(&count); err != nil {
+		return nil, 0, err
+	}
+
+	sql := `SELECT ` + credentialTemplateFields + ` FROM credential_templates WHERE issuer_did=$1 ORDER BY name`
+	if filter.MaxResults > 0 {
+		sql += fmt.Sprintf(" OFFSET %d LIMIT %d;", (filter.Page-1)*filter.MaxResults, filter.MaxResults)
+	}
+	rows, err := c.conn.Pgx.Query(ctx, sql, issuerDID.String())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	templates := make([]domain.CredentialTemplate, 0)
+	for rows.Next() {
+		template, err := scanCredentialTemplate(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		templates = append(templates, *template)
+	}
+	return templates, count, rows.Err()
+}
+
+// Delete removes the template of the issuer with the given id
+func (c *credentialTemplate) Delete(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	tag, err := c.conn.Pgx.Exec(ctx, `DELETE FROM credential_templates WHERE issuer_did=$1 AND id=$2`, issuerDID.String(), id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCredentialTemplateNotFound
+	}
+	return nil
+}
+
+const credentialTemplateFields = `id, issuer_did, name, schema_id, credential_subject, credential_expiration, credential_signature_proof, credential_mtp_proof, display_method_id, refresh_service, created_at, updated_at`
+
+func scanCredentialTemplate(row pgx.Row) (*domain.CredentialTemplate, error) {
+	var template domain.CredentialTemplate
+	var credentialSubject pgtype.JSONB
+	err := row.Scan(
+		&template.ID,
+		&template.IssuerDID,
+		&template.Name,
+		&template.SchemaID,
+		&credentialSubject,
+		&template.CredentialExpiration,
+		&template.CredentialSignatureProof,
+		&template.CredentialMTPProof,
+		&template.DisplayMethodID,
+		&template.RefreshService,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(credentialSubject.Bytes))
+	d.UseNumber()
+	if err := d.Decode(&template.CredentialSubject); err != nil {
+		return nil, fmt.Errorf("parsing credential subject: %w", err)
+	}
+	return &template, nil
+}
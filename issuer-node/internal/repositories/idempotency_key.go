@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrIdempotencyKeyNotFound idempotency key not found
+var ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+
+type idempotencyKey struct {
+	conn db.Storage
+}
+
+// NewIdempotencyKey returns a new idempotency keys repository
+func NewIdempotencyKey(conn db.Storage) ports.IdempotencyKeyRepository {
+	return &idempotencyKey{
+		conn,
+	}
+}
+
+// Reserve stores a new idempotency key without response. If the key already exists it is only replaced when it
+// has expired or when its request has been processing since before staleBefore. Returns false if the key was not stored.
+func (i *idempotencyKey) Reserve(ctx context.Context, key *domain.IdempotencyKey, staleBefore time.Time) (bool, error) {
+	sql := `INSERT INTO idempotency_keys (principal, key, request_hash, expires_at) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (principal, key) DO
+			UPDATE SET request_hash=EXCLUDED.request_hash, status_code=NULL, content_type=NULL, response_body=NULL, created_at=CURRENT_TIMESTAMP, expires_at=EXCLUDED.expires_at
+			WHERE idempotency_keys.expires_at < NOW() OR (idempotency_keys.status_code IS NULL AND idempotency_keys.created_at < $5)`
+	tag, err := i.conn.Pgx.Exec(ctx, sql, key.Principal, key.Key, key.RequestHash, key.ExpiresAt, staleBefore)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// GetByKey returns the idempotency key of the principal if it has not expired
+func (i *idempotencyKey) GetByKey(ctx context.Context, principal string, key string) (*domain.IdempotencyKey, error) {
+	var idempotencyKey domain.IdempotencyKey
+	err := i.conn.Pgx.QueryRow(ctx, `
+		SELECT principal, key, request_hash, status_code, content_type, response_body, created_at, expires_at
+		FROM idempotency_keys
+		WHERE principal=$1 AND key=$2 AND expires_at >= NOW()`, principal, key).Scan(
+		&idempotencyKey.Principal,
+		&idempotencyKey.Key,
+		&idempotencyKey.RequestHash,
+		&idempotencyKey.StatusCode,
+		&idempotencyKey.ContentType,
+		&idempotencyKey.ResponseBody,
+		&idempotencyKey.CreatedAt,
+		&idempotencyKey.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrIdempotencyKeyNotFound
+		}
+		return nil, err
+	}
+	return &idempotencyKey, nil
+}
+
+// SaveResponse stores the response of the request of the idempotency key
+func (i *idempotencyKey) SaveResponse(ctx context.Context, key *domain.IdempotencyKey) error {
+	tag, err := i.conn.Pgx.Exec(ctx, `UPDATE idempotency_keys SET status_code=$3, content_type=$4, response_body=$5 WHERE principal=$1 AND key=$2`,
+		key.Principal, key.Key, key.StatusCode, key.ContentType, key.ResponseBody)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrIdempotencyKeyNotFound
+	}
+	return nil
+}
+
+// Delete removes the idempotency key of the principal
+func (i *idempotencyKey) Delete(ctx context.Context, principal string, key string) error {
+	_, err := i.conn.Pgx.Exec(ctx, `DELETE FROM idempotency_keys WHERE principal=$1 AND key=$2`, principal, key)
+	return err
+}
+
+// DeleteExpired removes the expired idempotency keys and returns how many were removed
+func (i *idempotencyKey) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := i.conn.Pgx.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at < NOW()`)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
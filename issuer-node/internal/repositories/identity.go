@@ -97,13 +97,31 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.
 		&identity.AuthCoreClaimRevocationStatus)
 	if err != nil {
 		if strings.Contains(err.Error(), "no rows in result set") {
-			return nil, ErrIdentityNotFound
+			return i.getWithoutState(ctx, conn, identifier)
 		}
 		return nil, err
 	}
 	return &identity, err
 }
 
+// getWithoutState returns the identities that have no states, like the did:web identities
+func (i *identity) getWithoutState(ctx context.Context, conn db.Querier, identifier w3c.DID) (*domain.Identity, error) {
+	var identity domain.Identity
+	err := conn.QueryRow(ctx,
+		`SELECT identifier, keyType, address, display_name
+			   FROM identities
+			   WHERE identifier=$1 AND NOT EXISTS (SELECT 1 FROM identity_states WHERE identifier=$1)`, identifier.String()).
+		Scan(&identity.Identifier, &identity.KeyType, &identity.Address, &identity.DisplayName)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows in result set") {
+			return nil, ErrIdentityNotFound
+		}
+		return nil, err
+	}
+	identity.State.Identifier = identity.Identifier
+	return &identity, nil
+}
+
 func (i *identity) Get(ctx context.Context, conn db.Querier) (identities []domain.IdentityDisplayName, err error) {
 	rows, err := conn.Query(ctx, `SELECT identifier, display_name FROM identities`)
 	if err != nil {
@@ -138,7 +156,7 @@ func (i *identity) GetUnprocessedIssuersIDs(ctx context.Context, conn db.Querier
     SELECT identifier from identity_states WHERE status = 'transacted'
 )
 
-SELECT issuer FROM issuers_to_process WHERE issuer NOT IN (SELECT identifier FROM transacted_issuers);
+SELECT issuer FROM issuers_to_process WHERE issuer NOT IN (SELECT identifier FROM transacted_issuers) AND issuer NOT LIKE 'did:web:%';
 `)
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,10 +10,16 @@ import (
 	"github.com/jackc/pgx/v4"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/pagination"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
+// ErrIdentityStateNotFound identity state not found
+var ErrIdentityStateNotFound = errors.New("identity state not found")
+
+const identityStateChangeFields = "c.id, c.identifier, c.state, c.change_type, c.claim_id, c.revocation_nonce, c.created_at"
+
 type identityState struct{}
 
 // NewIdentityState returns a new identity state repository
@@ -31,8 +38,9 @@ func (isr *identityState) Save(ctx context.Context, conn db.Querier, state domai
 		block_number,
 		tx_id,
 		previous_state,
-		status
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT DO NOTHING`
+		status,
+		failure_reason
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT DO NOTHING`
 	_, err := conn.Exec(ctx, query,
 		state.Identifier,
 		state.State,
@@ -44,6 +52,7 @@ func (isr *identityState) Save(ctx context.Context, conn db.Querier, state domai
 		state.TxID,
 		state.PreviousState,
 		state.Status,
+		state.FailureReason,
 	)
 	if err != nil {
 		return fmt.Errorf("failed insert new state record: %w", err)
@@ -56,7 +65,7 @@ func (isr *identityState) Save(ctx context.Context, conn db.Querier, state domai
 // If 'confirmed' and non-genesis state are not found. Return genesis state.
 func (isr *identityState) GetLatestStateByIdentifier(ctx context.Context, conn db.Querier, identifier *w3c.DID) (*domain.IdentityState, error) {
 	row := conn.QueryRow(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, 
-       revocation_tree_root, block_timestamp, block_number, tx_id, previous_state, status, modified_at, created_at 
+       revocation_tree_root, block_timestamp, block_number, tx_id, replacement_tx_ids, previous_state, status, failure_reason, modified_at, created_at 
 FROM identity_states
 WHERE identifier=$1 AND status = 'confirmed' ORDER BY state_id DESC LIMIT 1`, identifier.String())
 	state := domain.IdentityState{}
@@ -69,8 +78,10 @@ WHERE identifier=$1 AND status = 'confirmed' ORDER BY state_id DESC LIMIT 1`, id
 		&state.BlockTimestamp,
 		&state.BlockNumber,
 		&state.TxID,
+		&state.ReplacementTxIDs,
 		&state.PreviousState,
 		&state.Status,
+		&state.FailureReason,
 		&state.ModifiedAt,
 		&state.CreatedAt); err != nil {
 		return nil, fmt.Errorf("error trying to get latest state:%w", err)
@@ -82,7 +93,7 @@ WHERE identifier=$1 AND status = 'confirmed' ORDER BY state_id DESC LIMIT 1`, id
 // GetStatesByStatus returns states which are not transacted
 func (isr *identityState) GetStatesByStatus(ctx context.Context, conn db.Querier, status domain.IdentityStatus) ([]domain.IdentityState, error) {
 	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
-       tx_id, previous_state, status, modified_at, created_at 
+       tx_id, replacement_tx_ids, previous_state, status, failure_reason, modified_at, created_at 
 	FROM identity_states WHERE status = $1 and previous_state IS NOT NULL`, status)
 	if err != nil {
 		return nil, err
@@ -117,8 +128,19 @@ func (isr *identityState) GetStates(ctx context.Context, conn db.Querier, issuer
 
 func (isr *identityState) UpdateState(ctx context.Context, conn db.Querier, state *domain.IdentityState) (int64, error) {
 	tag, err := conn.Exec(ctx, `UPDATE identity_states 
-		SET block_timestamp=$1, block_number=$2, tx_id=$3, status=$4 WHERE state = $5 `,
-		state.BlockTimestamp, state.BlockNumber, state.TxID, state.Status, state.State)
+		SET block_timestamp=$1, block_number=$2, tx_id=$3, status=$4, failure_reason=$5 WHERE state = $6 `,
+		state.BlockTimestamp, state.BlockNumber, state.TxID, state.Status, state.FailureReason, state.State)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// AddReplacementTxID appends the hash of a transaction that replaced the one publishing the state
+func (isr *identityState) AddReplacementTxID(ctx context.Context, conn db.Querier, state string, txID string) (int64, error) {
+	tag, err := conn.Exec(ctx, `UPDATE identity_states
+		SET replacement_tx_ids = array_append(replacement_tx_ids, $1) WHERE state = $2`, txID, state)
 	if err != nil {
 		return 0, err
 	}
@@ -129,7 +151,7 @@ func (isr *identityState) UpdateState(ctx context.Context, conn db.Querier, stat
 // GetStatesByStatusAndIssuerID returns states which are not transacted
 func (isr *identityState) GetStatesByStatusAndIssuerID(ctx context.Context, conn db.Querier, status domain.IdentityStatus, issuerID w3c.DID) ([]domain.IdentityState, error) {
 	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
-       tx_id, previous_state, status, modified_at, created_at 
+       tx_id, replacement_tx_ids, previous_state, status, failure_reason, modified_at, created_at 
 	FROM identity_states WHERE identifier = $1 and status = $2 and previous_state IS NOT NULL
 	ORDER BY created_at DESC
 	`, issuerID.String(), status)
@@ -150,8 +172,10 @@ func (isr *identityState) GetStatesByStatusAndIssuerID(ctx context.Context, conn
 			&state.BlockTimestamp,
 			&state.BlockNumber,
 			&state.TxID,
+			&state.ReplacementTxIDs,
 			&state.PreviousState,
 			&state.Status,
+			&state.FailureReason,
 			&state.ModifiedAt,
 			&state.CreatedAt); err != nil {
 			return nil, err
@@ -181,13 +205,115 @@ func (isr *identityState) GetGenesisState(ctx context.Context, conn db.Querier,
 		&state.PreviousState,
 		&state.Status,
 		&state.ModifiedAt,
-		&state.CreatedAt); err != nil {
+		&state.CreatedAt,
+		&state.ReplacementTxIDs,
+		&state.FailureReason); err != nil {
 		return nil, err
 	}
 
 	return &state, nil
 }
 
+// GetByState returns the state of the identity with the given state hash
+func (isr *identityState) GetByState(ctx context.Context, conn db.Querier, identifier w3c.DID, state string) (*domain.IdentityState, error) {
+	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
+       tx_id, replacement_tx_ids, previous_state, status, failure_reason, modified_at, created_at 
+	FROM identity_states WHERE identifier = $1 AND state = $2`, identifier.String(), state)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	states, err := toIdentityStatesDomain(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, ErrIdentityStateNotFound
+	}
+	return &states[0], nil
+}
+
+// GetAllByIdentifier returns all the states of the identity, from the genesis state to the latest one
+func (isr *identityState) GetAllByIdentifier(ctx context.Context, conn db.Querier, identifier w3c.DID) ([]domain.IdentityState, error) {
+	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
+       tx_id, replacement_tx_ids, previous_state, status, failure_reason, modified_at, created_at 
+	FROM identity_states WHERE identifier = $1
+	ORDER BY state_id`, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return toIdentityStatesDomain(rows)
+}
+
+// SaveChanges stores the credentials and revocations anchored by a state
+func (isr *identityState) SaveChanges(ctx context.Context, conn db.Querier, changes []domain.IdentityStateChange) error {
+	for _, change := range changes {
+		_, err := conn.Exec(ctx, `INSERT INTO identity_state_changes (identifier, state, change_type, claim_id, revocation_nonce)
+		VALUES ($1, $2, $3, $4, $5)`, change.Identifier, change.State, string(change.Type), change.ClaimID, change.RevocationNonce)
+		if err != nil {
+			return fmt.Errorf("failed insert identity state change: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetChanges returns a page of the credentials and revocations anchored by the given state, and the total number of them
+func (isr *identityState) GetChanges(ctx context.Context, conn db.Querier, identifier w3c.DID, state string, filter pagination.Filter) ([]domain.IdentityStateChange, uint, error) {
+	var count uint
+	if err := conn.QueryRow(ctx, `SELECT COUNT(*) FROM identity_state_changes WHERE identifier = $1 AND state = $2`,
+		identifier.String(), state).Scan(&count); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := conn.Query(ctx, `SELECT `+identityStateChangeFields+` FROM identity_state_changes c
+	WHERE c.identifier = $1 AND c.state = $2
+	ORDER BY c.id
+	OFFSET $3 LIMIT $4`, identifier.String(), state, filter.GetOffset(), filter.GetLimit())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	changes, err := toIdentityStateChangesDomain(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return changes, count, nil
+}
+
+// GetChangesBetween returns the states created after fromStateID, up to and including toStateID, and the changes they anchored
+func (isr *identityState) GetChangesBetween(ctx context.Context, conn db.Querier, identifier w3c.DID, fromStateID int64, toStateID int64) ([]domain.IdentityState, []domain.IdentityStateChange, error) {
+	stateRows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
+       tx_id, replacement_tx_ids, previous_state, status, failure_reason, modified_at, created_at 
+	FROM identity_states WHERE identifier = $1 AND state_id > $2 AND state_id <= $3
+	ORDER BY state_id`, identifier.String(), fromStateID, toStateID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer stateRows.Close()
+	states, err := toIdentityStatesDomain(stateRows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := conn.Query(ctx, `SELECT `+identityStateChangeFields+` FROM identity_state_changes c
+	JOIN identity_states s ON s.identifier = c.identifier AND s.state = c.state
+	WHERE c.identifier = $1 AND s.state_id > $2 AND s.state_id <= $3
+	ORDER BY s.state_id, c.id`, identifier.String(), fromStateID, toStateID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	changes, err := toIdentityStateChangesDomain(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	return states, changes, nil
+}
+
 func buildGetStatesQuery(filter *ports.GetStateTransactionsRequest) (string, string) {
 	fields := []string{
 		"state_id",
@@ -199,8 +325,10 @@ func buildGetStatesQuery(filter *ports.GetStateTransactionsRequest) (string, str
 		"block_timestamp",
 		"block_number",
 		"tx_id",
+		"replacement_tx_ids",
 		"previous_state",
 		"status",
+		"failure_reason",
 		"modified_at",
 		"created_at",
 	}
@@ -234,8 +362,10 @@ func toIdentityStatesDomain(rows pgx.Rows) ([]domain.IdentityState, error) {
 			&state.BlockTimestamp,
 			&state.BlockNumber,
 			&state.TxID,
+			&state.ReplacementTxIDs,
 			&state.PreviousState,
 			&state.Status,
+			&state.FailureReason,
 			&state.ModifiedAt,
 			&state.CreatedAt); err != nil {
 			return nil, err
@@ -249,3 +379,28 @@ func toIdentityStatesDomain(rows pgx.Rows) ([]domain.IdentityState, error) {
 
 	return states, nil
 }
+
+func toIdentityStateChangesDomain(rows pgx.Rows) ([]domain.IdentityStateChange, error) {
+	changes := make([]domain.IdentityStateChange, 0)
+	for rows.Next() {
+		var change domain.IdentityStateChange
+		var changeType string
+		if err := rows.Scan(&change.ID,
+			&change.Identifier,
+			&change.State,
+			&changeType,
+			&change.ClaimID,
+			&change.RevocationNonce,
+			&change.CreatedAt); err != nil {
+			return nil, err
+		}
+		change.Type = domain.IdentityStateChangeType(changeType)
+		changes = append(changes, change)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,149 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrKeyRotationNotFound key rotation not found
+	ErrKeyRotationNotFound = errors.New("key rotation not found")
+	// ErrKeyRotationUnfinished the identity already has a key rotation that is not completed
+	ErrKeyRotationUnfinished = errors.New("the identity has a key rotation that is not completed")
+)
+
+const keyRotationUnfinishedConstraint = "key_rotations_issuer_unfinished_key"
+
+type keyRotation struct {
+	conn db.Storage
+}
+
+// NewKeyRotation returns a new key rotations repository
+func NewKeyRotation(conn db.Storage) ports.KeyRotationRepository {
+	return &keyRotation{
+		conn,
+	}
+}
+
+// Save stores the rotation or updates its progress if it already exists. The lock taken with Lock is released.
+// It returns ErrKeyRotationUnfinished if a new rotation is saved while the identity has another one that is not completed.
+func (k *keyRotation) Save(ctx context.Context, conn db.Querier, rotation *domain.KeyRotation) error {
+	if conn == nil {
+		conn = k.conn.Pgx
+	}
+	sql := `INSERT INTO key_rotations (id, issuer_did, key_name, resign_credentials, old_auth_credential_id, new_key_id, new_auth_credential_id, published_state, step, error, resigned_credentials)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (id) DO
+			UPDATE SET new_key_id=$6, new_auth_credential_id=$7, published_state=$8, step=$9, error=$10, resigned_credentials=$11, locked_until=NULL, updated_at=NOW()`
+	_, err := conn.Exec(ctx, sql, rotation.ID, rotation.IssuerCoreDID().String(), rotation.KeyName, rotation.ResignCredentials, rotation.OldAuthCredentialID,
+		rotation.NewKeyID, rotation.NewAuthCredentialID, rotation.PublishedState, string(rotation.Step), rotation.Error, rotation.ResignedCredentials)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode && pgErr.ConstraintName == keyRotationUnfinishedConstraint {
+			return ErrKeyRotationUnfinished
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID returns the rotation of the issuer with the given id
+func (k *keyRotation) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.KeyRotation, error) {
+	sql := `SELECT ` + keyRotationFields + ` FROM key_rotations WHERE issuer_did=$1 AND id=$2`
+	rotation, err := scanKeyRotation(k.conn.Pgx.QueryRow(ctx, sql, issuerDID.String(), id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrKeyRotationNotFound
+		}
+		return nil, err
+	}
+	return rotation, nil
+}
+
+// GetAll returns all the rotations of the issuer, newest first
+func (k *keyRotation) GetAll(ctx context.Context, issuerDID w3c.DID) ([]*domain.KeyRotation, error) {
+	sql := `SELECT ` + keyRotationFields + ` FROM key_rotations WHERE issuer_did=$1 ORDER BY created_at DESC`
+	rows, err := k.conn.Pgx.Query(ctx, sql, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanKeyRotations(rows)
+}
+
+// GetUnfinished returns all the rotations, from any issuer, that are not completed, oldest first
+func (k *keyRotation) GetUnfinished(ctx context.Context) ([]*domain.KeyRotation, error) {
+	sql := `SELECT ` + keyRotationFields + ` FROM key_rotations WHERE step <> $1 ORDER BY created_at`
+	rows, err := k.conn.Pgx.Query(ctx, sql, string(domain.KeyRotationStepCompleted))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanKeyRotations(rows)
+}
+
+// Lock returns the rotation and locks it for the given duration or until it is saved. The lock is a lease stored in the
+// rotation, so no transaction is kept open while the rotation is processed, and it expires if the process holding it dies.
+// It returns ErrKeyRotationNotFound if the rotation is already locked.
+func (k *keyRotation) Lock(ctx context.Context, id uuid.UUID, duration time.Duration) (*domain.KeyRotation, error) {
+	sql := `UPDATE key_rotations SET locked_until=NOW() + $2 * INTERVAL '1 second'
+			WHERE id=$1 AND (locked_until IS NULL OR locked_until < NOW())
+			RETURNING ` + keyRotationFields
+	rotation, err := scanKeyRotation(k.conn.Pgx.QueryRow(ctx, sql, id, duration.Seconds()))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrKeyRotationNotFound
+		}
+		return nil, err
+	}
+	return rotation, nil
+}
+
+const keyRotationFields = `id, issuer_did, key_name, resign_credentials, old_auth_credential_id, new_key_id, new_auth_credential_id,
+       published_state, step, error, resigned_credentials, created_at, updated_at`
+
+func scanKeyRotation(row pgx.Row) (*domain.KeyRotation, error) {
+	var rotation domain.KeyRotation
+	var step string
+	err := row.Scan(
+		&rotation.ID,
+		&rotation.IssuerDID,
+		&rotation.KeyName,
+		&rotation.ResignCredentials,
+		&rotation.OldAuthCredentialID,
+		&rotation.NewKeyID,
+		&rotation.NewAuthCredentialID,
+		&rotation.PublishedState,
+		&step,
+		&rotation.Error,
+		&rotation.ResignedCredentials,
+		&rotation.CreatedAt,
+		&rotation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rotation.Step = domain.KeyRotationStep(step)
+	return &rotation, nil
+}
+
+func scanKeyRotations(rows pgx.Rows) ([]*domain.KeyRotation, error) {
+	rotations := make([]*domain.KeyRotation, 0)
+	for rows.Next() {
+		rotation, err := scanKeyRotation(rows)
+		if err != nil {
+			return nil, err
+		}
+		rotations = append(rotations, rotation)
+	}
+	return rotations, rows.Err()
+}
@@ -48,12 +48,12 @@ func (l link) Save(ctx context.Context, conn db.Querier, link *domain.Link) (*uu
 	}
 
 	var id uuid.UUID
-	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active, refresh_service, display_method)
-			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (id) DO
+	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active, refresh_service, display_method, credential_encryption)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) ON CONFLICT (id) DO
 			UPDATE SET issuer_id=$2, max_issuance=$3, valid_until=$4, schema_id=$5, credential_expiration=$6, credential_signature_proof=$7, credential_mtp_proof=$8, credential_attributes=$9, active=$10 
 			RETURNING id`
 	err := conn.QueryRow(ctx, sql, link.ID, link.IssuerCoreDID().String(), link.MaxIssuance, link.ValidUntil, link.SchemaID, link.CredentialExpiration, link.CredentialSignatureProof,
-		link.CredentialMTPProof, pgAttrs, link.Active, link.RefreshService, link.DisplayMethod).Scan(&id)
+		link.CredentialMTPProof, pgAttrs, link.Active, link.RefreshService, link.DisplayMethod, string(link.CredentialEncryption.OrDefault())).Scan(&id)
 
 	if err != nil && strings.Contains(err.Error(), `table "links" violates foreign key constraint "links_schemas_id_key"`) {
 		return nil, errorShemaNotFound
@@ -76,6 +76,7 @@ SELECT links.id,
        links.active,
 	   links.refresh_service,
 	   links.display_method,
+	   links.credential_encryption,
        count(claims.id) as issued_claims,
        links.authorization_request_message,
        schemas.id as schema_id,
@@ -94,6 +95,7 @@ GROUP BY links.id, schemas.id
 	link := domain.Link{}
 	s := dbSchema{}
 	var credentialSubject pgtype.JSONB
+	var credentialEncryption string
 	err := l.conn.Pgx.QueryRow(ctx, sql, id, issuerDID.String()).Scan(
 		&link.ID,
 		&link.IssuerDID,
@@ -108,6 +110,7 @@ GROUP BY links.id, schemas.id
 		&link.Active,
 		&link.RefreshService,
 		&link.DisplayMethod,
+		&credentialEncryption,
 		&link.IssuedClaims,
 		&link.AuthorizationRequestMessage,
 		&s.ID,
@@ -125,6 +128,8 @@ GROUP BY links.id, schemas.id
 		return nil, err
 	}
 
+	link.CredentialEncryption = domain.CredentialEncryptionPolicy(credentialEncryption)
+
 	d := json.NewDecoder(bytes.NewReader(credentialSubject.Bytes))
 	d.UseNumber()
 	if err := d.Decode(&link.CredentialSubject); err != nil {
@@ -152,6 +157,7 @@ SELECT links.id,
        links.active,
 	   links.refresh_service,
 	   links.display_method,
+	   links.credential_encryption,
 	   links.authorization_request_message,
        count(claims.id) as issued_claims,
        schemas.id as schema_id,
@@ -203,6 +209,7 @@ WHERE links.issuer_id = $1
 	var link *domain.Link
 	links := make([]*domain.Link, 0)
 	var credentialAttributes pgtype.JSONB
+	var credentialEncryption string
 	for rows.Next() {
 		link = &domain.Link{}
 		if err := rows.Scan(
@@ -218,6 +225,7 @@ WHERE links.issuer_id = $1
 			&link.Active,
 			&link.RefreshService,
 			&link.DisplayMethod,
+			&credentialEncryption,
 			&link.AuthorizationRequestMessage,
 			&link.IssuedClaims,
 			&schema.ID,
@@ -231,6 +239,7 @@ WHERE links.issuer_id = $1
 		); err != nil {
 			return nil, err
 		}
+		link.CredentialEncryption = domain.CredentialEncryptionPolicy(credentialEncryption)
 
 		if err := credentialAttributes.AssignTo(&link.CredentialSubject); err != nil {
 			return nil, fmt.Errorf("parsing credential attributes: %w", err)
@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrPublishPolicyNotFound publish policy not found
+var ErrPublishPolicyNotFound = errors.New("publish policy not found")
+
+type publishPolicy struct {
+	conn db.Storage
+}
+
+// NewPublishPolicy returns a new publish policies repository
+func NewPublishPolicy(conn db.Storage) ports.PublishPolicyRepository {
+	return &publishPolicy{
+		conn,
+	}
+}
+
+// Save stores the policy of the identity or replaces its values if it already has one
+func (p *publishPolicy) Save(ctx context.Context, policy *domain.PublishPolicy) error {
+	sql := `INSERT INTO publish_policies (identifier, min_pending_changes, min_interval_minutes, max_delay_minutes, window_start, window_end, publish_on_revocation)
+			VALUES($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (identifier) DO
+			UPDATE SET min_pending_changes=$2, min_interval_minutes=$3, max_delay_minutes=$4, window_start=$5, window_end=$6, publish_on_revocation=$7, updated_at=NOW()`
+	_, err := p.conn.Pgx.Exec(ctx, sql, policy.Identifier, policy.MinPendingChanges, policy.MinIntervalMinutes, policy.MaxDelayMinutes,
+		policy.WindowStart, policy.WindowEnd, policy.PublishOnRevocation)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationErrorCode {
+			return ErrIdentityNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Get returns the policy of the identity
+func (p *publishPolicy) Get(ctx context.Context, identifier w3c.DID) (*domain.PublishPolicy, error) {
+	var policy domain.PublishPolicy
+	err := p.conn.Pgx.QueryRow(ctx, `SELECT identifier, min_pending_changes, min_interval_minutes, max_delay_minutes, window_start, window_end, publish_on_revocation, created_at, updated_at
+			FROM publish_policies WHERE identifier=$1`, identifier.String()).Scan(
+		&policy.Identifier,
+		&policy.MinPendingChanges,
+		&policy.MinIntervalMinutes,
+		&policy.MaxDelayMinutes,
+		&policy.WindowStart,
+		&policy.WindowEnd,
+		&policy.PublishOnRevocation,
+		&policy.CreatedAt,
+		&policy.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPublishPolicyNotFound
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Delete removes the policy of the identity
+func (p *publishPolicy) Delete(ctx context.Context, identifier w3c.DID) error {
+	tag, err := p.conn.Pgx.Exec(ctx, `DELETE FROM publish_policies WHERE identifier=$1`, identifier.String())
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrPublishPolicyNotFound
+	}
+	return nil
+}
+
+// GetPendingPublications returns the identities with a publish policy and credentials or revocations not included in any state.
+// The identities with a state transition waiting to be mined and the did:web identities are not returned, as they cannot publish.
+func (p *publishPolicy) GetPendingPublications(ctx context.Context) ([]domain.PendingPublication, error) {
+	rows, err := p.conn.Pgx.Query(ctx, `
+WITH pending_claims AS
+(
+	SELECT issuer AS identifier, count(*) AS credentials, min(created_at) AS oldest
+	FROM claims
+	WHERE identity_state ISNULL AND identifier = issuer
+	GROUP BY issuer
+), pending_revocations AS
+(
+	SELECT identifier, count(*) AS revocations, min(created_at) AS oldest
+	FROM revocation
+	WHERE status = 0
+	GROUP BY identifier
+), pending AS
+(
+	SELECT COALESCE(pending_claims.identifier, pending_revocations.identifier) AS identifier,
+		COALESCE(pending_claims.credentials, 0) AS credentials,
+		COALESCE(pending_revocations.revocations, 0) AS revocations,
+		LEAST(pending_claims.oldest, pending_revocations.oldest) AS oldest
+	FROM pending_claims FULL OUTER JOIN pending_revocations ON pending_claims.identifier = pending_revocations.identifier
+)
+SELECT pending.identifier, pending.credentials, pending.revocations, pending.oldest,
+	(SELECT max(created_at) FROM identity_states WHERE identity_states.identifier = pending.identifier AND tx_id IS NOT NULL),
+	publish_policies.identifier, publish_policies.min_pending_changes, publish_policies.min_interval_minutes, publish_policies.max_delay_minutes,
+	publish_policies.window_start, publish_policies.window_end, publish_policies.publish_on_revocation, publish_policies.created_at, publish_policies.updated_at
+FROM pending
+JOIN publish_policies ON publish_policies.identifier = pending.identifier
+WHERE pending.identifier NOT IN (SELECT identifier FROM identity_states WHERE status = 'transacted')
+	AND pending.identifier NOT LIKE 'did:web:%'
+ORDER BY pending.oldest`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	publications := make([]domain.PendingPublication, 0)
+	for rows.Next() {
+		var publication domain.PendingPublication
+		err := rows.Scan(
+			&publication.Identifier,
+			&publication.Credentials,
+			&publication.Revocations,
+			&publication.OldestChangeAt,
+			&publication.LastPublishedAt,
+			&publication.Policy.Identifier,
+			&publication.Policy.MinPendingChanges,
+			&publication.Policy.MinIntervalMinutes,
+			&publication.Policy.MaxDelayMinutes,
+			&publication.Policy.WindowStart,
+			&publication.Policy.WindowEnd,
+			&publication.Policy.PublishOnRevocation,
+			&publication.Policy.CreatedAt,
+			&publication.Policy.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		publications = append(publications, publication)
+	}
+	return publications, rows.Err()
+}
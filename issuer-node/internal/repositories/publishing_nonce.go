@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// ErrPublishingNonceNotFound publishing nonce not found
+var ErrPublishingNonceNotFound = errors.New("publishing nonce not found")
+
+type publishingNonce struct{}
+
+// NewPublishingNonce returns a new publishing accounts nonces repository
+func NewPublishingNonce() ports.PublishingNonceRepository {
+	return &publishingNonce{}
+}
+
+// LockAccount locks the account until the end of the transaction and returns the next nonce never allocated to it
+func (p *publishingNonce) LockAccount(ctx context.Context, tx db.Querier, chainID int64, address string) (uint64, error) {
+	if _, err := tx.Exec(ctx, `INSERT INTO publishing_accounts (chain_id, address) VALUES ($1, $2) ON CONFLICT DO NOTHING`, chainID, address); err != nil {
+		return 0, err
+	}
+	var nextNonce uint64
+	err := tx.QueryRow(ctx, `SELECT next_nonce FROM publishing_accounts WHERE chain_id = $1 AND address = $2 FOR UPDATE`, chainID, address).Scan(&nextNonce)
+	return nextNonce, err
+}
+
+// SetNextNonce updates the next nonce never allocated to the account
+func (p *publishingNonce) SetNextNonce(ctx context.Context, tx db.Querier, chainID int64, address string, nextNonce uint64) error {
+	_, err := tx.Exec(ctx, `UPDATE publishing_accounts SET next_nonce = $3, modified_at = now() WHERE chain_id = $1 AND address = $2`, chainID, address, nextNonce)
+	return err
+}
+
+// DeleteMined deletes the nonces lower than confirmedNonce, whose transactions are already mined
+func (p *publishingNonce) DeleteMined(ctx context.Context, tx db.Querier, chainID int64, address string, confirmedNonce uint64) error {
+	_, err := tx.Exec(ctx, `DELETE FROM publishing_nonces WHERE chain_id = $1 AND address = $2 AND nonce < $3`, chainID, address, confirmedNonce)
+	return err
+}
+
+// GetReusable returns the lowest nonce, not lower than pendingNonce, that was released or that was allocated or sent before staleBefore.
+// As the node counts in pendingNonce the transactions in its mempool, these nonces belong to transactions that were never sent or were dropped.
+func (p *publishingNonce) GetReusable(ctx context.Context, tx db.Querier, chainID int64, address string, pendingNonce uint64, staleBefore time.Time) (*domain.PublishingNonce, error) {
+	var nonce domain.PublishingNonce
+	err := tx.QueryRow(ctx, `SELECT chain_id, address, nonce, status, tx_id, created_at, modified_at
+		FROM publishing_nonces
+		WHERE chain_id = $1 AND address = $2 AND nonce >= $3 AND (status = $4 OR modified_at < $5)
+		ORDER BY nonce
+		LIMIT 1`, chainID, address, pendingNonce, domain.PublishingNonceReleased, staleBefore).
+		Scan(&nonce.ChainID, &nonce.Address, &nonce.Nonce, &nonce.Status, &nonce.TxID, &nonce.CreatedAt, &nonce.ModifiedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrPublishingNonceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &nonce, nil
+}
+
+// Save stores the nonce or updates its status and transaction
+func (p *publishingNonce) Save(ctx context.Context, conn db.Querier, nonce domain.PublishingNonce) error {
+	_, err := conn.Exec(ctx, `INSERT INTO publishing_nonces (chain_id, address, nonce, status, tx_id) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT ON CONSTRAINT publishing_nonces_pkey DO
+		UPDATE SET status = EXCLUDED.status, tx_id = EXCLUDED.tx_id, modified_at = now()`,
+		nonce.ChainID, nonce.Address, nonce.Nonce, nonce.Status, nonce.TxID)
+	return err
+}
@@ -40,3 +40,22 @@ RETURNING identifier, nonce, version, status, description`,
 
 	return revs, nil
 }
+
+// GetAll returns all the revocations of the identity, published or not, in the order they were created
+func (r *revocation) GetAll(ctx context.Context, conn db.Querier, did *w3c.DID) ([]*domain.Revocation, error) {
+	rows, err := conn.Query(ctx, `SELECT id, identifier, nonce, COALESCE(version, 0), COALESCE(status, 0), COALESCE(description, '') FROM revocation WHERE identifier = $1 ORDER BY id`, did.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revs := make([]*domain.Revocation, 0)
+	for rows.Next() {
+		var revoke domain.Revocation
+		if err = rows.Scan(&revoke.ID, &revoke.Identifier, &revoke.Nonce, &revoke.Version, &revoke.Status, &revoke.Description); err != nil {
+			return nil, err
+		}
+		revs = append(revs, &revoke)
+	}
+	return revs, rows.Err()
+}
@@ -35,7 +35,9 @@ type dbSchema struct {
 	Hash            string
 	Words           string
 	DisplayMethodID *uuid.UUID
-	CreatedAt       time.Time
+	// CredentialEncryption is scanned as text and converted to domain.CredentialEncryptionPolicy
+	CredentialEncryption string
+	CreatedAt            time.Time
 }
 
 type schema struct {
@@ -49,10 +51,10 @@ func NewSchema(conn db.Storage) *schema {
 
 // Save stores a new entry in schemas table
 func (r *schema) Save(ctx context.Context, s *domain.Schema) error {
-	const insertSchema = `INSERT INTO schemas (id, issuer_id, url, type,  context_url, hash,  words, created_at, version, title, description, display_method_id) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	const insertSchema = `INSERT INTO schemas (id, issuer_id, url, type,  context_url, hash,  words, created_at, version, title, description, display_method_id, credential_encryption) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
  	ON CONFLICT (id) DO
     UPDATE 
-	SET display_method_id=$12`
+	SET display_method_id=$12, credential_encryption=$13`
 
 	hash, err := s.Hash.MarshalText()
 	if err != nil {
@@ -72,7 +74,8 @@ func (r *schema) Save(ctx context.Context, s *domain.Schema) error {
 		s.Version,
 		s.Title,
 		s.Description,
-		s.DisplayMethodID)
+		s.DisplayMethodID,
+		string(s.CredentialEncryption.OrDefault()))
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
@@ -91,7 +94,7 @@ func (r *schema) Save(ctx context.Context, s *domain.Schema) error {
 func (r *schema) Update(ctx context.Context, schema *domain.Schema) error {
 	const updateSchema = `
 	UPDATE schemas 
-	SET issuer_id=$2, url=$3, type=$4, context_url=$5, hash=$6,  words=$7, created_at=$8, version=$9, title=$10, description=$11, display_method_id=$12
+	SET issuer_id=$2, url=$3, type=$4, context_url=$5, hash=$6,  words=$7, created_at=$8, version=$9, title=$10, description=$11, display_method_id=$12, credential_encryption=$13
 	WHERE schemas.id = $1;`
 	hash, err := schema.Hash.MarshalText()
 	if err != nil {
@@ -112,6 +115,7 @@ func (r *schema) Update(ctx context.Context, schema *domain.Schema) error {
 		schema.Title,
 		schema.Description,
 		schema.DisplayMethodID,
+		string(schema.CredentialEncryption.OrDefault()),
 	)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -141,7 +145,7 @@ func (r *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) (
 	var err error
 	var rows pgx.Rows
 	sqlArgs := make([]interface{}, 0)
-	sqlQuery := `SELECT id, issuer_id, url, type, context_url, words, hash, created_at,version,title,description,display_method_id
+	sqlQuery := `SELECT id, issuer_id, url, type, context_url, words, hash, created_at,version,title,description,display_method_id,credential_encryption
 	FROM schemas
 	WHERE issuer_id=$1`
 	sqlArgs = append(sqlArgs, issuerDID.String())
@@ -162,7 +166,7 @@ func (r *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) (
 	schemaCol := make([]domain.Schema, 0)
 	s := dbSchema{}
 	for rows.Next() {
-		if err := rows.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.ContextURL, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description, &s.DisplayMethodID); err != nil {
+		if err := rows.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.ContextURL, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description, &s.DisplayMethodID, &s.CredentialEncryption); err != nil {
 			return nil, err
 		}
 		item, err := toSchemaDomain(&s)
@@ -176,13 +180,33 @@ func (r *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) (
 
 // GetByID searches and returns an schema by id
 func (r *schema) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error) {
-	const byID = `SELECT id, issuer_id, url, type, context_url, words, hash, created_at,version,title,description,display_method_id
+	const byID = `SELECT id, issuer_id, url, type, context_url, words, hash, created_at,version,title,description,display_method_id,credential_encryption
 		FROM schemas 
 		WHERE issuer_id = $1 AND id=$2`
 
 	s := dbSchema{}
 	row := r.conn.Pgx.QueryRow(ctx, byID, issuerDID.String(), id)
-	err := row.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.ContextURL, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description, &s.DisplayMethodID)
+	err := row.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.ContextURL, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description, &s.DisplayMethodID, &s.CredentialEncryption)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrSchemaDoesNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toSchemaDomain(&s)
+}
+
+// GetByURL returns the last imported schema of the issuer with the given url
+func (r *schema) GetByURL(ctx context.Context, issuerDID w3c.DID, url string) (*domain.Schema, error) {
+	const byURL = `SELECT id, issuer_id, url, type, context_url, words, hash, created_at,version,title,description,display_method_id,credential_encryption
+		FROM schemas 
+		WHERE issuer_id = $1 AND url=$2
+		ORDER BY created_at DESC
+		LIMIT 1`
+
+	s := dbSchema{}
+	row := r.conn.Pgx.QueryRow(ctx, byURL, issuerDID.String(), url)
+	err := row.Scan(&s.ID, &s.IssuerID, &s.URL, &s.Type, &s.ContextURL, &s.Words, &s.Hash, &s.CreatedAt, &s.Version, &s.Title, &s.Description, &s.DisplayMethodID, &s.CredentialEncryption)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, ErrSchemaDoesNotExist
 	}
@@ -202,17 +226,18 @@ func toSchemaDomain(s *dbSchema) (*domain.Schema, error) {
 		return nil, fmt.Errorf("parsing hash from schema: %w", err)
 	}
 	return &domain.Schema{
-		ID:              s.ID,
-		IssuerDID:       *issuerDID,
-		URL:             s.URL,
-		Type:            s.Type,
-		ContextURL:      s.ContextURL,
-		Hash:            schemaHash,
-		Words:           domain.SchemaWordsFromString(s.Words),
-		CreatedAt:       s.CreatedAt,
-		Version:         s.Version,
-		Title:           s.Title,
-		Description:     s.Description,
-		DisplayMethodID: s.DisplayMethodID,
+		ID:                   s.ID,
+		IssuerDID:            *issuerDID,
+		URL:                  s.URL,
+		Type:                 s.Type,
+		ContextURL:           s.ContextURL,
+		Hash:                 schemaHash,
+		Words:                domain.SchemaWordsFromString(s.Words),
+		CreatedAt:            s.CreatedAt,
+		Version:              s.Version,
+		Title:                s.Title,
+		Description:          s.Description,
+		DisplayMethodID:      s.DisplayMethodID,
+		CredentialEncryption: domain.CredentialEncryptionPolicy(s.CredentialEncryption),
 	}, nil
 }
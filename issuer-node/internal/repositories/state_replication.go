@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const stateReplicationFields = "r.id, r.identifier, r.state, r.chain, r.status, r.tx_id, r.replacement_tx_ids, r.block_number, r.block_timestamp, r.failure_reason, r.created_at, r.modified_at"
+
+type stateReplication struct{}
+
+// NewStateReplication returns a new identity state replications repository
+func NewStateReplication() ports.StateReplicationRepository {
+	return &stateReplication{}
+}
+
+// Schedule creates a pending replication to chain of the latest confirmed state of each identity of the blockchain and
+// network that doesn't have one yet. The pending and failed replications of older states are superseded by the new ones.
+// It returns the number of replications created.
+func (sr *stateReplication) Schedule(ctx context.Context, conn db.Querier, blockchain string, network string, chain string) (int64, error) {
+	tag, err := conn.Exec(ctx, `INSERT INTO identity_state_replications (identifier, state, chain, status)
+	SELECT s.identifier, s.state, $3, $4
+	FROM identity_states s
+	WHERE s.status = 'confirmed' AND s.previous_state IS NOT NULL
+	AND split_part(s.identifier, ':', 3) = $1 AND split_part(s.identifier, ':', 4) = $2
+	AND s.state_id = (SELECT MAX(l.state_id) FROM identity_states l
+		WHERE l.identifier = s.identifier AND l.status = 'confirmed' AND l.previous_state IS NOT NULL)
+	ON CONFLICT (identifier, state, chain) DO NOTHING`, blockchain, network, chain, domain.StateReplicationPending)
+	if err != nil {
+		return 0, fmt.Errorf("failed to schedule state replications: %w", err)
+	}
+
+	_, err = conn.Exec(ctx, `UPDATE identity_state_replications r SET status = $2
+	WHERE r.chain = $1 AND r.status IN ($3, $4)
+	AND EXISTS (SELECT 1 FROM identity_state_replications n WHERE n.identifier = r.identifier AND n.chain = r.chain AND n.id > r.id)`,
+		chain, domain.StateReplicationSuperseded, domain.StateReplicationPending, domain.StateReplicationFailed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to supersede state replications: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetByStatus returns the replications with the given status, oldest first
+func (sr *stateReplication) GetByStatus(ctx context.Context, conn db.Querier, status domain.StateReplicationStatus) ([]domain.StateReplication, error) {
+	rows, err := conn.Query(ctx, `SELECT `+stateReplicationFields+` FROM identity_state_replications r
+	WHERE r.status = $1
+	ORDER BY r.id`, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return toStateReplicationsDomain(rows)
+}
+
+// Update stores the status and the transactions of the replication
+func (sr *stateReplication) Update(ctx context.Context, conn db.Querier, replication *domain.StateReplication) error {
+	replacementTxIDs := replication.ReplacementTxIDs
+	if replacementTxIDs == nil {
+		replacementTxIDs = []string{}
+	}
+	_, err := conn.Exec(ctx, `UPDATE identity_state_replications
+	SET status = $1, tx_id = $2, replacement_tx_ids = $3, block_number = $4, block_timestamp = $5, failure_reason = $6
+	WHERE id = $7`,
+		replication.Status, replication.TxID, replacementTxIDs, replication.BlockNumber, replication.BlockTimestamp, replication.FailureReason, replication.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update state replication: %w", err)
+	}
+	return nil
+}
+
+// GetLatestByIdentifier returns the latest replication of the states of the identity to each chain
+func (sr *stateReplication) GetLatestByIdentifier(ctx context.Context, conn db.Querier, identifier w3c.DID) ([]domain.StateReplication, error) {
+	rows, err := conn.Query(ctx, `SELECT DISTINCT ON (r.chain) `+stateReplicationFields+` FROM identity_state_replications r
+	WHERE r.identifier = $1
+	ORDER BY r.chain, r.id DESC`, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return toStateReplicationsDomain(rows)
+}
+
+func toStateReplicationsDomain(rows pgx.Rows) ([]domain.StateReplication, error) {
+	replications := make([]domain.StateReplication, 0)
+	for rows.Next() {
+		var replication domain.StateReplication
+		var status string
+		if err := rows.Scan(&replication.ID,
+			&replication.Identifier,
+			&replication.State,
+			&replication.Chain,
+			&status,
+			&replication.TxID,
+			&replication.ReplacementTxIDs,
+			&replication.BlockNumber,
+			&replication.BlockTimestamp,
+			&replication.FailureReason,
+			&replication.CreatedAt,
+			&replication.ModifiedAt); err != nil {
+			return nil, err
+		}
+		replication.Status = domain.StateReplicationStatus(status)
+		replications = append(replications, replication)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return replications, nil
+}
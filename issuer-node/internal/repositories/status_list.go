@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrStatusListNotFound status list not found
+	ErrStatusListNotFound = errors.New("status list not found")
+	// ErrStatusListEntryNotFound status list entry not found
+	ErrStatusListEntryNotFound = errors.New("status list entry not found")
+)
+
+type statusList struct {
+	conn db.Storage
+}
+
+// NewStatusList returns a new bitstring status lists repository
+func NewStatusList(conn db.Storage) ports.StatusListRepository {
+	return &statusList{
+		conn,
+	}
+}
+
+// Save stores a new status list
+func (s *statusList) Save(ctx context.Context, conn db.Querier, statusList *domain.StatusList) error {
+	if conn == nil {
+		conn = s.conn.Pgx
+	}
+	sql := `INSERT INTO status_lists (id, issuer_id, purpose, size, next_index, bits) VALUES($1, $2, $3, $4, $5, $6)`
+	_, err := conn.Exec(ctx, sql, statusList.ID, statusList.IssuerID, statusList.Purpose, statusList.Size, statusList.NextIndex, statusList.Bits)
+	return err
+}
+
+// GetByID returns the status list of the issuer with the given id
+func (s *statusList) GetByID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) (*domain.StatusList, error) {
+	if conn == nil {
+		conn = s.conn.Pgx
+	}
+	sql := `SELECT ` + statusListFields + ` FROM status_lists WHERE issuer_id=$1 AND id=$2`
+	statusList, err := scanStatusList(conn.QueryRow(ctx, sql, issuerDID.String(), id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrStatusListNotFound
+		}
+		return nil, err
+	}
+	return statusList, nil
+}
+
+// GetAvailableForUpdate returns the oldest status list of the issuer with free indexes and locks it until the transaction finishes
+func (s *statusList) GetAvailableForUpdate(ctx context.Context, tx db.Querier, issuerDID w3c.DID, purpose string) (*domain.StatusList, error) {
+	sql := `SELECT ` + statusListFields + `
+			FROM status_lists
+			WHERE issuer_id=$1 AND purpose=$2 AND next_index < size
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE`
+	statusList, err := scanStatusList(tx.QueryRow(ctx, sql, issuerDID.String(), purpose))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrStatusListNotFound
+		}
+		return nil, err
+	}
+	return statusList, nil
+}
+
+// SaveEntry stores the index allocated to a revocation nonce and moves the next free index of the list past it
+func (s *statusList) SaveEntry(ctx context.Context, conn db.Querier, entry *domain.StatusListEntry) error {
+	if conn == nil {
+		conn = s.conn.Pgx
+	}
+	sql := `INSERT INTO status_list_entries (status_list_id, list_index, issuer_id, rev_nonce) VALUES($1, $2, $3, $4)`
+	if _, err := conn.Exec(ctx, sql, entry.StatusListID, entry.Index, entry.IssuerID, entry.RevNonce); err != nil {
+		return err
+	}
+	sql = `UPDATE status_lists SET next_index=GREATEST(next_index, $2 + 1), updated_at=NOW() WHERE id=$1`
+	_, err := conn.Exec(ctx, sql, entry.StatusListID, entry.Index)
+	return err
+}
+
+// ReuseEntry allocates to the revocation nonce the oldest released entry of the issuer in a list with the given purpose.
+// It returns ErrStatusListEntryNotFound when there are no released entries.
+func (s *statusList) ReuseEntry(ctx context.Context, tx db.Querier, issuerDID w3c.DID, purpose string, nonce domain.RevNonceUint64) (*domain.StatusListEntry, error) {
+	sql := `UPDATE status_list_entries SET rev_nonce=$3
+			WHERE (status_list_id, list_index) = (
+				SELECT entries.status_list_id, entries.list_index
+				FROM status_list_entries entries
+				JOIN status_lists ON status_lists.id = entries.status_list_id
+				WHERE entries.issuer_id=$1 AND status_lists.purpose=$2 AND entries.rev_nonce IS NULL
+				ORDER BY status_lists.created_at, entries.list_index
+				LIMIT 1
+				FOR UPDATE OF entries SKIP LOCKED)
+			RETURNING status_list_id, list_index`
+	entry := &domain.StatusListEntry{
+		IssuerID: issuerDID.String(),
+		RevNonce: nonce,
+	}
+	if err := tx.QueryRow(ctx, sql, issuerDID.String(), purpose, nonce).Scan(&entry.StatusListID, &entry.Index); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrStatusListEntryNotFound
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// ReleaseEntries releases the entries allocated to the revocation nonce when the issuer has no credential with that nonce,
+// so they can be allocated again. It returns the number of entries released.
+func (s *statusList) ReleaseEntries(ctx context.Context, conn db.Querier, issuerDID w3c.DID, nonce domain.RevNonceUint64) (int64, error) {
+	if conn == nil {
+		conn = s.conn.Pgx
+	}
+	sql := `UPDATE status_list_entries SET rev_nonce=NULL
+			WHERE issuer_id=$1 AND rev_nonce=$2
+			AND NOT EXISTS (SELECT 1 FROM claims WHERE claims.identifier=$1 AND claims.rev_nonce=$2)`
+	cmd, err := conn.Exec(ctx, sql, issuerDID.String(), nonce)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
+
+// SetStatus sets or clears the bits of all the entries of the issuer allocated to the revocation nonce in the lists with the given purpose.
+// The bitstring status list numbers the bits from the most significant bit of each byte while postgres
+// numbers them from the least significant one, so the position is mirrored within the byte.
+func (s *statusList) SetStatus(ctx context.Context, conn db.Querier, issuerDID w3c.DID, nonce domain.RevNonceUint64, purpose string, status bool) (int64, error) {
+	if conn == nil {
+		conn = s.conn.Pgx
+	}
+	value := 0
+	if status {
+		value = 1
+	}
+	sql := `UPDATE status_lists
+			SET bits=set_bit(status_lists.bits, (entries.list_index / 8) * 8 + 7 - entries.list_index % 8, $3), updated_at=NOW()
+			FROM status_list_entries entries
+			WHERE entries.status_list_id = status_lists.id AND entries.issuer_id=$1 AND entries.rev_nonce=$2 AND status_lists.purpose=$4`
+	cmd, err := conn.Exec(ctx, sql, issuerDID.String(), nonce, value, purpose)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
+
+const statusListFields = `id, issuer_id, purpose, size, next_index, bits, created_at, updated_at`
+
+func scanStatusList(row pgx.Row) (*domain.StatusList, error) {
+	var statusList domain.StatusList
+	err := row.Scan(
+		&statusList.ID,
+		&statusList.IssuerID,
+		&statusList.Purpose,
+		&statusList.Size,
+		&statusList.NextIndex,
+		&statusList.Bits,
+		&statusList.CreatedAt,
+		&statusList.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &statusList, nil
+}
@@ -0,0 +1,197 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ErrVerificationQueryNotFound verification query not found
+	ErrVerificationQueryNotFound = errors.New("verification query not found")
+	// ErrVerificationQueryDuplicateName the identity already has a verification query with the same name
+	ErrVerificationQueryDuplicateName = errors.New("verification query with the same name already exists")
+	// ErrVerificationSessionNotFound verification session not found
+	ErrVerificationSessionNotFound = errors.New("verification session not found")
+)
+
+type verification struct {
+	conn db.Storage
+}
+
+// NewVerification returns a new verification queries and sessions repository
+func NewVerification(conn db.Storage) ports.VerificationRepository {
+	return &verification{
+		conn,
+	}
+}
+
+// SaveQuery stores a new verification query
+func (v *verification) SaveQuery(ctx context.Context, query *domain.VerificationQuery) error {
+	scope := pgtype.JSONB{}
+	if err := scope.Set(query.Scope); err != nil {
+		return fmt.Errorf("cannot set scope values: %w", err)
+	}
+	sql := `INSERT INTO verification_queries (id, issuer_did, name, reason, scope) VALUES($1, $2, $3, $4, $5)`
+	_, err := v.conn.Pgx.Exec(ctx, sql, query.ID, query.IssuerCoreDID().String(), query.Name, query.Reason, scope)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return ErrVerificationQueryDuplicateName
+		}
+		return err
+	}
+	return nil
+}
+
+// GetQueryByID returns the verification query of the identity with the given id
+func (v *verification) GetQueryByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.VerificationQuery, error) {
+	sql := `SELECT ` + verificationQueryFields + ` FROM verification_queries WHERE issuer_did=$1 AND id=$2`
+	query, err := scanVerificationQuery(v.conn.Pgx.QueryRow(ctx, sql, issuerDID.String(), id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrVerificationQueryNotFound
+		}
+		return nil, err
+	}
+	return query, nil
+}
+
+// GetQueries returns the verification queries of the identity sorted by name
+func (v *verification) GetQueries(ctx context.Context, issuerDID w3c.DID) ([]domain.VerificationQuery, error) {
+	sql := `SELECT ` + verificationQueryFields + ` FROM verification_queries WHERE issuer_did=$1 ORDER BY name`
+	rows, err := v.conn.Pgx.Query(ctx, sql, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	queries := make([]domain.VerificationQuery, 0)
+	for rows.Next() {
+		query, err := scanVerificationQuery(rows)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, *query)
+	}
+	return queries, rows.Err()
+}
+
+// DeleteQuery removes the verification query of the identity with the given id and its sessions
+func (v *verification) DeleteQuery(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+	tag, err := v.conn.Pgx.Exec(ctx, `DELETE FROM verification_queries WHERE issuer_did=$1 AND id=$2`, issuerDID.String(), id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrVerificationQueryNotFound
+	}
+	return nil
+}
+
+// SaveSession stores a new verification session
+func (v *verification) SaveSession(ctx context.Context, session *domain.VerificationSession) error {
+	request := pgtype.JSONB{}
+	if err := request.Set(session.Request); err != nil {
+		return fmt.Errorf("cannot set request values: %w", err)
+	}
+	sql := `INSERT INTO verification_sessions (id, verification_query_id, issuer_did, request, status) VALUES($1, $2, $3, $4, $5)`
+	_, err := v.conn.Pgx.Exec(ctx, sql, session.ID, session.VerificationQueryID, session.IssuerCoreDID().String(), request, string(session.Status))
+	return err
+}
+
+// GetSessionByID returns the verification session with the given id
+func (v *verification) GetSessionByID(ctx context.Context, id uuid.UUID) (*domain.VerificationSession, error) {
+	sql := `SELECT ` + verificationSessionFields + ` FROM verification_sessions WHERE id=$1`
+	session, err := scanVerificationSession(v.conn.Pgx.QueryRow(ctx, sql, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrVerificationSessionNotFound
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+// UpdatePendingSession stores the result of a session that is still pending and returns the number of updated sessions
+func (v *verification) UpdatePendingSession(ctx context.Context, session *domain.VerificationSession) (int64, error) {
+	response := pgtype.JSONB{Status: pgtype.Null}
+	if session.Response != nil {
+		if err := response.Set(session.Response); err != nil {
+			return 0, fmt.Errorf("cannot set response values: %w", err)
+		}
+	}
+	sql := `UPDATE verification_sessions SET status=$2, user_did=$3, response=$4, error=$5, updated_at=NOW() WHERE id=$1 AND status=$6`
+	tag, err := v.conn.Pgx.Exec(ctx, sql, session.ID, string(session.Status), session.UserDID, response, session.Error, string(domain.VerificationSessionStatusPending))
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+const (
+	verificationQueryFields   = `id, issuer_did, name, reason, scope, created_at`
+	verificationSessionFields = `id, verification_query_id, issuer_did, request, status, user_did, response, error, created_at, updated_at`
+)
+
+func scanVerificationQuery(row pgx.Row) (*domain.VerificationQuery, error) {
+	var query domain.VerificationQuery
+	var scope pgtype.JSONB
+	if err := row.Scan(&query.ID, &query.IssuerDID, &query.Name, &query.Reason, &scope, &query.CreatedAt); err != nil {
+		return nil, err
+	}
+	if err := decodeJSONB(scope, &query.Scope); err != nil {
+		return nil, fmt.Errorf("parsing scope: %w", err)
+	}
+	return &query, nil
+}
+
+func scanVerificationSession(row pgx.Row) (*domain.VerificationSession, error) {
+	var session domain.VerificationSession
+	var request, response pgtype.JSONB
+	var status string
+	err := row.Scan(
+		&session.ID,
+		&session.VerificationQueryID,
+		&session.IssuerDID,
+		&request,
+		&status,
+		&session.UserDID,
+		&response,
+		&session.Error,
+		&session.CreatedAt,
+		&session.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	session.Status = domain.VerificationSessionStatus(status)
+	if err := decodeJSONB(request, &session.Request); err != nil {
+		return nil, fmt.Errorf("parsing request: %w", err)
+	}
+	if response.Status == pgtype.Present {
+		if err := decodeJSONB(response, &session.Response); err != nil {
+			return nil, fmt.Errorf("parsing response: %w", err)
+		}
+	}
+	return &session, nil
+}
+
+// decodeJSONB keeps the numbers of the queries as json.Number so that big values are not rounded
+func decodeJSONB(value pgtype.JSONB, target any) error {
+	d := json.NewDecoder(bytes.NewReader(value.Bytes))
+	d.UseNumber()
+	return d.Decode(target)
+}
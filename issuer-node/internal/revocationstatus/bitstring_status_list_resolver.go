@@ -0,0 +1,50 @@
+package revocationstatus
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/internal/network"
+)
+
+// BitstringStatusListEntry is the credential status type of the credentials whose revocation and suspension are published
+// in W3C Bitstring Status Lists hosted by the issuer node
+const BitstringStatusListEntry verifiable.CredentialStatusType = "BitstringStatusListEntry"
+
+// BitstringStatusListEntryStatus is the credentialStatus of a credential that uses a W3C Bitstring Status List
+type BitstringStatusListEntryStatus struct {
+	ID                   string                          `json:"id"`
+	Type                 verifiable.CredentialStatusType `json:"type"`
+	StatusPurpose        string                          `json:"statusPurpose"`
+	StatusListIndex      string                          `json:"statusListIndex"`
+	StatusListCredential string                          `json:"statusListCredential"`
+}
+
+// ErrStatusListEntryRequired is returned when a bitstring status list entry is resolved from the revocation nonce.
+// The entries are allocated by the status list service, that resolves them with GetBitstringStatusListEntry.
+var ErrStatusListEntryRequired = errors.New("bitstring status list entries are resolved from an allocated status list index")
+
+type bitstringStatusListResolver struct{}
+
+func (r *bitstringStatusListResolver) resolve(_ network.RhsSettings, _ w3c.DID, _ uint64, _ string) (*verifiable.CredentialStatus, error) {
+	return nil, ErrStatusListEntryRequired
+}
+
+func (r *bitstringStatusListResolver) resolveEntry(credentialStatusSettings network.RhsSettings, issuerDID w3c.DID, statusListID uuid.UUID, index int, purpose string) *BitstringStatusListEntryStatus {
+	statusListCredential := r.statusListCredentialURL(credentialStatusSettings, issuerDID, statusListID)
+	return &BitstringStatusListEntryStatus{
+		ID:                   statusListCredential + "#" + strconv.Itoa(index),
+		Type:                 BitstringStatusListEntry,
+		StatusPurpose:        purpose,
+		StatusListIndex:      strconv.Itoa(index),
+		StatusListCredential: statusListCredential,
+	}
+}
+
+func (r *bitstringStatusListResolver) statusListCredentialURL(credentialStatusSettings network.RhsSettings, issuerDID w3c.DID, statusListID uuid.UUID) string {
+	return buildStatusListCredentialURL(credentialStatusSettings.Iden3CommAgentStatus, issuerDID, statusListID)
+}
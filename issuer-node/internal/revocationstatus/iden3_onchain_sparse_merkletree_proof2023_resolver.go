@@ -10,11 +10,11 @@ import (
 
 type iden3OnChainSparseMerkleTreeProof2023Resolver struct{}
 
-func (r *iden3OnChainSparseMerkleTreeProof2023Resolver) resolve(credentialStatusSettings network.RhsSettings, issuerDID w3c.DID, nonce uint64, issuerState string) *verifiable.CredentialStatus {
+func (r *iden3OnChainSparseMerkleTreeProof2023Resolver) resolve(credentialStatusSettings network.RhsSettings, issuerDID w3c.DID, nonce uint64, issuerState string) (*verifiable.CredentialStatus, error) {
 	contractAddressHex := *credentialStatusSettings.ContractAddress
 	return &verifiable.CredentialStatus{
 		ID:              buildIden3OnchainSMTProofURL(issuerDID, nonce, ethcommon.HexToAddress(contractAddressHex), *credentialStatusSettings.ChainID, issuerState),
 		Type:            verifiable.Iden3OnchainSparseMerkleTreeProof2023,
 		RevocationNonce: nonce,
-	}
+	}, nil
 }
@@ -11,7 +11,7 @@ import (
 
 type iden3ReverseSparseMerkleTreeProofResolver struct{}
 
-func (r *iden3ReverseSparseMerkleTreeProofResolver) resolve(credentialStatusSettings network.RhsSettings, _ w3c.DID, nonce uint64, issuerState string) *verifiable.CredentialStatus {
+func (r *iden3ReverseSparseMerkleTreeProofResolver) resolve(credentialStatusSettings network.RhsSettings, _ w3c.DID, nonce uint64, issuerState string) (*verifiable.CredentialStatus, error) {
 	return &verifiable.CredentialStatus{
 		ID:              buildRHSRevocationURL(*credentialStatusSettings.RhsUrl, issuerState),
 		Type:            verifiable.Iden3ReverseSparseMerkleTreeProof,
@@ -21,5 +21,5 @@ func (r *iden3ReverseSparseMerkleTreeProofResolver) resolve(credentialStatusSett
 			Type:            verifiable.Iden3commRevocationStatusV1,
 			RevocationNonce: nonce,
 		},
-	}
+	}, nil
 }
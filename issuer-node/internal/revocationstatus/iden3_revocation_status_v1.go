@@ -11,10 +11,10 @@ import (
 
 type iden3CommRevocationStatusV1Resolver struct{}
 
-func (r *iden3CommRevocationStatusV1Resolver) resolve(credentialStatusSettings network.RhsSettings, _ w3c.DID, nonce uint64, _ string) *verifiable.CredentialStatus {
+func (r *iden3CommRevocationStatusV1Resolver) resolve(credentialStatusSettings network.RhsSettings, _ w3c.DID, nonce uint64, _ string) (*verifiable.CredentialStatus, error) {
 	return &verifiable.CredentialStatus{
 		ID:              fmt.Sprintf("%s/v2/agent", credentialStatusSettings.Iden3CommAgentStatus),
 		Type:            verifiable.Iden3commRevocationStatusV1,
 		RevocationNonce: nonce,
-	}
+	}, nil
 }
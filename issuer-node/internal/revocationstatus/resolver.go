@@ -4,23 +4,26 @@ import (
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/network"
 )
 
-const resolversLength = 3
+const resolversLength = 4
 
 type revocationCredentialStatusResolver interface {
-	resolve(credentialStatusSettings network.RhsSettings, issuerDID w3c.DID, nonce uint64, issuerState string) *verifiable.CredentialStatus
+	resolve(credentialStatusSettings network.RhsSettings, issuerDID w3c.DID, nonce uint64, issuerState string) (*verifiable.CredentialStatus, error)
 }
 
 // Resolver resolves credential status.
 type Resolver struct {
-	networkResolver network.Resolver
-	resolvers       map[verifiable.CredentialStatusType]revocationCredentialStatusResolver
+	networkResolver     network.Resolver
+	resolvers           map[verifiable.CredentialStatusType]revocationCredentialStatusResolver
+	statusListsResolver *bitstringStatusListResolver
 }
 
 // NewRevocationStatusResolver - constructor
@@ -29,9 +32,12 @@ func NewRevocationStatusResolver(networkResolver network.Resolver) *Resolver {
 	resolvers[verifiable.Iden3ReverseSparseMerkleTreeProof] = &iden3ReverseSparseMerkleTreeProofResolver{}
 	resolvers[verifiable.Iden3commRevocationStatusV1] = &iden3CommRevocationStatusV1Resolver{}
 	resolvers[verifiable.Iden3OnchainSparseMerkleTreeProof2023] = &iden3OnChainSparseMerkleTreeProof2023Resolver{}
+	statusListsResolver := &bitstringStatusListResolver{}
+	resolvers[BitstringStatusListEntry] = statusListsResolver
 	return &Resolver{
-		networkResolver: networkResolver,
-		resolvers:       resolvers,
+		networkResolver:     networkResolver,
+		resolvers:           resolvers,
+		statusListsResolver: statusListsResolver,
 	}
 }
 
@@ -47,15 +53,46 @@ func (rsr *Resolver) GetCredentialRevocationStatus(ctx context.Context, issuerDI
 		return nil, errors.New("unsupported credential credentialStatusType type")
 	}
 
-	resolverPrefix, err := common.ResolverPrefix(&issuerDID)
+	settings, err := rsr.rhsSettings(ctx, issuerDID)
 	if err != nil {
 		return nil, err
 	}
 
-	settings, err := rsr.networkResolver.GetRhsSettings(ctx, resolverPrefix)
+	return resolver.resolve(*settings, issuerDID, nonce, issuerState)
+}
+
+// GetBitstringStatusListEntry - return the credential status of a credential that uses the given index of a bitstring status list with the given purpose.
+// Bitstring status list entries are not resolved by GetCredentialRevocationStatus, that returns ErrStatusListEntryRequired,
+// because they depend on an allocated index.
+func (rsr *Resolver) GetBitstringStatusListEntry(ctx context.Context, issuerDID w3c.DID, statusListID uuid.UUID, index int, purpose string) (*BitstringStatusListEntryStatus, error) {
+	settings, err := rsr.rhsSettings(ctx, issuerDID)
 	if err != nil {
 		return nil, err
 	}
+	return rsr.statusListsResolver.resolveEntry(*settings, issuerDID, statusListID, index, purpose), nil
+}
 
-	return resolver.resolve(*settings, issuerDID, nonce, issuerState), nil
+// GetBitstringStatusListCredentialURL - return the url where the status list credential of the given bitstring status list is published.
+func (rsr *Resolver) GetBitstringStatusListCredentialURL(ctx context.Context, issuerDID w3c.DID, statusListID uuid.UUID) (string, error) {
+	settings, err := rsr.rhsSettings(ctx, issuerDID)
+	if err != nil {
+		return "", err
+	}
+	return rsr.statusListsResolver.statusListCredentialURL(*settings, issuerDID, statusListID), nil
+}
+
+func (rsr *Resolver) rhsSettings(ctx context.Context, issuerDID w3c.DID) (*network.RhsSettings, error) {
+	// did:web identities do not belong to a network, their status lists are hosted with their DID documents
+	if domain.IsWebDID(issuerDID) {
+		baseURL, err := domain.WebDIDBaseURL(issuerDID)
+		if err != nil {
+			return nil, err
+		}
+		return &network.RhsSettings{Iden3CommAgentStatus: baseURL}, nil
+	}
+	resolverPrefix, err := common.ResolverPrefix(&issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	return rsr.networkResolver.GetRhsSettings(ctx, resolverPrefix)
 }
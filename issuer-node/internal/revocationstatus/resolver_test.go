@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
 	"github.com/stretchr/testify/require"
@@ -142,3 +143,31 @@ func TestRevocationStatusResolver_GetCredentialRevocationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestRevocationStatusResolver_GetBitstringStatusListEntry(t *testing.T) {
+	const did = "did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu"
+	didW3c, err := w3c.ParseDID(did)
+	require.NoError(t, err)
+	statusListID := uuid.MustParse("8edd8112-c415-11ed-b036-debe37e1cbd6")
+
+	cfg := &config.Configuration{
+		ServerUrl:           "https://issuer-node.privado.id",
+		NetworkResolverPath: "",
+	}
+	networkResolver, err := network.NewResolver(context.Background(), *cfg, nil, common.CreateFile(t))
+	require.NoError(t, err)
+	rsr := NewRevocationStatusResolver(*networkResolver)
+
+	credentialStatus, err := rsr.GetBitstringStatusListEntry(context.Background(), *didW3c, statusListID, 94567, "revocation")
+	require.NoError(t, err)
+	require.Equal(t, &BitstringStatusListEntryStatus{
+		ID:                   "https://issuer-node.privado.id/v2/identities/did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu/status-lists/8edd8112-c415-11ed-b036-debe37e1cbd6#94567",
+		Type:                 BitstringStatusListEntry,
+		StatusPurpose:        "revocation",
+		StatusListIndex:      "94567",
+		StatusListCredential: "https://issuer-node.privado.id/v2/identities/did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu/status-lists/8edd8112-c415-11ed-b036-debe37e1cbd6",
+	}, credentialStatus)
+
+	_, err = rsr.GetCredentialRevocationStatus(context.Background(), *didW3c, 94567, "issuer-state", BitstringStatusListEntry)
+	require.ErrorIs(t, err, ErrStatusListEntryRequired)
+}
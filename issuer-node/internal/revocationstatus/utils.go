@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 )
 
@@ -17,3 +18,7 @@ func buildRHSRevocationURL(host, issuerState string) string {
 func buildIden3OnchainSMTProofURL(issuerDID w3c.DID, nonce uint64, contractAddress ethcommon.Address, chainID string, stateHex string) string {
 	return fmt.Sprintf("%s/credentialStatus?revocationNonce=%v&contractAddress=%s:%s&state=%s", issuerDID.String(), nonce, chainID, contractAddress.Hex(), stateHex)
 }
+
+func buildStatusListCredentialURL(host string, issuerDID w3c.DID, statusListID uuid.UUID) string {
+	return fmt.Sprintf("%s/v2/identities/%s/status-lists/%s", host, issuerDID.String(), statusListID)
+}
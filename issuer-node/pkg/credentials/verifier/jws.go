@@ -0,0 +1,243 @@
+package verifier
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/piprate/json-gold/ld"
+)
+
+// JWSProofType is the type of the linked data proofs with a detached JWS, used by the did:web issuers
+const JWSProofType verifiable.ProofType = "JsonWebSignature2020"
+
+const (
+	jwsAlgES256K       = "ES256K"
+	jwsAlgEdDSA        = "EdDSA"
+	jwsProofPurpose    = "assertionMethod"
+	secp256k1CoordSize = 32
+)
+
+// jwsProof is a JsonWebSignature2020 proof. Created is kept as is because it is part of the signed proof options.
+type jwsProof struct {
+	Type               verifiable.ProofType `json:"type"`
+	Created            string               `json:"created"`
+	VerificationMethod string               `json:"verificationMethod"`
+	ProofPurpose       string               `json:"proofPurpose"`
+	JWS                string               `json:"jws"`
+}
+
+// jwsHeader is the protected header of a detached JWS with an unencoded payload (RFC 7797)
+type jwsHeader struct {
+	Alg  string   `json:"alg"`
+	B64  *bool    `json:"b64"`
+	Crit []string `json:"crit"`
+}
+
+type didVerificationMethod struct {
+	ID           string         `json:"id"`
+	PublicKeyJwk map[string]any `json:"publicKeyJwk"`
+}
+
+type didDocument struct {
+	VerificationMethod []didVerificationMethod `json:"verificationMethod"`
+	AssertionMethod    []json.RawMessage       `json:"assertionMethod"`
+}
+
+// checkJWSProof checks the JsonWebSignature2020 proof with the key of the issuer DID document it references.
+// As in the Linked Data Proofs signature algorithm, the signed payload is the concatenation of the SHA-256 hashes
+// of the URDNA2015 canonical forms of the proof options and of the credential without proofs.
+func (v *Verifier) checkJWSProof(ctx context.Context, issuerDID w3c.DID, credential verifiable.W3CCredential, credentialProof verifiable.CredentialProof) error {
+	if v.didDocumentResolver == nil {
+		return errors.New("JsonWebSignature2020 proofs are not supported: no DID document resolver configured")
+	}
+
+	raw, err := json.Marshal(credentialProof)
+	if err != nil {
+		return fmt.Errorf("invalid proof: %w", err)
+	}
+	var proof jwsProof
+	if err := json.Unmarshal(raw, &proof); err != nil {
+		return fmt.Errorf("invalid proof: %w", err)
+	}
+	if proof.ProofPurpose != jwsProofPurpose {
+		return fmt.Errorf("unsupported proof purpose '%s'", proof.ProofPurpose)
+	}
+	if !strings.HasPrefix(proof.VerificationMethod, issuerDID.String()+"#") {
+		return errors.New("the verification method is not a key of the issuer")
+	}
+
+	jwk, err := v.resolveAssertionMethod(ctx, issuerDID, proof.VerificationMethod)
+	if err != nil {
+		return err
+	}
+
+	encodedHeader, header, signature, err := decodeDetachedJWS(proof.JWS)
+	if err != nil {
+		return err
+	}
+
+	proofOptionsHash, err := CanonicalHash(v.documentLoader, map[string]any{
+		"@context":           credential.Context,
+		"type":               proof.Type,
+		"created":            proof.Created,
+		"verificationMethod": proof.VerificationMethod,
+		"proofPurpose":       proof.ProofPurpose,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot canonicalize the proof options: %w", err)
+	}
+	credential.Proof = nil
+	credentialHash, err := CanonicalHash(v.documentLoader, credential)
+	if err != nil {
+		return fmt.Errorf("cannot canonicalize the credential: %w", err)
+	}
+
+	signingInput := append([]byte(encodedHeader+"."), append(proofOptionsHash, credentialHash...)...)
+	return verifyJWSSignature(header.Alg, jwk, signingInput, signature)
+}
+
+// resolveAssertionMethod returns the public key of the verification method, that must be an assertion method of the issuer
+func (v *Verifier) resolveAssertionMethod(ctx context.Context, issuerDID w3c.DID, verificationMethod string) (map[string]any, error) {
+	raw, err := v.didDocumentResolver.Resolve(ctx, issuerDID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve the DID document of the issuer: %w", err)
+	}
+	var doc didDocument
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("invalid DID document of the issuer: %w", err)
+	}
+
+	matches := func(id string) bool {
+		return id == verificationMethod || (strings.HasPrefix(id, "#") && issuerDID.String()+id == verificationMethod)
+	}
+	for _, entry := range doc.AssertionMethod {
+		var ref string
+		if err := json.Unmarshal(entry, &ref); err == nil {
+			if !matches(ref) {
+				continue
+			}
+			for _, method := range doc.VerificationMethod {
+				if matches(method.ID) && method.PublicKeyJwk != nil {
+					return method.PublicKeyJwk, nil
+				}
+			}
+			continue
+		}
+		var method didVerificationMethod
+		if err := json.Unmarshal(entry, &method); err == nil && matches(method.ID) && method.PublicKeyJwk != nil {
+			return method.PublicKeyJwk, nil
+		}
+	}
+	return nil, errors.New("the verification method is not an assertion method of the issuer")
+}
+
+// decodeDetachedJWS returns the encoded header, the header and the signature of a JWS serialized as header..signature
+func decodeDetachedJWS(jws string) (string, *jwsHeader, []byte, error) {
+	parts := strings.Split(jws, ".")
+	if len(parts) != 3 || parts[1] != "" {
+		return "", nil, nil, errors.New("invalid jws: the payload must be detached")
+	}
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("invalid jws header: %w", err)
+	}
+	var header jwsHeader
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return "", nil, nil, fmt.Errorf("invalid jws header: %w", err)
+	}
+	if header.B64 == nil || *header.B64 || !slices.Contains(header.Crit, "b64") {
+		return "", nil, nil, errors.New("invalid jws header: the payload must be unencoded")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("invalid jws signature: %w", err)
+	}
+	return parts[0], &header, signature, nil
+}
+
+// verifyJWSSignature checks an ES256K signature with a secp256k1 key or an EdDSA signature with an Ed25519 key
+func verifyJWSSignature(alg string, jwk map[string]any, signingInput []byte, signature []byte) error {
+	x, err := jwkCoordinate(jwk, "x")
+	if err != nil {
+		return err
+	}
+
+	var valid bool
+	switch {
+	case alg == jwsAlgEdDSA && jwk["kty"] == "OKP" && jwk["crv"] == "Ed25519":
+		if len(x) != ed25519.PublicKeySize {
+			return errors.New("invalid Ed25519 public key")
+		}
+		valid = ed25519.Verify(x, signingInput, signature)
+	case alg == jwsAlgES256K && jwk["kty"] == "EC" && jwk["crv"] == "secp256k1":
+		y, err := jwkCoordinate(jwk, "y")
+		if err != nil {
+			return err
+		}
+		if len(x) != secp256k1CoordSize || len(y) != secp256k1CoordSize {
+			return errors.New("invalid secp256k1 public key")
+		}
+		digest := sha256.Sum256(signingInput)
+		pubKey := append(append([]byte{0x04}, x...), y...)
+		valid = crypto.VerifySignature(pubKey, digest[:], signature)
+	default:
+		return fmt.Errorf("unsupported jws algorithm '%s' for the key of the verification method", alg)
+	}
+
+	if !valid {
+		return errors.New("the signature does not match the key of the verification method")
+	}
+	return nil
+}
+
+func jwkCoordinate(jwk map[string]any, name string) ([]byte, error) {
+	encoded, ok := jwk[name].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid public key: missing %s", name)
+	}
+	coordinate, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return coordinate, nil
+}
+
+// CanonicalHash returns the SHA-256 hash of the URDNA2015 canonical form of the JSON-LD document.
+// The JsonWebSignature2020 proofs sign the canonical hashes of the proof options and of the credential.
+func CanonicalHash(documentLoader ld.DocumentLoader, document any) ([]byte, error) {
+	b, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	var expanded any
+	if err := json.Unmarshal(b, &expanded); err != nil {
+		return nil, err
+	}
+
+	options := ld.NewJsonLdOptions("")
+	options.Algorithm = ld.AlgorithmURDNA2015
+	options.Format = "application/n-quads"
+	if documentLoader != nil {
+		options.DocumentLoader = documentLoader
+	}
+	normalized, err := ld.NewJsonLdProcessor().Normalize(expanded, options)
+	if err != nil {
+		return nil, err
+	}
+	nquads, ok := normalized.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected canonical form %T", normalized)
+	}
+	hash := sha256.Sum256([]byte(nquads))
+	return hash[:], nil
+}
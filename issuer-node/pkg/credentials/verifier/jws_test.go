@@ -0,0 +1,182 @@
+package verifier
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testContextURL = "https://example.com/credentials/v1"
+	testIssuerDID  = "did:web:issuer.example.com"
+)
+
+// testDocumentLoader serves the JSON-LD context of the test credentials, so they are canonicalized offline
+type testDocumentLoader struct{}
+
+func (testDocumentLoader) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	if u != testContextURL {
+		return nil, fmt.Errorf("unknown context %s", u)
+	}
+	return &ld.RemoteDocument{
+		DocumentURL: u,
+		Document: map[string]any{
+			"@context": map[string]any{
+				"@vocab": "https://example.com/vocab#",
+				"id":     "@id",
+				"type":   "@type",
+			},
+		},
+	}, nil
+}
+
+type didDocumentResolverMock map[string]json.RawMessage
+
+func (m didDocumentResolverMock) Resolve(_ context.Context, did w3c.DID) (json.RawMessage, error) {
+	doc, ok := m[did.String()]
+	if !ok {
+		return nil, errors.New("DID not found")
+	}
+	return doc, nil
+}
+
+func TestVerifier_VerifyJWSProof(t *testing.T) {
+	ctx := context.Background()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	jwk := func(key ed25519.PublicKey) map[string]any {
+		return map[string]any{"kty": "OKP", "crv": "Ed25519", "x": base64.RawURLEncoding.EncodeToString(key)}
+	}
+	didDocument, err := json.Marshal(map[string]any{
+		"verificationMethod": []map[string]any{
+			{"id": testIssuerDID + "#key-1", "publicKeyJwk": jwk(publicKey)},
+			{"id": testIssuerDID + "#key-2", "publicKeyJwk": jwk(otherPublicKey)},
+		},
+		"assertionMethod": []string{"#key-1"},
+	})
+	require.NoError(t, err)
+
+	v := New(nil,
+		WithDocumentLoader(testDocumentLoader{}),
+		WithDIDDocumentResolver(didDocumentResolverMock{testIssuerDID: didDocument}),
+	)
+
+	// sign returns the credential issued by issuer with a JsonWebSignature2020 proof of the verification method
+	sign := func(t *testing.T, issuer string, verificationMethod string) verifiable.W3CCredential {
+		t.Helper()
+		issuanceDate := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+		credential := verifiable.W3CCredential{
+			ID:                "urn:uuid:8edd8112-c415-11ed-b036-debe37e1cbd6",
+			Context:           []string{testContextURL},
+			Type:              []string{"VerifiableCredential", "TestCredential"},
+			Issuer:            issuer,
+			IssuanceDate:      &issuanceDate,
+			CredentialSubject: map[string]any{"id": "did:example:holder", "name": "Alice"},
+		}
+
+		proof := map[string]any{
+			"type":               JWSProofType,
+			"created":            "2026-10-16T00:00:00Z",
+			"verificationMethod": verificationMethod,
+			"proofPurpose":       jwsProofPurpose,
+		}
+		proofOptionsHash, err := CanonicalHash(testDocumentLoader{}, map[string]any{
+			"@context":           credential.Context,
+			"type":               proof["type"],
+			"created":            proof["created"],
+			"verificationMethod": proof["verificationMethod"],
+			"proofPurpose":       proof["proofPurpose"],
+		})
+		require.NoError(t, err)
+		credentialHash, err := CanonicalHash(testDocumentLoader{}, credential)
+		require.NoError(t, err)
+
+		header, err := json.Marshal(map[string]any{"alg": jwsAlgEdDSA, "b64": false, "crit": []string{"b64"}})
+		require.NoError(t, err)
+		encodedHeader := base64.RawURLEncoding.EncodeToString(header)
+		signingInput := append([]byte(encodedHeader+"."), append(proofOptionsHash, credentialHash...)...)
+		proof["jws"] = encodedHeader + ".." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(privateKey, signingInput))
+
+		raw, err := json.Marshal(credential)
+		require.NoError(t, err)
+		var document map[string]any
+		require.NoError(t, json.Unmarshal(raw, &document))
+		document["proof"] = []any{proof}
+		raw, err = json.Marshal(document)
+		require.NoError(t, err)
+		var signed verifiable.W3CCredential
+		require.NoError(t, json.Unmarshal(raw, &signed))
+		return signed
+	}
+
+	for _, tc := range []struct {
+		name       string
+		credential func(t *testing.T) verifiable.W3CCredential
+		valid      bool
+		message    string
+	}{
+		{
+			name: "valid proof",
+			credential: func(t *testing.T) verifiable.W3CCredential {
+				return sign(t, testIssuerDID, testIssuerDID+"#key-1")
+			},
+			valid: true,
+		},
+		{
+			name: "tampered credential",
+			credential: func(t *testing.T) verifiable.W3CCredential {
+				credential := sign(t, testIssuerDID, testIssuerDID+"#key-1")
+				credential.CredentialSubject["name"] = "Mallory"
+				return credential
+			},
+			message: "the signature does not match the key of the verification method",
+		},
+		{
+			name: "wrong verification method",
+			credential: func(t *testing.T) verifiable.W3CCredential {
+				return sign(t, testIssuerDID, testIssuerDID+"#key-2")
+			},
+			message: "the verification method is not an assertion method of the issuer",
+		},
+		{
+			name: "unknown DID",
+			credential: func(t *testing.T) verifiable.W3CCredential {
+				return sign(t, "did:web:unknown.example.com", "did:web:unknown.example.com#key-1")
+			},
+			message: "cannot resolve the DID document of the issuer: DID not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			report := v.Verify(ctx, tc.credential(t))
+			assert.Equal(t, tc.valid, report.Valid)
+			var check *Check
+			for i := range report.Checks {
+				if report.Checks[i].Name == CheckJWSProof {
+					check = &report.Checks[i]
+				}
+			}
+			require.NotNil(t, check)
+			if tc.valid {
+				assert.Equal(t, CheckPassed, check.Status, check.Message)
+				return
+			}
+			assert.Equal(t, CheckFailed, check.Status)
+			assert.Equal(t, tc.message, check.Message)
+		})
+	}
+}
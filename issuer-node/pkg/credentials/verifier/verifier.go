@@ -0,0 +1,482 @@
+package verifier
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/iden3/go-merkletree-sql/v2"
+	jsonSuite "github.com/iden3/go-schema-processor/v2/json"
+	"github.com/iden3/go-schema-processor/v2/merklize"
+	"github.com/iden3/go-schema-processor/v2/processor"
+	"github.com/iden3/go-schema-processor/v2/utils"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/piprate/json-gold/ld"
+)
+
+// CheckName identifies each one of the checks of a verification report
+type CheckName string
+
+const (
+	// CheckExpiration checks that the credential has not expired
+	CheckExpiration CheckName = "expiration"
+	// CheckSignatureProof checks the BJJSignatureProof2021 of the credential against the auth claim of the issuer
+	CheckSignatureProof CheckName = "BJJSignatureProof2021"
+	// CheckMTPProof checks the Iden3SparseMerkleTreeProof of the credential against the state of the issuer
+	CheckMTPProof CheckName = "Iden3SparseMerkleTreeProof"
+	// CheckJWSProof checks the JsonWebSignature2020 proof of the credential against the DID document of the issuer
+	CheckJWSProof CheckName = "JsonWebSignature2020"
+	// CheckCredentialStatus checks that the credential is not revoked or suspended
+	CheckCredentialStatus CheckName = "credentialStatus"
+)
+
+// CheckStatus is the result of a check
+type CheckStatus string
+
+const (
+	// CheckPassed means that the check succeeded
+	CheckPassed CheckStatus = "passed"
+	// CheckFailed means that the check did not succeed. The message of the check explains why
+	CheckFailed CheckStatus = "failed"
+	// CheckSkipped means that the credential does not include what the check verifies
+	CheckSkipped CheckStatus = "skipped"
+)
+
+// Check is the result of one of the verifications performed on a credential
+type Check struct {
+	Name    CheckName
+	Status  CheckStatus
+	Message string
+}
+
+// Report is the result of verifying a credential.
+// A credential is valid when it has at least one valid proof and none of the checks failed.
+type Report struct {
+	Valid  bool
+	Checks []Check
+}
+
+// CredentialStatus is the revocation status of a credential
+type CredentialStatus struct {
+	Revoked   bool
+	Suspended bool
+}
+
+// CredentialStatusResolver resolves the status of the credentials that use a credential status type
+type CredentialStatusResolver interface {
+	Resolve(ctx context.Context, issuerDID w3c.DID, credentialStatus json.RawMessage) (*CredentialStatus, error)
+}
+
+// StateResolver tells whether a state has been published by the issuer.
+// Genesis states are verified from the issuer DID and never reach the resolver.
+type StateResolver interface {
+	IsPublishedState(ctx context.Context, issuerDID w3c.DID, state *merkletree.Hash) (bool, error)
+}
+
+// DIDDocumentResolver returns the DID document of an issuer. It is used to check the JsonWebSignature2020 proofs.
+type DIDDocumentResolver interface {
+	Resolve(ctx context.Context, did w3c.DID) (json.RawMessage, error)
+}
+
+// Option configures a Verifier
+type Option func(v *Verifier)
+
+// WithCredentialStatusResolver registers the resolver of a credential status type
+func WithCredentialStatusResolver(statusType verifiable.CredentialStatusType, resolver CredentialStatusResolver) Option {
+	return func(v *Verifier) {
+		v.statusResolvers[statusType] = resolver
+	}
+}
+
+// WithDocumentLoader sets the loader of the JSON-LD documents used to rebuild the core claim of the credentials
+func WithDocumentLoader(loader ld.DocumentLoader) Option {
+	return func(v *Verifier) {
+		v.documentLoader = loader
+		v.merklizerOpts = append(v.merklizerOpts, merklize.WithDocumentLoader(loader))
+	}
+}
+
+// WithDIDDocumentResolver sets the resolver of the DID documents of the issuers. Without it, the credentials with a
+// JsonWebSignature2020 proof fail the verification.
+func WithDIDDocumentResolver(resolver DIDDocumentResolver) Option {
+	return func(v *Verifier) {
+		v.didDocumentResolver = resolver
+	}
+}
+
+// Verifier checks the proofs, status and expiration of iden3 W3C credentials and of the credentials signed with a
+// JsonWebSignature2020 proof
+type Verifier struct {
+	stateResolver       StateResolver
+	statusResolvers     map[verifiable.CredentialStatusType]CredentialStatusResolver
+	didDocumentResolver DIDDocumentResolver
+	documentLoader      ld.DocumentLoader
+	merklizerOpts       []merklize.MerklizeOption
+}
+
+// New returns a credential verifier that checks the issuer states with the given resolver
+func New(stateResolver StateResolver, opts ...Option) *Verifier {
+	v := &Verifier{
+		stateResolver:   stateResolver,
+		statusResolvers: make(map[verifiable.CredentialStatusType]CredentialStatusResolver),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Verify checks the credential and returns a report with the result of each check
+func (v *Verifier) Verify(ctx context.Context, credential verifiable.W3CCredential) *Report {
+	report := &Report{}
+	report.add(CheckExpiration, v.checkExpiration(credential))
+
+	issuerDID, err := w3c.ParseDID(credential.Issuer)
+	if err != nil {
+		err = fmt.Errorf("invalid issuer: %w", err)
+		report.add(CheckSignatureProof, err)
+		report.add(CheckMTPProof, err)
+		report.add(CheckJWSProof, err)
+		report.add(CheckCredentialStatus, err)
+		return report.complete()
+	}
+
+	var signatureProof *verifiable.BJJSignatureProof2021
+	var mtpProof *verifiable.Iden3SparseMerkleTreeProof
+	var jwsProof verifiable.CredentialProof
+	for _, proof := range credential.Proof {
+		switch p := proof.(type) {
+		case *verifiable.BJJSignatureProof2021:
+			signatureProof = p
+		case *verifiable.Iden3SparseMerkleTreeProof:
+			mtpProof = p
+		default:
+			if proof.ProofType() == JWSProofType {
+				jwsProof = proof
+			}
+		}
+	}
+
+	if signatureProof == nil {
+		report.add(CheckSignatureProof, errSkipped)
+	} else {
+		report.add(CheckSignatureProof, v.checkSignatureProof(ctx, *issuerDID, credential, signatureProof))
+	}
+
+	if mtpProof == nil {
+		report.add(CheckMTPProof, errSkipped)
+	} else {
+		report.add(CheckMTPProof, v.checkMTPProof(ctx, *issuerDID, credential, mtpProof))
+	}
+
+	if jwsProof == nil {
+		report.add(CheckJWSProof, errSkipped)
+	} else {
+		report.add(CheckJWSProof, v.checkJWSProof(ctx, *issuerDID, credential, jwsProof))
+	}
+
+	report.add(CheckCredentialStatus, v.checkCredentialStatus(ctx, *issuerDID, credential.CredentialStatus))
+	return report.complete()
+}
+
+// errSkipped is used internally to report the checks that do not apply to the credential
+var errSkipped = errors.New("skipped")
+
+func (r *Report) add(name CheckName, err error) {
+	check := Check{Name: name, Status: CheckPassed}
+	switch {
+	case errors.Is(err, errSkipped):
+		check.Status = CheckSkipped
+	case err != nil:
+		check.Status = CheckFailed
+		check.Message = err.Error()
+	}
+	r.Checks = append(r.Checks, check)
+}
+
+func (r *Report) complete() *Report {
+	validProof := false
+	for _, check := range r.Checks {
+		if check.Status == CheckFailed {
+			r.Valid = false
+			return r
+		}
+		if (check.Name == CheckSignatureProof || check.Name == CheckMTPProof || check.Name == CheckJWSProof) && check.Status == CheckPassed {
+			validProof = true
+		}
+	}
+	r.Valid = validProof
+	return r
+}
+
+func (v *Verifier) checkExpiration(credential verifiable.W3CCredential) error {
+	if credential.Expiration == nil {
+		return errSkipped
+	}
+	if credential.Expiration.Before(time.Now()) {
+		return fmt.Errorf("the credential expired at %s", credential.Expiration.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (v *Verifier) checkSignatureProof(ctx context.Context, issuerDID w3c.DID, credential verifiable.W3CCredential, proof *verifiable.BJJSignatureProof2021) error {
+	coreClaim, err := v.checkCoreClaim(ctx, credential, proof.CoreClaim)
+	if err != nil {
+		return err
+	}
+
+	authClaim := &core.Claim{}
+	if err := authClaim.FromHex(proof.IssuerData.AuthCoreClaim); err != nil {
+		return fmt.Errorf("invalid issuer auth claim: %w", err)
+	}
+	if err := v.checkIssuerData(ctx, issuerDID, proof.IssuerData, authClaim); err != nil {
+		return err
+	}
+
+	signature, err := hex.DecodeString(proof.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	var signatureComp babyjub.SignatureComp
+	if len(signature) != len(signatureComp) {
+		return errors.New("invalid signature length")
+	}
+	copy(signatureComp[:], signature)
+	sig, err := signatureComp.Decompress()
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	hashIndex, hashValue, err := coreClaim.HiHv()
+	if err != nil {
+		return err
+	}
+	message, err := poseidon.Hash([]*big.Int{hashIndex, hashValue})
+	if err != nil {
+		return err
+	}
+
+	const (
+		pubKeyXSlot = 2
+		pubKeyYSlot = 3
+	)
+	slots := authClaim.RawSlotsAsInts()
+	pubKey := babyjub.PublicKey{X: slots[pubKeyXSlot], Y: slots[pubKeyYSlot]}
+	if !pubKey.VerifyPoseidon(message, sig) {
+		return errors.New("the signature does not match the issuer auth claim")
+	}
+
+	if proof.IssuerData.CredentialStatus != nil {
+		status, err := v.resolveCredentialStatus(ctx, issuerDID, proof.IssuerData.CredentialStatus)
+		if err != nil {
+			return fmt.Errorf("cannot resolve the status of the issuer auth claim: %w", err)
+		}
+		if status.Revoked {
+			return errors.New("the issuer auth claim that signed the credential is revoked")
+		}
+	}
+	return nil
+}
+
+func (v *Verifier) checkMTPProof(ctx context.Context, issuerDID w3c.DID, credential verifiable.W3CCredential, proof *verifiable.Iden3SparseMerkleTreeProof) error {
+	coreClaim, err := v.checkCoreClaim(ctx, credential, proof.CoreClaim)
+	if err != nil {
+		return err
+	}
+	if proof.MTP == nil {
+		return errors.New("the proof has no merkle tree proof")
+	}
+
+	issuerData := proof.IssuerData
+	issuerData.MTP = proof.MTP
+	return v.checkIssuerData(ctx, issuerDID, issuerData, coreClaim)
+}
+
+// checkIssuerData checks that the claim is included in the claims tree of a valid state of the issuer
+func (v *Verifier) checkIssuerData(ctx context.Context, issuerDID w3c.DID, issuerData verifiable.IssuerData, claim *core.Claim) error {
+	if issuerData.ID != issuerDID.String() {
+		return errors.New("the proof was not created by the issuer of the credential")
+	}
+	if issuerData.MTP == nil || !issuerData.MTP.Existence {
+		return errors.New("the proof has no merkle tree proof of inclusion")
+	}
+	if issuerData.State.Value == nil || issuerData.State.ClaimsTreeRoot == nil {
+		return errors.New("the proof has no issuer state")
+	}
+
+	state, err := merkletree.NewHashFromHex(*issuerData.State.Value)
+	if err != nil {
+		return fmt.Errorf("invalid issuer state: %w", err)
+	}
+	claimsTreeRoot, err := merkletree.NewHashFromHex(*issuerData.State.ClaimsTreeRoot)
+	if err != nil {
+		return fmt.Errorf("invalid claims tree root: %w", err)
+	}
+	revocationTreeRoot, rootOfRoots := &merkletree.HashZero, &merkletree.HashZero
+	if issuerData.State.RevocationTreeRoot != nil {
+		if revocationTreeRoot, err = merkletree.NewHashFromHex(*issuerData.State.RevocationTreeRoot); err != nil {
+			return fmt.Errorf("invalid revocation tree root: %w", err)
+		}
+	}
+	if issuerData.State.RootOfRoots != nil {
+		if rootOfRoots, err = merkletree.NewHashFromHex(*issuerData.State.RootOfRoots); err != nil {
+			return fmt.Errorf("invalid root of roots: %w", err)
+		}
+	}
+	expectedState, err := merkletree.HashElems(claimsTreeRoot.BigInt(), revocationTreeRoot.BigInt(), rootOfRoots.BigInt())
+	if err != nil {
+		return err
+	}
+	if !expectedState.Equals(state) {
+		return errors.New("the issuer state does not match the tree roots")
+	}
+
+	hashIndex, hashValue, err := claim.HiHv()
+	if err != nil {
+		return err
+	}
+	if !merkletree.VerifyProof(claimsTreeRoot, issuerData.MTP, hashIndex, hashValue) {
+		return errors.New("the claim is not included in the issuer claims tree")
+	}
+
+	id, err := core.IDFromDID(issuerDID)
+	if err != nil {
+		return fmt.Errorf("invalid issuer: %w", err)
+	}
+	genesis, err := core.CheckGenesisStateID(id.BigInt(), state.BigInt())
+	if err != nil {
+		return err
+	}
+	if genesis {
+		return nil
+	}
+	published, err := v.stateResolver.IsPublishedState(ctx, issuerDID, state)
+	if err != nil {
+		return fmt.Errorf("cannot resolve the issuer state: %w", err)
+	}
+	if !published {
+		return errors.New("the issuer state has not been published")
+	}
+	return nil
+}
+
+// checkCoreClaim decodes the core claim of a proof and checks that it was built from the credential
+func (v *Verifier) checkCoreClaim(ctx context.Context, credential verifiable.W3CCredential, coreClaimHex string) (*core.Claim, error) {
+	coreClaim := &core.Claim{}
+	if err := coreClaim.FromHex(coreClaimHex); err != nil {
+		return nil, fmt.Errorf("invalid core claim: %w", err)
+	}
+
+	idPosition, err := coreClaim.GetIDPosition()
+	if err != nil {
+		return nil, fmt.Errorf("invalid core claim: %w", err)
+	}
+	var subjectPosition string
+	switch idPosition {
+	case core.IDPositionIndex:
+		subjectPosition = utils.SubjectPositionIndex
+	case core.IDPositionValue:
+		subjectPosition = utils.SubjectPositionValue
+	}
+	merklizedPosition, err := coreClaim.GetMerklizedPosition()
+	if err != nil {
+		return nil, fmt.Errorf("invalid core claim: %w", err)
+	}
+	var merklizedRootPosition string
+	switch merklizedPosition {
+	case core.MerklizedRootPositionIndex:
+		merklizedRootPosition = utils.MerklizedRootPositionIndex
+	case core.MerklizedRootPositionValue:
+		merklizedRootPosition = utils.MerklizedRootPositionValue
+	}
+
+	credentialClaim, err := jsonSuite.Parser{}.ParseClaim(ctx, credential, &processor.CoreClaimOptions{
+		RevNonce:              coreClaim.GetRevocationNonce(),
+		Version:               coreClaim.GetVersion(),
+		SubjectPosition:       subjectPosition,
+		MerklizedRootPosition: merklizedRootPosition,
+		Updatable:             coreClaim.GetFlagUpdatable(),
+		MerklizerOpts:         v.merklizerOpts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot build the core claim of the credential: %w", err)
+	}
+
+	hashIndex, hashValue, err := coreClaim.HiHv()
+	if err != nil {
+		return nil, err
+	}
+	credentialHashIndex, credentialHashValue, err := credentialClaim.HiHv()
+	if err != nil {
+		return nil, err
+	}
+	if hashIndex.Cmp(credentialHashIndex) != 0 || hashValue.Cmp(credentialHashValue) != 0 {
+		return nil, errors.New("the credential does not match the core claim of the proof")
+	}
+	return coreClaim, nil
+}
+
+func (v *Verifier) checkCredentialStatus(ctx context.Context, issuerDID w3c.DID, credentialStatus any) error {
+	if credentialStatus == nil {
+		return errSkipped
+	}
+	status, err := v.resolveCredentialStatus(ctx, issuerDID, credentialStatus)
+	if err != nil {
+		return err
+	}
+	if status.Revoked {
+		return errors.New("the credential is revoked")
+	}
+	if status.Suspended {
+		return errors.New("the credential is suspended")
+	}
+	return nil
+}
+
+// resolveCredentialStatus resolves the credential status with the resolver of its type. When the credential has several
+// statuses, like a revocation and a suspension status list entry, the credential is revoked or suspended if any of them says so.
+func (v *Verifier) resolveCredentialStatus(ctx context.Context, issuerDID w3c.DID, credentialStatus any) (*CredentialStatus, error) {
+	raw, err := json.Marshal(credentialStatus)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credential status: %w", err)
+	}
+	var statuses []json.RawMessage
+	if err := json.Unmarshal(raw, &statuses); err == nil {
+		if len(statuses) == 0 {
+			return nil, errors.New("invalid credential status: no status entries")
+		}
+		result := &CredentialStatus{}
+		for _, entry := range statuses {
+			status, err := v.resolveCredentialStatusEntry(ctx, issuerDID, entry)
+			if err != nil {
+				return nil, err
+			}
+			result.Revoked = result.Revoked || status.Revoked
+			result.Suspended = result.Suspended || status.Suspended
+		}
+		return result, nil
+	}
+	return v.resolveCredentialStatusEntry(ctx, issuerDID, raw)
+}
+
+func (v *Verifier) resolveCredentialStatusEntry(ctx context.Context, issuerDID w3c.DID, raw json.RawMessage) (*CredentialStatus, error) {
+	var status struct {
+		Type verifiable.CredentialStatusType `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &status); err != nil {
+		return nil, fmt.Errorf("invalid credential status: %w", err)
+	}
+	resolver, ok := v.statusResolvers[status.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported credential status type '%s'", status.Type)
+	}
+	return resolver.Resolve(ctx, issuerDID, raw)
+}